@@ -0,0 +1,62 @@
+package api
+
+import "net/http"
+
+// ErrorCode is the error code reported in the Error field of a Response.
+type ErrorCode uint
+
+const (
+	// ErrCodeNone is reported by successful requests.
+	ErrCodeNone ErrorCode = iota
+	// ErrCodeInternal is reported when the node fails to serve a valid request. Its value
+	// is the one previously reported by the legacy endpoints for all errors.
+	ErrCodeInternal
+	// ErrCodeInvalidParameter is reported when a path, query or body parameter cannot be parsed.
+	ErrCodeInvalidParameter
+	// ErrCodeNotFound is reported when the requested block, transaction or page does not exist.
+	ErrCodeNotFound
+	// ErrCodeRateLimited is reported when the client exceeded its request rate.
+	ErrCodeRateLimited
+	// ErrCodeTransactionRejected is reported when a broadcast transaction fails validation.
+	ErrCodeTransactionRejected
+	// ErrCodeTimeout is reported when the node could not complete the request in time.
+	ErrCodeTimeout
+)
+
+var errorCodeNames = map[ErrorCode]string{
+	ErrCodeNone:                "NONE",
+	ErrCodeInternal:            "INTERNAL",
+	ErrCodeInvalidParameter:    "INVALID_PARAMETER",
+	ErrCodeNotFound:            "NOT_FOUND",
+	ErrCodeRateLimited:         "RATE_LIMITED",
+	ErrCodeTransactionRejected: "TRANSACTION_REJECTED",
+	ErrCodeTimeout:             "TIMEOUT",
+}
+
+// String returns the name of the error code, as listed in the OpenAPI document.
+func (c ErrorCode) String() string {
+	if name, ok := errorCodeNames[c]; ok {
+		return name
+	}
+	return "UNKNOWN"
+}
+
+// HTTPStatus returns the HTTP status code with which the v2 endpoints report the error code.
+func (c ErrorCode) HTTPStatus() int {
+	switch c {
+	case ErrCodeNone:
+		return http.StatusOK
+	case ErrCodeInvalidParameter:
+		return http.StatusBadRequest
+	case ErrCodeNotFound:
+		return http.StatusNotFound
+	case ErrCodeRateLimited:
+		return http.StatusTooManyRequests
+	case ErrCodeTransactionRejected:
+		return http.StatusUnprocessableEntity
+	case ErrCodeTimeout:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,191 @@
+package api
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+const (
+	openAPIPath    = v2PathPrefix + "/openapi.json"
+	openAPIVersion = "3.0.3"
+	apiV2Version   = "2.0.0"
+)
+
+var (
+	pathParamRegex = regexp.MustCompile(`{([^}]+)}`)
+	bigIntType     = reflect.TypeOf(big.Int{})
+)
+
+// route describes an endpoint of the v2 public API.
+type route struct {
+	method    string
+	path      string
+	name      string
+	summary   string
+	handler   http.HandlerFunc
+	query     []*queryParam
+	response  interface{} // Value of the type of the response data, or of its items if paginated.
+	paginated bool
+}
+
+type queryParam struct {
+	name        string
+	typ         string
+	description string
+}
+
+// GetOpenAPIDocument serves the OpenAPI document describing the v2 endpoints.
+func (p *PublicAPIServer) GetOpenAPIDocument(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(p.openAPIDocument())
+}
+
+// openAPIDocument generates the OpenAPI document of the v2 routes. Path parameters are
+// taken from the route paths and response schemas are derived from the response types.
+func (p *PublicAPIServer) openAPIDocument() map[string]interface{} {
+	paths := make(map[string]interface{})
+	for _, rt := range p.v2Routes() {
+		params := make([]interface{}, 0)
+		for _, match := range pathParamRegex.FindAllStringSubmatch(rt.path, -1) {
+			params = append(params, map[string]interface{}{
+				"name":     match[1],
+				"in":       "path",
+				"required": true,
+				"schema":   map[string]interface{}{"type": "string"},
+			})
+		}
+		for _, q := range rt.query {
+			params = append(params, map[string]interface{}{
+				"name":        q.name,
+				"in":          "query",
+				"required":    false,
+				"description": q.description,
+				"schema":      map[string]interface{}{"type": q.typ},
+			})
+		}
+
+		data := schemaOf(reflect.TypeOf(rt.response))
+		if rt.paginated {
+			data = schemaOf(reflect.TypeOf(PaginatedResponse{}))
+			data["properties"].(map[string]interface{})["items"] = map[string]interface{}{
+				"type":  "array",
+				"items": schemaOf(reflect.TypeOf(rt.response)),
+			}
+		}
+		operations, ok := paths[rt.path].(map[string]interface{})
+		if !ok {
+			operations = make(map[string]interface{})
+			paths[rt.path] = operations
+		}
+		operations[strings.ToLower(rt.method)] = map[string]interface{}{
+			"operationId": rt.name,
+			"summary":     rt.summary,
+			"parameters":  params,
+			"responses": map[string]interface{}{
+				"200": responseSchema("Successful response.", data),
+				"default": responseSchema("Error response, whose error field holds the error code.",
+					map[string]interface{}{"nullable": true}),
+			},
+		}
+	}
+
+	return map[string]interface{}{
+		"openapi": openAPIVersion,
+		"info": map[string]interface{}{
+			"title":   "Zond public API",
+			"version": apiV2Version,
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": map[string]interface{}{
+				"ErrorCode": errorCodeSchema(),
+			},
+		},
+	}
+}
+
+func responseSchema(description string, data map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"description": description,
+		"content": map[string]interface{}{
+			"application/json": map[string]interface{}{
+				"schema": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"error":        map[string]interface{}{"$ref": "#/components/schemas/ErrorCode"},
+						"errorMessage": map[string]interface{}{"type": "string"},
+						"data":         data,
+					},
+				},
+			},
+		},
+	}
+}
+
+func errorCodeSchema() map[string]interface{} {
+	codes := make([]interface{}, 0, len(errorCodeNames))
+	names := make([]string, 0, len(errorCodeNames))
+	for code := ErrCodeNone; int(code) < len(errorCodeNames); code++ {
+		codes = append(codes, uint(code))
+		names = append(names, code.String())
+	}
+	return map[string]interface{}{
+		"type":            "integer",
+		"enum":            codes,
+		"x-enum-varnames": names,
+	}
+}
+
+// schemaOf returns the JSON schema of the JSON encoding of the given type.
+func schemaOf(t reflect.Type) map[string]interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == bigIntType {
+		return map[string]interface{}{"type": "integer"}
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		properties := make(map[string]interface{})
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+			if name == "-" {
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+			properties[name] = schemaOf(field.Type)
+		}
+		return map[string]interface{}{"type": "object", "properties": properties}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": "string", "format": "byte"}
+		}
+		return map[string]interface{}{"type": "array", "items": schemaOf(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": schemaOf(t.Elem())}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer", "format": "uint64", "minimum": 0}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	default:
+		// Interface fields, such as the transaction of a TransactionResponse, may hold any value.
+		return map[string]interface{}{}
+	}
+}
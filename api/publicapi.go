@@ -11,13 +11,16 @@ import (
 	"github.com/rs/cors"
 	log "github.com/sirupsen/logrus"
 	"github.com/theQRL/zond/api/view"
+	"github.com/theQRL/zond/block"
 	"github.com/theQRL/zond/chain"
 	"github.com/theQRL/zond/common"
 	"github.com/theQRL/zond/config"
+	"github.com/theQRL/zond/core/state"
 	"github.com/theQRL/zond/misc"
 	"github.com/theQRL/zond/ntp"
 	"github.com/theQRL/zond/p2p/messages"
 	"github.com/theQRL/zond/protos"
+	"github.com/theQRL/zond/transactions/pool"
 )
 
 type GetHeightResponse struct {
@@ -33,13 +36,26 @@ type GetVersionResponse struct {
 }
 
 type Response struct {
-	Error        uint        `json:"error"`
+	Error        ErrorCode   `json:"error"`
 	ErrorMessage string      `json:"errorMessage"`
 	Data         interface{} `json:"data"`
 }
 
+// publicAPIChain is the part of the chain served by the public API.
+type publicAPIChain interface {
+	GetLastBlock() *block.Block
+	GetBlock(headerHash common.Hash) (*block.Block, error)
+	GetBlockByNumber(number uint64) *block.Block
+	GetTransactionMetaDataByHash(txHash common.Hash) (*protos.Transaction, common.Hash, uint64, uint64)
+	Height() uint64
+	AccountDB() (*state.StateDB, error)
+	ValidateTransaction(protoTx *protos.Transaction) error
+	GetTransactionPool() *pool.TransactionPool
+	EVMCall(contractAddress common.Address, data []byte) ([]byte, error)
+}
+
 type PublicAPIServer struct {
-	chain                    *chain.Chain
+	chain                    publicAPIChain
 	ntp                      ntp.NTPInterface
 	config                   *config.Config
 	visitors                 *visitors
@@ -47,9 +63,36 @@ type PublicAPIServer struct {
 }
 
 func (p *PublicAPIServer) Start() {
-	c := config.GetConfig()
+	c := p.config.User.API.PublicAPI
+	go p.visitors.cleanupVisitors()
+	log.Fatal(http.ListenAndServe(fmt.Sprintf("%s:%d", c.Host, c.Port), p.newHandler()))
+}
+
+// newHandler returns the router wrapped by the CORS policy, which only allows cross-origin
+// requests from the configured origins.
+func (p *PublicAPIServer) newHandler() http.Handler {
+	allowedOrigins := p.config.User.API.PublicAPI.CORSAllowedOrigins
+	// cors allows every origin when none is given, so CORS support is left out instead.
+	if len(allowedOrigins) == 0 {
+		return p.newRouter()
+	}
+	co := cors.New(cors.Options{
+		AllowedOrigins: allowedOrigins,
+		AllowedMethods: []string{
+			http.MethodGet,
+			http.MethodPost,
+			http.MethodOptions,
+			http.MethodHead,
+		},
+		AllowedHeaders: []string{"Content-Type"},
+	})
+	return co.Handler(p.newRouter())
+}
 
+// newRouter registers the legacy and v2 endpoints, all of which are subject to the rate limiter.
+func (p *PublicAPIServer) newRouter() *mux.Router {
 	router := mux.NewRouter()
+	router.Use(p.visitors.middleware)
 	router.HandleFunc("/api/", p.RedirectToAPIDoc).Methods("GET")
 	router.HandleFunc("/api/version", p.GetVersion).Methods("GET")
 	router.HandleFunc("/api/block/{hash}", p.GetBlockByHash).Methods("GET")
@@ -61,32 +104,16 @@ func (p *PublicAPIServer) Start() {
 	router.HandleFunc("/api/broadcast/stake", p.BroadcastStakeTx).Methods("POST")
 	router.HandleFunc("/api/height", p.GetHeight).Methods("GET")
 	router.HandleFunc("/api/evmcall", p.EVMCall).Methods("POST")
-	//handler := cors.Default().Handler(router)
-	co := cors.New(cors.Options{
-		AllowedOrigins: []string{"*"}, // service is available and allowed for this base url
-		AllowedMethods: []string{
-			http.MethodGet, //http methods for your app
-			http.MethodPost,
-			http.MethodPut,
-			http.MethodPatch,
-			http.MethodDelete,
-			http.MethodOptions,
-			http.MethodHead,
-			"post",
-			"*",
-			"*/*",
-		},
-
-		AllowedHeaders: []string{
-			"*", //or you can your header key values which you are using in your application
 
-		},
-	})
+	for _, rt := range p.v2Routes() {
+		router.HandleFunc(rt.path, rt.handler).Methods(rt.method)
+	}
+	router.HandleFunc(openAPIPath, p.GetOpenAPIDocument).Methods("GET")
 	router.StrictSlash(false)
-	log.Fatal(http.ListenAndServe(fmt.Sprintf("%s:%d", c.User.API.PublicAPI.Host, c.User.API.PublicAPI.Port), co.Handler(router)))
+	return router
 }
 
-func (p *PublicAPIServer) prepareResponse(errorCode uint, errorMessage string, data interface{}) *Response {
+func (p *PublicAPIServer) prepareResponse(errorCode ErrorCode, errorMessage string, data interface{}) *Response {
 	r := &Response{
 		Error:        errorCode,
 		ErrorMessage: errorMessage,
@@ -96,37 +123,22 @@ func (p *PublicAPIServer) prepareResponse(errorCode uint, errorMessage string, d
 }
 
 func (p *PublicAPIServer) RedirectToAPIDoc(w http.ResponseWriter, r *http.Request) {
-	// Check Rate Limit
-	if !p.visitors.isAllowed(r.RemoteAddr) {
-		w.WriteHeader(429)
-		return
-	}
-	http.Redirect(w, r, "https://api.theqrl.org/", 301)
+	http.Redirect(w, r, openAPIPath, http.StatusMovedPermanently)
 }
 
 func (p *PublicAPIServer) GetVersion(w http.ResponseWriter, r *http.Request) {
-	// Check Rate Limit
-	if !p.visitors.isAllowed(r.RemoteAddr) {
-		w.WriteHeader(429)
-		return
-	}
 	fmt.Println("Get version called")
 	getVersionResponse := &GetVersionResponse{
 		Version: p.config.Dev.Version,
 	}
-	json.NewEncoder(w).Encode(p.prepareResponse(0, "", getVersionResponse))
+	json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeNone, "", getVersionResponse))
 }
 
 func (p *PublicAPIServer) GetBlockByHash(w http.ResponseWriter, r *http.Request) {
-	// Check Rate Limit
-	if !p.visitors.isAllowed(r.RemoteAddr) {
-		w.WriteHeader(429)
-		return
-	}
 	vars := mux.Vars(r)
 	hash, found := vars["hash"]
 	if !found {
-		json.NewEncoder(w).Encode(p.prepareResponse(1,
+		json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeInvalidParameter,
 			"block hash not provided",
 			nil))
 		return
@@ -136,51 +148,41 @@ func (p *PublicAPIServer) GetBlockByHash(w http.ResponseWriter, r *http.Request)
 	copy(headerHash[:], binData)
 
 	if err != nil {
-		json.NewEncoder(w).Encode(p.prepareResponse(1,
+		json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeInvalidParameter,
 			fmt.Sprintf("Error Decoding headerHash\n %s", err.Error()),
 			nil))
 		return
 	}
 	b, err := p.chain.GetBlock(headerHash)
 	if err != nil {
-		json.NewEncoder(w).Encode(p.prepareResponse(1,
+		json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeNotFound,
 			fmt.Sprintf("Error in GetBlock for headerHash %s\n %s", hash, err.Error()),
 			nil))
 		return
 	}
 	response := &view.PlainBlock{}
 	response.BlockFromPBData(b.PBData(), b.Hash())
-	json.NewEncoder(w).Encode(p.prepareResponse(0,
+	json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeNone,
 		"",
 		response))
 }
 
 func (p *PublicAPIServer) GetLastBlock(w http.ResponseWriter, r *http.Request) {
-	// Check Rate Limit
-	if !p.visitors.isAllowed(r.RemoteAddr) {
-		w.WriteHeader(429)
-		return
-	}
 	b := p.chain.GetLastBlock()
 
 	response := &view.PlainBlock{}
 	response.BlockFromPBData(b.PBData(), b.Hash())
-	json.NewEncoder(w).Encode(p.prepareResponse(0,
+	json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeNone,
 		"",
 		response))
 }
 
 func (p *PublicAPIServer) GetAddressState(w http.ResponseWriter, r *http.Request) {
-	// Check Rate Limit
-	if !p.visitors.isAllowed(r.RemoteAddr) {
-		w.WriteHeader(429)
-		return
-	}
 	vars := mux.Vars(r)
 	address := vars["address"]
 	binData, err := misc.HexStrToBytes(address)
 	if err != nil {
-		json.NewEncoder(w).Encode(p.prepareResponse(1,
+		json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeInvalidParameter,
 			fmt.Sprintf("Error Decoding address %s\n %s", address, err.Error()),
 			nil))
 		return
@@ -191,7 +193,7 @@ func (p *PublicAPIServer) GetAddressState(w http.ResponseWriter, r *http.Request
 
 	statedb, err := p.chain.AccountDB()
 	if err != nil {
-		json.NewEncoder(w).Encode(p.prepareResponse(1,
+		json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeInternal,
 			fmt.Sprintf("Failed to get statedb %s\n %s", address, err.Error()),
 			nil))
 		return
@@ -203,22 +205,17 @@ func (p *PublicAPIServer) GetAddressState(w http.ResponseWriter, r *http.Request
 	response := &view.PlainAddressState{}
 	response.FromData(binAddress, balance, nonce)
 
-	json.NewEncoder(w).Encode(p.prepareResponse(0,
+	json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeNone,
 		"",
 		response))
 }
 
 func (p *PublicAPIServer) GetBalance(w http.ResponseWriter, r *http.Request) {
-	// Check Rate Limit
-	if !p.visitors.isAllowed(r.RemoteAddr) {
-		w.WriteHeader(429)
-		return
-	}
 	vars := mux.Vars(r)
 	address := vars["address"]
 	binData, err := misc.HexStrToBytes(address)
 	if err != nil {
-		json.NewEncoder(w).Encode(p.prepareResponse(1,
+		json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeInvalidParameter,
 			fmt.Sprintf("Error Decoding address %s\n %s", address, err.Error()),
 			nil))
 		return
@@ -229,7 +226,7 @@ func (p *PublicAPIServer) GetBalance(w http.ResponseWriter, r *http.Request) {
 
 	statedb, err := p.chain.AccountDB()
 	if err != nil {
-		json.NewEncoder(w).Encode(p.prepareResponse(1,
+		json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeInternal,
 			fmt.Sprintf("Failed to get statedb %s\n %s", address, err.Error()),
 			nil))
 		return
@@ -239,68 +236,48 @@ func (p *PublicAPIServer) GetBalance(w http.ResponseWriter, r *http.Request) {
 
 	response := view.PlainBalance{}
 	response.Balance = strconv.FormatUint(balance, 10)
-	json.NewEncoder(w).Encode(p.prepareResponse(0,
+	json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeNone,
 		"",
 		response))
 }
 
 func (p *PublicAPIServer) GetHeight(w http.ResponseWriter, r *http.Request) {
-	// Check Rate Limit
-	if !p.visitors.isAllowed(r.RemoteAddr) {
-		w.WriteHeader(429)
-		return
-	}
 	response := &GetHeightResponse{Height: p.chain.Height()}
-	json.NewEncoder(w).Encode(p.prepareResponse(0,
+	json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeNone,
 		"",
 		response))
 }
 
 func (p *PublicAPIServer) GetNetworkStats(w http.ResponseWriter, r *http.Request) {
-	// Check Rate Limit
-	if !p.visitors.isAllowed(r.RemoteAddr) {
-		w.WriteHeader(429)
-		return
-	}
 }
 
 func (p *PublicAPIServer) GetEstimatedNetworkFee(w http.ResponseWriter, r *http.Request) {
-	// Check Rate Limit
-	if !p.visitors.isAllowed(r.RemoteAddr) {
-		w.WriteHeader(429)
-		return
-	}
 	// TODO: Fee needs to be calcuated by mean, median or mode
 	response := &GetEstimatedNetworkFeeResponse{Fee: "1"}
-	json.NewEncoder(w).Encode(p.prepareResponse(0,
+	json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeNone,
 		"",
 		response))
 }
 
 func (p *PublicAPIServer) BroadcastStakeTx(w http.ResponseWriter, r *http.Request) {
-	// Check Rate Limit
-	if !p.visitors.isAllowed(r.RemoteAddr) {
-		w.WriteHeader(429)
-		return
-	}
 	decoder := json.NewDecoder(r.Body)
 	var plainStakeTransaction view.PlainStakeTransaction
 	err := decoder.Decode(&plainStakeTransaction)
 	if err != nil {
-		json.NewEncoder(w).Encode(p.prepareResponse(1,
+		json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeInvalidParameter,
 			fmt.Sprintf("Error Decoding PlainStakeTransaction \n%s", err.Error()),
 			nil))
 		return
 	}
 	tx, err := plainStakeTransaction.ToStakeTransactionObject()
 	if err != nil {
-		json.NewEncoder(w).Encode(p.prepareResponse(1,
+		json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeInvalidParameter,
 			fmt.Sprintf("Error parsing ToStakeTransactionObject\n %s", err.Error()),
 			nil))
 		return
 	}
 	if err := p.chain.ValidateTransaction(tx.PBData()); err != nil {
-		json.NewEncoder(w).Encode(p.prepareResponse(1,
+		json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeTransactionRejected,
 			err.Error(),
 			nil))
 		return
@@ -313,7 +290,7 @@ func (p *PublicAPIServer) BroadcastStakeTx(w http.ResponseWriter, r *http.Reques
 		p.chain.GetLastBlock().SlotNumber(),
 		p.ntp.Time())
 	if err != nil {
-		json.NewEncoder(w).Encode(p.prepareResponse(1,
+		json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeTransactionRejected,
 			fmt.Sprintf("Failed to Add Txn into txn pool \n %s", err.Error()),
 			nil))
 		return
@@ -331,7 +308,7 @@ func (p *PublicAPIServer) BroadcastStakeTx(w http.ResponseWriter, r *http.Reques
 	select {
 	case p.registerAndBroadcastChan <- registerMessage:
 	case <-time.After(10 * time.Second):
-		json.NewEncoder(w).Encode(p.prepareResponse(1,
+		json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeTimeout,
 			"Transaction Broadcast Timeout",
 			nil))
 		return
@@ -340,36 +317,31 @@ func (p *PublicAPIServer) BroadcastStakeTx(w http.ResponseWriter, r *http.Reques
 	response := &BroadcastTransactionResponse{
 		TransactionHash: misc.BytesToHexStr(txHash[:]),
 	}
-	json.NewEncoder(w).Encode(p.prepareResponse(0,
+	json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeNone,
 		"",
 		response))
 }
 
 func (p *PublicAPIServer) BroadcastTransferTx(w http.ResponseWriter, r *http.Request) {
-	// Check Rate Limit
-	if !p.visitors.isAllowed(r.RemoteAddr) {
-		w.WriteHeader(429)
-		return
-	}
 	decoder := json.NewDecoder(r.Body)
 	var plainTransferTransaction view.PlainTransferTransaction
 	err := decoder.Decode(&plainTransferTransaction)
 	if err != nil {
-		json.NewEncoder(w).Encode(p.prepareResponse(1,
+		json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeInvalidParameter,
 			fmt.Sprintf("Error Decoding PlainTransferTransaction \n%s", err.Error()),
 			nil))
 		return
 	}
 	tx, err := plainTransferTransaction.ToTransferTransactionObject()
 	if err != nil {
-		json.NewEncoder(w).Encode(p.prepareResponse(1,
+		json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeInvalidParameter,
 			fmt.Sprintf("Error parsing ToTransferTransactionObject\n %s", err.Error()),
 			nil))
 		return
 	}
 
 	if err := p.chain.ValidateTransaction(tx.PBData()); err != nil {
-		json.NewEncoder(w).Encode(p.prepareResponse(1,
+		json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeTransactionRejected,
 			err.Error(),
 			nil))
 		return
@@ -382,7 +354,7 @@ func (p *PublicAPIServer) BroadcastTransferTx(w http.ResponseWriter, r *http.Req
 		p.chain.GetLastBlock().SlotNumber(),
 		p.ntp.Time())
 	if err != nil {
-		json.NewEncoder(w).Encode(p.prepareResponse(1,
+		json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeTransactionRejected,
 			fmt.Sprintf("Failed to Add Txn into txn pool \n %s", err.Error()),
 			nil))
 		return
@@ -400,7 +372,7 @@ func (p *PublicAPIServer) BroadcastTransferTx(w http.ResponseWriter, r *http.Req
 	select {
 	case p.registerAndBroadcastChan <- registerMessage:
 	case <-time.After(10 * time.Second):
-		json.NewEncoder(w).Encode(p.prepareResponse(1,
+		json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeTimeout,
 			"Transaction Broadcast Timeout",
 			nil))
 		return
@@ -408,23 +380,17 @@ func (p *PublicAPIServer) BroadcastTransferTx(w http.ResponseWriter, r *http.Req
 	response := &BroadcastTransactionResponse{
 		TransactionHash: misc.BytesToHexStr(txHash[:]),
 	}
-	json.NewEncoder(w).Encode(p.prepareResponse(0,
+	json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeNone,
 		"",
 		response))
 }
 
 func (p *PublicAPIServer) EVMCall(w http.ResponseWriter, r *http.Request) {
-	// Check Rate Limit
-	if !p.visitors.isAllowed(r.RemoteAddr) {
-		w.WriteHeader(429)
-		return
-	}
-
 	decoder := json.NewDecoder(r.Body)
 	var evmCall view.EVMCall
 	err := decoder.Decode(&evmCall)
 	if err != nil {
-		json.NewEncoder(w).Encode(p.prepareResponse(1,
+		json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeInvalidParameter,
 			fmt.Sprintf("Error Decoding EVMCall \n%s", err.Error()),
 			nil))
 		return
@@ -432,7 +398,7 @@ func (p *PublicAPIServer) EVMCall(w http.ResponseWriter, r *http.Request) {
 
 	output, err := misc.HexStrToBytes(evmCall.Address)
 	if err != nil {
-		json.NewEncoder(w).Encode(p.prepareResponse(1,
+		json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeInvalidParameter,
 			fmt.Sprintf("Error while decoding address \n%s", err.Error()),
 			nil))
 		return
@@ -443,7 +409,7 @@ func (p *PublicAPIServer) EVMCall(w http.ResponseWriter, r *http.Request) {
 
 	dataOutput, err := misc.HexStrToBytes(evmCall.Data)
 	if err != nil {
-		json.NewEncoder(w).Encode(p.prepareResponse(1,
+		json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeInvalidParameter,
 			fmt.Sprintf("Error while decoding data \n%s", err.Error()),
 			nil))
 		return
@@ -462,17 +428,18 @@ func (p *PublicAPIServer) EVMCall(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	json.NewEncoder(w).Encode(p.prepareResponse(0,
+	json.NewEncoder(w).Encode(p.prepareResponse(ErrCodeNone,
 		"",
 		response))
 }
 
 func NewPublicAPIServer(c *chain.Chain, registerAndBroadcastChan chan *messages.RegisterMessage) *PublicAPIServer {
+	apiConfig := config.GetConfig().User.API.PublicAPI
 	return &PublicAPIServer{
 		chain:                    c,
 		ntp:                      ntp.GetNTP(),
 		config:                   config.GetConfig(),
-		visitors:                 newVisitors(),
+		visitors:                 newVisitors(apiConfig.RateLimit, apiConfig.RateLimitBurst),
 		registerAndBroadcastChan: registerAndBroadcastChan,
 	}
 }
@@ -0,0 +1,426 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/theQRL/zond/api/pagination"
+	"github.com/theQRL/zond/api/view"
+	"github.com/theQRL/zond/block"
+	"github.com/theQRL/zond/common"
+	"github.com/theQRL/zond/misc"
+	"github.com/theQRL/zond/protos"
+	"github.com/theQRL/zond/transactions"
+)
+
+const (
+	v2PathPrefix = "/api/v2"
+
+	defaultV2PageSize = 25
+	maxV2PageSize     = 100
+
+	// maxAddressHistoryScanDepth is the maximum number of blocks scanned by a single
+	// request for the transaction history of an address. When the limit is reached
+	// before the page is filled, the page is returned with a token to resume the scan.
+	maxAddressHistoryScanDepth = 1024
+)
+
+var (
+	pageTokenParam = &queryParam{
+		name:        "page_token",
+		typ:         "string",
+		description: "Token of the page to return, as returned in nextPageToken. The first page is returned when empty.",
+	}
+	pageSizeParam = &queryParam{
+		name:        "page_size",
+		typ:         "integer",
+		description: fmt.Sprintf("Number of items per page, at most %d. Defaults to %d.", maxV2PageSize, defaultV2PageSize),
+	}
+)
+
+// v2Routes returns the routes of the v2 public API. The same routes are used to register
+// the handlers and to generate the OpenAPI document served at openAPIPath.
+func (p *PublicAPIServer) v2Routes() []*route {
+	return []*route{
+		{
+			method:   http.MethodGet,
+			path:     v2PathPrefix + "/version",
+			name:     "getVersion",
+			summary:  "Returns the version of the node.",
+			handler:  p.GetVersionV2,
+			response: GetVersionResponse{},
+		},
+		{
+			method:   http.MethodGet,
+			path:     v2PathPrefix + "/height",
+			name:     "getHeight",
+			summary:  "Returns the slot number of the head block.",
+			handler:  p.GetHeightV2,
+			response: GetHeightResponse{},
+		},
+		{
+			method:    http.MethodGet,
+			path:      v2PathPrefix + "/blocks",
+			name:      "listBlocks",
+			summary:   "Lists the blocks of the canonical chain, starting from the head block.",
+			handler:   p.ListBlocksV2,
+			query:     []*queryParam{pageTokenParam, pageSizeParam},
+			response:  BlockResponse{},
+			paginated: true,
+		},
+		{
+			method:   http.MethodGet,
+			path:     v2PathPrefix + "/blocks/{block_id}",
+			name:     "getBlock",
+			summary:  "Returns the block identified by its header hash, its slot number or \"latest\".",
+			handler:  p.GetBlockV2,
+			response: BlockResponse{},
+		},
+		{
+			method:    http.MethodGet,
+			path:      v2PathPrefix + "/blocks/{block_id}/transactions",
+			name:      "listBlockTransactions",
+			summary:   "Lists the transactions of the block identified by its header hash, its slot number or \"latest\".",
+			handler:   p.ListBlockTransactionsV2,
+			query:     []*queryParam{pageTokenParam, pageSizeParam},
+			response:  TransactionResponse{},
+			paginated: true,
+		},
+		{
+			method:   http.MethodGet,
+			path:     v2PathPrefix + "/transactions/{hash}",
+			name:     "getTransaction",
+			summary:  "Returns the transaction with the given hash, along with the block including it.",
+			handler:  p.GetTransactionV2,
+			response: TransactionResponse{},
+		},
+		{
+			method:   http.MethodGet,
+			path:     v2PathPrefix + "/addresses/{address}",
+			name:     "getAddressState",
+			summary:  "Returns the balance and nonce of the address at the head block.",
+			handler:  p.GetAddressStateV2,
+			response: view.PlainAddressState{},
+		},
+		{
+			method: http.MethodGet,
+			path:   v2PathPrefix + "/addresses/{address}/transactions",
+			name:   "listAddressTransactions",
+			summary: fmt.Sprintf("Lists the transactions sent or received by the address, starting from the head block. "+
+				"At most %d blocks are scanned per request, so a page may hold fewer items than requested "+
+				"while further pages are available.", maxAddressHistoryScanDepth),
+			handler:   p.ListAddressTransactionsV2,
+			query:     []*queryParam{pageTokenParam, pageSizeParam},
+			response:  TransactionResponse{},
+			paginated: true,
+		},
+	}
+}
+
+func (p *PublicAPIServer) GetVersionV2(w http.ResponseWriter, _ *http.Request) {
+	p.writeV2Response(w, ErrCodeNone, "", &GetVersionResponse{Version: p.config.Dev.Version})
+}
+
+func (p *PublicAPIServer) GetHeightV2(w http.ResponseWriter, _ *http.Request) {
+	p.writeV2Response(w, ErrCodeNone, "", &GetHeightResponse{Height: p.chain.Height()})
+}
+
+func (p *PublicAPIServer) ListBlocksV2(w http.ResponseWriter, r *http.Request) {
+	pageSize, err := parsePageSize(r)
+	if err != nil {
+		p.writeV2Response(w, ErrCodeInvalidParameter, err.Error(), nil)
+		return
+	}
+	next := p.chain.GetLastBlock()
+	if token := r.URL.Query().Get(pageTokenParam.name); token != "" {
+		hash, err := parseHash(token)
+		if err != nil {
+			p.writeV2Response(w, ErrCodeInvalidParameter, fmt.Sprintf("invalid page token: %s", err), nil)
+			return
+		}
+		next, err = p.chain.GetBlock(hash)
+		if err != nil {
+			p.writeV2Response(w, ErrCodeNotFound, fmt.Sprintf("block of page token %s not found", token), nil)
+			return
+		}
+	}
+
+	items := make([]*BlockResponse, 0, pageSize)
+	for next != nil && len(items) < pageSize {
+		items = append(items, newBlockResponse(next))
+		next = p.parentBlock(next)
+	}
+	nextPageToken := ""
+	if next != nil {
+		hash := next.Hash()
+		nextPageToken = misc.BytesToHexStr(hash[:])
+	}
+	p.writeV2Response(w, ErrCodeNone, "", &PaginatedResponse{
+		Items:         items,
+		NextPageToken: nextPageToken,
+	})
+}
+
+func (p *PublicAPIServer) GetBlockV2(w http.ResponseWriter, r *http.Request) {
+	b, code, err := p.blockByID(mux.Vars(r)["block_id"])
+	if err != nil {
+		p.writeV2Response(w, code, err.Error(), nil)
+		return
+	}
+	p.writeV2Response(w, ErrCodeNone, "", newBlockResponse(b))
+}
+
+func (p *PublicAPIServer) ListBlockTransactionsV2(w http.ResponseWriter, r *http.Request) {
+	pageSize, err := parsePageSize(r)
+	if err != nil {
+		p.writeV2Response(w, ErrCodeInvalidParameter, err.Error(), nil)
+		return
+	}
+	b, code, err := p.blockByID(mux.Vars(r)["block_id"])
+	if err != nil {
+		p.writeV2Response(w, code, err.Error(), nil)
+		return
+	}
+
+	txs := b.Transactions()
+	items := make([]*TransactionResponse, 0)
+	nextPageToken := ""
+	if len(txs) > 0 {
+		start, end, token, err := pagination.StartAndEndPage(r.URL.Query().Get(pageTokenParam.name), pageSize, len(txs))
+		if err != nil {
+			p.writeV2Response(w, ErrCodeInvalidParameter, fmt.Sprintf("invalid page token: %s", err), nil)
+			return
+		}
+		for i := start; i < end; i++ {
+			items = append(items, newTransactionResponse(txs[i], b.Hash(), b.Number(), uint64(i)))
+		}
+		nextPageToken = token
+	}
+	p.writeV2Response(w, ErrCodeNone, "", &PaginatedResponse{
+		Items:         items,
+		NextPageToken: nextPageToken,
+		TotalSize:     len(txs),
+	})
+}
+
+func (p *PublicAPIServer) GetTransactionV2(w http.ResponseWriter, r *http.Request) {
+	hash, err := parseHash(mux.Vars(r)["hash"])
+	if err != nil {
+		p.writeV2Response(w, ErrCodeInvalidParameter, fmt.Sprintf("invalid transaction hash: %s", err), nil)
+		return
+	}
+	tx, blockHash, blockNumber, index := p.chain.GetTransactionMetaDataByHash(hash)
+	if tx == nil {
+		p.writeV2Response(w, ErrCodeNotFound, fmt.Sprintf("transaction %s not found", mux.Vars(r)["hash"]), nil)
+		return
+	}
+	p.writeV2Response(w, ErrCodeNone, "", newTransactionResponse(tx, blockHash, blockNumber, index))
+}
+
+func (p *PublicAPIServer) GetAddressStateV2(w http.ResponseWriter, r *http.Request) {
+	address, err := parseAddress(mux.Vars(r)["address"])
+	if err != nil {
+		p.writeV2Response(w, ErrCodeInvalidParameter, fmt.Sprintf("invalid address: %s", err), nil)
+		return
+	}
+	statedb, err := p.chain.AccountDB()
+	if err != nil {
+		p.writeV2Response(w, ErrCodeInternal, fmt.Sprintf("could not get account state: %s", err), nil)
+		return
+	}
+
+	response := &view.PlainAddressState{}
+	response.FromData(address, statedb.GetBalance(address).Uint64(), statedb.GetNonce(address))
+	p.writeV2Response(w, ErrCodeNone, "", response)
+}
+
+func (p *PublicAPIServer) ListAddressTransactionsV2(w http.ResponseWriter, r *http.Request) {
+	address, err := parseAddress(mux.Vars(r)["address"])
+	if err != nil {
+		p.writeV2Response(w, ErrCodeInvalidParameter, fmt.Sprintf("invalid address: %s", err), nil)
+		return
+	}
+	pageSize, err := parsePageSize(r)
+	if err != nil {
+		p.writeV2Response(w, ErrCodeInvalidParameter, err.Error(), nil)
+		return
+	}
+	next, index, code, err := p.addressHistoryCursor(r.URL.Query().Get(pageTokenParam.name))
+	if err != nil {
+		p.writeV2Response(w, code, err.Error(), nil)
+		return
+	}
+
+	items := make([]*TransactionResponse, 0, pageSize)
+	for scanned := 0; next != nil && scanned < maxAddressHistoryScanDepth && len(items) < pageSize; scanned++ {
+		txs := next.Transactions()
+		for ; index < len(txs) && len(items) < pageSize; index++ {
+			if isAddressTransaction(txs[index], address) {
+				items = append(items, newTransactionResponse(txs[index], next.Hash(), next.Number(), uint64(index)))
+			}
+		}
+		if index < len(txs) {
+			break
+		}
+		next, index = p.parentBlock(next), 0
+	}
+	nextPageToken := ""
+	if next != nil {
+		hash := next.Hash()
+		nextPageToken = fmt.Sprintf("%s:%d", misc.BytesToHexStr(hash[:]), index)
+	}
+	p.writeV2Response(w, ErrCodeNone, "", &PaginatedResponse{
+		Items:         items,
+		NextPageToken: nextPageToken,
+	})
+}
+
+// writeV2Response writes the response with the HTTP status code matching its error code,
+// unlike the legacy endpoints which always respond with 200.
+func (p *PublicAPIServer) writeV2Response(w http.ResponseWriter, errorCode ErrorCode, errorMessage string, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(errorCode.HTTPStatus())
+	json.NewEncoder(w).Encode(p.prepareResponse(errorCode, errorMessage, data))
+}
+
+// blockByID returns the block identified by "latest", a header hash or a slot number.
+func (p *PublicAPIServer) blockByID(id string) (*block.Block, ErrorCode, error) {
+	if id == "latest" {
+		return p.chain.GetLastBlock(), ErrCodeNone, nil
+	}
+	if strings.HasPrefix(id, "0x") || len(id) == 2*common.HashLength {
+		hash, err := parseHash(id)
+		if err != nil {
+			return nil, ErrCodeInvalidParameter, errors.Wrap(err, "invalid block hash")
+		}
+		b, err := p.chain.GetBlock(hash)
+		if err != nil {
+			return nil, ErrCodeNotFound, errors.Errorf("block %s not found", id)
+		}
+		return b, ErrCodeNone, nil
+	}
+	slotNumber, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, ErrCodeInvalidParameter, errors.Errorf("invalid block id %s", id)
+	}
+	b := p.chain.GetBlockByNumber(slotNumber)
+	if b == nil {
+		return nil, ErrCodeNotFound, errors.Errorf("block at slot %d not found", slotNumber)
+	}
+	return b, ErrCodeNone, nil
+}
+
+// parentBlock returns the parent of the given block, or nil if the block is the genesis block.
+func (p *PublicAPIServer) parentBlock(b *block.Block) *block.Block {
+	if b.SlotNumber() == 0 {
+		return nil
+	}
+	parent, err := p.chain.GetBlock(b.ParentHash())
+	if err != nil {
+		return nil
+	}
+	return parent
+}
+
+// addressHistoryCursor returns the block and the transaction index from which the transaction
+// history of an address is scanned. Page tokens have the form <block hash>:<transaction index>.
+func (p *PublicAPIServer) addressHistoryCursor(token string) (*block.Block, int, ErrorCode, error) {
+	if token == "" {
+		return p.chain.GetLastBlock(), 0, ErrCodeNone, nil
+	}
+	parts := strings.Split(token, ":")
+	if len(parts) != 2 {
+		return nil, 0, ErrCodeInvalidParameter, errors.New("invalid page token")
+	}
+	hash, err := parseHash(parts[0])
+	if err != nil {
+		return nil, 0, ErrCodeInvalidParameter, errors.Wrap(err, "invalid page token")
+	}
+	index, err := strconv.Atoi(parts[1])
+	if err != nil || index < 0 {
+		return nil, 0, ErrCodeInvalidParameter, errors.New("invalid page token")
+	}
+	b, err := p.chain.GetBlock(hash)
+	if err != nil {
+		return nil, 0, ErrCodeNotFound, errors.Errorf("block of page token %s not found", token)
+	}
+	return b, index, ErrCodeNone, nil
+}
+
+func newBlockResponse(b *block.Block) *BlockResponse {
+	return &BlockResponse{
+		Header:           view.NewPlainBlockHeader(b.PBData().Header, b.Hash()),
+		TransactionCount: len(b.Transactions()),
+	}
+}
+
+func newTransactionResponse(tx *protos.Transaction, blockHash common.Hash, blockNumber, index uint64) *TransactionResponse {
+	var plainTx interface{}
+	switch tx.Type.(type) {
+	case *protos.Transaction_Transfer:
+		transfer := &view.PlainTransferTransaction{}
+		transfer.TransactionFromPBData(tx, tx.Hash)
+		plainTx = transfer
+	case *protos.Transaction_Stake:
+		stake := &view.PlainStakeTransaction{}
+		stake.TransactionFromPBData(tx, tx.Hash)
+		plainTx = stake
+	}
+	return &TransactionResponse{
+		BlockHash:   misc.BytesToHexStr(blockHash[:]),
+		BlockNumber: blockNumber,
+		Index:       index,
+		Transaction: plainTx,
+	}
+}
+
+// isAddressTransaction returns true if the transaction is sent by or transfers to the address.
+func isAddressTransaction(tx *protos.Transaction, address common.Address) bool {
+	if misc.GetAddressFromUnSizedPK(tx.Pk) == address {
+		return true
+	}
+	if transfer, ok := transactions.ProtoToTransaction(tx).(*transactions.Transfer); ok {
+		to := transfer.To()
+		return to != nil && *to == address
+	}
+	return false
+}
+
+func parsePageSize(r *http.Request) (int, error) {
+	raw := r.URL.Query().Get(pageSizeParam.name)
+	if raw == "" {
+		return defaultV2PageSize, nil
+	}
+	pageSize, err := strconv.Atoi(raw)
+	if err != nil || pageSize < 1 || pageSize > maxV2PageSize {
+		return 0, errors.Errorf("page size must be between 1 and %d", maxV2PageSize)
+	}
+	return pageSize, nil
+}
+
+func parseHash(hexHash string) (common.Hash, error) {
+	data, err := misc.HexStrToBytes(hexHash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if len(data) != common.HashLength {
+		return common.Hash{}, errors.Errorf("expected %d bytes, got %d", common.HashLength, len(data))
+	}
+	return common.BytesToHash(data), nil
+}
+
+func parseAddress(hexAddress string) (common.Address, error) {
+	data, err := misc.HexStrToBytes(hexAddress)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(data) != common.AddressLength {
+		return common.Address{}, errors.Errorf("expected %d bytes, got %d", common.AddressLength, len(data))
+	}
+	return common.BytesToAddress(data), nil
+}
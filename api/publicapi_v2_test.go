@@ -0,0 +1,552 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/zond/block"
+	"github.com/theQRL/zond/common"
+	"github.com/theQRL/zond/config"
+	"github.com/theQRL/zond/core/rawdb"
+	"github.com/theQRL/zond/core/state"
+	"github.com/theQRL/zond/misc"
+	"github.com/theQRL/zond/protos"
+	"github.com/theQRL/zond/transactions/pool"
+)
+
+// testChain is an in-memory chain whose block at slot i is blocks[i].
+type testChain struct {
+	blocks  []*block.Block
+	statedb *state.StateDB
+}
+
+func (c *testChain) GetLastBlock() *block.Block {
+	return c.blocks[len(c.blocks)-1]
+}
+
+func (c *testChain) GetBlock(headerHash common.Hash) (*block.Block, error) {
+	for _, b := range c.blocks {
+		if b.Hash() == headerHash {
+			return b, nil
+		}
+	}
+	return nil, errors.New("block not found")
+}
+
+func (c *testChain) GetBlockByNumber(number uint64) *block.Block {
+	if number >= uint64(len(c.blocks)) {
+		return nil
+	}
+	return c.blocks[number]
+}
+
+func (c *testChain) GetTransactionMetaDataByHash(txHash common.Hash) (*protos.Transaction, common.Hash, uint64, uint64) {
+	for _, b := range c.blocks {
+		for i, tx := range b.Transactions() {
+			if common.BytesToHash(tx.Hash) == txHash {
+				return tx, b.Hash(), b.Number(), uint64(i)
+			}
+		}
+	}
+	return nil, common.Hash{}, 0, 0
+}
+
+func (c *testChain) Height() uint64 {
+	return c.GetLastBlock().Number()
+}
+
+func (c *testChain) AccountDB() (*state.StateDB, error) {
+	return c.statedb, nil
+}
+
+func (c *testChain) ValidateTransaction(*protos.Transaction) error {
+	return errors.New("not supported")
+}
+
+func (c *testChain) GetTransactionPool() *pool.TransactionPool {
+	return nil
+}
+
+func (c *testChain) EVMCall(common.Address, []byte) ([]byte, error) {
+	return nil, errors.New("not supported")
+}
+
+func testPK(seed byte) []byte {
+	pk := make([]byte, dilithium.PKSizePacked)
+	for i := range pk {
+		pk[i] = seed
+	}
+	return pk
+}
+
+func testBlockHash(slot uint64) common.Hash {
+	return common.Hash{0xb1, byte(slot + 1)}
+}
+
+func testTransferTx(hash byte, from []byte, to common.Address) *protos.Transaction {
+	return &protos.Transaction{
+		Pk:   from,
+		Hash: common.Hash{0x7a, hash}.Bytes(),
+		Type: &protos.Transaction_Transfer{Transfer: &protos.Transfer{To: to.Bytes(), Value: 1}},
+	}
+}
+
+var (
+	testPKA, testPKC = testPK(0xaa), testPK(0xcc)
+	testAddressA     = misc.GetAddressFromUnSizedPK(testPKA)
+	testAddressB     = common.Address{0x01, 0x0b}
+	testAddressC     = misc.GetAddressFromUnSizedPK(testPKC)
+	testAddressD     = common.Address{0x01, 0x0d}
+)
+
+// newTestServer returns a server over a chain of 6 blocks. The transactions of address A are,
+// from the head, the first transaction of block 5, the second one of block 2 and the first one
+// of block 1. Block 4 holds 5 transactions which do not involve A.
+func newTestServer(t *testing.T, rateLimit float64, burst int, origins []string) *PublicAPIServer {
+	txs := [][]*protos.Transaction{
+		nil,
+		{testTransferTx(1, testPKA, testAddressB)},
+		{testTransferTx(2, testPKC, testAddressD), testTransferTx(3, testPKC, testAddressA)},
+		nil,
+		{
+			testTransferTx(4, testPKC, testAddressD), testTransferTx(5, testPKC, testAddressD),
+			testTransferTx(6, testPKC, testAddressD), testTransferTx(7, testPKC, testAddressD),
+			testTransferTx(8, testPKC, testAddressD),
+		},
+		{testTransferTx(9, testPKA, testAddressD), testTransferTx(10, testPKC, testAddressD)},
+	}
+	c := &testChain{}
+	for slot := range txs {
+		header := &protos.BlockHeader{
+			SlotNumber:       uint64(slot),
+			Hash:             testBlockHash(uint64(slot)).Bytes(),
+			TimestampSeconds: uint64(1000 + slot),
+		}
+		if slot > 0 {
+			header.ParentHash = testBlockHash(uint64(slot - 1)).Bytes()
+		}
+		c.blocks = append(c.blocks, block.BlockFromPBData(&protos.Block{Header: header, Transactions: txs[slot]}))
+	}
+
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	statedb.SetBalance(testAddressA, big.NewInt(100))
+	statedb.SetNonce(testAddressA, 3)
+	c.statedb = statedb
+
+	return &PublicAPIServer{
+		chain: c,
+		config: &config.Config{
+			Dev: &config.DevConfig{Version: "test"},
+			User: &config.UserConfig{API: &config.API{PublicAPI: &config.APIConfig{
+				RateLimit:          rateLimit,
+				RateLimitBurst:     burst,
+				CORSAllowedOrigins: origins,
+			}}},
+		},
+		visitors: newVisitors(rateLimit, burst),
+	}
+}
+
+// testResponse is a Response whose data is decoded by the test.
+type testResponse struct {
+	Error        ErrorCode       `json:"error"`
+	ErrorMessage string          `json:"errorMessage"`
+	Data         json.RawMessage `json:"data"`
+}
+
+type testPage struct {
+	Items         json.RawMessage `json:"items"`
+	NextPageToken string          `json:"nextPageToken"`
+	TotalSize     int             `json:"totalSize"`
+}
+
+func serve(t *testing.T, h http.Handler, method, target string) (*httptest.ResponseRecorder, *testResponse) {
+	t.Helper()
+	writer := httptest.NewRecorder()
+	h.ServeHTTP(writer, httptest.NewRequest(method, target, nil))
+	resp := &testResponse{}
+	if err := json.Unmarshal(writer.Body.Bytes(), resp); err != nil {
+		t.Fatalf("could not decode response %q: %v", writer.Body.String(), err)
+	}
+	return writer, resp
+}
+
+// get requests the target and decodes the data of the successful response into data.
+func get(t *testing.T, h http.Handler, target string, data interface{}) {
+	t.Helper()
+	writer, resp := serve(t, h, http.MethodGet, target)
+	if writer.Code != http.StatusOK || resp.Error != ErrCodeNone {
+		t.Fatalf("unexpected response %d %s: %s", writer.Code, resp.Error, resp.ErrorMessage)
+	}
+	if err := json.Unmarshal(resp.Data, data); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func checkErrorCode(t *testing.T, h http.Handler, target string, code ErrorCode) {
+	t.Helper()
+	writer, resp := serve(t, h, http.MethodGet, target)
+	if resp.Error != code || writer.Code != code.HTTPStatus() {
+		t.Errorf("unexpected response %d %s to %s, want %d %s", writer.Code, resp.Error, target, code.HTTPStatus(), code)
+	}
+	if resp.ErrorMessage == "" || string(resp.Data) != "null" {
+		t.Errorf("unexpected error message %q and data %s", resp.ErrorMessage, resp.Data)
+	}
+}
+
+func hexHash(h common.Hash) string {
+	return misc.BytesToHexStr(h[:])
+}
+
+func TestListBlocksV2(t *testing.T) {
+	h := newTestServer(t, 1000, 1000, nil).newRouter()
+
+	var slots []uint64
+	var tokens []string
+	token := ""
+	for {
+		page := &testPage{}
+		get(t, h, "/api/v2/blocks?page_size=4&page_token="+token, page)
+		var items []*BlockResponse
+		if err := json.Unmarshal(page.Items, &items); err != nil {
+			t.Fatal(err)
+		}
+		for _, item := range items {
+			slots = append(slots, item.Header.SlotNumber)
+		}
+		tokens = append(tokens, page.NextPageToken)
+		if page.NextPageToken == "" {
+			break
+		}
+		token = page.NextPageToken
+	}
+	if !reflect.DeepEqual(slots, []uint64{5, 4, 3, 2, 1, 0}) {
+		t.Errorf("unexpected slots %v", slots)
+	}
+	if !reflect.DeepEqual(tokens, []string{hexHash(testBlockHash(1)), ""}) {
+		t.Errorf("unexpected page tokens %v", tokens)
+	}
+
+	page := &testPage{}
+	get(t, h, "/api/v2/blocks", page)
+	var items []*BlockResponse
+	if err := json.Unmarshal(page.Items, &items); err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 6 || page.NextPageToken != "" || items[4].TransactionCount != 1 {
+		t.Errorf("unexpected first page of the default size: %d items, token %q", len(items), page.NextPageToken)
+	}
+
+	checkErrorCode(t, h, "/api/v2/blocks?page_token=0x1234", ErrCodeInvalidParameter)
+	checkErrorCode(t, h, "/api/v2/blocks?page_token="+hexHash(common.Hash{0xff}), ErrCodeNotFound)
+	checkErrorCode(t, h, "/api/v2/blocks?page_size=0", ErrCodeInvalidParameter)
+	checkErrorCode(t, h, fmt.Sprintf("/api/v2/blocks?page_size=%d", maxV2PageSize+1), ErrCodeInvalidParameter)
+}
+
+func TestListBlockTransactionsV2(t *testing.T) {
+	h := newTestServer(t, 1000, 1000, nil).newRouter()
+
+	tests := []struct {
+		token     string
+		indexes   []uint64
+		nextToken string
+	}{
+		{"", []uint64{0, 1}, "1"},
+		{"1", []uint64{2, 3}, "2"},
+		{"2", []uint64{4}, ""},
+	}
+	for _, tt := range tests {
+		page := &testPage{}
+		get(t, h, "/api/v2/blocks/4/transactions?page_size=2&page_token="+tt.token, page)
+		var items []*TransactionResponse
+		if err := json.Unmarshal(page.Items, &items); err != nil {
+			t.Fatal(err)
+		}
+		var indexes []uint64
+		for _, item := range items {
+			if item.BlockNumber != 4 || item.BlockHash != hexHash(testBlockHash(4)) {
+				t.Errorf("unexpected block %d %s", item.BlockNumber, item.BlockHash)
+			}
+			indexes = append(indexes, item.Index)
+		}
+		if !reflect.DeepEqual(indexes, tt.indexes) || page.NextPageToken != tt.nextToken || page.TotalSize != 5 {
+			t.Errorf("page %q: unexpected indexes %v, next page token %q and total size %d", tt.token, indexes, page.NextPageToken, page.TotalSize)
+		}
+	}
+
+	page := &testPage{}
+	get(t, h, "/api/v2/blocks/3/transactions", page)
+	if string(page.Items) != "[]" || page.NextPageToken != "" || page.TotalSize != 0 {
+		t.Errorf("unexpected page of a block without transactions: %s", page.Items)
+	}
+
+	checkErrorCode(t, h, "/api/v2/blocks/4/transactions?page_token=foo", ErrCodeInvalidParameter)
+	checkErrorCode(t, h, "/api/v2/blocks/4/transactions?page_size=2&page_token=3", ErrCodeInvalidParameter)
+	checkErrorCode(t, h, "/api/v2/blocks/10/transactions", ErrCodeNotFound)
+}
+
+func TestListAddressTransactionsV2(t *testing.T) {
+	h := newTestServer(t, 1000, 1000, nil).newRouter()
+	address := misc.BytesToHexStr(testAddressA[:])
+
+	// Every page size must return the same history, whatever the block at which pages stop.
+	want := []string{"5:0", "2:1", "1:0"}
+	for _, pageSize := range []int{1, 2, 3, 100} {
+		t.Run(fmt.Sprintf("page size %d", pageSize), func(t *testing.T) {
+			var got []string
+			token := ""
+			for pages := 0; pages <= len(want); pages++ {
+				page := &testPage{}
+				get(t, h, fmt.Sprintf("/api/v2/addresses/%s/transactions?page_size=%d&page_token=%s", address, pageSize, url.QueryEscape(token)), page)
+				var items []*TransactionResponse
+				if err := json.Unmarshal(page.Items, &items); err != nil {
+					t.Fatal(err)
+				}
+				if len(items) > pageSize {
+					t.Fatalf("page of %d items exceeds the page size", len(items))
+				}
+				for _, item := range items {
+					got = append(got, fmt.Sprintf("%d:%d", item.BlockNumber, item.Index))
+				}
+				if page.NextPageToken == "" {
+					break
+				}
+				token = page.NextPageToken
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("unexpected history %v, want %v", got, want)
+			}
+		})
+	}
+
+	// A page which stops in the middle of a block resumes at the next transaction of the block.
+	page := &testPage{}
+	get(t, h, "/api/v2/addresses/"+address+"/transactions?page_size=1", page)
+	if want := hexHash(testBlockHash(5)) + ":1"; page.NextPageToken != want {
+		t.Errorf("unexpected page token %q, want %q", page.NextPageToken, want)
+	}
+
+	invalid := []struct {
+		token string
+		code  ErrorCode
+	}{
+		{"foo", ErrCodeInvalidParameter},
+		{hexHash(testBlockHash(5)), ErrCodeInvalidParameter},
+		{"0x1234:0", ErrCodeInvalidParameter},
+		{hexHash(testBlockHash(5)) + ":-1", ErrCodeInvalidParameter},
+		{hexHash(testBlockHash(5)) + ":a", ErrCodeInvalidParameter},
+		{hexHash(common.Hash{0xff}) + ":0", ErrCodeNotFound},
+	}
+	for _, tt := range invalid {
+		checkErrorCode(t, h, "/api/v2/addresses/"+address+"/transactions?page_token="+url.QueryEscape(tt.token), tt.code)
+	}
+	checkErrorCode(t, h, "/api/v2/addresses/0x1234/transactions", ErrCodeInvalidParameter)
+}
+
+func TestGetV2ErrorCodes(t *testing.T) {
+	h := newTestServer(t, 1000, 1000, nil).newRouter()
+
+	block := &BlockResponse{}
+	get(t, h, "/api/v2/blocks/latest", block)
+	if block.Header.SlotNumber != 5 || block.TransactionCount != 2 {
+		t.Errorf("unexpected latest block %+v", block.Header)
+	}
+	get(t, h, "/api/v2/blocks/"+hexHash(testBlockHash(2)), block)
+	if block.Header.SlotNumber != 2 || block.Header.ParentHash != hexHash(testBlockHash(1)) {
+		t.Errorf("unexpected block %+v", block.Header)
+	}
+
+	tx := &TransactionResponse{}
+	get(t, h, "/api/v2/transactions/"+hexHash(common.Hash{0x7a, 3}), tx)
+	if tx.BlockNumber != 2 || tx.Index != 1 {
+		t.Errorf("unexpected transaction at %d:%d", tx.BlockNumber, tx.Index)
+	}
+
+	state := &struct {
+		Balance uint64 `json:"balance"`
+		Nonce   uint64 `json:"nonce"`
+	}{}
+	get(t, h, "/api/v2/addresses/"+misc.BytesToHexStr(testAddressA[:]), state)
+	if state.Balance != 100 || state.Nonce != 3 {
+		t.Errorf("unexpected address state %+v", state)
+	}
+
+	tests := []struct {
+		target string
+		code   ErrorCode
+	}{
+		{"/api/v2/blocks/foo", ErrCodeInvalidParameter},
+		{"/api/v2/blocks/0x1234", ErrCodeInvalidParameter},
+		{"/api/v2/blocks/10", ErrCodeNotFound},
+		{"/api/v2/blocks/" + hexHash(common.Hash{0xff}), ErrCodeNotFound},
+		{"/api/v2/transactions/0x1234", ErrCodeInvalidParameter},
+		{"/api/v2/transactions/" + hexHash(common.Hash{0xff}), ErrCodeNotFound},
+		{"/api/v2/addresses/foo", ErrCodeInvalidParameter},
+	}
+	for _, tt := range tests {
+		checkErrorCode(t, h, tt.target, tt.code)
+	}
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	p := newTestServer(t, 1000, 1000, nil)
+	writer := httptest.NewRecorder()
+	p.newRouter().ServeHTTP(writer, httptest.NewRequest(http.MethodGet, openAPIPath, nil))
+	if writer.Code != http.StatusOK {
+		t.Fatalf("unexpected status code %d", writer.Code)
+	}
+	doc := &struct {
+		OpenAPI string `json:"openapi"`
+		Info    struct {
+			Version string `json:"version"`
+		} `json:"info"`
+		Paths map[string]map[string]struct {
+			OperationID string `json:"operationId"`
+			Parameters  []struct {
+				Name string `json:"name"`
+				In   string `json:"in"`
+			} `json:"parameters"`
+			Responses map[string]struct {
+				Content map[string]struct {
+					Schema struct {
+						Properties struct {
+							Data struct {
+								Properties map[string]struct {
+									Type string `json:"type"`
+								} `json:"properties"`
+							} `json:"data"`
+						} `json:"properties"`
+					} `json:"schema"`
+				} `json:"content"`
+			} `json:"responses"`
+		} `json:"paths"`
+		Components struct {
+			Schemas struct {
+				ErrorCode struct {
+					Enum     []uint   `json:"enum"`
+					VarNames []string `json:"x-enum-varnames"`
+				} `json:"ErrorCode"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}{}
+	if err := json.Unmarshal(writer.Body.Bytes(), doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc.OpenAPI != openAPIVersion || doc.Info.Version != apiV2Version {
+		t.Errorf("unexpected versions %s and %s", doc.OpenAPI, doc.Info.Version)
+	}
+
+	routes := p.v2Routes()
+	if len(doc.Paths) != len(routes) {
+		t.Errorf("expected %d paths, got %d", len(routes), len(doc.Paths))
+	}
+	for _, rt := range routes {
+		op, ok := doc.Paths[rt.path]["get"]
+		if !ok || op.OperationID != rt.name {
+			t.Errorf("missing operation %s of %s", rt.name, rt.path)
+			continue
+		}
+		params := make(map[string]string)
+		for _, param := range op.Parameters {
+			params[param.Name] = param.In
+		}
+		for _, match := range pathParamRegex.FindAllStringSubmatch(rt.path, -1) {
+			if params[match[1]] != "path" {
+				t.Errorf("missing path parameter %s of %s", match[1], rt.path)
+			}
+		}
+		data := op.Responses["200"].Content["application/json"].Schema.Properties.Data
+		if items, ok := data.Properties["items"]; rt.paginated != (ok && items.Type == "array") {
+			t.Errorf("unexpected items schema of %s", rt.path)
+		}
+		if rt.paginated && (params[pageTokenParam.name] != "query" || params[pageSizeParam.name] != "query") {
+			t.Errorf("missing pagination parameters of %s", rt.path)
+		}
+	}
+
+	codes := doc.Components.Schemas.ErrorCode
+	if len(codes.Enum) != len(errorCodeNames) || len(codes.VarNames) != len(errorCodeNames) {
+		t.Fatalf("unexpected error codes %v %v", codes.Enum, codes.VarNames)
+	}
+	for i, code := range codes.Enum {
+		if codes.VarNames[i] != ErrorCode(code).String() {
+			t.Errorf("error code %d named %s, want %s", code, codes.VarNames[i], ErrorCode(code))
+		}
+	}
+
+	writer = httptest.NewRecorder()
+	p.newRouter().ServeHTTP(writer, httptest.NewRequest(http.MethodGet, "/api/", nil))
+	if writer.Code != http.StatusMovedPermanently || writer.Header().Get("Location") != openAPIPath {
+		t.Errorf("unexpected redirect %d to %s", writer.Code, writer.Header().Get("Location"))
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	// A negligible rate makes the burst the number of requests allowed during the test.
+	h := newTestServer(t, 0.0001, 2, nil).newHandler()
+	request := func(method, remoteAddr string) *httptest.ResponseRecorder {
+		writer := httptest.NewRecorder()
+		req := httptest.NewRequest(method, "/api/v2/height", nil)
+		req.RemoteAddr = remoteAddr
+		h.ServeHTTP(writer, req)
+		return writer
+	}
+
+	for i := 0; i < 2; i++ {
+		if writer := request(http.MethodGet, "10.0.0.1:1000"); writer.Code != http.StatusOK {
+			t.Fatalf("request %d rejected with status code %d", i, writer.Code)
+		}
+	}
+	writer := request(http.MethodGet, "10.0.0.1:2000")
+	resp := &testResponse{}
+	if err := json.Unmarshal(writer.Body.Bytes(), resp); err != nil {
+		t.Fatal(err)
+	}
+	if writer.Code != http.StatusTooManyRequests || resp.Error != ErrCodeRateLimited {
+		t.Errorf("unexpected response %d %s after the burst", writer.Code, resp.Error)
+	}
+	if writer := request(http.MethodOptions, "10.0.0.1:3000"); writer.Code == http.StatusTooManyRequests {
+		t.Error("preflight request rate limited")
+	}
+	if writer := request(http.MethodGet, "10.0.0.2:1000"); writer.Code != http.StatusOK {
+		t.Errorf("request of another visitor rejected with status code %d", writer.Code)
+	}
+	if writer := request(http.MethodGet, "invalid"); writer.Code != http.StatusTooManyRequests {
+		t.Errorf("request without a valid remote address served with status code %d", writer.Code)
+	}
+}
+
+func TestCORS(t *testing.T) {
+	const origin = "https://explorer.example.com"
+	request := func(h http.Handler, requestOrigin string) string {
+		writer := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/v2/height", nil)
+		req.Header.Set("Origin", requestOrigin)
+		h.ServeHTTP(writer, req)
+		return writer.Header().Get("Access-Control-Allow-Origin")
+	}
+
+	h := newTestServer(t, 1000, 1000, nil).newHandler()
+	if allowed := request(h, origin); allowed != "" {
+		t.Errorf("origin %s allowed without configured origins", allowed)
+	}
+
+	h = newTestServer(t, 1000, 1000, []string{origin}).newHandler()
+	if allowed := request(h, origin); allowed != origin {
+		t.Errorf("configured origin not allowed, got %q", allowed)
+	}
+	if allowed := request(h, "https://other.example.com"); allowed != "" {
+		t.Errorf("origin %s allowed but not configured", allowed)
+	}
+}
@@ -1,10 +1,18 @@
 package api
 
 import (
-	"golang.org/x/time/rate"
+	"encoding/json"
 	"net"
+	"net/http"
 	"sync"
 	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	visitorCleanupInterval = time.Minute
+	visitorExpiry          = 3 * time.Minute
 )
 
 type visitor struct {
@@ -14,11 +22,13 @@ type visitor struct {
 
 type visitors struct {
 	visitors map[string]*visitor
+	limit    rate.Limit
+	burst    int
 	lock     sync.Mutex
 }
 
 func (v *visitors) addVisitor(ip string) *rate.Limiter {
-	limiter := rate.NewLimiter(5, 500)
+	limiter := rate.NewLimiter(v.limit, v.burst)
 	v.lock.Lock()
 	v.visitors[ip] = &visitor{limiter, time.Now()}
 	v.lock.Unlock()
@@ -40,10 +50,10 @@ func (v *visitors) getVisitor(ip string) *rate.Limiter {
 
 func (v *visitors) cleanupVisitors() {
 	for {
-		time.Sleep(time.Minute)
+		time.Sleep(visitorCleanupInterval)
 		v.lock.Lock()
 		for ip, visitor := range v.visitors {
-			if time.Now().Sub(visitor.lastSeen) > 3*time.Minute {
+			if time.Since(visitor.lastSeen) > visitorExpiry {
 				delete(v.visitors, ip)
 			}
 		}
@@ -60,9 +70,30 @@ func (v *visitors) isAllowed(hostPort string) bool {
 	return limiter.Allow()
 }
 
-func newVisitors() *visitors {
+// middleware rejects the requests of the visitors that exceeded their request rate
+// before they reach the handlers of the router.
+func (v *visitors) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodOptions && !v.isAllowed(r.RemoteAddr) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(ErrCodeRateLimited.HTTPStatus())
+			json.NewEncoder(w).Encode(&Response{
+				Error:        ErrCodeRateLimited,
+				ErrorMessage: "rate limit exceeded",
+			})
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// newVisitors returns the per-IP rate limiter of the API, which allows every visitor
+// to make requestsPerSecond requests per second with bursts of up to burst requests.
+func newVisitors(requestsPerSecond float64, burst int) *visitors {
 	visitors := &visitors{
 		visitors: make(map[string]*visitor),
+		limit:    rate.Limit(requestsPerSecond),
+		burst:    burst,
 	}
 	return visitors
 }
@@ -1,5 +1,9 @@
 package api
 
+import (
+	"github.com/theQRL/zond/api/view"
+)
+
 type BroadcastTransactionResponse struct {
 	TransactionHash string `json:"transactionHash"`
 }
@@ -7,3 +11,23 @@ type BroadcastTransactionResponse struct {
 type EVMCallResponse struct {
 	Result string `json:"result"`
 }
+
+// PaginatedResponse is the data of the v2 listing endpoints. The next page is requested
+// by passing NextPageToken as the page_token query parameter; it is empty on the last page.
+type PaginatedResponse struct {
+	Items         interface{} `json:"items"`
+	NextPageToken string      `json:"nextPageToken"`
+	TotalSize     int         `json:"totalSize,omitempty"`
+}
+
+type BlockResponse struct {
+	Header           *view.PlainBlockHeader `json:"header"`
+	TransactionCount int                    `json:"transactionCount"`
+}
+
+type TransactionResponse struct {
+	BlockHash   string      `json:"blockHash"`
+	BlockNumber uint64      `json:"blockNumber"`
+	Index       uint64      `json:"index"`
+	Transaction interface{} `json:"transaction"`
+}
@@ -87,6 +87,12 @@ type FinalizationFetcher interface {
 	IsFinalized(ctx context.Context, blockRoot [32]byte) bool
 }
 
+// LightClientFetcher retrieves the latest light client updates derived by the node.
+type LightClientFetcher interface {
+	LightClientFinalityUpdate() *ethpb.LightClientFinalityUpdate
+	LightClientOptimisticUpdate() *ethpb.LightClientOptimisticUpdate
+}
+
 // OptimisticModeFetcher retrieves information about optimistic status of the node.
 type OptimisticModeFetcher interface {
 	IsOptimistic(ctx context.Context) (bool, error)
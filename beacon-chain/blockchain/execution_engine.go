@@ -7,6 +7,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/theQRL/zond/beacon-chain/core/blocks"
+	"github.com/theQRL/zond/beacon-chain/core/feed"
+	statefeed "github.com/theQRL/zond/beacon-chain/core/feed/state"
 	"github.com/theQRL/zond/beacon-chain/core/helpers"
 	"github.com/theQRL/zond/beacon-chain/core/time"
 	"github.com/theQRL/zond/beacon-chain/core/transition"
@@ -72,6 +74,21 @@ func (s *Service) notifyForkchoiceUpdate(ctx context.Context, arg *notifyForkcho
 		log.WithError(err).Error("Could not get head payload attribute")
 		return nil, nil
 	}
+	if hasAttr {
+		// Let external builders know a payload is being prepared for the next slot.
+		s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.PayloadAttributes,
+			Data: &statefeed.PayloadAttributesData{
+				Version:           arg.headState.Version(),
+				ProposerIndex:     proposerId,
+				ProposalSlot:      nextSlot,
+				ParentBlockNumber: headPayload.BlockNumber(),
+				ParentBlockRoot:   arg.headRoot[:],
+				ParentBlockHash:   headPayload.BlockHash(),
+				PayloadAttributes: attr,
+			},
+		})
+	}
 
 	payloadID, lastValidHash, err := s.cfg.ExecutionEngineCaller.ForkchoiceUpdated(ctx, fcs, attr)
 	if err != nil {
@@ -0,0 +1,150 @@
+package blockchain
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	lightclient "github.com/theQRL/zond/beacon-chain/core/light-client"
+	"github.com/theQRL/zond/config/params"
+	"github.com/theQRL/zond/consensus-types/interfaces"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	"github.com/theQRL/zond/encoding/bytesutil"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+	"github.com/theQRL/zond/runtime/version"
+	"github.com/theQRL/zond/time/slots"
+	"go.opencensus.io/trace"
+)
+
+// LightClientFinalityUpdate returns the latest light client finality update derived by the node.
+// Nil is returned if no finality update has been derived yet.
+func (s *Service) LightClientFinalityUpdate() *ethpb.LightClientFinalityUpdate {
+	s.lightClientLock.RLock()
+	defer s.lightClientLock.RUnlock()
+	return s.lightClientFinalityUpdate
+}
+
+// LightClientOptimisticUpdate returns the latest light client optimistic update derived by the node.
+// Nil is returned if no optimistic update has been derived yet.
+func (s *Service) LightClientOptimisticUpdate() *ethpb.LightClientOptimisticUpdate {
+	s.lightClientLock.RLock()
+	defer s.lightClientLock.RUnlock()
+	return s.lightClientOptimisticUpdate
+}
+
+// processLightClientUpdates derives the light client updates for the sync aggregate of an imported block.
+// The best update of each sync committee period is persisted, while the latest finality and optimistic
+// updates are kept in memory and broadcast to the network.
+func (s *Service) processLightClientUpdates(ctx context.Context, signed interfaces.SignedBeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.processLightClientUpdates")
+	defer span.End()
+
+	if signed.Version() < version.Altair {
+		return nil
+	}
+	attestedRoot := signed.Block().ParentRoot()
+	attestedBlock, err := s.getBlock(ctx, attestedRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get attested block")
+	}
+	if attestedBlock.Version() < version.Altair {
+		return nil
+	}
+	attestedState, err := s.cfg.StateGen.StateByRoot(ctx, attestedRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get attested state")
+	}
+	var finalizedBlock interfaces.SignedBeaconBlock
+	finalizedRoot := bytesutil.ToBytes32(attestedState.FinalizedCheckpoint().Root)
+	if finalizedRoot != params.BeaconConfig().ZeroHash {
+		finalizedBlock, err = s.getBlock(ctx, finalizedRoot)
+		if err != nil {
+			return errors.Wrap(err, "could not get finalized block")
+		}
+	}
+	update, err := lightclient.NewUpdate(ctx, signed, attestedState, attestedBlock, finalizedBlock)
+	if err != nil {
+		return errors.Wrap(err, "could not create light client update")
+	}
+
+	period := lightclient.Period(update.AttestedHeader.Beacon.Slot)
+	best, err := s.cfg.BeaconDB.LightClientUpdate(ctx, period)
+	if err != nil {
+		return errors.Wrap(err, "could not get best light client update")
+	}
+	if best == nil || lightclient.IsBetterUpdate(update, best) {
+		if err := s.cfg.BeaconDB.SaveLightClientUpdate(ctx, period, update); err != nil {
+			return errors.Wrap(err, "could not save light client update")
+		}
+	}
+
+	var finalityUpdate *ethpb.LightClientFinalityUpdate
+	var optimisticUpdate *ethpb.LightClientOptimisticUpdate
+	s.lightClientLock.Lock()
+	latestOptimistic := s.lightClientOptimisticUpdate
+	if latestOptimistic == nil || update.AttestedHeader.Beacon.Slot > latestOptimistic.AttestedHeader.Beacon.Slot {
+		optimisticUpdate = lightclient.OptimisticUpdate(update)
+		s.lightClientOptimisticUpdate = optimisticUpdate
+	}
+	latestFinality := s.lightClientFinalityUpdate
+	if lightclient.IsFinalityUpdate(update) && (latestFinality == nil ||
+		update.FinalizedHeader.Beacon.Slot > latestFinality.FinalizedHeader.Beacon.Slot) {
+		finalityUpdate = lightclient.FinalityUpdate(update)
+		s.lightClientFinalityUpdate = finalityUpdate
+	}
+	s.lightClientLock.Unlock()
+
+	if finalityUpdate != nil || optimisticUpdate != nil {
+		go s.broadcastLightClientUpdates(update.SignatureSlot, finalityUpdate, optimisticUpdate)
+	}
+	return nil
+}
+
+// broadcastLightClientUpdates broadcasts the given light client updates once a third of their signature
+// slot has elapsed, which is the earliest time at which peers accept them.
+func (s *Service) broadcastLightClientUpdates(
+	signatureSlot types.Slot,
+	finalityUpdate *ethpb.LightClientFinalityUpdate,
+	optimisticUpdate *ethpb.LightClientOptimisticUpdate,
+) {
+	broadcastTime := slots.StartTime(uint64(s.genesisTime.Unix()), signatureSlot).Add(slots.DivideSlotBy(3))
+	select {
+	case <-time.After(time.Until(broadcastTime)):
+	case <-s.ctx.Done():
+		return
+	}
+	if finalityUpdate != nil {
+		if err := s.cfg.P2p.Broadcast(s.ctx, finalityUpdate); err != nil {
+			log.WithError(err).Debug("Could not broadcast light client finality update")
+		}
+	}
+	if optimisticUpdate != nil {
+		if err := s.cfg.P2p.Broadcast(s.ctx, optimisticUpdate); err != nil {
+			log.WithError(err).Debug("Could not broadcast light client optimistic update")
+		}
+	}
+}
+
+// saveLightClientBootstrap persists the light client bootstrap of a newly finalized block, so that
+// light clients can start following the chain from it.
+func (s *Service) saveLightClientBootstrap(ctx context.Context, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.saveLightClientBootstrap")
+	defer span.End()
+
+	blk, err := s.getBlock(ctx, blockRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized block")
+	}
+	if blk.Version() < version.Altair {
+		return nil
+	}
+	st, err := s.cfg.StateGen.StateByRoot(ctx, blockRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized state")
+	}
+	bootstrap, err := lightclient.NewBootstrap(ctx, st, blk)
+	if err != nil {
+		return errors.Wrap(err, "could not create light client bootstrap")
+	}
+	return s.cfg.BeaconDB.SaveLightClientBootstrap(ctx, blockRoot, bootstrap)
+}
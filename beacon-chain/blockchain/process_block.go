@@ -201,6 +201,12 @@ func (s *Service) onBlock(ctx context.Context, signed interfaces.SignedBeaconBlo
 		return err
 	}
 
+	if features.Get().EnableLightClient {
+		if err := s.processLightClientUpdates(ctx, signed); err != nil {
+			log.WithError(err).Debug("Could not process light client updates")
+		}
+	}
+
 	// Send notification of the processed block to the state feed.
 	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
 		Type: statefeed.BlockProcessed,
@@ -241,6 +247,11 @@ func (s *Service) onBlock(ctx context.Context, signed interfaces.SignedBeaconBlo
 		if err := s.updateFinalized(ctx, &ethpb.Checkpoint{Epoch: finalized.Epoch, Root: finalized.Root[:]}); err != nil {
 			return err
 		}
+		if features.Get().EnableLightClient {
+			if err := s.saveLightClientBootstrap(ctx, finalized.Root); err != nil {
+				log.WithError(err).Debug("Could not save light client bootstrap")
+			}
+		}
 		isOptimistic, err := s.cfg.ForkChoiceStore.IsOptimistic(finalized.Root)
 		if err != nil {
 			return errors.Wrap(err, "could not check if node is optimistically synced")
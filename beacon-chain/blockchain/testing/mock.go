@@ -64,6 +64,18 @@ type ChainService struct {
 	ReceiveBlockMockErr         error
 	OptimisticCheckRootReceived [32]byte
 	FinalizedRoots              map[[32]byte]bool
+	FinalityUpdate              *ethpb.LightClientFinalityUpdate
+	OptimisticUpdate            *ethpb.LightClientOptimisticUpdate
+}
+
+// LightClientFinalityUpdate mocks the same method in the chain service.
+func (s *ChainService) LightClientFinalityUpdate() *ethpb.LightClientFinalityUpdate {
+	return s.FinalityUpdate
+}
+
+// LightClientOptimisticUpdate mocks the same method in the chain service.
+func (s *ChainService) LightClientOptimisticUpdate() *ethpb.LightClientOptimisticUpdate {
+	return s.OptimisticUpdate
 }
 
 // ForkChoicer mocks the same method in the chain service
@@ -6,11 +6,14 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/theQRL/zond/beacon-chain/core/helpers"
+	"github.com/theQRL/zond/beacon-chain/core/signing"
 	"github.com/theQRL/zond/beacon-chain/core/time"
 	"github.com/theQRL/zond/beacon-chain/state"
 	"github.com/theQRL/zond/config/params"
 	"github.com/theQRL/zond/consensus-types/blocks"
 	"github.com/theQRL/zond/consensus-types/interfaces"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	"github.com/theQRL/zond/crypto/bls"
 	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
 	"github.com/theQRL/zond/protos/zond/v1alpha1/attestation"
 	"go.opencensus.io/trace"
@@ -202,26 +205,27 @@ func VerifyIndexedAttestation(ctx context.Context, beaconState state.ReadOnlyBea
 	if err := attestation.IsValidAttestationIndices(ctx, indexedAtt); err != nil {
 		return err
 	}
-	// domain, err := signing.Domain(
-	// 	beaconState.Fork(),
-	// 	indexedAtt.Data.Target.Epoch,
-	// 	params.BeaconConfig().DomainBeaconAttester,
-	// 	beaconState.GenesisValidatorsRoot(),
-	// )
-	// if err != nil {
-	// 	return err
-	// }
-	//TODO (abhijeet): Replace bls with Dilithium
-	// indices := indexedAtt.AttestingIndices
-	// var pubkeys []bls.PublicKey
-	// for i := 0; i < len(indices); i++ {
-	// 	pubkeyAtIdx := beaconState.PubkeyAtIndex(types.ValidatorIndex(indices[i]))
-	// 	pk, err := bls.PublicKeyFromBytes(pubkeyAtIdx[:])
-	// 	if err != nil {
-	// 		return errors.Wrap(err, "could not deserialize validator public key")
-	// 	}
-	// 	pubkeys = append(pubkeys, pk)
-	// }
-	// return attestation.VerifyIndexedAttestationSig(ctx, indexedAtt, pubkeys, domain)
-	return nil
+	domain, err := signing.Domain(
+		beaconState.Fork(),
+		indexedAtt.Data.Target.Epoch,
+		params.BeaconConfig().DomainBeaconAttester,
+		beaconState.GenesisValidatorsRoot(),
+	)
+	if err != nil {
+		return err
+	}
+	indices := indexedAtt.AttestingIndices
+	var pubkeys []bls.PublicKey
+	for i := 0; i < len(indices); i++ {
+		v, err := beaconState.ValidatorAtIndex(types.ValidatorIndex(indices[i]))
+		if err != nil {
+			return err
+		}
+		pk, err := bls.PublicKeyFromBytes(v.PublicKey)
+		if err != nil {
+			return errors.Wrap(err, "could not deserialize validator public key")
+		}
+		pubkeys = append(pubkeys, pk)
+	}
+	return attestation.VerifyIndexedAttestationSig(ctx, indexedAtt, pubkeys, domain)
 }
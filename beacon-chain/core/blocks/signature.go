@@ -10,53 +10,52 @@ import (
 	"github.com/theQRL/zond/beacon-chain/state"
 	"github.com/theQRL/zond/config/params"
 	types "github.com/theQRL/zond/consensus-types/primitives"
+	"github.com/theQRL/zond/crypto/bls"
 	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
 	"github.com/theQRL/zond/time/slots"
 )
 
-//TODO (abhijeet): Replace bls with Dilithium
 // retrieves the signature batch from the raw data, public key,signature and domain provided.
-// func signatureBatch(signedData, pub, signature, domain []byte) (*bls.SignatureBatch, error) {
-// 	publicKey, err := bls.PublicKeyFromBytes(pub)
-// 	if err != nil {
-// 		return nil, errors.Wrap(err, "could not convert bytes to public key")
-// 	}
-// 	signingData := &ethpb.SigningData{
-// 		ObjectRoot: signedData,
-// 		Domain:     domain,
-// 	}
-// 	root, err := signingData.HashTreeRoot()
-// 	if err != nil {
-// 		return nil, errors.Wrap(err, "could not hash container")
-// 	}
-// 	return &bls.SignatureBatch{
-// 		Signatures: [][]byte{signature},
-// 		PublicKeys: []bls.PublicKey{publicKey},
-// 		Messages:   [][32]byte{root},
-// 	}, nil
-// }
+func signatureBatch(signedData, pub, signature, domain []byte) (*bls.SignatureBatch, error) {
+	publicKey, err := bls.PublicKeyFromBytes(pub)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert bytes to public key")
+	}
+	signingData := &ethpb.SigningData{
+		ObjectRoot: signedData,
+		Domain:     domain,
+	}
+	root, err := signingData.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash container")
+	}
+	return &bls.SignatureBatch{
+		Signatures: [][]byte{signature},
+		PublicKeys: []bls.PublicKey{publicKey},
+		Messages:   [][32]byte{root},
+	}, nil
+}
 
 // verifies the signature from the raw data, public key and domain provided.
 func verifySignature(signedData, pub, signature, domain []byte) error {
-	// set, err := signatureBatch(signedData, pub, signature, domain)
-	// if err != nil {
-	// 	return err
-	// }
-	// if len(set.Signatures) != 1 {
-	// 	return errors.Errorf("signature set contains %d signatures instead of 1", len(set.Signatures))
-	// }
-	//TODO (abhijeet): Replace bls with Dilithium
+	set, err := signatureBatch(signedData, pub, signature, domain)
+	if err != nil {
+		return err
+	}
+	if len(set.Signatures) != 1 {
+		return errors.Errorf("signature set contains %d signatures instead of 1", len(set.Signatures))
+	}
 	// We assume only one signature set is returned here.
-	// sig := set.Signatures[0]
-	// publicKey := set.PublicKeys[0]
-	// root := set.Messages[0]
-	// rSig, err := bls.SignatureFromBytes(sig)
-	// if err != nil {
-	// 	return err
-	// }
-	// if !rSig.Verify(publicKey, root[:]) {
-	// 	return signing.ErrSigFailedToVerify
-	// }
+	sig := set.Signatures[0]
+	publicKey := set.PublicKeys[0]
+	root := set.Messages[0]
+	rSig, err := bls.SignatureFromBytes(sig)
+	if err != nil {
+		return err
+	}
+	if !rSig.Verify(publicKey, root[:]) {
+		return signing.ErrSigFailedToVerify
+	}
 	return nil
 }
 
@@ -159,7 +158,10 @@ func randaoSigningData(ctx context.Context, beaconState state.ReadOnlyBeaconStat
 	if err != nil {
 		return nil, nil, nil, errors.Wrap(err, "could not get beacon proposer index")
 	}
-	proposerPub := beaconState.PubkeyAtIndex(proposerIdx)
+	proposer, err := beaconState.ValidatorAtIndex(proposerIdx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	currentEpoch := slots.ToEpoch(beaconState.Slot())
 	buf := make([]byte, 32)
@@ -169,7 +171,7 @@ func randaoSigningData(ctx context.Context, beaconState state.ReadOnlyBeaconStat
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	return buf, proposerPub[:], domain, nil
+	return buf, proposer.PublicKey, domain, nil
 }
 
 //TODO (abhijeet): Replace bls with Dilithium
@@ -0,0 +1,113 @@
+package blocks_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/theQRL/zond/beacon-chain/core/blocks"
+	"github.com/theQRL/zond/beacon-chain/core/signing"
+	"github.com/theQRL/zond/beacon-chain/state"
+	state_native "github.com/theQRL/zond/beacon-chain/state/state-native"
+	"github.com/theQRL/zond/config/params"
+	"github.com/theQRL/zond/crypto/bls"
+	"github.com/theQRL/zond/crypto/bls/common"
+	"github.com/theQRL/zond/crypto/bls/dilithium"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+)
+
+// proposerState builds a state whose only validator has the given key. The unsafe initializer skips the
+// SSZ size checks, which would reject a Dilithium key, as the consensus types still size keys for BLS.
+func proposerState(t *testing.T, pub common.PublicKey) state.BeaconState {
+	st, err := state_native.InitializeFromProtoUnsafePhase0(&ethpb.BeaconState{
+		Fork: &ethpb.Fork{
+			PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+			CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
+		},
+		GenesisValidatorsRoot: make([]byte, 32),
+		Validators: []*ethpb.Validator{
+			{PublicKey: pub.Marshal()},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return st
+}
+
+func signHeader(t *testing.T, st state.ReadOnlyBeaconState, priv common.SecretKey, header *ethpb.BeaconBlockHeader) []byte {
+	domain, err := signing.Domain(st.Fork(), 0, params.BeaconConfig().DomainBeaconProposer, st.GenesisValidatorsRoot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := signing.ComputeSigningRoot(header, domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return priv.Sign(root[:]).Marshal()
+}
+
+func testHeader(bodyRoot byte) *ethpb.BeaconBlockHeader {
+	return &ethpb.BeaconBlockHeader{
+		ParentRoot: make([]byte, 32),
+		StateRoot:  make([]byte, 32),
+		BodyRoot:   []byte{31: bodyRoot},
+	}
+}
+
+func TestVerifyBlockSignature_Dilithium(t *testing.T) {
+	priv, err := dilithium.RandKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(priv.PublicKey().Marshal()) != dilithium.PublicKeyLength {
+		t.Fatalf("unexpected public key length %d", len(priv.PublicKey().Marshal()))
+	}
+	st := proposerState(t, priv.PublicKey())
+	header := testHeader(1)
+	sig := signHeader(t, st, priv, header)
+
+	if err := blocks.VerifyBlockSignature(st, 0, sig, header.HashTreeRoot); err != nil {
+		t.Fatalf("valid Dilithium signature did not verify: %v", err)
+	}
+
+	other := testHeader(2)
+	err = blocks.VerifyBlockSignature(st, 0, sig, other.HashTreeRoot)
+	if !errors.Is(err, signing.ErrSigFailedToVerify) {
+		t.Fatalf("expected %v for another block, got %v", signing.ErrSigFailedToVerify, err)
+	}
+
+	otherPriv, err := dilithium.RandKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherSig := signHeader(t, st, otherPriv, header)
+	err = blocks.VerifyBlockSignature(st, 0, otherSig, header.HashTreeRoot)
+	if !errors.Is(err, signing.ErrSigFailedToVerify) {
+		t.Fatalf("expected %v for another proposer key, got %v", signing.ErrSigFailedToVerify, err)
+	}
+}
+
+func TestVerifyBlockSignature_MixedSchemes(t *testing.T) {
+	blsPriv, err := bls.RandKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dilithiumPriv, err := dilithium.RandKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	header := testHeader(1)
+
+	blsState := proposerState(t, blsPriv.PublicKey())
+	if err := blocks.VerifyBlockSignature(blsState, 0, signHeader(t, blsState, blsPriv, header), header.HashTreeRoot); err != nil {
+		t.Fatalf("valid BLS signature did not verify: %v", err)
+	}
+	// A Dilithium signature must not verify against a BLS proposer, and vice versa.
+	if err := blocks.VerifyBlockSignature(blsState, 0, signHeader(t, blsState, dilithiumPriv, header), header.HashTreeRoot); err == nil {
+		t.Fatal("Dilithium signature verified against a BLS proposer")
+	}
+	dilithiumState := proposerState(t, dilithiumPriv.PublicKey())
+	if err := blocks.VerifyBlockSignature(dilithiumState, 0, signHeader(t, dilithiumState, blsPriv, header), header.HashTreeRoot); err == nil {
+		t.Fatal("BLS signature verified against a Dilithium proposer")
+	}
+}
@@ -2,6 +2,7 @@
 package operation
 
 import (
+	"github.com/theQRL/zond/consensus-types/interfaces"
 	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
 )
 
@@ -19,6 +20,16 @@ const (
 
 	// SyncCommitteeContributionReceived is sent after a sync committee contribution object has been received.
 	SyncCommitteeContributionReceived
+
+	// BlockGossipReceived is sent after a block has been received from gossip and passed validation,
+	// before it is imported.
+	BlockGossipReceived
+
+	// ProposerSlashingReceived is sent after a proposer slashing object has been inserted into the slashings pool.
+	ProposerSlashingReceived
+
+	// AttesterSlashingReceived is sent after an attester slashing object has been inserted into the slashings pool.
+	AttesterSlashingReceived
 )
 
 // UnAggregatedAttReceivedData is the data sent with UnaggregatedAttReceived events.
@@ -44,3 +55,21 @@ type SyncCommitteeContributionReceivedData struct {
 	// Contribution is the sync committee contribution object.
 	Contribution *ethpb.SignedContributionAndProof
 }
+
+// BlockGossipReceivedData is the data sent with BlockGossipReceived events.
+type BlockGossipReceivedData struct {
+	// SignedBlock is the block received from gossip.
+	SignedBlock interfaces.SignedBeaconBlock
+}
+
+// ProposerSlashingReceivedData is the data sent with ProposerSlashingReceived events.
+type ProposerSlashingReceivedData struct {
+	// ProposerSlashing is the proposer slashing object.
+	ProposerSlashing *ethpb.ProposerSlashing
+}
+
+// AttesterSlashingReceivedData is the data sent with AttesterSlashingReceived events.
+type AttesterSlashingReceivedData struct {
+	// AttesterSlashing is the attester slashing object.
+	AttesterSlashing *ethpb.AttesterSlashing
+}
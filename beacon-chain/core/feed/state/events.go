@@ -8,6 +8,7 @@ import (
 
 	"github.com/theQRL/zond/consensus-types/interfaces"
 	types "github.com/theQRL/zond/consensus-types/primitives"
+	enginev1 "github.com/theQRL/zond/protos/engine/v1"
 )
 
 const (
@@ -26,6 +27,9 @@ const (
 	FinalizedCheckpoint
 	// NewHead of the chain event.
 	NewHead
+	// PayloadAttributes is sent when fork choice asks the execution engine to prepare a payload
+	// for the next slot's proposer.
+	PayloadAttributes
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
@@ -59,3 +63,21 @@ type InitializedData struct {
 	// GenesisValidatorsRoot represents state.validators.HashTreeRoot().
 	GenesisValidatorsRoot []byte
 }
+
+// PayloadAttributesData is the data sent with PayloadAttributes events.
+type PayloadAttributesData struct {
+	// Version is the fork version of the head state the payload is built on.
+	Version int
+	// ProposerIndex is the index of the validator proposing at ProposalSlot.
+	ProposerIndex types.ValidatorIndex
+	// ProposalSlot is the slot of the block the payload is prepared for.
+	ProposalSlot types.Slot
+	// ParentBlockNumber is the execution block number of the parent payload.
+	ParentBlockNumber uint64
+	// ParentBlockRoot is the beacon block root of the parent block.
+	ParentBlockRoot []byte
+	// ParentBlockHash is the execution block hash of the parent payload.
+	ParentBlockHash []byte
+	// PayloadAttributes are the attributes passed to the execution engine.
+	PayloadAttributes *enginev1.PayloadAttributes
+}
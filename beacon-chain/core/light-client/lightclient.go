@@ -0,0 +1,283 @@
+// Package lightclient derives the data served to light clients from the blocks and states
+// of the beacon chain, as defined in the Altair light client sync protocol.
+package lightclient
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/theQRL/zond/beacon-chain/state"
+	fieldparams "github.com/theQRL/zond/config/fieldparams"
+	"github.com/theQRL/zond/config/params"
+	consensusblocks "github.com/theQRL/zond/consensus-types/blocks"
+	"github.com/theQRL/zond/consensus-types/interfaces"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+	"github.com/theQRL/zond/runtime/version"
+	"github.com/theQRL/zond/time/slots"
+)
+
+const (
+	// syncCommitteeBranchDepth is the depth of the Merkle proof of a sync committee in the beacon state.
+	syncCommitteeBranchDepth = 5
+	// finalityBranchDepth is the depth of the Merkle proof of the finalized root in the beacon state.
+	finalityBranchDepth = 6
+)
+
+// Period returns the sync committee period of the given slot.
+func Period(slot types.Slot) uint64 {
+	return slots.SyncCommitteePeriod(slots.ToEpoch(slot))
+}
+
+// BlockToHeader returns the light client header of the given block.
+func BlockToHeader(block interfaces.SignedBeaconBlock) (*ethpb.LightClientHeader, error) {
+	if err := consensusblocks.BeaconBlockIsNil(block); err != nil {
+		return nil, err
+	}
+	header, err := block.Header()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get block header")
+	}
+	return &ethpb.LightClientHeader{Beacon: header.Header}, nil
+}
+
+// NewBootstrap creates the light client bootstrap of the given block from its post state.
+func NewBootstrap(ctx context.Context, st state.BeaconState, block interfaces.SignedBeaconBlock) (*ethpb.LightClientBootstrap, error) {
+	if st.Version() < version.Altair {
+		return nil, errors.New("light client bootstrap is not supported before Altair")
+	}
+	if st.Slot() != st.LatestBlockHeader().Slot {
+		return nil, errors.New("state slot does not match the slot of its latest block header")
+	}
+	header, err := BlockToHeader(block)
+	if err != nil {
+		return nil, err
+	}
+	stateRoot, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute state root")
+	}
+	if !bytes.Equal(stateRoot[:], header.Beacon.StateRoot) {
+		return nil, errors.New("state root does not match the state root of the block")
+	}
+	committee, err := st.CurrentSyncCommittee()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get current sync committee")
+	}
+	branch, err := st.CurrentSyncCommitteeProof(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get current sync committee proof")
+	}
+	return &ethpb.LightClientBootstrap{
+		Header:                     header,
+		CurrentSyncCommittee:       committee,
+		CurrentSyncCommitteeBranch: branch,
+	}, nil
+}
+
+// NewUpdate creates the light client update for the sync aggregate of the given block.
+// The attested block is the parent of the block, whose post state is the attested state.
+// The finalized block is the block of the finalized checkpoint of the attested state,
+// and may be nil when that checkpoint is the genesis checkpoint.
+func NewUpdate(
+	ctx context.Context,
+	block interfaces.SignedBeaconBlock,
+	attestedState state.BeaconState,
+	attestedBlock interfaces.SignedBeaconBlock,
+	finalizedBlock interfaces.SignedBeaconBlock,
+) (*ethpb.LightClientUpdate, error) {
+	if err := consensusblocks.BeaconBlockIsNil(block); err != nil {
+		return nil, err
+	}
+	if block.Version() < version.Altair || attestedState.Version() < version.Altair {
+		return nil, errors.New("light client update is not supported before Altair")
+	}
+	syncAggregate, err := block.Block().Body().SyncAggregate()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get sync aggregate")
+	}
+	if participants := bitfield.Bitvector512(syncAggregate.SyncCommitteeBits).Count(); participants < params.BeaconConfig().MinSyncCommitteeParticipants {
+		return nil, errors.Errorf("sync aggregate has %d participants, which is less than the minimum of %d",
+			participants, params.BeaconConfig().MinSyncCommitteeParticipants)
+	}
+	if attestedState.Slot() != attestedState.LatestBlockHeader().Slot {
+		return nil, errors.New("attested state slot does not match the slot of its latest block header")
+	}
+	attestedHeader, err := BlockToHeader(attestedBlock)
+	if err != nil {
+		return nil, err
+	}
+	attestedRoot, err := attestedHeader.Beacon.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attested header root")
+	}
+	parentRoot := block.Block().ParentRoot()
+	if attestedRoot != parentRoot {
+		return nil, errors.New("attested block is not the parent of the block")
+	}
+
+	update := &ethpb.LightClientUpdate{
+		AttestedHeader:          attestedHeader,
+		NextSyncCommittee:       emptySyncCommittee(),
+		NextSyncCommitteeBranch: emptyBranch(syncCommitteeBranchDepth),
+		FinalizedHeader:         emptyHeader(),
+		SyncAggregate:           syncAggregate,
+		SignatureSlot:           block.Block().Slot(),
+	}
+	// The next sync committee is only useful to light clients while it has not become the current one.
+	if Period(attestedBlock.Block().Slot()) == Period(block.Block().Slot()) {
+		update.NextSyncCommittee, err = attestedState.NextSyncCommittee()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get next sync committee")
+		}
+		update.NextSyncCommitteeBranch, err = attestedState.NextSyncCommitteeProof(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get next sync committee proof")
+		}
+	}
+
+	finalizedRoot := attestedState.FinalizedCheckpoint().Root
+	if !bytes.Equal(finalizedRoot, params.BeaconConfig().ZeroHash[:]) {
+		finalizedHeader, err := BlockToHeader(finalizedBlock)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get finalized header")
+		}
+		root, err := finalizedHeader.Beacon.HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not compute finalized header root")
+		}
+		if !bytes.Equal(root[:], finalizedRoot) {
+			return nil, errors.New("finalized block does not match the finalized checkpoint of the attested state")
+		}
+		update.FinalizedHeader = finalizedHeader
+	}
+	update.FinalityBranch, err = attestedState.FinalizedRootProof(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized root proof")
+	}
+	return update, nil
+}
+
+// FinalityUpdate returns the finality update corresponding to the given update.
+func FinalityUpdate(update *ethpb.LightClientUpdate) *ethpb.LightClientFinalityUpdate {
+	return &ethpb.LightClientFinalityUpdate{
+		AttestedHeader:  update.AttestedHeader,
+		FinalizedHeader: update.FinalizedHeader,
+		FinalityBranch:  update.FinalityBranch,
+		SyncAggregate:   update.SyncAggregate,
+		SignatureSlot:   update.SignatureSlot,
+	}
+}
+
+// OptimisticUpdate returns the optimistic update corresponding to the given update.
+func OptimisticUpdate(update *ethpb.LightClientUpdate) *ethpb.LightClientOptimisticUpdate {
+	return &ethpb.LightClientOptimisticUpdate{
+		AttestedHeader: update.AttestedHeader,
+		SyncAggregate:  update.SyncAggregate,
+		SignatureSlot:  update.SignatureSlot,
+	}
+}
+
+// IsSyncCommitteeUpdate returns true if the update carries the next sync committee.
+func IsSyncCommitteeUpdate(update *ethpb.LightClientUpdate) bool {
+	return !isEmptyBranch(update.NextSyncCommitteeBranch)
+}
+
+// IsFinalityUpdate returns true if the update carries a finalized header.
+func IsFinalityUpdate(update *ethpb.LightClientUpdate) bool {
+	return !isEmptyBranch(update.FinalityBranch)
+}
+
+// IsBetterUpdate returns true if the new update is better than the old one according to
+// the ranking of the light client sync protocol. It is used to keep the best update of
+// each sync committee period.
+func IsBetterUpdate(newUpdate, oldUpdate *ethpb.LightClientUpdate) bool {
+	maxParticipants := bitfield.Bitvector512(newUpdate.SyncAggregate.SyncCommitteeBits).Len()
+	newParticipants := bitfield.Bitvector512(newUpdate.SyncAggregate.SyncCommitteeBits).Count()
+	oldParticipants := bitfield.Bitvector512(oldUpdate.SyncAggregate.SyncCommitteeBits).Count()
+
+	// Compare supermajority (> 2/3) sync committee participation.
+	newHasSupermajority := newParticipants*3 >= maxParticipants*2
+	oldHasSupermajority := oldParticipants*3 >= maxParticipants*2
+	if newHasSupermajority != oldHasSupermajority {
+		return newHasSupermajority
+	}
+	if !newHasSupermajority && newParticipants != oldParticipants {
+		return newParticipants > oldParticipants
+	}
+
+	// Compare presence of the relevant sync committee.
+	newHasSyncCommittee := IsSyncCommitteeUpdate(newUpdate) &&
+		Period(newUpdate.AttestedHeader.Beacon.Slot) == Period(newUpdate.SignatureSlot)
+	oldHasSyncCommittee := IsSyncCommitteeUpdate(oldUpdate) &&
+		Period(oldUpdate.AttestedHeader.Beacon.Slot) == Period(oldUpdate.SignatureSlot)
+	if newHasSyncCommittee != oldHasSyncCommittee {
+		return newHasSyncCommittee
+	}
+
+	// Compare indication of any finality.
+	newHasFinality := IsFinalityUpdate(newUpdate)
+	oldHasFinality := IsFinalityUpdate(oldUpdate)
+	if newHasFinality != oldHasFinality {
+		return newHasFinality
+	}
+
+	// Compare sync committee finality.
+	if newHasFinality {
+		newHasSyncCommitteeFinality := Period(newUpdate.FinalizedHeader.Beacon.Slot) == Period(newUpdate.AttestedHeader.Beacon.Slot)
+		oldHasSyncCommitteeFinality := Period(oldUpdate.FinalizedHeader.Beacon.Slot) == Period(oldUpdate.AttestedHeader.Beacon.Slot)
+		if newHasSyncCommitteeFinality != oldHasSyncCommitteeFinality {
+			return newHasSyncCommitteeFinality
+		}
+	}
+
+	// Tiebreaker 1: sync committee participation beyond supermajority.
+	if newParticipants != oldParticipants {
+		return newParticipants > oldParticipants
+	}
+	// Tiebreaker 2: prefer older data.
+	if newUpdate.AttestedHeader.Beacon.Slot != oldUpdate.AttestedHeader.Beacon.Slot {
+		return newUpdate.AttestedHeader.Beacon.Slot < oldUpdate.AttestedHeader.Beacon.Slot
+	}
+	return newUpdate.SignatureSlot < oldUpdate.SignatureSlot
+}
+
+func emptyHeader() *ethpb.LightClientHeader {
+	return &ethpb.LightClientHeader{
+		Beacon: &ethpb.BeaconBlockHeader{
+			ParentRoot: make([]byte, fieldparams.RootLength),
+			StateRoot:  make([]byte, fieldparams.RootLength),
+			BodyRoot:   make([]byte, fieldparams.RootLength),
+		},
+	}
+}
+
+func emptySyncCommittee() *ethpb.SyncCommittee {
+	pubkeys := make([][]byte, params.BeaconConfig().SyncCommitteeSize)
+	for i := range pubkeys {
+		pubkeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+	}
+	return &ethpb.SyncCommittee{
+		Pubkeys:         pubkeys,
+		AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength),
+	}
+}
+
+func emptyBranch(depth int) [][]byte {
+	branch := make([][]byte, depth)
+	for i := range branch {
+		branch[i] = make([]byte, fieldparams.RootLength)
+	}
+	return branch
+}
+
+func isEmptyBranch(branch [][]byte) bool {
+	for _, node := range branch {
+		if !bytes.Equal(node, params.BeaconConfig().ZeroHash[:]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,354 @@
+package lightclient
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/theQRL/zond/beacon-chain/state"
+	state_native "github.com/theQRL/zond/beacon-chain/state/state-native"
+	nativetypes "github.com/theQRL/zond/beacon-chain/state/state-native/types"
+	fieldparams "github.com/theQRL/zond/config/fieldparams"
+	"github.com/theQRL/zond/config/params"
+	"github.com/theQRL/zond/consensus-types/blocks"
+	"github.com/theQRL/zond/consensus-types/interfaces"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	"github.com/theQRL/zond/container/trie"
+	"github.com/theQRL/zond/encoding/bytesutil"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+	"google.golang.org/protobuf/proto"
+)
+
+func zeroRoots(n uint64) [][]byte {
+	roots := make([][]byte, n)
+	for i := range roots {
+		roots[i] = make([]byte, 32)
+	}
+	return roots
+}
+
+// testCommittee returns a sync committee whose public keys are distinct for each seed.
+func testCommittee(seed byte) *ethpb.SyncCommittee {
+	pubkeys := make([][]byte, params.BeaconConfig().SyncCommitteeSize)
+	for i := range pubkeys {
+		pubkeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+		pubkeys[i][0] = seed
+		pubkeys[i][1] = byte(i)
+		pubkeys[i][2] = byte(i >> 8)
+	}
+	aggregate := make([]byte, fieldparams.BLSPubkeyLength)
+	aggregate[0] = seed
+	return &ethpb.SyncCommittee{Pubkeys: pubkeys, AggregatePubkey: aggregate}
+}
+
+// testState returns an Altair state at the given slot, whose latest block header is at the
+// same slot, with the given finalized checkpoint.
+func testState(t *testing.T, slot types.Slot, finalized *ethpb.Checkpoint) state.BeaconState {
+	st, err := state_native.InitializeFromProtoUnsafeAltair(&ethpb.BeaconStateAltair{
+		Slot:                        slot,
+		GenesisValidatorsRoot:       make([]byte, 32),
+		Fork:                        &ethpb.Fork{PreviousVersion: make([]byte, 4), CurrentVersion: make([]byte, 4)},
+		LatestBlockHeader:           &ethpb.BeaconBlockHeader{Slot: slot, ParentRoot: make([]byte, 32), StateRoot: make([]byte, 32), BodyRoot: make([]byte, 32)},
+		BlockRoots:                  zeroRoots(uint64(params.BeaconConfig().SlotsPerHistoricalRoot)),
+		StateRoots:                  zeroRoots(uint64(params.BeaconConfig().SlotsPerHistoricalRoot)),
+		Eth1Data:                    &ethpb.Eth1Data{DepositRoot: make([]byte, 32), BlockHash: make([]byte, 32)},
+		RandaoMixes:                 zeroRoots(uint64(params.BeaconConfig().EpochsPerHistoricalVector)),
+		Slashings:                   make([]uint64, params.BeaconConfig().EpochsPerSlashingsVector),
+		JustificationBits:           bitfield.Bitvector4{0},
+		PreviousJustifiedCheckpoint: &ethpb.Checkpoint{Root: make([]byte, 32)},
+		CurrentJustifiedCheckpoint:  &ethpb.Checkpoint{Root: make([]byte, 32)},
+		FinalizedCheckpoint:         finalized,
+		CurrentSyncCommittee:        testCommittee(1),
+		NextSyncCommittee:           testCommittee(2),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return st
+}
+
+// testBlock returns an Altair block whose sync aggregate carries the votes of the given number
+// of sync committee members.
+func testBlock(t *testing.T, slot types.Slot, parentRoot, stateRoot []byte, participants uint64) interfaces.SignedBeaconBlock {
+	bits := bitfield.NewBitvector512()
+	for i := uint64(0); i < participants; i++ {
+		bits.SetBitAt(i, true)
+	}
+	blk, err := blocks.NewSignedBeaconBlock(&ethpb.SignedBeaconBlockAltair{
+		Block: &ethpb.BeaconBlockAltair{
+			Slot:       slot,
+			ParentRoot: bytesutil.SafeCopyBytes(parentRoot),
+			StateRoot:  bytesutil.SafeCopyBytes(stateRoot),
+			Body: &ethpb.BeaconBlockBodyAltair{
+				RandaoReveal: make([]byte, 96),
+				Eth1Data:     &ethpb.Eth1Data{DepositRoot: make([]byte, 32), BlockHash: make([]byte, 32)},
+				Graffiti:     make([]byte, 32),
+				SyncAggregate: &ethpb.SyncAggregate{
+					SyncCommitteeBits:      bits,
+					SyncCommitteeSignature: make([]byte, 96),
+				},
+			},
+		},
+		Signature: make([]byte, 96),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return blk
+}
+
+func mustHeader(t *testing.T, blk interfaces.SignedBeaconBlock) *ethpb.LightClientHeader {
+	header, err := BlockToHeader(blk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return header
+}
+
+func headerRoot(t *testing.T, blk interfaces.SignedBeaconBlock) []byte {
+	root, err := mustHeader(t, blk).Beacon.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return root[:]
+}
+
+func stateRoot(t *testing.T, st state.BeaconState) []byte {
+	root, err := st.HashTreeRoot(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return root[:]
+}
+
+func TestNewBootstrap(t *testing.T) {
+	ctx := context.Background()
+	st := testState(t, 100, &ethpb.Checkpoint{Root: make([]byte, 32)})
+	root := stateRoot(t, st)
+	blk := testBlock(t, 100, make([]byte, 32), root, 0)
+
+	bootstrap, err := NewBootstrap(ctx, st, blk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bootstrap.Header.Beacon.Slot != 100 || string(bootstrap.Header.Beacon.StateRoot) != string(root) {
+		t.Errorf("unexpected bootstrap header %v", bootstrap.Header.Beacon)
+	}
+	if !proto.Equal(bootstrap.CurrentSyncCommittee, testCommittee(1)) {
+		t.Error("bootstrap does not carry the current sync committee")
+	}
+	committeeRoot, err := bootstrap.CurrentSyncCommittee.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	index := uint64(nativetypes.CurrentSyncCommittee.RealPosition())
+	if len(bootstrap.CurrentSyncCommitteeBranch) != syncCommitteeBranchDepth {
+		t.Fatalf("expected a branch of depth %d, got %d", syncCommitteeBranchDepth, len(bootstrap.CurrentSyncCommitteeBranch))
+	}
+	if !trie.VerifyMerkleProof(root, committeeRoot[:], index, bootstrap.CurrentSyncCommitteeBranch) {
+		t.Error("current sync committee branch does not verify against the state root")
+	}
+}
+
+func TestNewBootstrap_Invalid(t *testing.T) {
+	ctx := context.Background()
+	st := testState(t, 100, &ethpb.Checkpoint{Root: make([]byte, 32)})
+	root := stateRoot(t, st)
+
+	phase0, err := state_native.InitializeFromProtoUnsafePhase0(&ethpb.BeaconState{Slot: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	skipped := testState(t, 100, &ethpb.Checkpoint{Root: make([]byte, 32)})
+	if err := skipped.SetSlot(101); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		st      state.BeaconState
+		blk     interfaces.SignedBeaconBlock
+		message string
+	}{
+		{"phase 0 state", phase0, testBlock(t, 100, make([]byte, 32), root, 0), "not supported before Altair"},
+		{"state after an empty slot", skipped, testBlock(t, 100, make([]byte, 32), root, 0), "state slot does not match"},
+		{"state root mismatch", st, testBlock(t, 100, make([]byte, 32), make([]byte, 32), 0), "state root does not match"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewBootstrap(ctx, tt.st, tt.blk); err == nil || !strings.Contains(err.Error(), tt.message) {
+				t.Errorf("unexpected error %v, want one containing %q", err, tt.message)
+			}
+		})
+	}
+}
+
+func TestNewUpdate(t *testing.T) {
+	ctx := context.Background()
+	slotsPerPeriod := types.Slot(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * params.BeaconConfig().SlotsPerEpoch
+	finalizedBlock := testBlock(t, 32, make([]byte, 32), make([]byte, 32), 0)
+	finalized := &ethpb.Checkpoint{Epoch: 1, Root: headerRoot(t, finalizedBlock)}
+
+	tests := []struct {
+		name          string
+		attestedSlot  types.Slot
+		signatureSlot types.Slot
+		finalized     *ethpb.Checkpoint
+		finalizedBlk  interfaces.SignedBeaconBlock
+		syncCommittee bool
+	}{
+		{"same period", 100, 101, finalized, finalizedBlock, true},
+		{"next period", slotsPerPeriod - 1, slotsPerPeriod, finalized, finalizedBlock, false},
+		{"genesis checkpoint", 100, 101, &ethpb.Checkpoint{Root: make([]byte, 32)}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attestedState := testState(t, tt.attestedSlot, tt.finalized)
+			attestedRoot := stateRoot(t, attestedState)
+			attestedBlock := testBlock(t, tt.attestedSlot, make([]byte, 32), attestedRoot, 0)
+			blk := testBlock(t, tt.signatureSlot, headerRoot(t, attestedBlock), make([]byte, 32), 400)
+
+			update, err := NewUpdate(ctx, blk, attestedState, attestedBlock, tt.finalizedBlk)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if update.AttestedHeader.Beacon.Slot != tt.attestedSlot || update.SignatureSlot != tt.signatureSlot {
+				t.Errorf("unexpected attested slot %d and signature slot %d", update.AttestedHeader.Beacon.Slot, update.SignatureSlot)
+			}
+			if got := bitfield.Bitvector512(update.SyncAggregate.SyncCommitteeBits).Count(); got != 400 {
+				t.Errorf("unexpected sync aggregate with %d participants", got)
+			}
+
+			if IsSyncCommitteeUpdate(update) != tt.syncCommittee {
+				t.Errorf("unexpected sync committee update %v", IsSyncCommitteeUpdate(update))
+			}
+			if tt.syncCommittee {
+				if !proto.Equal(update.NextSyncCommittee, testCommittee(2)) {
+					t.Error("update does not carry the next sync committee")
+				}
+				committeeRoot, err := update.NextSyncCommittee.HashTreeRoot()
+				if err != nil {
+					t.Fatal(err)
+				}
+				index := uint64(nativetypes.NextSyncCommittee.RealPosition())
+				if !trie.VerifyMerkleProof(attestedRoot, committeeRoot[:], index, update.NextSyncCommitteeBranch) {
+					t.Error("next sync committee branch does not verify against the attested state root")
+				}
+			} else if !proto.Equal(update.NextSyncCommittee, emptySyncCommittee()) || len(update.NextSyncCommitteeBranch) != syncCommitteeBranchDepth {
+				t.Error("expected an empty next sync committee and branch")
+			}
+
+			if tt.finalizedBlk != nil {
+				if !proto.Equal(update.FinalizedHeader.Beacon, mustHeader(t, tt.finalizedBlk).Beacon) {
+					t.Errorf("unexpected finalized header %v", update.FinalizedHeader.Beacon)
+				}
+			} else if !proto.Equal(update.FinalizedHeader, emptyHeader()) {
+				t.Errorf("expected an empty finalized header, got %v", update.FinalizedHeader.Beacon)
+			}
+			if len(update.FinalityBranch) != finalityBranchDepth {
+				t.Fatalf("expected a finality branch of depth %d, got %d", finalityBranchDepth, len(update.FinalityBranch))
+			}
+			if !trie.VerifyMerkleProof(attestedRoot, tt.finalized.Root, state_native.FinalizedRootGeneralizedIndex(), update.FinalityBranch) {
+				t.Error("finality branch does not verify against the attested state root")
+			}
+
+			finalityUpdate := FinalityUpdate(update)
+			if !proto.Equal(finalityUpdate.FinalizedHeader, update.FinalizedHeader) || finalityUpdate.SignatureSlot != update.SignatureSlot {
+				t.Error("finality update does not match the update")
+			}
+			optimisticUpdate := OptimisticUpdate(update)
+			if !proto.Equal(optimisticUpdate.AttestedHeader, update.AttestedHeader) || optimisticUpdate.SignatureSlot != update.SignatureSlot {
+				t.Error("optimistic update does not match the update")
+			}
+		})
+	}
+}
+
+func TestNewUpdate_Invalid(t *testing.T) {
+	ctx := context.Background()
+	finalizedBlock := testBlock(t, 32, make([]byte, 32), make([]byte, 32), 0)
+	attestedState := testState(t, 100, &ethpb.Checkpoint{Epoch: 1, Root: headerRoot(t, finalizedBlock)})
+	attestedBlock := testBlock(t, 100, make([]byte, 32), stateRoot(t, attestedState), 0)
+	parentRoot := headerRoot(t, attestedBlock)
+
+	skipped := attestedState.Copy()
+	if err := skipped.SetSlot(101); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		blk           interfaces.SignedBeaconBlock
+		attestedState state.BeaconState
+		finalizedBlk  interfaces.SignedBeaconBlock
+		message       string
+	}{
+		{"no participants", testBlock(t, 101, parentRoot, make([]byte, 32), 0), attestedState, finalizedBlock, "less than the minimum"},
+		{"attested state after an empty slot", testBlock(t, 102, parentRoot, make([]byte, 32), 400), skipped, finalizedBlock, "attested state slot does not match"},
+		{"attested block is not the parent", testBlock(t, 101, make([]byte, 32), make([]byte, 32), 400), attestedState, finalizedBlock, "not the parent"},
+		{"missing finalized block", testBlock(t, 101, parentRoot, make([]byte, 32), 400), attestedState, nil, "could not get finalized header"},
+		{"finalized block mismatch", testBlock(t, 101, parentRoot, make([]byte, 32), 400), attestedState, testBlock(t, 33, make([]byte, 32), make([]byte, 32), 0), "does not match the finalized checkpoint"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewUpdate(ctx, tt.blk, tt.attestedState, attestedBlock, tt.finalizedBlk); err == nil || !strings.Contains(err.Error(), tt.message) {
+				t.Errorf("unexpected error %v, want one containing %q", err, tt.message)
+			}
+		})
+	}
+}
+
+func TestIsBetterUpdate(t *testing.T) {
+	slotsPerPeriod := types.Slot(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * params.BeaconConfig().SlotsPerEpoch
+	// update returns an update with the given participation, attested and signature slots,
+	// which carries the next sync committee and a finalized header at the given slot if requested.
+	update := func(participants uint64, attestedSlot, signatureSlot types.Slot, syncCommittee bool, finalizedSlot *types.Slot) *ethpb.LightClientUpdate {
+		bits := bitfield.NewBitvector512()
+		for i := uint64(0); i < participants; i++ {
+			bits.SetBitAt(i, true)
+		}
+		u := &ethpb.LightClientUpdate{
+			AttestedHeader:          &ethpb.LightClientHeader{Beacon: &ethpb.BeaconBlockHeader{Slot: attestedSlot}},
+			NextSyncCommitteeBranch: emptyBranch(syncCommitteeBranchDepth),
+			FinalizedHeader:         emptyHeader(),
+			FinalityBranch:          emptyBranch(finalityBranchDepth),
+			SyncAggregate:           &ethpb.SyncAggregate{SyncCommitteeBits: bits},
+			SignatureSlot:           signatureSlot,
+		}
+		if syncCommittee {
+			u.NextSyncCommitteeBranch[0] = []byte{1}
+		}
+		if finalizedSlot != nil {
+			u.FinalizedHeader.Beacon.Slot = *finalizedSlot
+			u.FinalityBranch[0] = []byte{1}
+		}
+		return u
+	}
+	samePeriod := types.Slot(64)
+	previousPeriod := slotsPerPeriod - 1
+
+	tests := []struct {
+		name     string
+		new, old *ethpb.LightClientUpdate
+		better   bool
+	}{
+		{"supermajority", update(342, 100, 101, false, nil), update(341, 100, 101, true, &samePeriod), true},
+		{"no supermajority", update(341, 100, 101, false, nil), update(342, 100, 101, true, &samePeriod), false},
+		{"more participants without supermajority", update(300, 100, 101, false, nil), update(200, 100, 101, true, &samePeriod), true},
+		{"sync committee", update(400, 100, 101, true, nil), update(500, 100, 101, false, &samePeriod), true},
+		{"sync committee of the next period", update(400, slotsPerPeriod-1, slotsPerPeriod, true, nil), update(500, 100, 101, false, nil), false},
+		{"finality", update(400, 100, 101, true, &samePeriod), update(500, 100, 101, true, nil), true},
+		{"sync committee finality", update(400, 100, 101, true, &samePeriod), update(500, slotsPerPeriod+100, slotsPerPeriod+101, true, &previousPeriod), true},
+		{"participation beyond supermajority", update(401, 100, 101, true, &samePeriod), update(400, 100, 101, true, &samePeriod), true},
+		{"older attested header", update(400, 99, 101, true, &samePeriod), update(400, 100, 101, true, &samePeriod), true},
+		{"older signature slot", update(400, 100, 102, true, &samePeriod), update(400, 100, 101, true, &samePeriod), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsBetterUpdate(tt.new, tt.old); got != tt.better {
+				t.Errorf("IsBetterUpdate() = %v, want %v", got, tt.better)
+			}
+		})
+	}
+}
@@ -57,6 +57,10 @@ type ReadOnlyDatabase interface {
 	// origin checkpoint sync support
 	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
 	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
+	// Light client operations.
+	LightClientUpdate(ctx context.Context, period uint64) (*ethpb.LightClientUpdate, error)
+	LightClientUpdates(ctx context.Context, startPeriod, endPeriod uint64) ([]*ethpb.LightClientUpdate, error)
+	LightClientBootstrap(ctx context.Context, blockRoot [32]byte) (*ethpb.LightClientBootstrap, error)
 }
 
 // NoHeadAccessDatabase defines a struct without access to chain head data.
@@ -88,6 +92,9 @@ type NoHeadAccessDatabase interface {
 	// Fee reicipients operations.
 	SaveFeeRecipientsByValidatorIDs(ctx context.Context, ids []types.ValidatorIndex, addrs []common.Address) error
 	SaveRegistrationsByValidatorIDs(ctx context.Context, ids []types.ValidatorIndex, regs []*ethpb.ValidatorRegistrationV1) error
+	// Light client operations.
+	SaveLightClientUpdate(ctx context.Context, period uint64, update *ethpb.LightClientUpdate) error
+	SaveLightClientBootstrap(ctx context.Context, blockRoot [32]byte, bootstrap *ethpb.LightClientBootstrap) error
 
 	CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint types.Slot) error
 }
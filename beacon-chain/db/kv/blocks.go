@@ -72,7 +72,7 @@ func (s *Store) OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
 	return root, err
 }
 
-// BackfillBlockRoot keeps track of the highest block available before the OriginCheckpointBlockRoot
+// BackfillBlockRoot keeps track of the lowest block backfilled below the OriginCheckpointBlockRoot
 func (s *Store) BackfillBlockRoot(ctx context.Context) ([32]byte, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.BackfillBlockRoot")
 	defer span.End()
@@ -126,6 +126,9 @@ var Buckets = [][]byte{
 
 	feeRecipientBucket,
 	registrationBucket,
+
+	lightClientUpdatesBucket,
+	lightClientBootstrapBucket,
 }
 
 // NewKVStore initializes a new boltDB key-value store at the directory
@@ -0,0 +1,108 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/zond/encoding/bytesutil"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// SaveLightClientUpdate saves the best light client update known for the given sync committee period.
+func (s *Store) SaveLightClientUpdate(ctx context.Context, period uint64, update *ethpb.LightClientUpdate) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveLightClientUpdate")
+	defer span.End()
+
+	enc, err := encode(ctx, update)
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(lightClientUpdatesBucket)
+		return bkt.Put(bytesutil.Uint64ToBytesBigEndian(period), enc)
+	})
+}
+
+// LightClientUpdate returns the light client update saved for the given sync committee period.
+// A nil update is returned if there is no update for the period.
+func (s *Store) LightClientUpdate(ctx context.Context, period uint64) (*ethpb.LightClientUpdate, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LightClientUpdate")
+	defer span.End()
+
+	var update *ethpb.LightClientUpdate
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(lightClientUpdatesBucket).Get(bytesutil.Uint64ToBytesBigEndian(period))
+		if enc == nil {
+			return nil
+		}
+		update = &ethpb.LightClientUpdate{}
+		return decode(ctx, enc, update)
+	})
+	return update, err
+}
+
+// LightClientUpdates returns the light client updates saved for the sync committee periods
+// in the range [startPeriod, endPeriod]. The updates are ordered by period and the range
+// ends at the first period without an update, so that the result has no gaps.
+func (s *Store) LightClientUpdates(ctx context.Context, startPeriod, endPeriod uint64) ([]*ethpb.LightClientUpdate, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LightClientUpdates")
+	defer span.End()
+
+	if startPeriod > endPeriod {
+		return nil, errors.New("start period cannot be greater than end period")
+	}
+	updates := make([]*ethpb.LightClientUpdate, 0)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(lightClientUpdatesBucket).Cursor()
+		expected := startPeriod
+		for k, v := c.Seek(bytesutil.Uint64ToBytesBigEndian(startPeriod)); k != nil; k, v = c.Next() {
+			period := bytesutil.BytesToUint64BigEndian(k)
+			if period > endPeriod || period != expected {
+				break
+			}
+			update := &ethpb.LightClientUpdate{}
+			if err := decode(ctx, v, update); err != nil {
+				return err
+			}
+			updates = append(updates, update)
+			expected++
+		}
+		return nil
+	})
+	return updates, err
+}
+
+// SaveLightClientBootstrap saves the light client bootstrap of the given block root.
+func (s *Store) SaveLightClientBootstrap(ctx context.Context, blockRoot [32]byte, bootstrap *ethpb.LightClientBootstrap) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveLightClientBootstrap")
+	defer span.End()
+
+	enc, err := encode(ctx, bootstrap)
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(lightClientBootstrapBucket)
+		return bkt.Put(blockRoot[:], enc)
+	})
+}
+
+// LightClientBootstrap returns the light client bootstrap of the given block root.
+// A nil bootstrap is returned if there is no bootstrap for the block root.
+func (s *Store) LightClientBootstrap(ctx context.Context, blockRoot [32]byte) (*ethpb.LightClientBootstrap, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LightClientBootstrap")
+	defer span.End()
+
+	var bootstrap *ethpb.LightClientBootstrap
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(lightClientBootstrapBucket).Get(blockRoot[:])
+		if enc == nil {
+			return nil
+		}
+		bootstrap = &ethpb.LightClientBootstrap{}
+		return decode(ctx, enc, bootstrap)
+	})
+	return bootstrap, err
+}
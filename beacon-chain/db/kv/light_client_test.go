@@ -0,0 +1,158 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	fieldparams "github.com/theQRL/zond/config/fieldparams"
+	"github.com/theQRL/zond/config/params"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+	"google.golang.org/protobuf/proto"
+)
+
+func setupLightClientDB(t *testing.T) *Store {
+	s, err := NewKVStore(context.Background(), t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := s.Close(); err != nil {
+			t.Fatalf("failed to close database: %v", err)
+		}
+	})
+	return s
+}
+
+func testLightClientHeader(slot types.Slot) *ethpb.LightClientHeader {
+	return &ethpb.LightClientHeader{Beacon: &ethpb.BeaconBlockHeader{
+		Slot:       slot,
+		ParentRoot: make([]byte, fieldparams.RootLength),
+		StateRoot:  make([]byte, fieldparams.RootLength),
+		BodyRoot:   make([]byte, fieldparams.RootLength),
+	}}
+}
+
+func testSyncCommittee() *ethpb.SyncCommittee {
+	pubkeys := make([][]byte, params.BeaconConfig().SyncCommitteeSize)
+	for i := range pubkeys {
+		pubkeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+	}
+	return &ethpb.SyncCommittee{Pubkeys: pubkeys, AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength)}
+}
+
+func testBranch(depth int) [][]byte {
+	branch := make([][]byte, depth)
+	for i := range branch {
+		branch[i] = make([]byte, fieldparams.RootLength)
+	}
+	return branch
+}
+
+// testLightClientUpdate returns an update whose attested header is at the given slot.
+func testLightClientUpdate(slot types.Slot) *ethpb.LightClientUpdate {
+	return &ethpb.LightClientUpdate{
+		AttestedHeader:          testLightClientHeader(slot),
+		NextSyncCommittee:       testSyncCommittee(),
+		NextSyncCommitteeBranch: testBranch(5),
+		FinalizedHeader:         testLightClientHeader(0),
+		FinalityBranch:          testBranch(6),
+		SyncAggregate:           &ethpb.SyncAggregate{SyncCommitteeBits: bitfield.NewBitvector512(), SyncCommitteeSignature: make([]byte, 96)},
+		SignatureSlot:           slot + 1,
+	}
+}
+
+func TestStore_LightClientUpdates(t *testing.T) {
+	ctx := context.Background()
+	s := setupLightClientDB(t)
+
+	update, err := s.LightClientUpdate(ctx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if update != nil {
+		t.Fatalf("expected no update before saving one, got %v", update)
+	}
+	// Periods 1 to 3 are stored, followed by a gap at period 4 and period 5.
+	for _, period := range []uint64{1, 2, 3, 5} {
+		if err := s.SaveLightClientUpdate(ctx, period, testLightClientUpdate(types.Slot(period))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Saving an update again replaces the update of the period.
+	if err := s.SaveLightClientUpdate(ctx, 2, testLightClientUpdate(20)); err != nil {
+		t.Fatal(err)
+	}
+	update, err = s.LightClientUpdate(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(update, testLightClientUpdate(20)) {
+		t.Errorf("unexpected update %v", update)
+	}
+
+	tests := []struct {
+		name       string
+		start, end uint64
+		slots      []types.Slot
+	}{
+		{"single period", 3, 3, []types.Slot{3}},
+		{"range", 1, 3, []types.Slot{1, 20, 3}},
+		{"range ends at the first gap", 2, 10, []types.Slot{20, 3}},
+		{"range starts at a gap", 4, 5, nil},
+		{"range after the last update", 6, 10, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updates, err := s.LightClientUpdates(ctx, tt.start, tt.end)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(updates) != len(tt.slots) {
+				t.Fatalf("expected %d updates, got %d", len(tt.slots), len(updates))
+			}
+			for i, u := range updates {
+				if !proto.Equal(u, testLightClientUpdate(tt.slots[i])) {
+					t.Errorf("unexpected update at position %d with attested slot %d", i, u.AttestedHeader.Beacon.Slot)
+				}
+			}
+		})
+	}
+
+	if _, err := s.LightClientUpdates(ctx, 3, 2); err == nil {
+		t.Error("expected a start period after the end period to be rejected")
+	}
+}
+
+func TestStore_LightClientBootstrap(t *testing.T) {
+	ctx := context.Background()
+	s := setupLightClientDB(t)
+	root := [32]byte{'a'}
+
+	bootstrap, err := s.LightClientBootstrap(ctx, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bootstrap != nil {
+		t.Fatalf("expected no bootstrap before saving one, got %v", bootstrap)
+	}
+	want := &ethpb.LightClientBootstrap{
+		Header:                     testLightClientHeader(100),
+		CurrentSyncCommittee:       testSyncCommittee(),
+		CurrentSyncCommitteeBranch: testBranch(5),
+	}
+	if err := s.SaveLightClientBootstrap(ctx, root, want); err != nil {
+		t.Fatal(err)
+	}
+	bootstrap, err = s.LightClientBootstrap(ctx, root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(bootstrap, want) {
+		t.Errorf("unexpected bootstrap %v", bootstrap)
+	}
+	if bootstrap, err := s.LightClientBootstrap(ctx, [32]byte{'b'}); err != nil || bootstrap != nil {
+		t.Errorf("expected no bootstrap for another block root, got %v, %v", bootstrap, err)
+	}
+}
@@ -17,17 +17,13 @@ import (
 // syncing, using the provided values as their point of origin. This is an alternative
 // to syncing from genesis, and should only be run on an empty database.
 func (s *Store) SaveOrigin(ctx context.Context, serState, serBlock []byte) error {
-	genesisRoot, err := s.GenesisBlockRoot(ctx)
+	_, err := s.GenesisBlockRoot(ctx)
 	if err != nil {
 		if errors.Is(err, ErrNotFoundGenesisBlockRoot) {
 			return errors.Wrap(err, "genesis block root not found: genesis must be provided for checkpoint sync")
 		}
 		return errors.Wrap(err, "genesis block root query error: checkpoint sync must verify genesis to proceed")
 	}
-	err = s.SaveBackfillBlockRoot(ctx, genesisRoot)
-	if err != nil {
-		return errors.Wrap(err, "unable to save genesis root as initial backfill starting point for checkpoint sync")
-	}
 
 	cf, err := detect.FromState(serState)
 	if err != nil {
@@ -59,6 +55,10 @@ func (s *Store) SaveOrigin(ctx context.Context, serState, serBlock []byte) error
 	if err := s.SaveBlock(ctx, wblk); err != nil {
 		return errors.Wrap(err, "could not save checkpoint block")
 	}
+	// blocks below the checkpoint block are backfilled downwards from it
+	if err := s.SaveBackfillBlockRoot(ctx, blockRoot); err != nil {
+		return errors.Wrap(err, "unable to save checkpoint block root as initial backfill starting point")
+	}
 
 	// save state
 	log.Infof("calling SaveState w/ blockRoot=%x", blockRoot)
@@ -7,6 +7,7 @@ import (
 	"github.com/theQRL/zond/beacon-chain/state"
 	"github.com/theQRL/zond/beacon-chain/state/stategen"
 	"github.com/theQRL/zond/common"
+	"github.com/theQRL/zond/zond/gasprice"
 )
 
 type Option func(s *Service) error
@@ -91,6 +92,22 @@ func WithEth1HeaderRequestLimit(limit uint64) Option {
 	}
 }
 
+// WithAddressIndex enables the address transaction index of the execution chain.
+func WithAddressIndex(enabled bool) Option {
+	return func(s *Service) error {
+		s.cfg.addressIndex = enabled
+		return nil
+	}
+}
+
+// WithGasPriceOracle sets the gas price oracle settings of the execution chain.
+func WithGasPriceOracle(cfg gasprice.Config) Option {
+	return func(s *Service) error {
+		s.cfg.gpo = &cfg
+		return nil
+	}
+}
+
 // WithBeaconNodeStatsUpdater to set the beacon node stats updater.
 func WithBeaconNodeStatsUpdater(updater BeaconNodeStatsUpdater) Option {
 	return func(s *Service) error {
@@ -44,7 +44,9 @@ import (
 	"github.com/theQRL/zond/time/slots"
 	"github.com/theQRL/zond/zond"
 	"github.com/theQRL/zond/zond/catalyst"
+	"github.com/theQRL/zond/zond/gasprice"
 	"github.com/theQRL/zond/zond/tracers"
+	"github.com/theQRL/zond/zond/zondconfig"
 )
 
 var (
@@ -141,6 +143,8 @@ type config struct {
 	currHttpEndpoint        network.Endpoint
 	headers                 []string
 	finalizedStateAtStartup state.BeaconState
+	addressIndex            bool
+	gpo                     *gasprice.Config
 }
 
 // Service fetches important information about the canonical
@@ -276,7 +280,12 @@ func (s *Service) Start() {
 	if err != nil {
 		log.Error("Failed to start API stacks ", err)
 	}
-	backend, err := zond.New(s.stack)
+	zondConfig := zondconfig.Defaults
+	zondConfig.AddressIndex = s.cfg.addressIndex
+	if s.cfg.gpo != nil {
+		zondConfig.GPO = *s.cfg.gpo
+	}
+	backend, err := zond.New(s.stack, &zondConfig)
 	if err != nil {
 		log.Errorf("Error creating zond backend: %v", err)
 	}
@@ -284,6 +293,7 @@ func (s *Service) Start() {
 	s.consensusApi = catalyst.NewConsensusAPI(s.zond)
 
 	defer s.stack.Close()
+	defer s.zond.Stop()
 
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt)
@@ -15,6 +15,8 @@ import (
 	"sync"
 	"syscall"
 
+	"github.com/gorilla/mux"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	apigateway "github.com/theQRL/zond/api/gateway"
@@ -53,10 +55,12 @@ import (
 	"github.com/theQRL/zond/common"
 	"github.com/theQRL/zond/config/features"
 	"github.com/theQRL/zond/config/params"
+	"github.com/theQRL/zond/consensus-types/interfaces"
 	types "github.com/theQRL/zond/consensus-types/primitives"
 	"github.com/theQRL/zond/container/slice"
 	"github.com/theQRL/zond/encoding/bytesutil"
 	"github.com/theQRL/zond/monitoring/prometheus"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
 	"github.com/theQRL/zond/runtime"
 	"github.com/theQRL/zond/runtime/debug"
 	"github.com/theQRL/zond/runtime/prereqs"
@@ -76,6 +80,7 @@ type serviceFlagOpts struct {
 	blockchainFlagOpts     []blockchain.Option
 	executionChainFlagOpts []execution.Option
 	builderOpts            []builder.Option
+	backfillOpts           []backfill.ServiceOption
 }
 
 // BeaconNode defines a struct that handles the services running a random beacon chain
@@ -108,6 +113,7 @@ type BeaconNode struct {
 	GenesisInitializer      genesis.Initializer
 	CheckpointInitializer   checkpoint.Initializer
 	forkChoicer             forkchoice.ForkChoicer
+	router                  *mux.Router
 }
 
 // New creates a new node instance, sets up configuration options, and registers
@@ -172,6 +178,7 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		slasherAttestationsFeed: new(event.Feed),
 		serviceFlagOpts:         &serviceFlagOpts{},
 		proposerIdsCache:        cache.NewProposerPayloadIDsCache(),
+		router:                  mux.NewRouter(),
 	}
 
 	for _, opt := range opts {
@@ -240,6 +247,11 @@ func New(cliCtx *cli.Context, opts ...Option) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	log.Debugln("Registering Backfill Service")
+	if err := beacon.registerBackfillService(bfs); err != nil {
+		return nil, err
+	}
+
 	log.Debugln("Registering Slasher Service")
 	if err := beacon.registerSlasherService(); err != nil {
 		return nil, err
@@ -695,6 +707,34 @@ func (b *BeaconNode) registerInitialSyncService() error {
 	return b.services.RegisterService(is)
 }
 
+func (b *BeaconNode) registerBackfillService(bfs *backfill.Status) error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	var initSync *initialsync.Service
+	if err := b.services.FetchService(&initSync); err != nil {
+		return err
+	}
+
+	p2pService := b.fetchP2P()
+	requester := func(ctx context.Context, pid peer.ID, req *ethpb.BeaconBlocksByRangeRequest) ([]interfaces.SignedBeaconBlock, error) {
+		return regularsync.SendBeaconBlocksByRangeRequest(ctx, chainService, p2pService, pid, req, nil)
+	}
+	opts := append([]backfill.ServiceOption{
+		backfill.WithDatabase(b.db),
+		backfill.WithP2P(p2pService),
+		backfill.WithBlocksByRangeRequester(requester),
+		backfill.WithSyncChecker(initSync),
+	}, b.serviceFlagOpts.backfillOpts...)
+	bf, err := backfill.NewService(b.ctx, bfs, opts...)
+	if err != nil {
+		return errors.Wrap(err, "could not create backfill service")
+	}
+	return b.services.RegisterService(bf)
+}
+
 func (b *BeaconNode) registerSlasherService() error {
 	if !features.Get().EnableSlasher {
 		return nil
@@ -713,6 +753,7 @@ func (b *BeaconNode) registerSlasherService() error {
 		BeaconBlockHeadersFeed:  b.slasherBlockHeadersFeed,
 		Database:                b.slasherDB,
 		StateNotifier:           b,
+		OperationNotifier:       b,
 		AttestationStateFetcher: chainService,
 		StateGen:                b.stateGen,
 		SlashingPoolInserter:    b.slashingsPool,
@@ -804,6 +845,7 @@ func (b *BeaconNode) registerRPCService() error {
 		GenesisTimeFetcher:            chainService,
 		GenesisFetcher:                chainService,
 		OptimisticModeFetcher:         chainService,
+		LightClientFetcher:            chainService,
 		AttestationsPool:              b.attestationPool,
 		ExitPool:                      b.exitPool,
 		SlashingsPool:                 b.slashingsPool,
@@ -824,6 +866,7 @@ func (b *BeaconNode) registerRPCService() error {
 		MaxMsgSize:                    maxMsgSize,
 		ProposerIdsCache:              b.proposerIdsCache,
 		BlockBuilder:                  b.fetchBuilderService(),
+		Router:                        b.router,
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -889,6 +932,7 @@ func (b *BeaconNode) registerGRPCGateway() error {
 		apigateway.WithMaxCallRecvMsgSize(maxCallSize),
 		apigateway.WithAllowedOrigins(allowedOrigins),
 		apigateway.WithTimeout(uint64(timeout)),
+		apigateway.WithRouter(b.router),
 	}
 	if flags.EnableHTTPEthAPI(httpModules) {
 		opts = append(opts, apigateway.WithApiMiddleware(&apimiddleware.BeaconEndpointFactory{}))
@@ -4,6 +4,7 @@ import (
 	"github.com/theQRL/zond/beacon-chain/blockchain"
 	"github.com/theQRL/zond/beacon-chain/builder"
 	"github.com/theQRL/zond/beacon-chain/execution"
+	"github.com/theQRL/zond/beacon-chain/sync/backfill"
 )
 
 // Option for beacon node configuration.
@@ -32,3 +33,11 @@ func WithBuilderFlagOptions(opts []builder.Option) Option {
 		return nil
 	}
 }
+
+// WithBackfillFlagOptions includes functional options for the backfill service related to CLI flags.
+func WithBackfillFlagOptions(opts []backfill.ServiceOption) Option {
+	return func(bn *BeaconNode) error {
+		bn.serviceFlagOpts.backfillOpts = opts
+		return nil
+	}
+}
@@ -120,3 +120,32 @@ func (m *ErrorMessage) UnmarshalSSZ(buf []byte) error {
 	*m = errMsg
 	return nil
 }
+
+// LightClientBootstrapReq specifies the light client bootstrap request type, which is the
+// root of the block the light client trusts.
+type LightClientBootstrapReq [rootLength]byte
+
+// MarshalSSZTo marshals the light client bootstrap request with the provided byte slice.
+func (r *LightClientBootstrapReq) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, r[:]...), nil
+}
+
+// MarshalSSZ Marshals the light client bootstrap request type into the serialized object.
+func (r *LightClientBootstrapReq) MarshalSSZ() ([]byte, error) {
+	return r.MarshalSSZTo(make([]byte, 0, r.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized representation.
+func (r *LightClientBootstrapReq) SizeSSZ() int {
+	return rootLength
+}
+
+// UnmarshalSSZ unmarshals the provided bytes buffer into the
+// light client bootstrap request object.
+func (r *LightClientBootstrapReq) UnmarshalSSZ(buf []byte) error {
+	if len(buf) != rootLength {
+		return ssz.ErrSize
+	}
+	copy(r[:], buf)
+	return nil
+}
@@ -403,6 +403,24 @@ func receiveEvents(eventChan <-chan *sse.Event, w http.ResponseWriter, req *http
 				data = &EventChainReorgJson{}
 			case events.SyncCommitteeContributionTopic:
 				data = &SignedContributionAndProofJson{}
+			case events.BlockGossipTopic:
+				data = &EventBlockGossipJson{}
+			case events.ProposerSlashingTopic:
+				data = &ProposerSlashingJson{}
+			case events.AttesterSlashingTopic:
+				data = &AttesterSlashingJson{}
+			case events.PayloadAttributesTopic:
+				data = &EventPayloadAttributesJson{}
+
+				// Payload attributes are sent as a generic protobuf struct, which is wrapped
+				// in a "value" field when marshaled inside the event. Unwrap it here.
+				wrapped := &struct {
+					Value json.RawMessage `json:"value"`
+				}{}
+				if err := json.Unmarshal(msg.Data, wrapped); err != nil {
+					return apimiddleware.InternalServerError(err)
+				}
+				msg.Data = wrapped.Value
 			case "error":
 				data = &EventErrorJson{}
 			default:
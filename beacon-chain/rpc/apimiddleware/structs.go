@@ -926,6 +926,31 @@ type EventChainReorgJson struct {
 	ExecutionOptimistic bool   `json:"execution_optimistic"`
 }
 
+type EventBlockGossipJson struct {
+	Slot  string `json:"slot"`
+	Block string `json:"block" hex:"true"`
+}
+
+type EventPayloadAttributesJson struct {
+	Version string                          `json:"version"`
+	Data    *EventPayloadAttributesDataJson `json:"data"`
+}
+
+type EventPayloadAttributesDataJson struct {
+	ProposerIndex     string                 `json:"proposer_index"`
+	ProposalSlot      string                 `json:"proposal_slot"`
+	ParentBlockNumber string                 `json:"parent_block_number"`
+	ParentBlockRoot   string                 `json:"parent_block_root"`
+	ParentBlockHash   string                 `json:"parent_block_hash"`
+	PayloadAttributes *PayloadAttributesJson `json:"payload_attributes"`
+}
+
+type PayloadAttributesJson struct {
+	Timestamp             string `json:"timestamp"`
+	PrevRandao            string `json:"prev_randao"`
+	SuggestedFeeRecipient string `json:"suggested_fee_recipient"`
+}
+
 // ---------------
 // Error handling.
 // ---------------
@@ -0,0 +1,55 @@
+package core
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+)
+
+// ErrorReason is a reason for a failure of a core computation, independent of the transport.
+type ErrorReason uint8
+
+const (
+	Internal ErrorReason = iota
+	Unavailable
+	BadRequest
+	NotFound
+)
+
+// RpcError represents an error result of a core computation.
+type RpcError struct {
+	Err    error
+	Reason ErrorReason
+}
+
+// ErrorReasonToGRPC converts the error reason into a gRPC status code.
+func ErrorReasonToGRPC(reason ErrorReason) codes.Code {
+	switch reason {
+	case Internal:
+		return codes.Internal
+	case Unavailable:
+		return codes.Unavailable
+	case BadRequest:
+		return codes.InvalidArgument
+	case NotFound:
+		return codes.NotFound
+	default:
+		return codes.Internal
+	}
+}
+
+// ErrorReasonToHTTP converts the error reason into an HTTP status code.
+func ErrorReasonToHTTP(reason ErrorReason) int {
+	switch reason {
+	case Internal:
+		return http.StatusInternalServerError
+	case Unavailable:
+		return http.StatusServiceUnavailable
+	case BadRequest:
+		return http.StatusBadRequest
+	case NotFound:
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
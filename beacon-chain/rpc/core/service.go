@@ -0,0 +1,14 @@
+// Package core contains logic shared by the gRPC and the HTTP implementations of the beacon node API.
+package core
+
+import (
+	"github.com/theQRL/zond/beacon-chain/blockchain"
+	"github.com/theQRL/zond/beacon-chain/sync"
+)
+
+// Service holds the dependencies of the shared API logic.
+type Service struct {
+	HeadFetcher        blockchain.HeadFetcher
+	GenesisTimeFetcher blockchain.TimeFetcher
+	SyncChecker        sync.Checker
+}
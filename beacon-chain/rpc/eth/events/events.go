@@ -1,6 +1,7 @@
 package events
 
 import (
+	"strconv"
 	"strings"
 
 	gwpb "github.com/grpc-ecosystem/grpc-gateway/v2/proto/gateway"
@@ -9,13 +10,16 @@ import (
 	blockfeed "github.com/theQRL/zond/beacon-chain/core/feed/block"
 	"github.com/theQRL/zond/beacon-chain/core/feed/operation"
 	statefeed "github.com/theQRL/zond/beacon-chain/core/feed/state"
+	"github.com/theQRL/zond/common/hexutil"
 	ethpbservice "github.com/theQRL/zond/protos/eth/service"
 	ethpb "github.com/theQRL/zond/protos/eth/v1"
 	"github.com/theQRL/zond/protos/migration"
+	"github.com/theQRL/zond/runtime/version"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 const (
@@ -33,6 +37,15 @@ const (
 	ChainReorgTopic = "chain_reorg"
 	// SyncCommitteeContributionTopic represents a new sync committee contribution event topic.
 	SyncCommitteeContributionTopic = "contribution_and_proof"
+	// PayloadAttributesTopic represents a new payload attributes event topic, fired when fork choice
+	// prepares an execution payload for the next proposer.
+	PayloadAttributesTopic = "payload_attributes"
+	// BlockGossipTopic represents a block received from gossip, fired before the block is imported.
+	BlockGossipTopic = "block_gossip"
+	// ProposerSlashingTopic represents a new proposer slashing event topic.
+	ProposerSlashingTopic = "proposer_slashing"
+	// AttesterSlashingTopic represents a new attester slashing event topic.
+	AttesterSlashingTopic = "attester_slashing"
 )
 
 var casesHandled = map[string]bool{
@@ -43,6 +56,10 @@ var casesHandled = map[string]bool{
 	FinalizedCheckpointTopic:       true,
 	ChainReorgTopic:                true,
 	SyncCommitteeContributionTopic: true,
+	PayloadAttributesTopic:         true,
+	BlockGossipTopic:               true,
+	ProposerSlashingTopic:          true,
+	AttesterSlashingTopic:          true,
 }
 
 // StreamEvents allows requesting all events from a set of topics defined in the Ethereum consensus API standard.
@@ -178,6 +195,46 @@ func handleBlockOperationEvents(
 		}
 		v2Data := migration.V1Alpha1SignedContributionAndProofToV2(contributionData.Contribution)
 		return streamData(stream, SyncCommitteeContributionTopic, v2Data)
+	case operation.BlockGossipReceived:
+		if _, ok := requestedTopics[BlockGossipTopic]; !ok {
+			return nil
+		}
+		blkData, ok := event.Data.(*operation.BlockGossipReceivedData)
+		if !ok {
+			return nil
+		}
+		v1Data, err := migration.BlockIfaceToV1BlockHeader(blkData.SignedBlock)
+		if err != nil {
+			return err
+		}
+		root, err := v1Data.Message.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "could not hash tree root block")
+		}
+		return streamData(stream, BlockGossipTopic, &ethpb.EventBlock{
+			Slot:  v1Data.Message.Slot,
+			Block: root[:],
+		})
+	case operation.ProposerSlashingReceived:
+		if _, ok := requestedTopics[ProposerSlashingTopic]; !ok {
+			return nil
+		}
+		slashingData, ok := event.Data.(*operation.ProposerSlashingReceivedData)
+		if !ok {
+			return nil
+		}
+		v1Data := migration.V1Alpha1ProposerSlashingToV1(slashingData.ProposerSlashing)
+		return streamData(stream, ProposerSlashingTopic, v1Data)
+	case operation.AttesterSlashingReceived:
+		if _, ok := requestedTopics[AttesterSlashingTopic]; !ok {
+			return nil
+		}
+		slashingData, ok := event.Data.(*operation.AttesterSlashingReceivedData)
+		if !ok {
+			return nil
+		}
+		v1Data := migration.V1Alpha1AttSlashingToV1(slashingData.AttesterSlashing)
+		return streamData(stream, AttesterSlashingTopic, v1Data)
 	default:
 		return nil
 	}
@@ -214,11 +271,47 @@ func handleStateEvents(
 			return nil
 		}
 		return streamData(stream, ChainReorgTopic, reorg)
+	case statefeed.PayloadAttributes:
+		if _, ok := requestedTopics[PayloadAttributesTopic]; !ok {
+			return nil
+		}
+		attrData, ok := event.Data.(*statefeed.PayloadAttributesData)
+		if !ok {
+			return nil
+		}
+		payloadAttributes, err := payloadAttributesEvent(attrData)
+		if err != nil {
+			return errors.Wrap(err, "could not build payload attributes event")
+		}
+		return streamData(stream, PayloadAttributesTopic, payloadAttributes)
 	default:
 		return nil
 	}
 }
 
+// payloadAttributesEvent converts the payload attributes event data into its API representation.
+// There is no dedicated protobuf message for this event, so it is sent as a generic struct.
+func payloadAttributesEvent(data *statefeed.PayloadAttributesData) (*structpb.Struct, error) {
+	if data.PayloadAttributes == nil {
+		return nil, errors.New("nil payload attributes")
+	}
+	return structpb.NewStruct(map[string]interface{}{
+		"version": version.String(data.Version),
+		"data": map[string]interface{}{
+			"proposer_index":      strconv.FormatUint(uint64(data.ProposerIndex), 10),
+			"proposal_slot":       strconv.FormatUint(uint64(data.ProposalSlot), 10),
+			"parent_block_number": strconv.FormatUint(data.ParentBlockNumber, 10),
+			"parent_block_root":   hexutil.Encode(data.ParentBlockRoot),
+			"parent_block_hash":   hexutil.Encode(data.ParentBlockHash),
+			"payload_attributes": map[string]interface{}{
+				"timestamp":               strconv.FormatUint(data.PayloadAttributes.Timestamp, 10),
+				"prev_randao":             hexutil.Encode(data.PayloadAttributes.PrevRandao),
+				"suggested_fee_recipient": hexutil.Encode(data.PayloadAttributes.SuggestedFeeRecipient),
+			},
+		},
+	})
+}
+
 func streamData(stream ethpbservice.Events_StreamEventsServer, name string, data proto.Message) error {
 	returnData, err := anypb.New(data)
 	if err != nil {
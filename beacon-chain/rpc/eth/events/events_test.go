@@ -0,0 +1,251 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	gwpb "github.com/grpc-ecosystem/grpc-gateway/v2/proto/gateway"
+	"github.com/theQRL/zond/async/event"
+	mockChain "github.com/theQRL/zond/beacon-chain/blockchain/testing"
+	"github.com/theQRL/zond/beacon-chain/core/feed"
+	"github.com/theQRL/zond/beacon-chain/core/feed/operation"
+	statefeed "github.com/theQRL/zond/beacon-chain/core/feed/state"
+	enginev1 "github.com/theQRL/zond/protos/engine/v1"
+	ethpbv1 "github.com/theQRL/zond/protos/eth/v1"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+	"github.com/theQRL/zond/runtime/version"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// fakeEventStream collects the events sent by StreamEvents.
+type fakeEventStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *gwpb.EventSource
+}
+
+func (s *fakeEventStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeEventStream) Send(e *gwpb.EventSource) error {
+	s.events <- e
+	return nil
+}
+
+// startStream starts streaming the given topics and returns the stream together with a function stopping it.
+func startStream(t *testing.T, srv *Server, topics ...string) (*fakeEventStream, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	srv.Ctx = ctx
+	stream := &fakeEventStream{ctx: ctx, events: make(chan *gwpb.EventSource, 10)}
+	done := make(chan error, 1)
+	go func() {
+		done <- srv.StreamEvents(&ethpbv1.StreamEventsRequest{Topics: topics}, stream)
+	}()
+	return stream, func() {
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("Stream did not terminate")
+		}
+	}
+}
+
+// sendUntilSubscribed sends the event once the stream has subscribed to the feed.
+func sendUntilSubscribed(f *event.Feed, e *feed.Event) {
+	for sent := 0; sent == 0; {
+		sent = f.Send(e)
+	}
+}
+
+func receiveEvent(t *testing.T, stream *fakeEventStream) *gwpb.EventSource {
+	select {
+	case e := <-stream.events:
+		return e
+	case <-time.After(5 * time.Second):
+		t.Fatal("No event received")
+	}
+	return nil
+}
+
+func newServer() *Server {
+	return &Server{
+		StateNotifier:     &mockChain.MockStateNotifier{},
+		BlockNotifier:     &mockChain.MockBlockNotifier{},
+		OperationNotifier: &mockChain.MockOperationNotifier{},
+	}
+}
+
+func TestStreamEvents_UnknownTopic(t *testing.T) {
+	srv := newServer()
+	srv.Ctx = context.Background()
+	stream := &fakeEventStream{ctx: context.Background(), events: make(chan *gwpb.EventSource, 1)}
+	err := srv.StreamEvents(&ethpbv1.StreamEventsRequest{Topics: []string{"proposer_slashing,foo"}}, stream)
+	if err == nil {
+		t.Fatal("Expected error for unknown topic")
+	}
+}
+
+func TestStreamEvents_ProposerSlashing(t *testing.T) {
+	srv := newServer()
+	stream, stop := startStream(t, srv, ProposerSlashingTopic)
+	defer stop()
+
+	slashing := &ethpb.ProposerSlashing{
+		Header_1: &ethpb.SignedBeaconBlockHeader{
+			Header:    &ethpb.BeaconBlockHeader{ProposerIndex: 7, Slot: 3},
+			Signature: []byte{1},
+		},
+		Header_2: &ethpb.SignedBeaconBlockHeader{
+			Header:    &ethpb.BeaconBlockHeader{ProposerIndex: 7, Slot: 3},
+			Signature: []byte{2},
+		},
+	}
+	// Events for topics which were not requested must be filtered out.
+	sendUntilSubscribed(srv.OperationNotifier.OperationFeed(), &feed.Event{
+		Type: operation.AttesterSlashingReceived,
+		Data: &operation.AttesterSlashingReceivedData{AttesterSlashing: &ethpb.AttesterSlashing{}},
+	})
+	sendUntilSubscribed(srv.OperationNotifier.OperationFeed(), &feed.Event{
+		Type: operation.ProposerSlashingReceived,
+		Data: &operation.ProposerSlashingReceivedData{ProposerSlashing: slashing},
+	})
+
+	e := receiveEvent(t, stream)
+	if e.Event != ProposerSlashingTopic {
+		t.Fatalf("Wrong event topic, want %s got %s", ProposerSlashingTopic, e.Event)
+	}
+	got := &ethpbv1.ProposerSlashing{}
+	if err := e.Data.UnmarshalTo(got); err != nil {
+		t.Fatal(err)
+	}
+	if got.SignedHeader_1.Message.ProposerIndex != 7 || got.SignedHeader_2.Message.Slot != 3 {
+		t.Errorf("Unexpected proposer slashing %v", got)
+	}
+}
+
+func TestStreamEvents_AttesterSlashing(t *testing.T) {
+	srv := newServer()
+	stream, stop := startStream(t, srv, AttesterSlashingTopic)
+	defer stop()
+
+	att := &ethpb.IndexedAttestation{
+		AttestingIndices: []uint64{1, 2},
+		Data: &ethpb.AttestationData{
+			Slot:   5,
+			Source: &ethpb.Checkpoint{},
+			Target: &ethpb.Checkpoint{Epoch: 1},
+		},
+	}
+	sendUntilSubscribed(srv.OperationNotifier.OperationFeed(), &feed.Event{
+		Type: operation.AttesterSlashingReceived,
+		Data: &operation.AttesterSlashingReceivedData{
+			AttesterSlashing: &ethpb.AttesterSlashing{Attestation_1: att, Attestation_2: att},
+		},
+	})
+
+	e := receiveEvent(t, stream)
+	if e.Event != AttesterSlashingTopic {
+		t.Fatalf("Wrong event topic, want %s got %s", AttesterSlashingTopic, e.Event)
+	}
+	got := &ethpbv1.AttesterSlashing{}
+	if err := e.Data.UnmarshalTo(got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Attestation_1.AttestingIndices) != 2 || got.Attestation_2.Data.Slot != 5 {
+		t.Errorf("Unexpected attester slashing %v", got)
+	}
+}
+
+func TestStreamEvents_PayloadAttributes(t *testing.T) {
+	srv := newServer()
+	stream, stop := startStream(t, srv, HeadTopic, PayloadAttributesTopic)
+	defer stop()
+
+	sendUntilSubscribed(srv.StateNotifier.StateFeed(), &feed.Event{
+		Type: statefeed.PayloadAttributes,
+		Data: &statefeed.PayloadAttributesData{
+			Version:           version.Bellatrix,
+			ProposerIndex:     11,
+			ProposalSlot:      12,
+			ParentBlockNumber: 13,
+			ParentBlockRoot:   []byte{0xaa},
+			ParentBlockHash:   []byte{0xbb},
+			PayloadAttributes: &enginev1.PayloadAttributes{
+				Timestamp:             14,
+				PrevRandao:            []byte{0xcc},
+				SuggestedFeeRecipient: []byte{0xdd},
+			},
+		},
+	})
+
+	e := receiveEvent(t, stream)
+	if e.Event != PayloadAttributesTopic {
+		t.Fatalf("Wrong event topic, want %s got %s", PayloadAttributesTopic, e.Event)
+	}
+	s := &structpb.Struct{}
+	if err := e.Data.UnmarshalTo(s); err != nil {
+		t.Fatal(err)
+	}
+	raw, err := protojson.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := &struct {
+		Version string `json:"version"`
+		Data    struct {
+			ProposerIndex     string `json:"proposer_index"`
+			ProposalSlot      string `json:"proposal_slot"`
+			ParentBlockNumber string `json:"parent_block_number"`
+			ParentBlockRoot   string `json:"parent_block_root"`
+			ParentBlockHash   string `json:"parent_block_hash"`
+			PayloadAttributes struct {
+				Timestamp             string `json:"timestamp"`
+				PrevRandao            string `json:"prev_randao"`
+				SuggestedFeeRecipient string `json:"suggested_fee_recipient"`
+			} `json:"payload_attributes"`
+		} `json:"data"`
+	}{}
+	if err := json.Unmarshal(raw, got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Version != "bellatrix" {
+		t.Errorf("Wrong version %s", got.Version)
+	}
+	if got.Data.ProposerIndex != "11" || got.Data.ProposalSlot != "12" || got.Data.ParentBlockNumber != "13" {
+		t.Errorf("Unexpected proposal data %+v", got.Data)
+	}
+	if got.Data.ParentBlockRoot != "0xaa" || got.Data.ParentBlockHash != "0xbb" {
+		t.Errorf("Unexpected parent data %+v", got.Data)
+	}
+	attr := got.Data.PayloadAttributes
+	if attr.Timestamp != "14" || attr.PrevRandao != "0xcc" || attr.SuggestedFeeRecipient != "0xdd" {
+		t.Errorf("Unexpected payload attributes %+v", attr)
+	}
+}
+
+func TestStreamEvents_BlockGossipNotRequested(t *testing.T) {
+	srv := newServer()
+	stream, stop := startStream(t, srv, ProposerSlashingTopic)
+	defer stop()
+
+	// The block gossip event is dropped without touching its data since the topic was not requested.
+	sendUntilSubscribed(srv.OperationNotifier.OperationFeed(), &feed.Event{
+		Type: operation.BlockGossipReceived,
+		Data: &operation.BlockGossipReceivedData{},
+	})
+	sendUntilSubscribed(srv.OperationNotifier.OperationFeed(), &feed.Event{
+		Type: operation.ProposerSlashingReceived,
+		Data: &operation.ProposerSlashingReceivedData{ProposerSlashing: &ethpb.ProposerSlashing{}},
+	})
+
+	e := receiveEvent(t, stream)
+	if e.Event != ProposerSlashingTopic {
+		t.Fatalf("Wrong event topic, want %s got %s", ProposerSlashingTopic, e.Event)
+	}
+}
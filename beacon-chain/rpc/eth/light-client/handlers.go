@@ -0,0 +1,216 @@
+package lightclient
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/theQRL/zond/common/hexutil"
+	fieldparams "github.com/theQRL/zond/config/fieldparams"
+	"github.com/theQRL/zond/config/params"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	"github.com/theQRL/zond/encoding/bytesutil"
+	"github.com/theQRL/zond/network"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+	"github.com/theQRL/zond/runtime/version"
+	"github.com/theQRL/zond/time/slots"
+)
+
+// GetLightClientBootstrap is an HTTP handler for Beacon API getLightClientBootstrap. It returns
+// the light client bootstrap of the requested finalized block root.
+func (s *Server) GetLightClientBootstrap(w http.ResponseWriter, r *http.Request) {
+	rawRoot := mux.Vars(r)["block_root"]
+	root, err := hexutil.Decode(rawRoot)
+	if err != nil || len(root) != fieldparams.RootLength {
+		network.WriteError(w, &network.DefaultErrorJson{
+			Message: fmt.Sprintf("Invalid block root %s", rawRoot),
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+	bootstrap, err := s.BeaconDB.LightClientBootstrap(r.Context(), bytesutil.ToBytes32(root))
+	if err != nil {
+		network.WriteError(w, &network.DefaultErrorJson{
+			Message: "Could not get light client bootstrap: " + err.Error(),
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+	if bootstrap == nil {
+		network.WriteError(w, &network.DefaultErrorJson{
+			Message: "Light client bootstrap not available for block root " + rawRoot,
+			Code:    http.StatusNotFound,
+		})
+		return
+	}
+	network.WriteJson(w, &LightClientBootstrapResponse{
+		Version: versionAtSlot(bootstrap.Header.Beacon.Slot),
+		Data: &LightClientBootstrap{
+			Header:                     headerToJson(bootstrap.Header),
+			CurrentSyncCommittee:       syncCommitteeToJson(bootstrap.CurrentSyncCommittee),
+			CurrentSyncCommitteeBranch: branchToJson(bootstrap.CurrentSyncCommitteeBranch),
+		},
+	})
+}
+
+// GetLightClientUpdatesByRange is an HTTP handler for Beacon API getLightClientUpdatesByRange. It returns
+// the best light client updates of the requested range of sync committee periods. At most
+// MAX_REQUEST_LIGHT_CLIENT_UPDATES updates are returned, and the range ends at the first period for
+// which the node has no update.
+func (s *Server) GetLightClientUpdatesByRange(w http.ResponseWriter, r *http.Request) {
+	startPeriod, err := strconv.ParseUint(r.URL.Query().Get("start_period"), 10, 64)
+	if err != nil {
+		network.WriteError(w, &network.DefaultErrorJson{
+			Message: "Could not parse start_period: " + err.Error(),
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+	count, err := strconv.ParseUint(r.URL.Query().Get("count"), 10, 64)
+	if err != nil {
+		network.WriteError(w, &network.DefaultErrorJson{
+			Message: "Could not parse count: " + err.Error(),
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+	if count == 0 {
+		network.WriteError(w, &network.DefaultErrorJson{
+			Message: "Count must be greater than 0",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+	if max := params.BeaconNetworkConfig().MaxRequestLightClientUpdates; count > max {
+		count = max
+	}
+	if startPeriod > math.MaxUint64-count {
+		network.WriteError(w, &network.DefaultErrorJson{
+			Message: "Requested range exceeds the maximum sync committee period",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+	updates, err := s.BeaconDB.LightClientUpdates(r.Context(), startPeriod, startPeriod+count-1)
+	if err != nil {
+		network.WriteError(w, &network.DefaultErrorJson{
+			Message: "Could not get light client updates: " + err.Error(),
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+	resp := make([]*LightClientUpdateWithVersion, len(updates))
+	for i, update := range updates {
+		resp[i] = &LightClientUpdateWithVersion{
+			Version: versionAtSlot(update.AttestedHeader.Beacon.Slot),
+			Data: &LightClientUpdate{
+				AttestedHeader:          headerToJson(update.AttestedHeader),
+				NextSyncCommittee:       syncCommitteeToJson(update.NextSyncCommittee),
+				NextSyncCommitteeBranch: branchToJson(update.NextSyncCommitteeBranch),
+				FinalizedHeader:         headerToJson(update.FinalizedHeader),
+				FinalityBranch:          branchToJson(update.FinalityBranch),
+				SyncAggregate:           syncAggregateToJson(update.SyncAggregate),
+				SignatureSlot:           strconv.FormatUint(uint64(update.SignatureSlot), 10),
+			},
+		}
+	}
+	network.WriteJson(w, resp)
+}
+
+// GetLightClientFinalityUpdate is an HTTP handler for Beacon API getLightClientFinalityUpdate. It returns
+// the latest finality update derived by the node.
+func (s *Server) GetLightClientFinalityUpdate(w http.ResponseWriter, _ *http.Request) {
+	update := s.LightClientFetcher.LightClientFinalityUpdate()
+	if update == nil {
+		network.WriteError(w, &network.DefaultErrorJson{
+			Message: "No light client finality update available",
+			Code:    http.StatusNotFound,
+		})
+		return
+	}
+	network.WriteJson(w, &LightClientFinalityUpdateResponse{
+		Version: versionAtSlot(update.AttestedHeader.Beacon.Slot),
+		Data: &LightClientFinalityUpdate{
+			AttestedHeader:  headerToJson(update.AttestedHeader),
+			FinalizedHeader: headerToJson(update.FinalizedHeader),
+			FinalityBranch:  branchToJson(update.FinalityBranch),
+			SyncAggregate:   syncAggregateToJson(update.SyncAggregate),
+			SignatureSlot:   strconv.FormatUint(uint64(update.SignatureSlot), 10),
+		},
+	})
+}
+
+// GetLightClientOptimisticUpdate is an HTTP handler for Beacon API getLightClientOptimisticUpdate. It returns
+// the latest optimistic update derived by the node.
+func (s *Server) GetLightClientOptimisticUpdate(w http.ResponseWriter, _ *http.Request) {
+	update := s.LightClientFetcher.LightClientOptimisticUpdate()
+	if update == nil {
+		network.WriteError(w, &network.DefaultErrorJson{
+			Message: "No light client optimistic update available",
+			Code:    http.StatusNotFound,
+		})
+		return
+	}
+	network.WriteJson(w, &LightClientOptimisticUpdateResponse{
+		Version: versionAtSlot(update.AttestedHeader.Beacon.Slot),
+		Data: &LightClientOptimisticUpdate{
+			AttestedHeader: headerToJson(update.AttestedHeader),
+			SyncAggregate:  syncAggregateToJson(update.SyncAggregate),
+			SignatureSlot:  strconv.FormatUint(uint64(update.SignatureSlot), 10),
+		},
+	})
+}
+
+// versionAtSlot returns the name of the fork active at the given slot. Light client data
+// only exists from Altair onwards.
+func versionAtSlot(slot types.Slot) string {
+	epoch := slots.ToEpoch(slot)
+	switch {
+	case epoch >= params.BeaconConfig().CapellaForkEpoch:
+		return version.String(version.Capella)
+	case epoch >= params.BeaconConfig().BellatrixForkEpoch:
+		return version.String(version.Bellatrix)
+	default:
+		return version.String(version.Altair)
+	}
+}
+
+func headerToJson(h *ethpb.LightClientHeader) *LightClientHeader {
+	return &LightClientHeader{
+		Beacon: &BeaconBlockHeader{
+			Slot:          strconv.FormatUint(uint64(h.Beacon.Slot), 10),
+			ProposerIndex: strconv.FormatUint(uint64(h.Beacon.ProposerIndex), 10),
+			ParentRoot:    hexutil.Encode(h.Beacon.ParentRoot),
+			StateRoot:     hexutil.Encode(h.Beacon.StateRoot),
+			BodyRoot:      hexutil.Encode(h.Beacon.BodyRoot),
+		},
+	}
+}
+
+func syncCommitteeToJson(c *ethpb.SyncCommittee) *SyncCommittee {
+	pubkeys := make([]string, len(c.Pubkeys))
+	for i, pk := range c.Pubkeys {
+		pubkeys[i] = hexutil.Encode(pk)
+	}
+	return &SyncCommittee{
+		Pubkeys:         pubkeys,
+		AggregatePubkey: hexutil.Encode(c.AggregatePubkey),
+	}
+}
+
+func syncAggregateToJson(a *ethpb.SyncAggregate) *SyncAggregate {
+	return &SyncAggregate{
+		SyncCommitteeBits:      hexutil.Encode(a.SyncCommitteeBits),
+		SyncCommitteeSignature: hexutil.Encode(a.SyncCommitteeSignature),
+	}
+}
+
+func branchToJson(branch [][]byte) []string {
+	nodes := make([]string, len(branch))
+	for i, node := range branch {
+		nodes[i] = hexutil.Encode(node)
+	}
+	return nodes
+}
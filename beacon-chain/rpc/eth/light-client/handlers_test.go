@@ -0,0 +1,269 @@
+package lightclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/theQRL/zond/beacon-chain/blockchain/testing"
+	dbtest "github.com/theQRL/zond/beacon-chain/db/testing"
+	"github.com/theQRL/zond/common/hexutil"
+	fieldparams "github.com/theQRL/zond/config/fieldparams"
+	"github.com/theQRL/zond/config/params"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	"github.com/theQRL/zond/network"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+)
+
+func testHeader(slot types.Slot) *ethpb.LightClientHeader {
+	return &ethpb.LightClientHeader{Beacon: &ethpb.BeaconBlockHeader{
+		Slot:       slot,
+		ParentRoot: make([]byte, fieldparams.RootLength),
+		StateRoot:  make([]byte, fieldparams.RootLength),
+		BodyRoot:   make([]byte, fieldparams.RootLength),
+	}}
+}
+
+func testBranch(depth int) [][]byte {
+	branch := make([][]byte, depth)
+	for i := range branch {
+		branch[i] = make([]byte, fieldparams.RootLength)
+	}
+	return branch
+}
+
+func testSyncCommittee() *ethpb.SyncCommittee {
+	pubkeys := make([][]byte, params.BeaconConfig().SyncCommitteeSize)
+	for i := range pubkeys {
+		pubkeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+	}
+	return &ethpb.SyncCommittee{Pubkeys: pubkeys, AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength)}
+}
+
+func testSyncAggregate() *ethpb.SyncAggregate {
+	return &ethpb.SyncAggregate{SyncCommitteeBits: bitfield.NewBitvector512(), SyncCommitteeSignature: make([]byte, 96)}
+}
+
+// testUpdate returns an update whose attested header is at the given slot.
+func testUpdate(slot types.Slot) *ethpb.LightClientUpdate {
+	return &ethpb.LightClientUpdate{
+		AttestedHeader:          testHeader(slot),
+		NextSyncCommittee:       testSyncCommittee(),
+		NextSyncCommitteeBranch: testBranch(5),
+		FinalizedHeader:         testHeader(0),
+		FinalityBranch:          testBranch(6),
+		SyncAggregate:           testSyncAggregate(),
+		SignatureSlot:           slot + 1,
+	}
+}
+
+// testServer returns a server whose database holds the updates of the periods 1 to 3 and a
+// bootstrap for the given block root.
+func testServer(t *testing.T, root [32]byte) *Server {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	for period := uint64(1); period <= 3; period++ {
+		if err := beaconDB.SaveLightClientUpdate(ctx, period, testUpdate(types.Slot(period))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	bootstrap := &ethpb.LightClientBootstrap{
+		Header:                     testHeader(100),
+		CurrentSyncCommittee:       testSyncCommittee(),
+		CurrentSyncCommitteeBranch: testBranch(5),
+	}
+	if err := beaconDB.SaveLightClientBootstrap(ctx, root, bootstrap); err != nil {
+		t.Fatal(err)
+	}
+	return &Server{BeaconDB: beaconDB, LightClientFetcher: &mock.ChainService{}}
+}
+
+func checkError(t *testing.T, writer *httptest.ResponseRecorder, code int, message string) {
+	t.Helper()
+	if writer.Code != code {
+		t.Fatalf("unexpected status code %d: %s", writer.Code, writer.Body.String())
+	}
+	e := &network.DefaultErrorJson{}
+	if err := json.Unmarshal(writer.Body.Bytes(), e); err != nil {
+		t.Fatal(err)
+	}
+	if e.Code != code || !strings.Contains(e.Message, message) {
+		t.Errorf("unexpected error %d %q, want %d containing %q", e.Code, e.Message, code, message)
+	}
+}
+
+func TestGetLightClientBootstrap(t *testing.T) {
+	root := [32]byte{'a'}
+	s := testServer(t, root)
+	request := func(rawRoot string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/light_client/bootstrap/"+rawRoot, nil)
+		return mux.SetURLVars(req, map[string]string{"block_root": rawRoot})
+	}
+
+	writer := httptest.NewRecorder()
+	s.GetLightClientBootstrap(writer, request(hexutil.Encode(root[:])))
+	if writer.Code != http.StatusOK {
+		t.Fatalf("unexpected status code %d: %s", writer.Code, writer.Body.String())
+	}
+	resp := &LightClientBootstrapResponse{}
+	if err := json.Unmarshal(writer.Body.Bytes(), resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Version != "altair" || resp.Data.Header.Beacon.Slot != "100" {
+		t.Errorf("unexpected bootstrap of version %s at slot %s", resp.Version, resp.Data.Header.Beacon.Slot)
+	}
+	if len(resp.Data.CurrentSyncCommittee.Pubkeys) != int(params.BeaconConfig().SyncCommitteeSize) || len(resp.Data.CurrentSyncCommitteeBranch) != 5 {
+		t.Errorf("unexpected sync committee of %d keys and branch of depth %d", len(resp.Data.CurrentSyncCommittee.Pubkeys), len(resp.Data.CurrentSyncCommitteeBranch))
+	}
+
+	writer = httptest.NewRecorder()
+	s.GetLightClientBootstrap(writer, request("0x1234"))
+	checkError(t, writer, http.StatusBadRequest, "Invalid block root 0x1234")
+
+	unknown := [32]byte{'b'}
+	writer = httptest.NewRecorder()
+	s.GetLightClientBootstrap(writer, request(hexutil.Encode(unknown[:])))
+	checkError(t, writer, http.StatusNotFound, "Light client bootstrap not available")
+}
+
+func TestGetLightClientUpdatesByRange(t *testing.T) {
+	s := testServer(t, [32]byte{})
+	request := func(startPeriod, count string) *http.Request {
+		return httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/light_client/updates?start_period="+startPeriod+"&count="+count, nil)
+	}
+
+	tests := []struct {
+		name               string
+		startPeriod, count string
+		slots              []string
+	}{
+		{"range", "1", "2", []string{"1", "2"}},
+		{"range beyond the last update", "2", "10", []string{"2", "3"}},
+		{"count above the maximum", "1", "18446744073709551615", []string{"1", "2", "3"}},
+		{"range without updates", "4", "2", []string{}},
+		{"range at the end of the periods", strconv.FormatUint(1<<64-11, 10), "5", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writer := httptest.NewRecorder()
+			s.GetLightClientUpdatesByRange(writer, request(tt.startPeriod, tt.count))
+			if writer.Code != http.StatusOK {
+				t.Fatalf("unexpected status code %d: %s", writer.Code, writer.Body.String())
+			}
+			var resp []*LightClientUpdateWithVersion
+			if err := json.Unmarshal(writer.Body.Bytes(), &resp); err != nil {
+				t.Fatal(err)
+			}
+			if len(resp) != len(tt.slots) {
+				t.Fatalf("expected %d updates, got %d", len(tt.slots), len(resp))
+			}
+			for i, u := range resp {
+				if u.Version != "altair" || u.Data.AttestedHeader.Beacon.Slot != tt.slots[i] {
+					t.Errorf("unexpected update of version %s attested at slot %s", u.Version, u.Data.AttestedHeader.Beacon.Slot)
+				}
+			}
+		})
+	}
+
+	invalid := []struct {
+		name               string
+		startPeriod, count string
+		message            string
+	}{
+		{"malformed start period", "foo", "1", "Could not parse start_period"},
+		{"malformed count", "1", "foo", "Could not parse count"},
+		{"no updates requested", "1", "0", "Count must be greater than 0"},
+		{"end period overflows", strconv.FormatUint(1<<64-6, 10), "10", "exceeds the maximum sync committee period"},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			writer := httptest.NewRecorder()
+			s.GetLightClientUpdatesByRange(writer, request(tt.startPeriod, tt.count))
+			checkError(t, writer, http.StatusBadRequest, tt.message)
+		})
+	}
+}
+
+func TestGetLightClientFinalityAndOptimisticUpdates(t *testing.T) {
+	update := testUpdate(10)
+	chain := &mock.ChainService{}
+	s := &Server{LightClientFetcher: chain}
+	req := httptest.NewRequest(http.MethodGet, "http://example.com/eth/v1/beacon/light_client/finality_update", nil)
+
+	writer := httptest.NewRecorder()
+	s.GetLightClientFinalityUpdate(writer, req)
+	checkError(t, writer, http.StatusNotFound, "No light client finality update available")
+	writer = httptest.NewRecorder()
+	s.GetLightClientOptimisticUpdate(writer, req)
+	checkError(t, writer, http.StatusNotFound, "No light client optimistic update available")
+
+	chain.FinalityUpdate = &ethpb.LightClientFinalityUpdate{
+		AttestedHeader:  update.AttestedHeader,
+		FinalizedHeader: update.FinalizedHeader,
+		FinalityBranch:  update.FinalityBranch,
+		SyncAggregate:   update.SyncAggregate,
+		SignatureSlot:   update.SignatureSlot,
+	}
+	chain.OptimisticUpdate = &ethpb.LightClientOptimisticUpdate{
+		AttestedHeader: update.AttestedHeader,
+		SyncAggregate:  update.SyncAggregate,
+		SignatureSlot:  update.SignatureSlot,
+	}
+
+	writer = httptest.NewRecorder()
+	s.GetLightClientFinalityUpdate(writer, req)
+	if writer.Code != http.StatusOK {
+		t.Fatalf("unexpected status code %d: %s", writer.Code, writer.Body.String())
+	}
+	finality := &LightClientFinalityUpdateResponse{}
+	if err := json.Unmarshal(writer.Body.Bytes(), finality); err != nil {
+		t.Fatal(err)
+	}
+	if finality.Data.AttestedHeader.Beacon.Slot != "10" || finality.Data.SignatureSlot != "11" || len(finality.Data.FinalityBranch) != 6 {
+		t.Errorf("unexpected finality update %+v", finality.Data)
+	}
+
+	writer = httptest.NewRecorder()
+	s.GetLightClientOptimisticUpdate(writer, req)
+	if writer.Code != http.StatusOK {
+		t.Fatalf("unexpected status code %d: %s", writer.Code, writer.Body.String())
+	}
+	optimistic := &LightClientOptimisticUpdateResponse{}
+	if err := json.Unmarshal(writer.Body.Bytes(), optimistic); err != nil {
+		t.Fatal(err)
+	}
+	if optimistic.Data.AttestedHeader.Beacon.Slot != "10" || optimistic.Data.SignatureSlot != "11" {
+		t.Errorf("unexpected optimistic update %+v", optimistic.Data)
+	}
+}
+
+func TestVersionAtSlot(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 1
+	cfg.BellatrixForkEpoch = 2
+	cfg.CapellaForkEpoch = 3
+	params.OverrideBeaconConfig(cfg)
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	tests := []struct {
+		slot types.Slot
+		want string
+	}{
+		{slotsPerEpoch, "altair"},
+		{2*slotsPerEpoch - 1, "altair"},
+		{2 * slotsPerEpoch, "bellatrix"},
+		{3 * slotsPerEpoch, "capella"},
+	}
+	for _, tt := range tests {
+		if got := versionAtSlot(tt.slot); got != tt.want {
+			t.Errorf("versionAtSlot(%d) = %s, want %s", tt.slot, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,14 @@
+// Package lightclient defines HTTP handlers for the light client endpoints of
+// the Ethereum Beacon Node API.
+package lightclient
+
+import (
+	"github.com/theQRL/zond/beacon-chain/blockchain"
+	"github.com/theQRL/zond/beacon-chain/db"
+)
+
+// Server serves the light client data derived by the node from imported blocks.
+type Server struct {
+	BeaconDB           db.ReadOnlyDatabase
+	LightClientFetcher blockchain.LightClientFetcher
+}
@@ -0,0 +1,555 @@
+package rewards
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/theQRL/zond/beacon-chain/core/altair"
+	coreblocks "github.com/theQRL/zond/beacon-chain/core/blocks"
+	"github.com/theQRL/zond/beacon-chain/core/epoch/precompute"
+	"github.com/theQRL/zond/beacon-chain/core/helpers"
+	"github.com/theQRL/zond/beacon-chain/core/validators"
+	"github.com/theQRL/zond/beacon-chain/rpc/lookup"
+	"github.com/theQRL/zond/beacon-chain/state"
+	"github.com/theQRL/zond/common/hexutil"
+	fieldparams "github.com/theQRL/zond/config/fieldparams"
+	"github.com/theQRL/zond/config/params"
+	"github.com/theQRL/zond/consensus-types/blocks"
+	"github.com/theQRL/zond/consensus-types/interfaces"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	"github.com/theQRL/zond/encoding/bytesutil"
+	"github.com/theQRL/zond/network"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+	"github.com/theQRL/zond/runtime/version"
+	"github.com/theQRL/zond/time/slots"
+)
+
+// BlockRewards is an HTTP handler for Beacon API getBlockRewards.
+func (s *Server) BlockRewards(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	blk, errJson := s.blockForRewards(r)
+	if errJson != nil {
+		network.WriteError(w, errJson)
+		return
+	}
+	st, errJson := s.preBlockState(r, blk)
+	if errJson != nil {
+		network.WriteError(w, errJson)
+		return
+	}
+
+	// Process the block operations in spec order and record the proposer's balance after each
+	// step, so that every operation type can be attributed its share of the reward.
+	proposerIndex := blk.Block().ProposerIndex()
+	initBalance, err := st.BalanceAtIndex(proposerIndex)
+	if err != nil {
+		network.WriteError(w, internalError("Could not get proposer's balance", err))
+		return
+	}
+	st, err = coreblocks.ProcessProposerSlashings(ctx, st, blk.Block().Body().ProposerSlashings(), validators.SlashValidator)
+	if err != nil {
+		network.WriteError(w, internalError("Could not process proposer slashings", err))
+		return
+	}
+	proposerSlashingsBalance, err := st.BalanceAtIndex(proposerIndex)
+	if err != nil {
+		network.WriteError(w, internalError("Could not get proposer's balance", err))
+		return
+	}
+	st, err = coreblocks.ProcessAttesterSlashings(ctx, st, blk.Block().Body().AttesterSlashings(), validators.SlashValidator)
+	if err != nil {
+		network.WriteError(w, internalError("Could not process attester slashings", err))
+		return
+	}
+	attSlashingsBalance, err := st.BalanceAtIndex(proposerIndex)
+	if err != nil {
+		network.WriteError(w, internalError("Could not get proposer's balance", err))
+		return
+	}
+	st, err = altair.ProcessAttestationsNoVerifySignature(ctx, st, blk)
+	if err != nil {
+		network.WriteError(w, internalError("Could not process attestations", err))
+		return
+	}
+	attBalance, err := st.BalanceAtIndex(proposerIndex)
+	if err != nil {
+		network.WriteError(w, internalError("Could not get proposer's balance", err))
+		return
+	}
+	sa, err := blk.Block().Body().SyncAggregate()
+	if err != nil {
+		network.WriteError(w, internalError("Could not get sync aggregate", err))
+		return
+	}
+	voted, _, err := syncCommitteeVotes(st, sa)
+	if err != nil {
+		network.WriteError(w, internalError("Could not get sync committee votes", err))
+		return
+	}
+	activeBalance, err := helpers.TotalActiveBalance(st)
+	if err != nil {
+		network.WriteError(w, internalError("Could not get total active balance", err))
+		return
+	}
+	proposerReward, _, err := altair.SyncRewards(activeBalance)
+	if err != nil {
+		network.WriteError(w, internalError("Could not get sync rewards", err))
+		return
+	}
+	syncAggregateReward := proposerReward * uint64(len(voted))
+
+	optimistic, finalized, errJson := s.blockStatus(r, blk)
+	if errJson != nil {
+		network.WriteError(w, errJson)
+		return
+	}
+	network.WriteJson(w, &BlockRewardsResponse{
+		Data: &BlockRewards{
+			ProposerIndex:     strconv.FormatUint(uint64(proposerIndex), 10),
+			Total:             strconv.FormatUint(attBalance-initBalance+syncAggregateReward, 10),
+			Attestations:      strconv.FormatUint(attBalance-attSlashingsBalance, 10),
+			SyncAggregate:     strconv.FormatUint(syncAggregateReward, 10),
+			ProposerSlashings: strconv.FormatUint(proposerSlashingsBalance-initBalance, 10),
+			AttesterSlashings: strconv.FormatUint(attSlashingsBalance-proposerSlashingsBalance, 10),
+		},
+		ExecutionOptimistic: optimistic,
+		Finalized:           finalized,
+	})
+}
+
+// AttestationRewards is an HTTP handler for Beacon API getAttestationsRewards.
+// The request body may contain a list of validator indices or public keys to limit the response to.
+func (s *Server) AttestationRewards(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestedEpoch, err := strconv.ParseUint(mux.Vars(r)["epoch"], 10, 64)
+	if err != nil {
+		network.WriteError(w, badRequestError("Could not parse epoch", err))
+		return
+	}
+	epoch := types.Epoch(requestedEpoch)
+	if epoch < params.BeaconConfig().AltairForkEpoch {
+		network.WriteError(w, &network.DefaultErrorJson{
+			Message: "Attestation rewards are not supported for Phase 0",
+			Code:    http.StatusNotFound,
+		})
+		return
+	}
+	currentEpoch := slots.ToEpoch(s.TimeFetcher.CurrentSlot())
+	if epoch+1 >= currentEpoch {
+		network.WriteError(w, &network.DefaultErrorJson{
+			Message: "Attestation rewards are available after two epoch transitions to ensure all attestations have a chance of inclusion",
+			Code:    http.StatusNotFound,
+		})
+		return
+	}
+
+	// Attestations for the requested epoch can be included until the end of the next epoch, so the
+	// rewards are computed on the state at the last slot of the next epoch, before epoch processing.
+	// The requested epoch is the previous epoch of that state.
+	nextEpochEnd, err := slots.EpochEnd(epoch + 1)
+	if err != nil {
+		network.WriteError(w, internalError("Could not get the last slot of the next epoch", err))
+		return
+	}
+	st, err := s.ReplayerBuilder.ReplayerForSlot(nextEpochEnd).ReplayBlocks(ctx)
+	if err != nil {
+		network.WriteError(w, internalError("Could not replay state", err))
+		return
+	}
+	valIndices, errJson := requestedValidatorIndices(r, st)
+	if errJson != nil {
+		network.WriteError(w, errJson)
+		return
+	}
+	allVals, bal, err := altair.InitializePrecomputeValidators(ctx, st)
+	if err != nil {
+		network.WriteError(w, internalError("Could not initialize precompute validators", err))
+		return
+	}
+	allVals, bal, err = altair.ProcessEpochParticipation(ctx, st, bal, allVals)
+	if err != nil {
+		network.WriteError(w, internalError("Could not process epoch participation", err))
+		return
+	}
+	if valIndices == nil {
+		valIndices = make([]types.ValidatorIndex, len(allVals))
+		for i := range allVals {
+			valIndices[i] = types.ValidatorIndex(i)
+		}
+	}
+
+	totalRewards, err := totalAttRewards(st, bal, allVals, valIndices)
+	if err != nil {
+		network.WriteError(w, internalError("Could not compute attestation rewards", err))
+		return
+	}
+	idealRewards, err := idealAttRewards(st, bal, allVals, valIndices)
+	if err != nil {
+		network.WriteError(w, internalError("Could not compute ideal attestation rewards", err))
+		return
+	}
+
+	root, err := latestBlockRoot(r, st)
+	if err != nil {
+		network.WriteError(w, internalError("Could not get block root", err))
+		return
+	}
+	optimistic, err := s.OptimisticModeFetcher.IsOptimisticForRoot(ctx, root)
+	if err != nil {
+		network.WriteError(w, internalError("Could not get optimistic mode info", err))
+		return
+	}
+	network.WriteJson(w, &AttestationRewardsResponse{
+		Data: &AttestationRewards{
+			IdealRewards: idealRewards,
+			TotalRewards: totalRewards,
+		},
+		ExecutionOptimistic: optimistic,
+		Finalized:           s.FinalizationFetcher.IsFinalized(ctx, root),
+	})
+}
+
+// SyncCommitteeRewards is an HTTP handler for Beacon API getSyncCommitteeRewards.
+// The request body may contain a list of validator indices or public keys to limit the response to.
+func (s *Server) SyncCommitteeRewards(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	blk, errJson := s.blockForRewards(r)
+	if errJson != nil {
+		network.WriteError(w, errJson)
+		return
+	}
+	st, errJson := s.preBlockState(r, blk)
+	if errJson != nil {
+		network.WriteError(w, errJson)
+		return
+	}
+	requested, errJson := requestedValidatorIndices(r, st)
+	if errJson != nil {
+		network.WriteError(w, errJson)
+		return
+	}
+	sa, err := blk.Block().Body().SyncAggregate()
+	if err != nil {
+		network.WriteError(w, internalError("Could not get sync aggregate", err))
+		return
+	}
+	voted, didntVote, err := syncCommitteeVotes(st, sa)
+	if err != nil {
+		network.WriteError(w, internalError("Could not get sync committee votes", err))
+		return
+	}
+
+	committee := append(append(make([]types.ValidatorIndex, 0, len(voted)+len(didntVote)), voted...), didntVote...)
+	valIndices := committee
+	if requested != nil {
+		requestedSet := make(map[types.ValidatorIndex]bool, len(requested))
+		for _, idx := range requested {
+			requestedSet[idx] = true
+		}
+		valIndices = make([]types.ValidatorIndex, 0, len(requested))
+		for _, idx := range committee {
+			if requestedSet[idx] {
+				valIndices = append(valIndices, idx)
+			}
+		}
+	}
+	preBalances := make([]uint64, len(valIndices))
+	for i, idx := range valIndices {
+		preBalances[i], err = st.BalanceAtIndex(idx)
+		if err != nil {
+			network.WriteError(w, internalError("Could not get validator's balance", err))
+			return
+		}
+	}
+	activeBalance, err := helpers.TotalActiveBalance(st)
+	if err != nil {
+		network.WriteError(w, internalError("Could not get total active balance", err))
+		return
+	}
+	proposerReward, _, err := altair.SyncRewards(activeBalance)
+	if err != nil {
+		network.WriteError(w, internalError("Could not get sync rewards", err))
+		return
+	}
+	st, err = altair.ApplySyncRewardsPenalties(ctx, st, voted, didntVote)
+	if err != nil {
+		network.WriteError(w, internalError("Could not apply sync committee rewards", err))
+		return
+	}
+
+	rewards := make([]*SyncCommitteeReward, len(valIndices))
+	for i, idx := range valIndices {
+		postBalance, err := st.BalanceAtIndex(idx)
+		if err != nil {
+			network.WriteError(w, internalError("Could not get validator's balance", err))
+			return
+		}
+		reward := int64(postBalance) - int64(preBalances[i])
+		// The proposer's share of the sync aggregate is reported by the block rewards endpoint.
+		if idx == blk.Block().ProposerIndex() {
+			reward -= int64(proposerReward * uint64(len(voted)))
+		}
+		rewards[i] = &SyncCommitteeReward{
+			ValidatorIndex: strconv.FormatUint(uint64(idx), 10),
+			Reward:         strconv.FormatInt(reward, 10),
+		}
+	}
+
+	optimistic, finalized, errJson := s.blockStatus(r, blk)
+	if errJson != nil {
+		network.WriteError(w, errJson)
+		return
+	}
+	network.WriteJson(w, &SyncCommitteeRewardsResponse{
+		Data:                rewards,
+		ExecutionOptimistic: optimistic,
+		Finalized:           finalized,
+	})
+}
+
+// blockForRewards retrieves the block requested by the block_id path parameter.
+func (s *Server) blockForRewards(r *http.Request) (interfaces.SignedBeaconBlock, *network.DefaultErrorJson) {
+	blk, err := s.Blocker.Block(r.Context(), []byte(mux.Vars(r)["block_id"]))
+	if invalidBlockIdErr, ok := err.(*lookup.BlockIdParseError); ok {
+		return nil, badRequestError("Invalid block ID", invalidBlockIdErr)
+	}
+	if err != nil {
+		return nil, internalError("Could not get block from block ID", err)
+	}
+	if err := blocks.BeaconBlockIsNil(blk); err != nil {
+		return nil, &network.DefaultErrorJson{
+			Message: "Could not find requested block: " + err.Error(),
+			Code:    http.StatusNotFound,
+		}
+	}
+	if blk.Version() == version.Phase0 {
+		return nil, &network.DefaultErrorJson{
+			Message: "Rewards are not supported for Phase 0 blocks",
+			Code:    http.StatusBadRequest,
+		}
+	}
+	if blk.Block().Slot() == 0 {
+		return nil, &network.DefaultErrorJson{
+			Message: "Rewards are not supported for the genesis block",
+			Code:    http.StatusBadRequest,
+		}
+	}
+	return blk, nil
+}
+
+// preBlockState replays the state up to the block's slot, without applying the block itself.
+func (s *Server) preBlockState(r *http.Request, blk interfaces.SignedBeaconBlock) (state.BeaconState, *network.DefaultErrorJson) {
+	slot := blk.Block().Slot()
+	st, err := s.ReplayerBuilder.ReplayerForSlot(slot-1).ReplayToSlot(r.Context(), slot)
+	if err != nil {
+		return nil, internalError("Could not replay state", err)
+	}
+	return st, nil
+}
+
+// blockStatus returns whether the block is optimistic and whether it is finalized.
+func (s *Server) blockStatus(r *http.Request, blk interfaces.SignedBeaconBlock) (bool, bool, *network.DefaultErrorJson) {
+	root, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		return false, false, internalError("Could not get block root", err)
+	}
+	optimistic, err := s.OptimisticModeFetcher.IsOptimisticForRoot(r.Context(), root)
+	if err != nil {
+		return false, false, internalError("Could not get optimistic mode info", err)
+	}
+	return optimistic, s.FinalizationFetcher.IsFinalized(r.Context(), root), nil
+}
+
+// syncCommitteeVotes returns the indices of the current sync committee members that took part
+// in the sync aggregate and of the ones that did not.
+func syncCommitteeVotes(st state.BeaconState, sa *ethpb.SyncAggregate) (voted, didntVote []types.ValidatorIndex, err error) {
+	committee, err := st.CurrentSyncCommittee()
+	if err != nil {
+		return nil, nil, err
+	}
+	if committee == nil {
+		return nil, nil, errors.New("nil current sync committee in state")
+	}
+	if sa.SyncCommitteeBits.Len() > uint64(len(committee.Pubkeys)) {
+		return nil, nil, errors.New("bits length exceeds committee length")
+	}
+	voted = make([]types.ValidatorIndex, 0, len(committee.Pubkeys))
+	for i := uint64(0); i < sa.SyncCommitteeBits.Len(); i++ {
+		idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(committee.Pubkeys[i]))
+		if !ok {
+			return nil, nil, errors.New("validator public key does not exist in state")
+		}
+		if sa.SyncCommitteeBits.BitAt(i) {
+			voted = append(voted, idx)
+		} else {
+			didntVote = append(didntVote, idx)
+		}
+	}
+	return voted, didntVote, nil
+}
+
+// requestedValidatorIndices reads the optional list of validator indices or public keys from
+// the request body. A nil slice is returned when no validators were requested.
+func requestedValidatorIndices(r *http.Request, st state.ReadOnlyBeaconState) ([]types.ValidatorIndex, *network.DefaultErrorJson) {
+	if r.Body == nil {
+		return nil, nil
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, badRequestError("Could not read request body", err)
+	}
+	if len(body) == 0 {
+		return nil, nil
+	}
+	var ids []string
+	if err := json.Unmarshal(body, &ids); err != nil {
+		return nil, badRequestError("Could not decode validators", err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	indices := make([]types.ValidatorIndex, len(ids))
+	for i, id := range ids {
+		if bytesutil.IsHex([]byte(id)) {
+			pubkey, err := hexutil.Decode(id)
+			if err != nil {
+				return nil, badRequestError(fmt.Sprintf("Invalid validator public key %s", id), err)
+			}
+			if len(pubkey) != fieldparams.BLSPubkeyLength {
+				return nil, &network.DefaultErrorJson{
+					Message: fmt.Sprintf("Invalid validator public key length %d", len(pubkey)),
+					Code:    http.StatusBadRequest,
+				}
+			}
+			idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubkey))
+			if !ok {
+				return nil, &network.DefaultErrorJson{
+					Message: fmt.Sprintf("No validator index found for public key %s", id),
+					Code:    http.StatusBadRequest,
+				}
+			}
+			indices[i] = idx
+			continue
+		}
+		idx, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			return nil, badRequestError(fmt.Sprintf("Invalid validator index %s", id), err)
+		}
+		if idx >= uint64(st.NumValidators()) {
+			return nil, &network.DefaultErrorJson{
+				Message: fmt.Sprintf("Validator index %d is too large. Maximum allowed index is %d", idx, st.NumValidators()-1),
+				Code:    http.StatusBadRequest,
+			}
+		}
+		indices[i] = types.ValidatorIndex(idx)
+	}
+	return indices, nil
+}
+
+// totalAttRewards returns the actual attestation rewards and penalties of the given validators.
+func totalAttRewards(
+	st state.BeaconState,
+	bal *precompute.Balance,
+	allVals []*precompute.Validator,
+	valIndices []types.ValidatorIndex,
+) ([]*TotalAttestationReward, error) {
+	vals := make([]*precompute.Validator, len(valIndices))
+	for i, idx := range valIndices {
+		vals[i] = allVals[idx]
+	}
+	deltas, err := altair.AttestationsDeltaComponents(st, bal, vals)
+	if err != nil {
+		return nil, err
+	}
+	rewards := make([]*TotalAttestationReward, len(valIndices))
+	for i, d := range deltas {
+		rewards[i] = &TotalAttestationReward{
+			ValidatorIndex: strconv.FormatUint(uint64(valIndices[i]), 10),
+			Head:           strconv.FormatUint(d.HeadReward, 10),
+			Source:         strconv.FormatInt(int64(d.SourceReward)-int64(d.SourcePenalty), 10),
+			Target:         strconv.FormatInt(int64(d.TargetReward)-int64(d.TargetPenalty), 10),
+			Inactivity:     strconv.FormatInt(-int64(d.InactivityPenalty), 10),
+		}
+	}
+	return rewards, nil
+}
+
+// idealAttRewards returns the rewards a validator would have received for a timely and correct
+// attestation, for every effective balance found among the given validators.
+func idealAttRewards(
+	st state.BeaconState,
+	bal *precompute.Balance,
+	allVals []*precompute.Validator,
+	valIndices []types.ValidatorIndex,
+) ([]*IdealAttestationReward, error) {
+	seen := make(map[uint64]bool)
+	var effectiveBalances []uint64
+	for _, idx := range valIndices {
+		eb := allVals[idx].CurrentEpochEffectiveBalance
+		if !seen[eb] {
+			seen[eb] = true
+			effectiveBalances = append(effectiveBalances, eb)
+		}
+	}
+	sort.Slice(effectiveBalances, func(i, j int) bool { return effectiveBalances[i] < effectiveBalances[j] })
+
+	idealVals := make([]*precompute.Validator, len(effectiveBalances))
+	for i, eb := range effectiveBalances {
+		idealVals[i] = &precompute.Validator{
+			IsActivePrevEpoch:            true,
+			IsPrevEpochSourceAttester:    true,
+			IsPrevEpochTargetAttester:    true,
+			IsPrevEpochHeadAttester:      true,
+			CurrentEpochEffectiveBalance: eb,
+		}
+	}
+	deltas, err := altair.AttestationsDeltaComponents(st, bal, idealVals)
+	if err != nil {
+		return nil, err
+	}
+	rewards := make([]*IdealAttestationReward, len(deltas))
+	for i, d := range deltas {
+		rewards[i] = &IdealAttestationReward{
+			EffectiveBalance: strconv.FormatUint(effectiveBalances[i], 10),
+			Head:             strconv.FormatUint(d.HeadReward, 10),
+			Source:           strconv.FormatUint(d.SourceReward, 10),
+			Target:           strconv.FormatUint(d.TargetReward, 10),
+			Inactivity:       "0",
+		}
+	}
+	return rewards, nil
+}
+
+// latestBlockRoot returns the root of the latest block applied to the state.
+func latestBlockRoot(r *http.Request, st state.BeaconState) ([32]byte, error) {
+	header := ethpb.CopyBeaconBlockHeader(st.LatestBlockHeader())
+	// The state root of the latest block header is only filled in when the next slot is processed.
+	if bytesutil.ToBytes32(header.StateRoot) == params.BeaconConfig().ZeroHash {
+		stateRoot, err := st.HashTreeRoot(r.Context())
+		if err != nil {
+			return [32]byte{}, err
+		}
+		header.StateRoot = stateRoot[:]
+	}
+	return header.HashTreeRoot()
+}
+
+func badRequestError(message string, err error) *network.DefaultErrorJson {
+	return &network.DefaultErrorJson{
+		Message: message + ": " + err.Error(),
+		Code:    http.StatusBadRequest,
+	}
+}
+
+func internalError(message string, err error) *network.DefaultErrorJson {
+	return &network.DefaultErrorJson{
+		Message: message + ": " + err.Error(),
+		Code:    http.StatusInternalServerError,
+	}
+}
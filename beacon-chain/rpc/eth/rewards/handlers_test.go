@@ -0,0 +1,489 @@
+package rewards
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/theQRL/zond/beacon-chain/blockchain/testing"
+	"github.com/theQRL/zond/beacon-chain/core/altair"
+	"github.com/theQRL/zond/beacon-chain/core/helpers"
+	"github.com/theQRL/zond/beacon-chain/rpc/lookup"
+	"github.com/theQRL/zond/beacon-chain/state"
+	state_native "github.com/theQRL/zond/beacon-chain/state/state-native"
+	mockstategen "github.com/theQRL/zond/beacon-chain/state/stategen/mock"
+	"github.com/theQRL/zond/common/hexutil"
+	fieldparams "github.com/theQRL/zond/config/fieldparams"
+	"github.com/theQRL/zond/config/params"
+	"github.com/theQRL/zond/consensus-types/blocks"
+	"github.com/theQRL/zond/consensus-types/interfaces"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	"github.com/theQRL/zond/network"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+)
+
+// The test state holds 512 validators, which all sit in the sync committee in index order. All of
+// them have an effective balance of 32 ETH except for the last one, which has 16 ETH.
+//
+// In the previous epoch validators 0-383 attested timely to the source, target and head, validators
+// 384-447 to the source only and validators 448-511 not at all, the latter carrying an inactivity
+// score of 8. Validators 0-383 take part in the sync aggregate of the test block.
+const (
+	numValidators = 512
+	numAttesters  = 384
+	numSourceOnly = 64
+	numSyncVoters = 384
+
+	// With a total active balance of 16368 ETH the base reward per increment is 15819 Gwei.
+	participantReward = 493
+	proposerReward    = 70
+)
+
+type testBlocker struct {
+	blk interfaces.SignedBeaconBlock
+	err error
+}
+
+func (b *testBlocker) Block(context.Context, []byte) (interfaces.SignedBeaconBlock, error) {
+	return b.blk, b.err
+}
+
+func testPubkey(i int) []byte {
+	pubkey := make([]byte, fieldparams.BLSPubkeyLength)
+	binary.BigEndian.PutUint64(pubkey, uint64(i)+1)
+	return pubkey
+}
+
+func zeroRoots(n uint64) [][]byte {
+	roots := make([][]byte, n)
+	for i := range roots {
+		roots[i] = make([]byte, 32)
+	}
+	return roots
+}
+
+func setupRewardsConfig(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 1
+	params.OverrideBeaconConfig(cfg)
+	helpers.ClearCache()
+}
+
+func testState(t *testing.T, slot types.Slot) state.BeaconState {
+	validators := make([]*ethpb.Validator, numValidators)
+	balances := make([]uint64, numValidators)
+	prevParticipation := make([]byte, numValidators)
+	inactivityScores := make([]uint64, numValidators)
+	pubkeys := make([][]byte, numValidators)
+	for i := range validators {
+		effectiveBalance := params.BeaconConfig().MaxEffectiveBalance
+		if i == numValidators-1 {
+			effectiveBalance /= 2
+		}
+		validators[i] = &ethpb.Validator{
+			PublicKey:             testPubkey(i),
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      effectiveBalance,
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch:     params.BeaconConfig().FarFutureEpoch,
+		}
+		balances[i] = effectiveBalance
+		pubkeys[i] = testPubkey(i)
+		switch {
+		case i < numAttesters:
+			prevParticipation[i] = 0b111
+		case i < numAttesters+numSourceOnly:
+			prevParticipation[i] = 0b001
+		default:
+			inactivityScores[i] = 8
+		}
+	}
+	committee := &ethpb.SyncCommittee{Pubkeys: pubkeys, AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength)}
+	st, err := state_native.InitializeFromProtoUnsafeAltair(&ethpb.BeaconStateAltair{
+		Slot:                  slot,
+		GenesisValidatorsRoot: make([]byte, 32),
+		Fork: &ethpb.Fork{
+			PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+			CurrentVersion:  params.BeaconConfig().AltairForkVersion,
+		},
+		LatestBlockHeader: &ethpb.BeaconBlockHeader{
+			ParentRoot: make([]byte, 32),
+			StateRoot:  []byte{31: 1},
+			BodyRoot:   make([]byte, 32),
+		},
+		BlockRoots:                  zeroRoots(uint64(params.BeaconConfig().SlotsPerHistoricalRoot)),
+		StateRoots:                  zeroRoots(uint64(params.BeaconConfig().SlotsPerHistoricalRoot)),
+		RandaoMixes:                 zeroRoots(uint64(params.BeaconConfig().EpochsPerHistoricalVector)),
+		Slashings:                   make([]uint64, params.BeaconConfig().EpochsPerSlashingsVector),
+		Eth1Data:                    &ethpb.Eth1Data{DepositRoot: make([]byte, 32), BlockHash: make([]byte, 32)},
+		Validators:                  validators,
+		Balances:                    balances,
+		PreviousEpochParticipation:  prevParticipation,
+		CurrentEpochParticipation:   make([]byte, numValidators),
+		JustificationBits:           bitfield.NewBitvector4(),
+		PreviousJustifiedCheckpoint: &ethpb.Checkpoint{Root: make([]byte, 32)},
+		CurrentJustifiedCheckpoint:  &ethpb.Checkpoint{Root: make([]byte, 32)},
+		FinalizedCheckpoint:         &ethpb.Checkpoint{Root: make([]byte, 32)},
+		InactivityScores:            inactivityScores,
+		CurrentSyncCommittee:        committee,
+		NextSyncCommittee:           committee,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return st
+}
+
+// testBlock returns an Altair block at the given slot, proposed by the proposer of the pre-state,
+// whose sync aggregate carries the votes of the first numSyncVoters committee members.
+func testBlock(t *testing.T, st state.BeaconState) interfaces.SignedBeaconBlock {
+	proposerIndex, err := helpers.BeaconProposerIndex(context.Background(), st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bits := bitfield.NewBitvector512()
+	for i := uint64(0); i < numSyncVoters; i++ {
+		bits.SetBitAt(i, true)
+	}
+	blk, err := blocks.NewSignedBeaconBlock(&ethpb.SignedBeaconBlockAltair{
+		Block: &ethpb.BeaconBlockAltair{
+			Slot:          st.Slot(),
+			ProposerIndex: proposerIndex,
+			ParentRoot:    make([]byte, 32),
+			StateRoot:     make([]byte, 32),
+			Body: &ethpb.BeaconBlockBodyAltair{
+				RandaoReveal: make([]byte, 96),
+				Eth1Data:     &ethpb.Eth1Data{DepositRoot: make([]byte, 32), BlockHash: make([]byte, 32)},
+				Graffiti:     make([]byte, 32),
+				SyncAggregate: &ethpb.SyncAggregate{
+					SyncCommitteeBits:      bits,
+					SyncCommitteeSignature: make([]byte, 96),
+				},
+			},
+		},
+		Signature: make([]byte, 96),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return blk
+}
+
+// blockServer serves a block at slot 1 on top of the test state.
+func blockServer(t *testing.T) (*Server, interfaces.SignedBeaconBlock) {
+	st := testState(t, 1)
+	blk := testBlock(t, st)
+	replayer := mockstategen.NewMockReplayerBuilder()
+	replayer.SetMockStateForSlot(st, 0)
+	chain := &mock.ChainService{Optimistic: true, FinalizedRoots: map[[32]byte]bool{}}
+	return &Server{
+		Blocker:               &testBlocker{blk: blk},
+		OptimisticModeFetcher: chain,
+		FinalizationFetcher:   chain,
+		TimeFetcher:           chain,
+		ReplayerBuilder:       replayer,
+	}, blk
+}
+
+func blockRequest(body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/beacon/rewards", strings.NewReader(body))
+	return mux.SetURLVars(req, map[string]string{"block_id": "head"})
+}
+
+func checkError(t *testing.T, writer *httptest.ResponseRecorder, code int, message string) {
+	t.Helper()
+	if writer.Code != code {
+		t.Fatalf("unexpected status code %d, want %d: %s", writer.Code, code, writer.Body.String())
+	}
+	e := &network.DefaultErrorJson{}
+	if err := json.Unmarshal(writer.Body.Bytes(), e); err != nil {
+		t.Fatal(err)
+	}
+	if e.Code != code || !strings.Contains(e.Message, message) {
+		t.Errorf("unexpected error %d %q, want %d containing %q", e.Code, e.Message, code, message)
+	}
+}
+
+func TestBlockRewards(t *testing.T) {
+	setupRewardsConfig(t)
+	s, blk := blockServer(t)
+
+	writer := httptest.NewRecorder()
+	s.BlockRewards(writer, blockRequest(""))
+	if writer.Code != http.StatusOK {
+		t.Fatalf("unexpected status code %d: %s", writer.Code, writer.Body.String())
+	}
+	resp := &BlockRewardsResponse{}
+	if err := json.Unmarshal(writer.Body.Bytes(), resp); err != nil {
+		t.Fatal(err)
+	}
+	syncAggregate := strconv.Itoa(proposerReward * numSyncVoters)
+	want := BlockRewards{
+		ProposerIndex:     strconv.FormatUint(uint64(blk.Block().ProposerIndex()), 10),
+		Total:             syncAggregate,
+		Attestations:      "0",
+		SyncAggregate:     syncAggregate,
+		ProposerSlashings: "0",
+		AttesterSlashings: "0",
+	}
+	if *resp.Data != want {
+		t.Errorf("unexpected rewards %+v, want %+v", *resp.Data, want)
+	}
+	if !resp.ExecutionOptimistic || resp.Finalized {
+		t.Errorf("unexpected status: optimistic %v, finalized %v", resp.ExecutionOptimistic, resp.Finalized)
+	}
+}
+
+func TestBlockRewards_InvalidBlocks(t *testing.T) {
+	setupRewardsConfig(t)
+	s, _ := blockServer(t)
+
+	phase0, err := blocks.NewSignedBeaconBlock(&ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 1, Body: &ethpb.BeaconBlockBody{}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis := testBlock(t, testState(t, 0))
+
+	tests := []struct {
+		name    string
+		blocker *testBlocker
+		code    int
+		message string
+	}{
+		{"invalid block id", &testBlocker{err: &lookup.BlockIdParseError{}}, http.StatusBadRequest, "Invalid block ID"},
+		{"lookup failure", &testBlocker{err: fmt.Errorf("db failure")}, http.StatusInternalServerError, "db failure"},
+		{"missing block", &testBlocker{}, http.StatusNotFound, "Could not find requested block"},
+		{"phase 0 block", &testBlocker{blk: phase0}, http.StatusBadRequest, "not supported for Phase 0 blocks"},
+		{"genesis block", &testBlocker{blk: genesis}, http.StatusBadRequest, "not supported for the genesis block"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s.Blocker = tt.blocker
+			writer := httptest.NewRecorder()
+			s.BlockRewards(writer, blockRequest(""))
+			checkError(t, writer, tt.code, tt.message)
+		})
+	}
+}
+
+func TestSyncCommitteeRewards(t *testing.T) {
+	setupRewardsConfig(t)
+	s, _ := blockServer(t)
+
+	writer := httptest.NewRecorder()
+	s.SyncCommitteeRewards(writer, blockRequest(""))
+	if writer.Code != http.StatusOK {
+		t.Fatalf("unexpected status code %d: %s", writer.Code, writer.Body.String())
+	}
+	resp := &SyncCommitteeRewardsResponse{}
+	if err := json.Unmarshal(writer.Body.Bytes(), resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Data) != numValidators {
+		t.Fatalf("expected %d rewards, got %d", numValidators, len(resp.Data))
+	}
+	// The proposer's share of the sync aggregate is left out, so it is reported like any other member.
+	for i, reward := range resp.Data {
+		want := strconv.Itoa(participantReward)
+		if i >= numSyncVoters {
+			want = strconv.Itoa(-participantReward)
+		}
+		if reward.ValidatorIndex != strconv.Itoa(i) || reward.Reward != want {
+			t.Errorf("unexpected reward %+v at position %d, want %s", reward, i, want)
+		}
+	}
+
+	// Validators may be requested by index or public key.
+	writer = httptest.NewRecorder()
+	s.SyncCommitteeRewards(writer, blockRequest(fmt.Sprintf(`["500","%s"]`, hexutil.Encode(testPubkey(3)))))
+	if writer.Code != http.StatusOK {
+		t.Fatalf("unexpected status code %d: %s", writer.Code, writer.Body.String())
+	}
+	requested := &SyncCommitteeRewardsResponse{}
+	if err := json.Unmarshal(writer.Body.Bytes(), requested); err != nil {
+		t.Fatal(err)
+	}
+	want := []SyncCommitteeReward{
+		{ValidatorIndex: "3", Reward: strconv.Itoa(participantReward)},
+		{ValidatorIndex: "500", Reward: strconv.Itoa(-participantReward)},
+	}
+	if len(requested.Data) != len(want) {
+		t.Fatalf("expected %d rewards, got %d", len(want), len(requested.Data))
+	}
+	for i := range want {
+		if *requested.Data[i] != want[i] {
+			t.Errorf("unexpected reward %+v, want %+v", *requested.Data[i], want[i])
+		}
+	}
+}
+
+func TestSyncCommitteeRewards_InvalidValidators(t *testing.T) {
+	setupRewardsConfig(t)
+	s, _ := blockServer(t)
+
+	tests := []struct {
+		name    string
+		body    string
+		message string
+	}{
+		{"malformed body", `{"validators":[]}`, "Could not decode validators"},
+		{"invalid index", `["foo"]`, "Invalid validator index foo"},
+		{"index too large", `["512"]`, "Validator index 512 is too large"},
+		{"short public key", `["0x0102"]`, "Invalid validator public key length 2"},
+		{"unknown public key", fmt.Sprintf(`["%s"]`, hexutil.Encode(testPubkey(numValidators))), "No validator index found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writer := httptest.NewRecorder()
+			s.SyncCommitteeRewards(writer, blockRequest(tt.body))
+			checkError(t, writer, http.StatusBadRequest, tt.message)
+		})
+	}
+}
+
+// attestationServer serves the state at the end of epoch 2, in which the attestations of epoch 1
+// are rewarded, with the wall clock in epoch 3.
+func attestationServer(t *testing.T) *Server {
+	st := testState(t, 95)
+	header := ethpb.CopyBeaconBlockHeader(st.LatestBlockHeader())
+	root, err := header.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	currentSlot := types.Slot(96)
+	chain := &mock.ChainService{Slot: &currentSlot, FinalizedRoots: map[[32]byte]bool{root: true}}
+	return &Server{
+		OptimisticModeFetcher: chain,
+		FinalizationFetcher:   chain,
+		TimeFetcher:           chain,
+		ReplayerBuilder:       mockstategen.NewMockReplayerBuilder(mockstategen.WithMockState(st)),
+	}
+}
+
+func attestationRequest(epoch string, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/beacon/rewards/attestations", strings.NewReader(body))
+	return mux.SetURLVars(req, map[string]string{"epoch": epoch})
+}
+
+func TestAttestationRewards(t *testing.T) {
+	setupRewardsConfig(t)
+	s := attestationServer(t)
+
+	writer := httptest.NewRecorder()
+	s.AttestationRewards(writer, attestationRequest("1", `["0","400","500","511"]`))
+	if writer.Code != http.StatusOK {
+		t.Fatalf("unexpected status code %d: %s", writer.Code, writer.Body.String())
+	}
+	resp := &AttestationRewardsResponse{}
+	if err := json.Unmarshal(writer.Body.Bytes(), resp); err != nil {
+		t.Fatal(err)
+	}
+	wantTotal := []TotalAttestationReward{
+		{ValidatorIndex: "0", Head: "83130", Target: "154386", Source: "96986", Inactivity: "0"},
+		{ValidatorIndex: "400", Head: "0", Target: "-205647", Source: "96986", Inactivity: "0"},
+		{ValidatorIndex: "500", Head: "0", Target: "-205647", Source: "-110733", Inactivity: "-1271"},
+		{ValidatorIndex: "511", Head: "0", Target: "-102823", Source: "-55366", Inactivity: "-635"},
+	}
+	if len(resp.Data.TotalRewards) != len(wantTotal) {
+		t.Fatalf("expected %d total rewards, got %d", len(wantTotal), len(resp.Data.TotalRewards))
+	}
+	for i := range wantTotal {
+		if *resp.Data.TotalRewards[i] != wantTotal[i] {
+			t.Errorf("unexpected total reward %+v, want %+v", *resp.Data.TotalRewards[i], wantTotal[i])
+		}
+	}
+	wantIdeal := []IdealAttestationReward{
+		{EffectiveBalance: "16000000000", Head: "41565", Target: "77193", Source: "48493", Inactivity: "0"},
+		{EffectiveBalance: "32000000000", Head: "83130", Target: "154386", Source: "96986", Inactivity: "0"},
+	}
+	if len(resp.Data.IdealRewards) != len(wantIdeal) {
+		t.Fatalf("expected %d ideal rewards, got %d", len(wantIdeal), len(resp.Data.IdealRewards))
+	}
+	for i := range wantIdeal {
+		if *resp.Data.IdealRewards[i] != wantIdeal[i] {
+			t.Errorf("unexpected ideal reward %+v, want %+v", *resp.Data.IdealRewards[i], wantIdeal[i])
+		}
+	}
+	if resp.ExecutionOptimistic || !resp.Finalized {
+		t.Errorf("unexpected status: optimistic %v, finalized %v", resp.ExecutionOptimistic, resp.Finalized)
+	}
+
+	// Without a request body every validator is reported.
+	writer = httptest.NewRecorder()
+	s.AttestationRewards(writer, attestationRequest("1", ""))
+	if writer.Code != http.StatusOK {
+		t.Fatalf("unexpected status code %d: %s", writer.Code, writer.Body.String())
+	}
+	all := &AttestationRewardsResponse{}
+	if err := json.Unmarshal(writer.Body.Bytes(), all); err != nil {
+		t.Fatal(err)
+	}
+	if len(all.Data.TotalRewards) != numValidators || len(all.Data.IdealRewards) != 2 {
+		t.Errorf("unexpected reward counts %d and %d", len(all.Data.TotalRewards), len(all.Data.IdealRewards))
+	}
+}
+
+func TestAttestationRewards_InvalidEpoch(t *testing.T) {
+	setupRewardsConfig(t)
+	s := attestationServer(t)
+
+	tests := []struct {
+		name    string
+		epoch   string
+		code    int
+		message string
+	}{
+		{"malformed epoch", "foo", http.StatusBadRequest, "Could not parse epoch"},
+		{"phase 0 epoch", "0", http.StatusNotFound, "not supported for Phase 0"},
+		{"previous epoch", "2", http.StatusNotFound, "available after two epoch transitions"},
+		{"current epoch", "3", http.StatusNotFound, "available after two epoch transitions"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writer := httptest.NewRecorder()
+			s.AttestationRewards(writer, attestationRequest(tt.epoch, ""))
+			checkError(t, writer, tt.code, tt.message)
+		})
+	}
+}
+
+func TestIdealAttRewards(t *testing.T) {
+	setupRewardsConfig(t)
+	st := testState(t, 95)
+	allVals, bal, err := altair.InitializePrecomputeValidators(context.Background(), st)
+	if err != nil {
+		t.Fatal(err)
+	}
+	allVals, bal, err = altair.ProcessEpochParticipation(context.Background(), st, bal, allVals)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// One reward is reported per distinct effective balance, in ascending order, and a
+	// validator that missed its duties is still rewarded as if it had attested.
+	rewards, err := idealAttRewards(st, bal, allVals, []types.ValidatorIndex{500, 511, 0, 511})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"16000000000", "32000000000"}
+	if len(rewards) != len(want) {
+		t.Fatalf("expected %d rewards, got %d", len(want), len(rewards))
+	}
+	for i, r := range rewards {
+		if r.EffectiveBalance != want[i] {
+			t.Errorf("unexpected effective balance %s at position %d, want %s", r.EffectiveBalance, i, want[i])
+		}
+	}
+	if rewards[1].Source != "96986" || rewards[1].Target != "154386" || rewards[1].Head != "83130" {
+		t.Errorf("unexpected ideal reward %+v", *rewards[1])
+	}
+}
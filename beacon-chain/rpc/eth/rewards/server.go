@@ -0,0 +1,19 @@
+// Package rewards defines HTTP handlers for the standard rewards endpoints of
+// the Ethereum Beacon Node API.
+package rewards
+
+import (
+	"github.com/theQRL/zond/beacon-chain/blockchain"
+	"github.com/theQRL/zond/beacon-chain/rpc/lookup"
+	"github.com/theQRL/zond/beacon-chain/state/stategen"
+)
+
+// Server computes rewards by replaying states through the consensus
+// transition functions.
+type Server struct {
+	Blocker               lookup.Blocker
+	OptimisticModeFetcher blockchain.OptimisticModeFetcher
+	FinalizationFetcher   blockchain.FinalizationFetcher
+	TimeFetcher           blockchain.TimeFetcher
+	ReplayerBuilder       stategen.ReplayerBuilder
+}
@@ -0,0 +1,191 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/theQRL/zond/beacon-chain/core/helpers"
+	"github.com/theQRL/zond/beacon-chain/state"
+	"github.com/theQRL/zond/config/params"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	"github.com/theQRL/zond/network"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+	"github.com/theQRL/zond/protos/zond/v1alpha1/attestation"
+	"github.com/theQRL/zond/time/slots"
+)
+
+// LivenessResponse is the response of the liveness endpoint.
+type LivenessResponse struct {
+	Data []*ValidatorLiveness `json:"data"`
+}
+
+// ValidatorLiveness tells whether the validator was seen in the requested epoch.
+type ValidatorLiveness struct {
+	Index  string `json:"index"`
+	IsLive bool   `json:"is_live"`
+}
+
+// Liveness is an HTTP handler for Beacon API getLiveness. It reports whether the requested validators
+// were seen performing their duties in the given epoch. A validator is considered live if any of its
+// participation flags is set for the epoch, or if one of its attestations for the epoch is waiting
+// in the attestation pool to be included in a block.
+func (vs *Server) Liveness(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	requestedEpoch, err := strconv.ParseUint(mux.Vars(r)["epoch"], 10, 64)
+	if err != nil {
+		network.WriteError(w, livenessError(http.StatusBadRequest, "Could not parse epoch", err))
+		return
+	}
+	epoch := types.Epoch(requestedEpoch)
+	if epoch < params.BeaconConfig().AltairForkEpoch {
+		network.WriteError(w, &network.DefaultErrorJson{
+			Message: "Liveness is not supported for Phase 0 epochs",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+	currentEpoch := slots.ToEpoch(vs.TimeFetcher.CurrentSlot())
+	if epoch > currentEpoch {
+		network.WriteError(w, &network.DefaultErrorJson{
+			Message: fmt.Sprintf("Requested epoch %d cannot be in the future, current epoch is %d", epoch, currentEpoch),
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		network.WriteError(w, livenessError(http.StatusBadRequest, "Could not read request body", err))
+		return
+	}
+	var ids []string
+	if err := json.Unmarshal(body, &ids); err != nil {
+		network.WriteError(w, livenessError(http.StatusBadRequest, "Could not decode validator indices", err))
+		return
+	}
+	if len(ids) == 0 {
+		network.WriteError(w, &network.DefaultErrorJson{
+			Message: "No validator indices provided",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	headState, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		network.WriteError(w, livenessError(http.StatusInternalServerError, "Could not get head state", err))
+		return
+	}
+	participation, err := vs.epochParticipation(ctx, headState, epoch)
+	if err != nil {
+		network.WriteError(w, livenessError(http.StatusInternalServerError, "Could not get epoch participation", err))
+		return
+	}
+	// Attestations which have not been included in a block yet can only be found for recent epochs.
+	pooled := make(map[types.ValidatorIndex]bool)
+	if epoch+1 >= currentEpoch {
+		pooled, err = vs.pooledAttesters(ctx, headState, epoch)
+		if err != nil {
+			network.WriteError(w, livenessError(http.StatusInternalServerError, "Could not get attesters from the attestation pool", err))
+			return
+		}
+	}
+
+	numVals := uint64(headState.NumValidators())
+	resp := &LivenessResponse{Data: make([]*ValidatorLiveness, len(ids))}
+	for i, id := range ids {
+		idx, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			network.WriteError(w, livenessError(http.StatusBadRequest, fmt.Sprintf("Invalid validator index %s", id), err))
+			return
+		}
+		if idx >= numVals {
+			network.WriteError(w, &network.DefaultErrorJson{
+				Message: fmt.Sprintf("Validator index %d is invalid", idx),
+				Code:    http.StatusBadRequest,
+			})
+			return
+		}
+		live := pooled[types.ValidatorIndex(idx)]
+		if idx < uint64(len(participation)) && participation[idx] != 0 {
+			live = true
+		}
+		resp.Data[i] = &ValidatorLiveness{
+			Index:  id,
+			IsLive: live,
+		}
+	}
+	network.WriteJson(w, resp)
+}
+
+// epochParticipation returns the participation flags of all validators for the given epoch.
+func (vs *Server) epochParticipation(ctx context.Context, headState state.BeaconState, epoch types.Epoch) ([]byte, error) {
+	headEpoch := slots.ToEpoch(headState.Slot())
+	switch {
+	case epoch > headEpoch:
+		// No block of the requested epoch has been processed yet.
+		return nil, nil
+	case epoch == headEpoch:
+		return headState.CurrentEpochParticipation()
+	case epoch+1 == headEpoch:
+		return headState.PreviousEpochParticipation()
+	}
+	// Attestations for an epoch can be included until the end of the next epoch,
+	// where they are reflected in the previous epoch participation.
+	nextEpochEnd, err := slots.EpochEnd(epoch + 1)
+	if err != nil {
+		return nil, err
+	}
+	st, err := vs.StateFetcher.StateBySlot(ctx, nextEpochEnd)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get state for slot %d", nextEpochEnd)
+	}
+	return st.PreviousEpochParticipation()
+}
+
+// pooledAttesters returns the validators which have an attestation targeting the given epoch in the attestation pool.
+func (vs *Server) pooledAttesters(ctx context.Context, headState state.ReadOnlyBeaconState, epoch types.Epoch) (map[types.ValidatorIndex]bool, error) {
+	unaggregated, err := vs.AttestationsPool.UnaggregatedAttestations()
+	if err != nil {
+		return nil, err
+	}
+	atts := append(vs.AttestationsPool.AggregatedAttestations(), unaggregated...)
+	attesters := make(map[types.ValidatorIndex]bool)
+	for _, att := range atts {
+		if att == nil || att.Data == nil || att.Data.Target == nil || att.Data.Target.Epoch != epoch {
+			continue
+		}
+		if err := vs.markAttesters(ctx, headState, att, attesters); err != nil {
+			return nil, err
+		}
+	}
+	return attesters, nil
+}
+
+func (vs *Server) markAttesters(ctx context.Context, headState state.ReadOnlyBeaconState, att *ethpb.Attestation, attesters map[types.ValidatorIndex]bool) error {
+	committee, err := helpers.BeaconCommitteeFromState(ctx, headState, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		return errors.Wrapf(err, "could not get committee %d at slot %d", att.Data.CommitteeIndex, att.Data.Slot)
+	}
+	indices, err := attestation.AttestingIndices(att.AggregationBits, committee)
+	if err != nil {
+		return err
+	}
+	for _, idx := range indices {
+		attesters[types.ValidatorIndex(idx)] = true
+	}
+	return nil
+}
+
+func livenessError(code int, message string, err error) *network.DefaultErrorJson {
+	return &network.DefaultErrorJson{
+		Message: message + ": " + err.Error(),
+		Code:    code,
+	}
+}
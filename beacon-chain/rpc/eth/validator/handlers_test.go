@@ -0,0 +1,216 @@
+package validator
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/theQRL/zond/beacon-chain/blockchain/testing"
+	"github.com/theQRL/zond/beacon-chain/core/helpers"
+	"github.com/theQRL/zond/beacon-chain/operations/attestations"
+	"github.com/theQRL/zond/beacon-chain/rpc/testutil"
+	"github.com/theQRL/zond/beacon-chain/state"
+	state_native "github.com/theQRL/zond/beacon-chain/state/state-native"
+	fieldparams "github.com/theQRL/zond/config/fieldparams"
+	"github.com/theQRL/zond/config/params"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	"github.com/theQRL/zond/network"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+)
+
+const numLivenessValidators = 64
+
+func zeroRoots(n uint64) [][]byte {
+	roots := make([][]byte, n)
+	for i := range roots {
+		roots[i] = make([]byte, 32)
+	}
+	return roots
+}
+
+// livenessState returns an Altair state at the given slot, in which the given validators have a
+// participation flag set in the previous and in the current epoch.
+func livenessState(t *testing.T, slot types.Slot, prevLive, currLive []int) state.BeaconState {
+	validators := make([]*ethpb.Validator, numLivenessValidators)
+	balances := make([]uint64, numLivenessValidators)
+	for i := range validators {
+		pubkey := make([]byte, fieldparams.BLSPubkeyLength)
+		binary.BigEndian.PutUint64(pubkey, uint64(i)+1)
+		validators[i] = &ethpb.Validator{
+			PublicKey:             pubkey,
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      params.BeaconConfig().MaxEffectiveBalance,
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch:     params.BeaconConfig().FarFutureEpoch,
+		}
+		balances[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+	prevParticipation := make([]byte, numLivenessValidators)
+	for _, i := range prevLive {
+		prevParticipation[i] = 0b010
+	}
+	currParticipation := make([]byte, numLivenessValidators)
+	for _, i := range currLive {
+		currParticipation[i] = 0b001
+	}
+	st, err := state_native.InitializeFromProtoUnsafeAltair(&ethpb.BeaconStateAltair{
+		Slot:                       slot,
+		GenesisValidatorsRoot:      make([]byte, 32),
+		Fork:                       &ethpb.Fork{PreviousVersion: make([]byte, 4), CurrentVersion: make([]byte, 4)},
+		LatestBlockHeader:          &ethpb.BeaconBlockHeader{ParentRoot: make([]byte, 32), StateRoot: make([]byte, 32), BodyRoot: make([]byte, 32)},
+		BlockRoots:                 zeroRoots(uint64(params.BeaconConfig().SlotsPerHistoricalRoot)),
+		StateRoots:                 zeroRoots(uint64(params.BeaconConfig().SlotsPerHistoricalRoot)),
+		RandaoMixes:                zeroRoots(uint64(params.BeaconConfig().EpochsPerHistoricalVector)),
+		Slashings:                  make([]uint64, params.BeaconConfig().EpochsPerSlashingsVector),
+		Validators:                 validators,
+		Balances:                   balances,
+		PreviousEpochParticipation: prevParticipation,
+		CurrentEpochParticipation:  currParticipation,
+		InactivityScores:           make([]uint64, numLivenessValidators),
+		FinalizedCheckpoint:        &ethpb.Checkpoint{Root: make([]byte, 32)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return st
+}
+
+// poolAttestation returns an unaggregated attestation of the given validator targeting the epoch
+// of the state.
+func poolAttestation(t *testing.T, st state.ReadOnlyBeaconState, validator types.ValidatorIndex) *ethpb.Attestation {
+	epoch := types.Epoch(st.Slot() / params.BeaconConfig().SlotsPerEpoch)
+	start := types.Slot(epoch) * params.BeaconConfig().SlotsPerEpoch
+	for slot := start; slot < start+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		committee, err := helpers.BeaconCommitteeFromState(context.Background(), st, slot, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, idx := range committee {
+			if idx != validator {
+				continue
+			}
+			bits := bitfield.NewBitlist(uint64(len(committee)))
+			bits.SetBitAt(uint64(i), true)
+			return &ethpb.Attestation{
+				AggregationBits: bits,
+				Data: &ethpb.AttestationData{
+					Slot:            slot,
+					BeaconBlockRoot: make([]byte, 32),
+					Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+					Target:          &ethpb.Checkpoint{Epoch: epoch, Root: make([]byte, 32)},
+				},
+				Signature: make([]byte, 96),
+			}
+		}
+	}
+	t.Fatalf("validator %d has no attestation duty in epoch %d", validator, epoch)
+	return nil
+}
+
+// livenessServer serves a head state in epoch 3, which is also the current epoch. Validator 0 is
+// live in the current epoch, validator 1 in the previous one and validator 3 has an attestation
+// for the current epoch waiting in the pool. The state at the end of epoch 2 marks validator 2
+// as live in epoch 1.
+func livenessServer(t *testing.T) *Server {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 1
+	params.OverrideBeaconConfig(cfg)
+	helpers.ClearCache()
+
+	headSlot := types.Slot(100)
+	headState := livenessState(t, headSlot, []int{1}, []int{0})
+	pool := attestations.NewPool()
+	if err := pool.SaveUnaggregatedAttestation(poolAttestation(t, headState, 3)); err != nil {
+		t.Fatal(err)
+	}
+	chain := &mock.ChainService{State: headState, Slot: &headSlot}
+	return &Server{
+		HeadFetcher:      chain,
+		TimeFetcher:      chain,
+		AttestationsPool: pool,
+		StateFetcher:     &testutil.MockFetcher{BeaconState: livenessState(t, 95, []int{2}, nil)},
+	}
+}
+
+func livenessRequest(epoch string, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/validator/liveness/"+epoch, strings.NewReader(body))
+	return mux.SetURLVars(req, map[string]string{"epoch": epoch})
+}
+
+func TestLiveness(t *testing.T) {
+	s := livenessServer(t)
+
+	tests := []struct {
+		name  string
+		epoch string
+		live  []bool
+	}{
+		{"current epoch", "3", []bool{true, false, false, true, false}},
+		{"previous epoch", "2", []bool{false, true, false, false, false}},
+		{"older epoch", "1", []bool{false, false, true, false, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writer := httptest.NewRecorder()
+			s.Liveness(writer, livenessRequest(tt.epoch, `["0","1","2","3","4"]`))
+			if writer.Code != http.StatusOK {
+				t.Fatalf("unexpected status code %d: %s", writer.Code, writer.Body.String())
+			}
+			resp := &LivenessResponse{}
+			if err := json.Unmarshal(writer.Body.Bytes(), resp); err != nil {
+				t.Fatal(err)
+			}
+			if len(resp.Data) != len(tt.live) {
+				t.Fatalf("expected %d validators, got %d", len(tt.live), len(resp.Data))
+			}
+			for i, v := range resp.Data {
+				if v.Index != strconv.Itoa(i) || v.IsLive != tt.live[i] {
+					t.Errorf("unexpected liveness %+v at position %d, want %v", *v, i, tt.live[i])
+				}
+			}
+		})
+	}
+}
+
+func TestLiveness_InvalidRequests(t *testing.T) {
+	s := livenessServer(t)
+
+	tests := []struct {
+		name    string
+		epoch   string
+		body    string
+		message string
+	}{
+		{"malformed epoch", "foo", `["0"]`, "Could not parse epoch"},
+		{"phase 0 epoch", "0", `["0"]`, "not supported for Phase 0 epochs"},
+		{"future epoch", "4", `["0"]`, "cannot be in the future, current epoch is 3"},
+		{"malformed body", "3", `{"indices":["0"]}`, "Could not decode validator indices"},
+		{"no indices", "3", `[]`, "No validator indices provided"},
+		{"invalid index", "3", `["foo"]`, "Invalid validator index foo"},
+		{"unknown index", "3", `["64"]`, "Validator index 64 is invalid"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writer := httptest.NewRecorder()
+			s.Liveness(writer, livenessRequest(tt.epoch, tt.body))
+			if writer.Code != http.StatusBadRequest {
+				t.Fatalf("unexpected status code %d: %s", writer.Code, writer.Body.String())
+			}
+			e := &network.DefaultErrorJson{}
+			if err := json.Unmarshal(writer.Body.Bytes(), e); err != nil {
+				t.Fatal(err)
+			}
+			if e.Code != http.StatusBadRequest || !strings.Contains(e.Message, tt.message) {
+				t.Errorf("unexpected error %d %q, want one containing %q", e.Code, e.Message, tt.message)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package lookup
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/zond/beacon-chain/blockchain"
+	"github.com/theQRL/zond/beacon-chain/db"
+	"github.com/theQRL/zond/common/hexutil"
+	"github.com/theQRL/zond/consensus-types/interfaces"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	"github.com/theQRL/zond/encoding/bytesutil"
+)
+
+// BlockIdParseError represents an error scenario where a block ID could not be parsed.
+type BlockIdParseError struct {
+	message string
+}
+
+// NewBlockIdParseError creates a new error instance.
+func NewBlockIdParseError(reason error) BlockIdParseError {
+	return BlockIdParseError{
+		message: errors.Wrapf(reason, "could not parse block ID").Error(),
+	}
+}
+
+// Error returns the underlying error message.
+func (e *BlockIdParseError) Error() string {
+	return e.message
+}
+
+// Blocker is responsible for retrieving blocks.
+type Blocker interface {
+	Block(ctx context.Context, id []byte) (interfaces.SignedBeaconBlock, error)
+}
+
+// BeaconDbBlocker is an implementation of Blocker. It retrieves blocks from the beacon chain database.
+type BeaconDbBlocker struct {
+	BeaconDB         db.ReadOnlyDatabase
+	ChainInfoFetcher blockchain.ChainInfoFetcher
+}
+
+// Block returns the beacon block for a given identifier. The identifier can be one of:
+//   - "head" (canonical head in node's view)
+//   - "genesis"
+//   - "finalized"
+//   - <slot>
+//   - <hex encoded block root with '0x' prefix>
+//   - <block root>
+//
+// A nil block and no error are returned if there is no canonical block at the requested slot.
+func (p *BeaconDbBlocker) Block(ctx context.Context, id []byte) (interfaces.SignedBeaconBlock, error) {
+	var err error
+	var blk interfaces.SignedBeaconBlock
+	switch string(id) {
+	case "head":
+		blk, err = p.ChainInfoFetcher.HeadBlock(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not retrieve head block")
+		}
+	case "finalized":
+		finalized := p.ChainInfoFetcher.FinalizedCheckpt()
+		finalizedRoot := bytesutil.ToBytes32(finalized.Root)
+		blk, err = p.BeaconDB.Block(ctx, finalizedRoot)
+		if err != nil {
+			return nil, errors.New("could not get finalized block from db")
+		}
+	case "genesis":
+		blk, err = p.BeaconDB.GenesisBlock(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not retrieve blocks for genesis slot")
+		}
+	default:
+		if bytesutil.IsHex(id) {
+			decoded, err := hexutil.Decode(string(id))
+			if err != nil {
+				e := NewBlockIdParseError(err)
+				return nil, &e
+			}
+			id = decoded
+		}
+		if len(id) == 32 {
+			blk, err = p.BeaconDB.Block(ctx, bytesutil.ToBytes32(id))
+			if err != nil {
+				return nil, errors.Wrap(err, "could not retrieve block")
+			}
+			return blk, nil
+		}
+		slot, err := strconv.ParseUint(string(id), 10, 64)
+		if err != nil {
+			e := NewBlockIdParseError(err)
+			return nil, &e
+		}
+		blks, err := p.BeaconDB.BlocksBySlot(ctx, types.Slot(slot))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not retrieve blocks for slot %d", slot)
+		}
+		_, roots, err := p.BeaconDB.BlockRootsBySlot(ctx, types.Slot(slot))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not retrieve block roots for slot %d", slot)
+		}
+		for i, b := range blks {
+			canonical, err := p.ChainInfoFetcher.IsCanonical(ctx, roots[i])
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not determine if block root is canonical")
+			}
+			if canonical {
+				return b, nil
+			}
+		}
+		return nil, nil
+	}
+	return blk, nil
+}
@@ -6,8 +6,10 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
 	"sync"
 
+	"github.com/gorilla/mux"
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpcopentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
@@ -28,16 +30,21 @@ import (
 	"github.com/theQRL/zond/beacon-chain/operations/synccommittee"
 	"github.com/theQRL/zond/beacon-chain/operations/voluntaryexits"
 	"github.com/theQRL/zond/beacon-chain/p2p"
+	"github.com/theQRL/zond/beacon-chain/rpc/core"
 	"github.com/theQRL/zond/beacon-chain/rpc/eth/beacon"
 	"github.com/theQRL/zond/beacon-chain/rpc/eth/debug"
 	"github.com/theQRL/zond/beacon-chain/rpc/eth/events"
+	lightclient "github.com/theQRL/zond/beacon-chain/rpc/eth/light-client"
 	"github.com/theQRL/zond/beacon-chain/rpc/eth/node"
+	"github.com/theQRL/zond/beacon-chain/rpc/eth/rewards"
 	"github.com/theQRL/zond/beacon-chain/rpc/eth/validator"
+	"github.com/theQRL/zond/beacon-chain/rpc/lookup"
 	"github.com/theQRL/zond/beacon-chain/rpc/statefetcher"
 	beaconv1alpha1 "github.com/theQRL/zond/beacon-chain/rpc/zond/v1alpha1/beacon"
 	debugv1alpha1 "github.com/theQRL/zond/beacon-chain/rpc/zond/v1alpha1/debug"
 	nodev1alpha1 "github.com/theQRL/zond/beacon-chain/rpc/zond/v1alpha1/node"
 	validatorv1alpha1 "github.com/theQRL/zond/beacon-chain/rpc/zond/v1alpha1/validator"
+	zondvalidator "github.com/theQRL/zond/beacon-chain/rpc/zond/validator"
 	slasherservice "github.com/theQRL/zond/beacon-chain/slasher"
 	"github.com/theQRL/zond/beacon-chain/state/stategen"
 	chainSync "github.com/theQRL/zond/beacon-chain/sync"
@@ -113,7 +120,9 @@ type Config struct {
 	ExecutionEngineCaller         execution.EngineCaller
 	ProposerIdsCache              *cache.ProposerPayloadIDsCache
 	OptimisticModeFetcher         blockchain.OptimisticModeFetcher
+	LightClientFetcher            blockchain.LightClientFetcher
 	BlockBuilder                  builder.BlockBuilder
+	Router                        *mux.Router
 }
 
 // NewService instantiates a new RPC service instance that will
@@ -268,6 +277,11 @@ func (s *Service) Start() {
 		HeadFetcher:           s.cfg.HeadFetcher,
 	}
 
+	coreService := &core.Service{
+		HeadFetcher:        s.cfg.HeadFetcher,
+		GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
+		SyncChecker:        s.cfg.SyncService,
+	}
 	beaconChainServer := &beaconv1alpha1.Server{
 		Ctx:                         s.ctx,
 		BeaconDB:                    s.cfg.BeaconDB,
@@ -291,6 +305,7 @@ func (s *Service) Start() {
 		ReceivedAttestationsBuffer:  make(chan *ethpbv1alpha1.Attestation, attestationBufferSize),
 		CollectedAttestationsBuffer: make(chan []*ethpbv1alpha1.Attestation, attestationBufferSize),
 		ReplayerBuilder:             ch,
+		CoreService:                 coreService,
 	}
 	beaconChainServerV1 := &beacon.Server{
 		CanonicalHistory:   ch,
@@ -318,6 +333,36 @@ func (s *Service) Start() {
 		SyncChecker:                   s.cfg.SyncService,
 		ExecutionPayloadReconstructor: s.cfg.ExecutionPayloadReconstructor,
 	}
+	rewardsServer := &rewards.Server{
+		Blocker: &lookup.BeaconDbBlocker{
+			BeaconDB:         s.cfg.BeaconDB,
+			ChainInfoFetcher: s.cfg.ChainInfoFetcher,
+		},
+		OptimisticModeFetcher: s.cfg.OptimisticModeFetcher,
+		FinalizationFetcher:   s.cfg.FinalizationFetcher,
+		TimeFetcher:           s.cfg.GenesisTimeFetcher,
+		ReplayerBuilder:       ch,
+	}
+	zondValidatorServer := &zondvalidator.Server{
+		CoreService: coreService,
+	}
+	lightClientServer := &lightclient.Server{
+		BeaconDB:           s.cfg.BeaconDB,
+		LightClientFetcher: s.cfg.LightClientFetcher,
+	}
+	if s.cfg.Router != nil {
+		s.cfg.Router.HandleFunc("/eth/v1/beacon/rewards/blocks/{block_id}", rewardsServer.BlockRewards).Methods(http.MethodGet)
+		s.cfg.Router.HandleFunc("/eth/v1/beacon/rewards/attestations/{epoch}", rewardsServer.AttestationRewards).Methods(http.MethodGet, http.MethodPost)
+		s.cfg.Router.HandleFunc("/eth/v1/beacon/rewards/sync_committee/{block_id}", rewardsServer.SyncCommitteeRewards).Methods(http.MethodGet, http.MethodPost)
+		s.cfg.Router.HandleFunc("/eth/v1/validator/liveness/{epoch}", validatorServerV1.Liveness).Methods(http.MethodPost)
+		s.cfg.Router.HandleFunc("/zond/v1/validators/performance", zondValidatorServer.GetValidatorPerformance).Methods(http.MethodPost)
+		if features.Get().EnableLightClient {
+			s.cfg.Router.HandleFunc("/eth/v1/beacon/light_client/bootstrap/{block_root}", lightClientServer.GetLightClientBootstrap).Methods(http.MethodGet)
+			s.cfg.Router.HandleFunc("/eth/v1/beacon/light_client/updates", lightClientServer.GetLightClientUpdatesByRange).Methods(http.MethodGet)
+			s.cfg.Router.HandleFunc("/eth/v1/beacon/light_client/finality_update", lightClientServer.GetLightClientFinalityUpdate).Methods(http.MethodGet)
+			s.cfg.Router.HandleFunc("/eth/v1/beacon/light_client/optimistic_update", lightClientServer.GetLightClientOptimisticUpdate).Methods(http.MethodGet)
+		}
+	}
 	ethpbv1alpha1.RegisterNodeServer(s.grpcServer, nodeServer)
 	ethpbservice.RegisterBeaconNodeServer(s.grpcServer, nodeServerV1)
 	ethpbv1alpha1.RegisterHealthServer(s.grpcServer, nodeServer)
@@ -17,6 +17,7 @@ import (
 	"github.com/theQRL/zond/beacon-chain/operations/attestations"
 	"github.com/theQRL/zond/beacon-chain/operations/slashings"
 	"github.com/theQRL/zond/beacon-chain/p2p"
+	"github.com/theQRL/zond/beacon-chain/rpc/core"
 	"github.com/theQRL/zond/beacon-chain/state/stategen"
 	"github.com/theQRL/zond/beacon-chain/sync"
 	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
@@ -48,4 +49,5 @@ type Server struct {
 	ReplayerBuilder             stategen.ReplayerBuilder
 	HeadUpdater                 blockchain.HeadUpdater
 	OptimisticModeFetcher       blockchain.OptimisticModeFetcher
+	CoreService                 *core.Service
 }
@@ -0,0 +1,90 @@
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/theQRL/zond/beacon-chain/rpc/core"
+	"github.com/theQRL/zond/common/hexutil"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	"github.com/theQRL/zond/network"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+)
+
+// GetValidatorPerformance is an HTTP handler for the validator performance endpoint. It mirrors
+// the GetValidatorPerformance method of the v1alpha1 beacon chain gRPC service.
+func (s *Server) GetValidatorPerformance(w http.ResponseWriter, r *http.Request) {
+	var req ValidatorPerformanceRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+		network.WriteError(w, &network.DefaultErrorJson{
+			Message: "Could not decode request body: " + err.Error(),
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+	pbReq := &ethpb.ValidatorPerformanceRequest{
+		PublicKeys: make([][]byte, len(req.PublicKeys)),
+		Indices:    make([]types.ValidatorIndex, len(req.Indices)),
+	}
+	for i, pk := range req.PublicKeys {
+		pubkey, err := hexutil.Decode(pk)
+		if err != nil {
+			network.WriteError(w, &network.DefaultErrorJson{
+				Message: fmt.Sprintf("Invalid public key %s: %v", pk, err),
+				Code:    http.StatusBadRequest,
+			})
+			return
+		}
+		pbReq.PublicKeys[i] = pubkey
+	}
+	for i, id := range req.Indices {
+		idx, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			network.WriteError(w, &network.DefaultErrorJson{
+				Message: fmt.Sprintf("Invalid validator index %s: %v", id, err),
+				Code:    http.StatusBadRequest,
+			})
+			return
+		}
+		pbReq.Indices[i] = types.ValidatorIndex(idx)
+	}
+
+	computed, rpcErr := s.CoreService.ComputeValidatorPerformance(r.Context(), pbReq)
+	if rpcErr != nil {
+		network.WriteError(w, &network.DefaultErrorJson{
+			Message: "Could not compute validator performance: " + rpcErr.Err.Error(),
+			Code:    core.ErrorReasonToHTTP(rpcErr.Reason),
+		})
+		return
+	}
+	network.WriteJson(w, &ValidatorPerformanceResponse{
+		PublicKeys:                    encodeBytes(computed.PublicKeys),
+		CorrectlyVotedSource:          computed.CorrectlyVotedSource,
+		CorrectlyVotedTarget:          computed.CorrectlyVotedTarget,
+		CorrectlyVotedHead:            computed.CorrectlyVotedHead,
+		CurrentEffectiveBalances:      formatUints(computed.CurrentEffectiveBalances),
+		BalancesBeforeEpochTransition: formatUints(computed.BalancesBeforeEpochTransition),
+		BalancesAfterEpochTransition:  formatUints(computed.BalancesAfterEpochTransition),
+		MissingValidators:             encodeBytes(computed.MissingValidators),
+		InactivityScores:              formatUints(computed.InactivityScores),
+	})
+}
+
+func encodeBytes(values [][]byte) []string {
+	encoded := make([]string, len(values))
+	for i, v := range values {
+		encoded[i] = hexutil.Encode(v)
+	}
+	return encoded
+}
+
+func formatUints(values []uint64) []string {
+	formatted := make([]string, len(values))
+	for i, v := range values {
+		formatted[i] = strconv.FormatUint(v, 10)
+	}
+	return formatted
+}
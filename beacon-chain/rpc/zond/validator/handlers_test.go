@@ -0,0 +1,215 @@
+package validator
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	mock "github.com/theQRL/zond/beacon-chain/blockchain/testing"
+	"github.com/theQRL/zond/beacon-chain/core/helpers"
+	"github.com/theQRL/zond/beacon-chain/rpc/core"
+	"github.com/theQRL/zond/beacon-chain/rpc/zond/v1alpha1/beacon"
+	"github.com/theQRL/zond/beacon-chain/state"
+	state_native "github.com/theQRL/zond/beacon-chain/state/state-native"
+	mockSync "github.com/theQRL/zond/beacon-chain/sync/initial-sync/testing"
+	"github.com/theQRL/zond/common/hexutil"
+	fieldparams "github.com/theQRL/zond/config/fieldparams"
+	"github.com/theQRL/zond/config/params"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	"github.com/theQRL/zond/network"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func testPubkey(i int) []byte {
+	pubkey := make([]byte, fieldparams.BLSPubkeyLength)
+	binary.BigEndian.PutUint64(pubkey, uint64(i)+1)
+	return pubkey
+}
+
+func zeroRoots(n uint64) [][]byte {
+	roots := make([][]byte, n)
+	for i := range roots {
+		roots[i] = make([]byte, 32)
+	}
+	return roots
+}
+
+// performanceState returns an Altair state in epoch 3 with four active validators, which attested in
+// the previous epoch to the source, target and head, to the source and target, to the source only
+// and not at all. A fifth validator only activates in the next epoch.
+func performanceState(t *testing.T) state.BeaconState {
+	flags := []byte{0b111, 0b011, 0b001, 0, 0}
+	validators := make([]*ethpb.Validator, len(flags))
+	balances := make([]uint64, len(flags))
+	for i := range validators {
+		validators[i] = &ethpb.Validator{
+			PublicKey:             testPubkey(i),
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      params.BeaconConfig().MaxEffectiveBalance,
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch:     params.BeaconConfig().FarFutureEpoch,
+		}
+		balances[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+	validators[4].ActivationEpoch = 4
+	st, err := state_native.InitializeFromProtoUnsafeAltair(&ethpb.BeaconStateAltair{
+		Slot:                       100,
+		GenesisValidatorsRoot:      make([]byte, 32),
+		Fork:                       &ethpb.Fork{PreviousVersion: make([]byte, 4), CurrentVersion: make([]byte, 4)},
+		LatestBlockHeader:          &ethpb.BeaconBlockHeader{ParentRoot: make([]byte, 32), StateRoot: make([]byte, 32), BodyRoot: make([]byte, 32)},
+		BlockRoots:                 zeroRoots(uint64(params.BeaconConfig().SlotsPerHistoricalRoot)),
+		StateRoots:                 zeroRoots(uint64(params.BeaconConfig().SlotsPerHistoricalRoot)),
+		RandaoMixes:                zeroRoots(uint64(params.BeaconConfig().EpochsPerHistoricalVector)),
+		Slashings:                  make([]uint64, params.BeaconConfig().EpochsPerSlashingsVector),
+		Validators:                 validators,
+		Balances:                   balances,
+		PreviousEpochParticipation: flags,
+		CurrentEpochParticipation:  make([]byte, len(flags)),
+		InactivityScores:           make([]uint64, len(flags)),
+		FinalizedCheckpoint:        &ethpb.Checkpoint{Root: make([]byte, 32)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return st
+}
+
+// coreService returns a core service on a copy of the given head state, as computing the
+// performance applies the epoch rewards to the head state.
+func coreService(st state.BeaconState, syncing bool) *core.Service {
+	slot := st.Slot()
+	chain := &mock.ChainService{State: st.Copy(), Slot: &slot}
+	return &core.Service{
+		HeadFetcher:        chain,
+		GenesisTimeFetcher: chain,
+		SyncChecker:        &mockSync.Sync{IsSyncing: syncing},
+	}
+}
+
+func performanceRequest(body string) *http.Request {
+	return httptest.NewRequest(http.MethodPost, "http://example.com/zond/v1/validator/performance", strings.NewReader(body))
+}
+
+func TestGetValidatorPerformance(t *testing.T) {
+	helpers.ClearCache()
+	st := performanceState(t)
+	unknown := testPubkey(10)
+
+	body := `{"public_keys":["` + hexutil.Encode(testPubkey(2)) + `","` + hexutil.Encode(unknown) + `"],"indices":["3","1","0","4","2"]}`
+	writer := httptest.NewRecorder()
+	(&Server{CoreService: coreService(st, false)}).GetValidatorPerformance(writer, performanceRequest(body))
+	if writer.Code != http.StatusOK {
+		t.Fatalf("unexpected status code %d: %s", writer.Code, writer.Body.String())
+	}
+	resp := &ValidatorPerformanceResponse{}
+	if err := json.Unmarshal(writer.Body.Bytes(), resp); err != nil {
+		t.Fatal(err)
+	}
+
+	// Validators are reported once, in index order, and the unknown and inactive ones as missing.
+	wantKeys := []string{hexutil.Encode(testPubkey(0)), hexutil.Encode(testPubkey(1)), hexutil.Encode(testPubkey(2)), hexutil.Encode(testPubkey(3))}
+	if !reflect.DeepEqual(resp.PublicKeys, wantKeys) {
+		t.Errorf("unexpected public keys %v", resp.PublicKeys)
+	}
+	if want := []string{hexutil.Encode(unknown), hexutil.Encode(testPubkey(4))}; !reflect.DeepEqual(resp.MissingValidators, want) {
+		t.Errorf("unexpected missing validators %v, want %v", resp.MissingValidators, want)
+	}
+	if want := []bool{true, true, true, false}; !reflect.DeepEqual(resp.CorrectlyVotedSource, want) {
+		t.Errorf("unexpected source votes %v", resp.CorrectlyVotedSource)
+	}
+	if want := []bool{true, true, false, false}; !reflect.DeepEqual(resp.CorrectlyVotedTarget, want) {
+		t.Errorf("unexpected target votes %v", resp.CorrectlyVotedTarget)
+	}
+	if want := []bool{true, false, false, false}; !reflect.DeepEqual(resp.CorrectlyVotedHead, want) {
+		t.Errorf("unexpected head votes %v", resp.CorrectlyVotedHead)
+	}
+	for i := range resp.PublicKeys {
+		if resp.BalancesBeforeEpochTransition[i] != "32000000000" || resp.CurrentEffectiveBalances[i] != "32000000000" {
+			t.Errorf("validator %d: unexpected balance %s and effective balance %s", i, resp.BalancesBeforeEpochTransition[i], resp.CurrentEffectiveBalances[i])
+		}
+	}
+	// Only the validators that attested to the target are rewarded.
+	for i, rewarded := range []bool{true, true, false, false} {
+		after, err := strconv.ParseUint(resp.BalancesAfterEpochTransition[i], 10, 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (after > params.BeaconConfig().MaxEffectiveBalance) != rewarded {
+			t.Errorf("validator %d: unexpected balance %d after the epoch transition", i, after)
+		}
+	}
+	if len(resp.InactivityScores) != len(wantKeys) {
+		t.Errorf("expected %d inactivity scores, got %d", len(wantKeys), len(resp.InactivityScores))
+	}
+
+	// The gRPC service computes the same performance.
+	grpcResp, err := (&beacon.Server{CoreService: coreService(st, false)}).GetValidatorPerformance(context.Background(), &ethpb.ValidatorPerformanceRequest{
+		PublicKeys: [][]byte{testPubkey(2), unknown},
+		Indices:    []types.ValidatorIndex{3, 1, 0, 4, 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	converted := &ValidatorPerformanceResponse{
+		PublicKeys:                    encodeBytes(grpcResp.PublicKeys),
+		CorrectlyVotedSource:          grpcResp.CorrectlyVotedSource,
+		CorrectlyVotedTarget:          grpcResp.CorrectlyVotedTarget,
+		CorrectlyVotedHead:            grpcResp.CorrectlyVotedHead,
+		CurrentEffectiveBalances:      formatUints(grpcResp.CurrentEffectiveBalances),
+		BalancesBeforeEpochTransition: formatUints(grpcResp.BalancesBeforeEpochTransition),
+		BalancesAfterEpochTransition:  formatUints(grpcResp.BalancesAfterEpochTransition),
+		MissingValidators:             encodeBytes(grpcResp.MissingValidators),
+		InactivityScores:              formatUints(grpcResp.InactivityScores),
+	}
+	if !reflect.DeepEqual(converted, resp) {
+		t.Errorf("gRPC and HTTP responses differ:\n%+v\n%+v", converted, resp)
+	}
+}
+
+func TestGetValidatorPerformance_Errors(t *testing.T) {
+	helpers.ClearCache()
+	st := performanceState(t)
+
+	tests := []struct {
+		name    string
+		syncing bool
+		body    string
+		code    int
+		message string
+	}{
+		{"syncing", true, `{}`, http.StatusServiceUnavailable, "syncing to latest head"},
+		{"malformed body", false, `[]`, http.StatusBadRequest, "Could not decode request body"},
+		{"invalid public key", false, `{"public_keys":["0xzz"]}`, http.StatusBadRequest, "Invalid public key 0xzz"},
+		{"invalid index", false, `{"indices":["foo"]}`, http.StatusBadRequest, "Invalid validator index foo"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			writer := httptest.NewRecorder()
+			(&Server{CoreService: coreService(st, tt.syncing)}).GetValidatorPerformance(writer, performanceRequest(tt.body))
+			if writer.Code != tt.code {
+				t.Fatalf("unexpected status code %d: %s", writer.Code, writer.Body.String())
+			}
+			e := &network.DefaultErrorJson{}
+			if err := json.Unmarshal(writer.Body.Bytes(), e); err != nil {
+				t.Fatal(err)
+			}
+			if e.Code != tt.code || !strings.Contains(e.Message, tt.message) {
+				t.Errorf("unexpected error %d %q, want %d containing %q", e.Code, e.Message, tt.code, tt.message)
+			}
+		})
+	}
+
+	// The gRPC service reports a syncing node with the matching status code.
+	_, err := (&beacon.Server{CoreService: coreService(st, true)}).GetValidatorPerformance(context.Background(), &ethpb.ValidatorPerformanceRequest{})
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("unexpected gRPC error %v", err)
+	}
+}
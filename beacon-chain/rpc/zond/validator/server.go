@@ -0,0 +1,10 @@
+// Package validator defines HTTP handlers for the zond specific validator endpoints
+// of the beacon node API.
+package validator
+
+import "github.com/theQRL/zond/beacon-chain/rpc/core"
+
+// Server defines a server implementation of the zond specific validator endpoints.
+type Server struct {
+	CoreService *core.Service
+}
@@ -0,0 +1,18 @@
+package validator
+
+type ValidatorPerformanceRequest struct {
+	PublicKeys []string `json:"public_keys"`
+	Indices    []string `json:"indices"`
+}
+
+type ValidatorPerformanceResponse struct {
+	PublicKeys                    []string `json:"public_keys"`
+	CorrectlyVotedSource          []bool   `json:"correctly_voted_source"`
+	CorrectlyVotedTarget          []bool   `json:"correctly_voted_target"`
+	CorrectlyVotedHead            []bool   `json:"correctly_voted_head"`
+	CurrentEffectiveBalances      []string `json:"current_effective_balances"`
+	BalancesBeforeEpochTransition []string `json:"balances_before_epoch_transition"`
+	BalancesAfterEpochTransition  []string `json:"balances_after_epoch_transition"`
+	MissingValidators             []string `json:"missing_validators"`
+	InactivityScores              []string `json:"inactivity_scores"`
+}
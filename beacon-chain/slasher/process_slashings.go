@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/theQRL/zond/beacon-chain/core/blocks"
+	"github.com/theQRL/zond/beacon-chain/core/feed"
+	opfeed "github.com/theQRL/zond/beacon-chain/core/feed/operation"
 	"github.com/theQRL/zond/beacon-chain/state"
 	"github.com/theQRL/zond/encoding/bytesutil"
 	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
@@ -40,7 +42,14 @@ func (s *Service) processAttesterSlashings(ctx context.Context, slashings []*eth
 			ctx, beaconState, sl,
 		); err != nil {
 			log.WithError(err).Error("Could not insert attester slashing into operations pool")
+			continue
 		}
+		s.serviceCfg.OperationNotifier.OperationFeed().Send(&feed.Event{
+			Type: opfeed.AttesterSlashingReceived,
+			Data: &opfeed.AttesterSlashingReceivedData{
+				AttesterSlashing: sl,
+			},
+		})
 	}
 	return nil
 }
@@ -73,7 +82,14 @@ func (s *Service) processProposerSlashings(ctx context.Context, slashings []*eth
 		logProposerSlashing(sl)
 		if err := s.serviceCfg.SlashingPoolInserter.InsertProposerSlashing(ctx, beaconState, sl); err != nil {
 			log.WithError(err).Error("Could not insert attester slashing into operations pool")
+			continue
 		}
+		s.serviceCfg.OperationNotifier.OperationFeed().Send(&feed.Event{
+			Type: opfeed.ProposerSlashingReceived,
+			Data: &opfeed.ProposerSlashingReceivedData{
+				ProposerSlashing: sl,
+			},
+		})
 	}
 	return nil
 }
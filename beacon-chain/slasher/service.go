@@ -11,6 +11,7 @@ import (
 	"github.com/theQRL/zond/async/event"
 	"github.com/theQRL/zond/beacon-chain/blockchain"
 	"github.com/theQRL/zond/beacon-chain/core/feed"
+	opfeed "github.com/theQRL/zond/beacon-chain/core/feed/operation"
 	statefeed "github.com/theQRL/zond/beacon-chain/core/feed/state"
 	"github.com/theQRL/zond/beacon-chain/db"
 	"github.com/theQRL/zond/beacon-chain/operations/slashings"
@@ -34,6 +35,7 @@ type ServiceConfig struct {
 	BeaconBlockHeadersFeed  *event.Feed
 	Database                db.SlasherDatabase
 	StateNotifier           statefeed.Notifier
+	OperationNotifier       opfeed.Notifier
 	AttestationStateFetcher blockchain.AttestationStateFetcher
 	StateGen                stategen.StateManager
 	SlashingPoolInserter    slashings.PoolInserter
@@ -0,0 +1,57 @@
+package backfill
+
+import (
+	"github.com/theQRL/zond/beacon-chain/p2p"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+)
+
+// ServiceOption is a functional option for the backfill Service.
+type ServiceOption func(s *Service) error
+
+// WithDatabase sets the database the backfilled blocks are written to.
+func WithDatabase(db Database) ServiceOption {
+	return func(s *Service) error {
+		s.cfg.db = db
+		return nil
+	}
+}
+
+// WithP2P sets the p2p service used to select peers.
+func WithP2P(p p2p.PeersProvider) ServiceOption {
+	return func(s *Service) error {
+		s.cfg.p2p = p
+		return nil
+	}
+}
+
+// WithBlocksByRangeRequester sets the function used to request blocks from a peer.
+func WithBlocksByRangeRequester(r BlocksByRangeRequester) ServiceOption {
+	return func(s *Service) error {
+		s.cfg.requester = r
+		return nil
+	}
+}
+
+// WithSyncChecker delays backfilling until the node is synced with the head of the chain.
+func WithSyncChecker(c SyncChecker) ServiceOption {
+	return func(s *Service) error {
+		s.cfg.syncChecker = c
+		return nil
+	}
+}
+
+// WithBatchSize sets the number of slots requested from a peer at once.
+func WithBatchSize(n uint64) ServiceOption {
+	return func(s *Service) error {
+		s.cfg.batchSize = n
+		return nil
+	}
+}
+
+// WithOldestSlot bounds how far back blocks are backfilled.
+func WithOldestSlot(slot types.Slot) ServiceOption {
+	return func(s *Service) error {
+		s.cfg.oldestSlot = slot
+		return nil
+	}
+}
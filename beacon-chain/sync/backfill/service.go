@@ -0,0 +1,328 @@
+package backfill
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/theQRL/zond/beacon-chain/p2p"
+	"github.com/theQRL/zond/config/params"
+	"github.com/theQRL/zond/consensus-types/interfaces"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	"github.com/theQRL/zond/crypto/rand"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+	"github.com/theQRL/zond/time/slots"
+)
+
+const (
+	// defaultBatchSize is the number of slots requested from a peer at once.
+	defaultBatchSize = 64
+	// retryInterval is how long the service waits before retrying when no peer is available
+	// or the node is still syncing.
+	retryInterval = 6 * time.Second
+	// minRetryBackoff is how long the service waits after a first failed batch before requesting
+	// another one. The wait doubles with every consecutive failure, up to maxRetryBackoff.
+	minRetryBackoff = time.Second
+	maxRetryBackoff = time.Minute
+)
+
+// ErrChainBroken is returned when a batch of blocks does not link to the lowest backfilled block.
+var ErrChainBroken = errors.New("backfill batch does not link to the lowest backfilled block")
+
+// BlocksByRangeRequester sends a BeaconBlocksByRange request to the given peer, returning the blocks of the
+// response in increasing slot order.
+type BlocksByRangeRequester func(ctx context.Context, pid peer.ID, req *ethpb.BeaconBlocksByRangeRequest) ([]interfaces.SignedBeaconBlock, error)
+
+// SyncChecker reports whether the node has caught up with the head of the chain.
+type SyncChecker interface {
+	Synced() bool
+}
+
+// Database describes the set of DB methods that the backfill Service needs to function.
+type Database interface {
+	BackfillDB
+	SaveBlocks(ctx context.Context, blocks []interfaces.SignedBeaconBlock) error
+}
+
+type config struct {
+	db          Database
+	p2p         p2p.PeersProvider
+	requester   BlocksByRangeRequester
+	syncChecker SyncChecker
+	batchSize   uint64
+	oldestSlot  types.Slot
+}
+
+// Service downloads the blocks missing between genesis and the origin checkpoint of a node which was
+// initialized via checkpoint sync. Blocks are requested from peers in batches, walking backwards from
+// the origin block. Every batch is verified to form a chain of parent roots ending at the lowest block
+// already in the database before being saved, so the history inherits the trust placed in the origin
+// checkpoint without replaying state transitions.
+type Service struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	cfg    *config
+	status *Status
+	// parentRoot is the parent root of the lowest backfilled block, which the next batch needs to end with.
+	parentRoot [32]byte
+	// cursor is the slot below which the next batch is requested.
+	cursor types.Slot
+	// backoff is how long to wait before the next batch after a failed one.
+	backoff time.Duration
+	errLock sync.RWMutex
+	err     error
+}
+
+// NewService initializes the backfill service with the given options.
+func NewService(ctx context.Context, status *Status, opts ...ServiceOption) (*Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg:    &config{batchSize: defaultBatchSize},
+		status: status,
+	}
+	for _, opt := range opts {
+		if err := opt(s); err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	if s.cfg.batchSize == 0 {
+		cancel()
+		return nil, errors.New("backfill batch size must be greater than zero")
+	}
+	if s.cfg.batchSize > params.BeaconNetworkConfig().MaxRequestBlocks {
+		s.cfg.batchSize = params.BeaconNetworkConfig().MaxRequestBlocks
+	}
+	return s, nil
+}
+
+// Start the backfill routine if the node was initialized via checkpoint sync.
+func (s *Service) Start() {
+	if s.status.GenesisSync() {
+		log.Debug("Node was synced from genesis, nothing to backfill")
+		return
+	}
+	go s.run()
+}
+
+// Stop the backfill routine.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the backfill service.
+func (s *Service) Status() error {
+	s.errLock.RLock()
+	defer s.errLock.RUnlock()
+	return s.err
+}
+
+func (s *Service) setErr(err error) {
+	s.errLock.Lock()
+	defer s.errLock.Unlock()
+	s.err = err
+}
+
+func (s *Service) run() {
+	if err := s.resetCursor(); err != nil {
+		log.WithError(err).Error("Could not initialize backfill")
+		s.setErr(err)
+		return
+	}
+	for !s.done() {
+		select {
+		case <-s.ctx.Done():
+			return
+		default:
+		}
+		finished, err := s.closeGap()
+		if err != nil {
+			log.WithError(err).Error("Could not complete backfill")
+			s.setErr(err)
+			return
+		}
+		if finished {
+			break
+		}
+		if s.cfg.syncChecker != nil && !s.cfg.syncChecker.Synced() {
+			s.wait(retryInterval)
+			continue
+		}
+		pid, ok := s.pickPeer()
+		if !ok {
+			log.Debug("No peers available to backfill from")
+			s.wait(retryInterval)
+			continue
+		}
+		if err := s.backfillBatch(pid); err != nil {
+			s.backoff = nextBackoff(s.backoff)
+			log.WithError(err).WithFields(map[string]interface{}{
+				"peer":    pid,
+				"backoff": s.backoff,
+			}).Debug("Could not backfill batch")
+			if errors.Is(err, ErrChainBroken) {
+				s.cfg.p2p.Peers().Scorers().BadResponsesScorer().Increment(pid)
+				if err := s.resetCursor(); err != nil {
+					log.WithError(err).Error("Could not reset backfill position")
+					s.setErr(err)
+					return
+				}
+			}
+			s.wait(s.backoff)
+			continue
+		}
+		s.backoff = 0
+	}
+	log.WithField("slot", s.status.EndGap()).Info("Backfill complete")
+}
+
+// done returns true once the blocks down to the oldest slot have been backfilled.
+func (s *Service) done() bool {
+	return s.status.EndGap() <= s.lowestSlot()
+}
+
+// closeGap returns true once there is nothing left to request from peers. This happens when the lowest
+// backfilled block is the child of the genesis block, or when every slot down to the lowest slot has been
+// requested because the blocks right above it were skipped slots.
+func (s *Service) closeGap() (bool, error) {
+	genesisRoot, err := s.cfg.db.GenesisBlockRoot(s.ctx)
+	if err != nil {
+		return false, err
+	}
+	if s.parentRoot == genesisRoot {
+		// The genesis block is already in the database, the gap is closed.
+		return true, s.status.Advance(s.ctx, s.status.StartGap(), genesisRoot)
+	}
+	if s.cursor > s.lowestSlot() {
+		return false, nil
+	}
+	if s.cfg.oldestSlot > s.status.StartGap() {
+		return true, nil
+	}
+	return true, errors.Errorf("backfill reached slot %d without linking to the genesis block %#x", s.cursor, genesisRoot)
+}
+
+// lowestSlot is the slot backfill stops at, bounded by genesis and the configured oldest slot.
+func (s *Service) lowestSlot() types.Slot {
+	if s.cfg.oldestSlot > s.status.StartGap() {
+		return s.cfg.oldestSlot
+	}
+	return s.status.StartGap()
+}
+
+// resetCursor positions the next request right below the lowest backfilled block.
+func (s *Service) resetCursor() error {
+	root, err := s.cfg.db.BackfillBlockRoot(s.ctx)
+	if err != nil {
+		return err
+	}
+	blk, err := s.cfg.db.Block(s.ctx, root)
+	if err != nil {
+		return err
+	}
+	if blk == nil || blk.IsNil() {
+		return errors.Errorf("no block found for backfill root %#x", root)
+	}
+	s.parentRoot = blk.Block().ParentRoot()
+	s.cursor = blk.Block().Slot()
+	return nil
+}
+
+func (s *Service) backfillBatch(pid peer.ID) error {
+	start := s.lowestSlot()
+	if s.cursor > start+types.Slot(s.cfg.batchSize) {
+		start = s.cursor - types.Slot(s.cfg.batchSize)
+	}
+	req := &ethpb.BeaconBlocksByRangeRequest{
+		StartSlot: start,
+		Count:     uint64(s.cursor - start),
+		Step:      1,
+	}
+	blks, err := s.cfg.requester(s.ctx, pid, req)
+	if err != nil {
+		return err
+	}
+	chain, err := verifyBatch(blks, s.parentRoot)
+	if err != nil {
+		return err
+	}
+	s.cursor = start
+	if len(chain) == 0 {
+		// The peer reported the whole range as skipped slots. If it lied, the next batch
+		// will not link up and the cursor is reset.
+		return nil
+	}
+
+	if err := s.cfg.db.SaveBlocks(s.ctx, chain); err != nil {
+		return errors.Wrap(err, "could not save backfilled blocks")
+	}
+	lowest := chain[0]
+	lowestRoot, err := lowest.Block().HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	if err := s.status.Advance(s.ctx, lowest.Block().Slot(), lowestRoot); err != nil {
+		return err
+	}
+	s.parentRoot = lowest.Block().ParentRoot()
+	log.WithFields(map[string]interface{}{
+		"slot":      lowest.Block().Slot(),
+		"remaining": s.status.EndGap() - s.lowestSlot(),
+	}).Debug("Backfilled batch of blocks")
+	return nil
+}
+
+// verifyBatch checks that the given blocks, in increasing slot order, form a chain ending with the block
+// whose root is parentRoot. The blocks of that chain are returned in increasing slot order.
+func verifyBatch(blks []interfaces.SignedBeaconBlock, parentRoot [32]byte) ([]interfaces.SignedBeaconBlock, error) {
+	expected := parentRoot
+	for i := len(blks) - 1; i >= 0; i-- {
+		if blks[i] == nil || blks[i].IsNil() {
+			return nil, errors.New("nil block in backfill batch")
+		}
+		root, err := blks[i].Block().HashTreeRoot()
+		if err != nil {
+			return nil, err
+		}
+		if root != expected {
+			return nil, errors.Wrapf(ErrChainBroken, "block at slot %d has root %#x, expected %#x", blks[i].Block().Slot(), root, expected)
+		}
+		if i > 0 && blks[i-1].Block().Slot() >= blks[i].Block().Slot() {
+			return nil, errors.New("backfill batch is not in increasing slot order")
+		}
+		expected = blks[i].Block().ParentRoot()
+	}
+	return blks, nil
+}
+
+// pickPeer returns a random peer among the ones whose finalized checkpoint covers the range to backfill.
+func (s *Service) pickPeer() (peer.ID, bool) {
+	_, pids := s.cfg.p2p.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, slots.ToEpoch(s.status.EndGap()))
+	if len(pids) == 0 {
+		return "", false
+	}
+	return pids[rand.NewGenerator().Intn(len(pids))], true
+}
+
+func (s *Service) wait(d time.Duration) {
+	select {
+	case <-s.ctx.Done():
+	case <-time.After(d):
+	}
+}
+
+// nextBackoff doubles the given backoff, starting at minRetryBackoff and capped at maxRetryBackoff.
+func nextBackoff(d time.Duration) time.Duration {
+	if d < minRetryBackoff {
+		return minRetryBackoff
+	}
+	if d >= maxRetryBackoff/2 {
+		return maxRetryBackoff
+	}
+	return 2 * d
+}
@@ -0,0 +1,270 @@
+package backfill
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/theQRL/zond/beacon-chain/p2p/peers"
+	"github.com/theQRL/zond/beacon-chain/p2p/peers/scorers"
+	"github.com/theQRL/zond/consensus-types/blocks"
+	"github.com/theQRL/zond/consensus-types/interfaces"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+)
+
+func testBlock(t *testing.T, slot types.Slot, parentRoot [32]byte) (interfaces.SignedBeaconBlock, [32]byte) {
+	blk, err := blocks.NewSignedBeaconBlock(&ethpb.SignedBeaconBlock{
+		Block: &ethpb.BeaconBlock{
+			Slot:       slot,
+			ParentRoot: parentRoot[:],
+			StateRoot:  make([]byte, 32),
+			Body: &ethpb.BeaconBlockBody{
+				RandaoReveal: make([]byte, 96),
+				Eth1Data: &ethpb.Eth1Data{
+					DepositRoot: make([]byte, 32),
+					BlockHash:   make([]byte, 32),
+				},
+				Graffiti: make([]byte, 32),
+			},
+		},
+		Signature: make([]byte, 96),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return blk, root
+}
+
+func TestVerifyBatch(t *testing.T) {
+	b1, r1 := testBlock(t, 1, [32]byte{'g'})
+	b2, r2 := testBlock(t, 2, r1)
+	// slot 3 is skipped
+	b4, r4 := testBlock(t, 4, r2)
+	fork, _ := testBlock(t, 3, r1)
+
+	chain, err := verifyBatch([]interfaces.SignedBeaconBlock{b1, b2, b4}, r4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(chain) != 3 {
+		t.Errorf("expected 3 blocks, got %d", len(chain))
+	}
+
+	chain, err = verifyBatch(nil, r4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(chain) != 0 {
+		t.Errorf("expected an empty chain, got %d blocks", len(chain))
+	}
+
+	if _, err := verifyBatch([]interfaces.SignedBeaconBlock{b1, b2, fork}, r4); !errors.Is(err, ErrChainBroken) {
+		t.Errorf("expected %v, got %v", ErrChainBroken, err)
+	}
+	if _, err := verifyBatch([]interfaces.SignedBeaconBlock{b1, b2}, r4); !errors.Is(err, ErrChainBroken) {
+		t.Errorf("expected %v, got %v", ErrChainBroken, err)
+	}
+}
+
+func TestNextBackoff(t *testing.T) {
+	var d time.Duration
+	for _, want := range []time.Duration{
+		minRetryBackoff, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 32 * time.Second,
+		maxRetryBackoff, maxRetryBackoff,
+	} {
+		d = nextBackoff(d)
+		if d != want {
+			t.Fatalf("expected backoff %v, got %v", want, d)
+		}
+	}
+}
+
+// testPeers provides the status of a single connected peer, which has finalized the whole chain.
+type testPeers struct {
+	status *peers.Status
+}
+
+func (p *testPeers) Peers() *peers.Status {
+	return p.status
+}
+
+const testPeer = peer.ID("backfill-peer")
+
+func newTestPeers() *testPeers {
+	status := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{Threshold: 5},
+		},
+	})
+	status.Add(nil, testPeer, nil, network.DirOutbound)
+	status.SetConnectionState(testPeer, peers.PeerConnected)
+	status.SetChainState(testPeer, &ethpb.Status{FinalizedEpoch: 10, HeadSlot: 320})
+	return &testPeers{status: status}
+}
+
+// chainRequester serves the blocks of the given chain, recording the requests it receives.
+type chainRequester struct {
+	lock     sync.Mutex
+	blks     []interfaces.SignedBeaconBlock
+	requests []*ethpb.BeaconBlocksByRangeRequest
+	// corrupt replaces the response to the first request with a block that does not link up.
+	corrupt interfaces.SignedBeaconBlock
+}
+
+func (r *chainRequester) request(_ context.Context, _ peer.ID, req *ethpb.BeaconBlocksByRangeRequest) ([]interfaces.SignedBeaconBlock, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.requests = append(r.requests, req)
+	if r.corrupt != nil {
+		corrupt := r.corrupt
+		r.corrupt = nil
+		return []interfaces.SignedBeaconBlock{corrupt}, nil
+	}
+	var resp []interfaces.SignedBeaconBlock
+	for sl := req.StartSlot; sl < req.StartSlot+types.Slot(req.Count) && int(sl) < len(r.blks); sl++ {
+		if r.blks[sl] != nil {
+			resp = append(resp, r.blks[sl])
+		}
+	}
+	return resp, nil
+}
+
+func newTestService(t *testing.T, d *mockDB, r *chainRequester, opts ...ServiceOption) *Service {
+	status := NewStatus(d)
+	if err := status.Reload(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	opts = append([]ServiceOption{
+		WithDatabase(d),
+		WithP2P(newTestPeers()),
+		WithBlocksByRangeRequester(r.request),
+		WithBatchSize(4),
+	}, opts...)
+	s, err := NewService(context.Background(), status, opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := s.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	return s
+}
+
+func TestService_BackfillsToGenesis(t *testing.T) {
+	blks, roots := testChain(t, 20, 7)
+	d := checkpointDB(t, blks, roots, 20)
+	r := &chainRequester{blks: blks}
+	s := newTestService(t, d, r)
+
+	s.run()
+
+	if err := s.Status(); err != nil {
+		t.Fatal(err)
+	}
+	if s.status.StartGap() != 0 || s.status.EndGap() != 0 {
+		t.Errorf("expected the gap to be closed, got %d to %d", s.status.StartGap(), s.status.EndGap())
+	}
+	for sl, blk := range blks {
+		if blk != nil && !d.HasBlock(context.Background(), roots[sl]) {
+			t.Errorf("block at slot %d was not backfilled", sl)
+		}
+		if !s.status.SlotCovered(types.Slot(sl)) {
+			t.Errorf("slot %d is not covered", sl)
+		}
+	}
+	// Batches walk down from the origin block in steps of the batch size.
+	var starts []types.Slot
+	for _, req := range r.requests {
+		starts = append(starts, req.StartSlot)
+	}
+	if want := []types.Slot{16, 12, 8, 4, 0}; !equalSlots(starts, want) {
+		t.Errorf("expected requests starting at %v, got %v", want, starts)
+	}
+}
+
+func TestService_StopsAtOldestSlot(t *testing.T) {
+	blks, roots := testChain(t, 20)
+	d := checkpointDB(t, blks, roots, 20)
+	r := &chainRequester{blks: blks}
+	s := newTestService(t, d, r, WithOldestSlot(12))
+
+	s.run()
+
+	if err := s.Status(); err != nil {
+		t.Fatal(err)
+	}
+	if s.status.EndGap() != 12 {
+		t.Errorf("expected backfill to stop at slot 12, got %d", s.status.EndGap())
+	}
+	for _, req := range r.requests {
+		if req.StartSlot < 12 {
+			t.Errorf("requested blocks from slot %d, below the oldest slot", req.StartSlot)
+		}
+	}
+	if d.HasBlock(context.Background(), roots[11]) {
+		t.Error("backfilled a block below the oldest slot")
+	}
+}
+
+func TestService_BrokenBatch(t *testing.T) {
+	blks, roots := testChain(t, 8)
+	d := checkpointDB(t, blks, roots, 8)
+	fork, _ := testBlock(t, 7, [32]byte{'f'})
+	r := &chainRequester{blks: blks, corrupt: fork}
+	s := newTestService(t, d, r)
+
+	s.run()
+
+	if err := s.Status(); err != nil {
+		t.Fatal(err)
+	}
+	if s.status.EndGap() != 0 {
+		t.Errorf("expected the gap to be closed, got %d", s.status.EndGap())
+	}
+	// The broken batch is requested again from the same position.
+	if len(r.requests) < 2 || r.requests[0].StartSlot != r.requests[1].StartSlot {
+		t.Errorf("expected the broken batch to be retried, got %v", r.requests)
+	}
+	count, err := s.cfg.p2p.Peers().Scorers().BadResponsesScorer().Count(testPeer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("expected 1 bad response for the peer, got %d", count)
+	}
+}
+
+func TestService_GenesisSync(t *testing.T) {
+	r := &chainRequester{}
+	s := newTestService(t, newMockDB(), r)
+
+	s.Start()
+
+	if len(r.requests) != 0 {
+		t.Errorf("expected no requests for a genesis synced node, got %d", len(r.requests))
+	}
+}
+
+func equalSlots(a, b []types.Slot) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
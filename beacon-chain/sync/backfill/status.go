@@ -2,6 +2,7 @@ package backfill
 
 import (
 	"context"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/theQRL/zond/beacon-chain/db"
@@ -19,10 +20,13 @@ func NewStatus(store BackfillDB) *Status {
 
 // Status provides a way to update and query the status of a backfill process that may be necessary to track when
 // a node was initialized via checkpoint sync. With checkpoint sync, there will be a gap in node history from genesis
-// until the checkpoint sync origin block. Status provides the means to update the value keeping track of the lower
-// end of the missing block range via the Advance() method, to check whether a Slot is missing from the database
-// via the SlotCovered() method, and to see the current StartGap() and EndGap().
+// until the checkpoint sync origin block. Blocks are backfilled downwards from the origin block, so Status provides
+// the means to update the value keeping track of the upper end of the missing block range via the Advance() method,
+// to check whether a Slot is missing from the database via the SlotCovered() method, and to see the current
+// StartGap() and EndGap(). Status is safe for concurrent use, as the backfill service advances it while other
+// services query it.
 type Status struct {
+	lock        sync.RWMutex
 	start       types.Slot
 	end         types.Slot
 	store       BackfillDB
@@ -33,11 +37,13 @@ type Status struct {
 // If the slot is <= StartGap(), or >= EndGap(), the result is true.
 // If the slot is between StartGap() and EndGap(), the result is false.
 func (s *Status) SlotCovered(sl types.Slot) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
 	// short circuit if the node was synced from genesis
 	if s.genesisSync {
 		return true
 	}
-	if s.StartGap() < sl && sl < s.EndGap() {
+	if s.start < sl && sl < s.end {
 		return false
 	}
 	return true
@@ -45,29 +51,48 @@ func (s *Status) SlotCovered(sl types.Slot) bool {
 
 // StartGap returns the slot at the beginning of the range that needs to be backfilled.
 func (s *Status) StartGap() types.Slot {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
 	return s.start
 }
 
-// EndGap returns the slot at the end of the range that needs to be backfilled.
+// EndGap returns the slot at the end of the range that needs to be backfilled. This is the slot of the lowest
+// block that has been backfilled so far, or the origin checkpoint slot before backfill has started.
 func (s *Status) EndGap() types.Slot {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
 	return s.end
 }
 
+// GenesisSync returns true if the node was synced from genesis, in which case there is nothing to backfill.
+func (s *Status) GenesisSync() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.genesisSync
+}
+
 var ErrAdvancePastOrigin = errors.New("cannot advance backfill Status beyond the origin checkpoint slot")
 
-// Advance advances the backfill position to the given slot & root.
+// Advance advances the backfill position down to the given slot & root.
 // It updates the backfill block root entry in the database,
 // and also updates the Status value's copy of the backfill position slot.
-func (s *Status) Advance(ctx context.Context, upTo types.Slot, root [32]byte) error {
-	if upTo > s.end {
-		return errors.Wrapf(ErrAdvancePastOrigin, "advance slot=%d, origin slot=%d", upTo, s.end)
+func (s *Status) Advance(ctx context.Context, downTo types.Slot, root [32]byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if downTo > s.end {
+		return errors.Wrapf(ErrAdvancePastOrigin, "advance slot=%d, backfill slot=%d", downTo, s.end)
+	}
+	if err := s.store.SaveBackfillBlockRoot(ctx, root); err != nil {
+		return err
 	}
-	s.start = upTo
-	return s.store.SaveBackfillBlockRoot(ctx, root)
+	s.end = downTo
+	return nil
 }
 
 // Reload queries the database for backfill status, initializing the internal data and validating the database state.
 func (s *Status) Reload(ctx context.Context) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
 	cpRoot, err := s.store.OriginCheckpointBlockRoot(ctx)
 	if err != nil {
 		// mark genesis sync and short circuit further lookups
@@ -84,15 +109,21 @@ func (s *Status) Reload(ctx context.Context) error {
 	if err := blocks.BeaconBlockIsNil(cpBlock); err != nil {
 		return err
 	}
-	s.end = cpBlock.Block().Slot()
-
-	_, err = s.store.GenesisBlockRoot(ctx)
+	genesisRoot, err := s.store.GenesisBlockRoot(ctx)
 	if err != nil {
 		if errors.Is(err, db.ErrNotFoundGenesisBlockRoot) {
 			return errors.Wrap(err, "genesis block root required for checkpoint sync")
 		}
 		return err
 	}
+	genesisBlock, err := s.store.Block(ctx, genesisRoot)
+	if err != nil {
+		return errors.Wrapf(err, "error retrieving block for genesis root=%#x", genesisRoot)
+	}
+	if err := blocks.BeaconBlockIsNil(genesisBlock); err != nil {
+		return err
+	}
+	s.start = genesisBlock.Block().Slot()
 
 	bfRoot, err := s.store.BackfillBlockRoot(ctx)
 	if err != nil {
@@ -101,6 +132,14 @@ func (s *Status) Reload(ctx context.Context) error {
 		}
 		return err
 	}
+	// Databases initialized before backfill was implemented point the backfill block root at genesis even though
+	// nothing below the origin block was downloaded. Restart the backfill from the origin block in that case.
+	if bfRoot == genesisRoot && bfRoot != cpRoot && !s.store.HasBlock(ctx, cpBlock.Block().ParentRoot()) {
+		if err := s.store.SaveBackfillBlockRoot(ctx, cpRoot); err != nil {
+			return err
+		}
+		bfRoot = cpRoot
+	}
 	bfBlock, err := s.store.Block(ctx, bfRoot)
 	if err != nil {
 		return errors.Wrapf(err, "error retrieving block for backfill root=%#x", bfRoot)
@@ -108,7 +147,7 @@ func (s *Status) Reload(ctx context.Context) error {
 	if err := blocks.BeaconBlockIsNil(bfBlock); err != nil {
 		return err
 	}
-	s.start = bfBlock.Block().Slot()
+	s.end = bfBlock.Block().Slot()
 	return nil
 }
 
@@ -119,4 +158,5 @@ type BackfillDB interface {
 	OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error)
 	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
 	Block(ctx context.Context, blockRoot [32]byte) (interfaces.SignedBeaconBlock, error)
+	HasBlock(ctx context.Context, blockRoot [32]byte) bool
 }
@@ -0,0 +1,247 @@
+package backfill
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/theQRL/zond/beacon-chain/db"
+	"github.com/theQRL/zond/consensus-types/interfaces"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+)
+
+// mockDB is an in-memory Database holding the blocks and the roots tracked by backfill.
+type mockDB struct {
+	lock         sync.Mutex
+	blocks       map[[32]byte]interfaces.SignedBeaconBlock
+	genesisRoot  [32]byte
+	originRoot   [32]byte
+	backfillRoot [32]byte
+	hasOrigin    bool
+}
+
+func newMockDB() *mockDB {
+	return &mockDB{blocks: make(map[[32]byte]interfaces.SignedBeaconBlock)}
+}
+
+func (d *mockDB) SaveBackfillBlockRoot(_ context.Context, blockRoot [32]byte) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.backfillRoot = blockRoot
+	return nil
+}
+
+func (d *mockDB) GenesisBlockRoot(_ context.Context) ([32]byte, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.genesisRoot == [32]byte{} {
+		return [32]byte{}, db.ErrNotFoundGenesisBlockRoot
+	}
+	return d.genesisRoot, nil
+}
+
+func (d *mockDB) OriginCheckpointBlockRoot(_ context.Context) ([32]byte, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if !d.hasOrigin {
+		return [32]byte{}, db.ErrNotFoundOriginBlockRoot
+	}
+	return d.originRoot, nil
+}
+
+func (d *mockDB) BackfillBlockRoot(_ context.Context) ([32]byte, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.backfillRoot == [32]byte{} {
+		return [32]byte{}, db.ErrNotFoundBackfillBlockRoot
+	}
+	return d.backfillRoot, nil
+}
+
+func (d *mockDB) Block(_ context.Context, blockRoot [32]byte) (interfaces.SignedBeaconBlock, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.blocks[blockRoot], nil
+}
+
+func (d *mockDB) HasBlock(_ context.Context, blockRoot [32]byte) bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	_, ok := d.blocks[blockRoot]
+	return ok
+}
+
+func (d *mockDB) SaveBlocks(_ context.Context, blks []interfaces.SignedBeaconBlock) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	for _, blk := range blks {
+		root, err := blk.Block().HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		d.blocks[root] = blk
+	}
+	return nil
+}
+
+func (d *mockDB) saveBlock(t *testing.T, blk interfaces.SignedBeaconBlock) {
+	if err := d.SaveBlocks(context.Background(), []interfaces.SignedBeaconBlock{blk}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// testChain builds a chain of blocks from a genesis block at slot 0 up to the given slot, leaving out the
+// skipped slots. The blocks are returned by slot, with nil entries for the skipped slots.
+func testChain(t *testing.T, head types.Slot, skipped ...types.Slot) ([]interfaces.SignedBeaconBlock, [][32]byte) {
+	isSkipped := make(map[types.Slot]bool)
+	for _, sl := range skipped {
+		isSkipped[sl] = true
+	}
+	blks := make([]interfaces.SignedBeaconBlock, head+1)
+	roots := make([][32]byte, head+1)
+	var parent [32]byte
+	for sl := types.Slot(0); sl <= head; sl++ {
+		if isSkipped[sl] {
+			continue
+		}
+		blks[sl], roots[sl] = testBlock(t, sl, parent)
+		parent = roots[sl]
+	}
+	return blks, roots
+}
+
+// checkpointDB returns a database of a node that was checkpoint synced from the block at the origin slot,
+// holding only the genesis block and the origin block.
+func checkpointDB(t *testing.T, blks []interfaces.SignedBeaconBlock, roots [][32]byte, origin types.Slot) *mockDB {
+	d := newMockDB()
+	d.saveBlock(t, blks[0])
+	d.saveBlock(t, blks[origin])
+	d.genesisRoot = roots[0]
+	d.originRoot = roots[origin]
+	d.backfillRoot = roots[origin]
+	d.hasOrigin = true
+	return d
+}
+
+func TestStatus_GenesisSync(t *testing.T) {
+	s := NewStatus(newMockDB())
+	if err := s.Reload(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if !s.GenesisSync() {
+		t.Fatal("expected a genesis synced node")
+	}
+	for _, sl := range []types.Slot{0, 1, 100} {
+		if !s.SlotCovered(sl) {
+			t.Errorf("slot %d is not covered", sl)
+		}
+	}
+}
+
+func TestStatus_Gap(t *testing.T) {
+	ctx := context.Background()
+	blks, roots := testChain(t, 20)
+	s := NewStatus(checkpointDB(t, blks, roots, 20))
+	if err := s.Reload(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// The gap runs from the genesis block up to the origin block, which is where backfill starts.
+	if s.GenesisSync() {
+		t.Fatal("expected a checkpoint synced node")
+	}
+	if s.StartGap() != 0 || s.EndGap() != 20 {
+		t.Fatalf("expected a gap from 0 to 20, got %d to %d", s.StartGap(), s.EndGap())
+	}
+	covered := map[types.Slot]bool{0: true, 1: false, 10: false, 19: false, 20: true, 21: true}
+	for sl, want := range covered {
+		if s.SlotCovered(sl) != want {
+			t.Errorf("slot %d: expected covered=%v", sl, want)
+		}
+	}
+
+	// Advancing moves the end of the gap down to the lowest backfilled block.
+	if err := s.Advance(ctx, 10, roots[10]); err != nil {
+		t.Fatal(err)
+	}
+	if s.StartGap() != 0 || s.EndGap() != 10 {
+		t.Fatalf("expected a gap from 0 to 10, got %d to %d", s.StartGap(), s.EndGap())
+	}
+	if !s.SlotCovered(15) || s.SlotCovered(9) {
+		t.Error("unexpected coverage after advancing")
+	}
+	bfRoot, err := s.store.BackfillBlockRoot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bfRoot != roots[10] {
+		t.Errorf("expected the backfill root to be saved, got %#x", bfRoot)
+	}
+
+	if err := s.Advance(ctx, 15, roots[15]); !errors.Is(err, ErrAdvancePastOrigin) {
+		t.Errorf("expected %v, got %v", ErrAdvancePastOrigin, err)
+	}
+	if s.EndGap() != 10 {
+		t.Errorf("a failed advance moved the end of the gap to %d", s.EndGap())
+	}
+}
+
+func TestStatus_ReloadRestartsLegacyBackfill(t *testing.T) {
+	blks, roots := testChain(t, 20)
+	d := checkpointDB(t, blks, roots, 20)
+	// Databases created before backfill point the backfill root at genesis without holding the blocks below the origin.
+	d.backfillRoot = roots[0]
+
+	s := NewStatus(d)
+	if err := s.Reload(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if s.EndGap() != 20 {
+		t.Errorf("expected backfill to restart from the origin slot, got %d", s.EndGap())
+	}
+	if d.backfillRoot != roots[20] {
+		t.Errorf("expected the backfill root to be reset to the origin root, got %#x", d.backfillRoot)
+	}
+}
+
+func TestStatus_ReloadMissingBackfillRoot(t *testing.T) {
+	blks, roots := testChain(t, 20)
+	d := checkpointDB(t, blks, roots, 20)
+	d.backfillRoot = [32]byte{}
+
+	if err := NewStatus(d).Reload(context.Background()); !errors.Is(err, db.ErrNotFoundBackfillBlockRoot) {
+		t.Errorf("expected %v, got %v", db.ErrNotFoundBackfillBlockRoot, err)
+	}
+}
+
+func TestStatus_ConcurrentAccess(t *testing.T) {
+	ctx := context.Background()
+	blks, roots := testChain(t, 20)
+	s := NewStatus(checkpointDB(t, blks, roots, 20))
+	if err := s.Reload(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for sl := types.Slot(19); sl > 0; sl-- {
+			if err := s.Advance(ctx, sl, roots[sl]); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		s.SlotCovered(types.Slot(i % 20))
+		if s.StartGap() > s.EndGap() {
+			t.Fatal("the start of the gap is above its end")
+		}
+	}
+	wg.Wait()
+	if s.EndGap() != 1 {
+		t.Errorf("expected the gap to end at slot 1, got %d", s.EndGap())
+	}
+}
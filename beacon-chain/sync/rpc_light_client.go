@@ -0,0 +1,161 @@
+package sync
+
+import (
+	"context"
+	"math"
+
+	libp2pcore "github.com/libp2p/go-libp2p/core"
+	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/theQRL/zond/beacon-chain/p2p/types"
+	"github.com/theQRL/zond/config/params"
+	primitives "github.com/theQRL/zond/consensus-types/primitives"
+	"github.com/theQRL/zond/network/forks"
+	pb "github.com/theQRL/zond/protos/zond/v1alpha1"
+	"github.com/theQRL/zond/time/slots"
+)
+
+// lightClientBootstrapRPCHandler responds with the light client bootstrap of the requested block root.
+func (s *Service) lightClientBootstrapRPCHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	ctx, cancel := context.WithTimeout(ctx, ttfbTimeout)
+	defer cancel()
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", "light_client_bootstrap")
+
+	req, ok := msg.(*types.LightClientBootstrapReq)
+	if !ok {
+		return errors.New("message is not type LightClientBootstrapReq")
+	}
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		return err
+	}
+	s.rateLimiter.add(stream, 1)
+
+	bootstrap, err := s.cfg.beaconDB.LightClientBootstrap(ctx, *req)
+	if err != nil {
+		log.WithError(err).Debug("Could not fetch light client bootstrap")
+		s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+		return err
+	}
+	if bootstrap == nil {
+		s.writeErrorResponseToStream(responseCodeResourceUnavailable, types.ErrResourceUnavailable.Error(), stream)
+		return nil
+	}
+	SetStreamWriteDeadline(stream, defaultWriteDuration)
+	if err := s.writeLightClientChunk(stream, bootstrap.Header.Beacon.Slot, bootstrap); err != nil {
+		return err
+	}
+	closeStream(stream, log)
+	return nil
+}
+
+// lightClientUpdatesByRangeRPCHandler responds with the best light client updates of the requested
+// range of sync committee periods.
+func (s *Service) lightClientUpdatesByRangeRPCHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+	ctx, cancel := context.WithTimeout(ctx, respTimeout)
+	defer cancel()
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", "light_client_updates_by_range")
+
+	req, ok := msg.(*pb.LightClientUpdatesByRangeRequest)
+	if !ok {
+		return errors.New("message is not type LightClientUpdatesByRangeRequest")
+	}
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		return err
+	}
+	s.rateLimiter.add(stream, 1)
+
+	if req.Count == 0 {
+		s.writeErrorResponseToStream(responseCodeInvalidRequest, "no light client updates requested", stream)
+		return errors.New("no light client updates requested")
+	}
+	count := req.Count
+	if max := params.BeaconNetworkConfig().MaxRequestLightClientUpdates; count > max {
+		count = max
+	}
+	if req.StartPeriod > math.MaxUint64-count {
+		s.writeErrorResponseToStream(responseCodeInvalidRequest, types.ErrInvalidRequest.Error(), stream)
+		s.cfg.p2p.Peers().Scorers().BadResponsesScorer().Increment(stream.Conn().RemotePeer())
+		return types.ErrInvalidRequest
+	}
+	updates, err := s.cfg.beaconDB.LightClientUpdates(ctx, req.StartPeriod, req.StartPeriod+count-1)
+	if err != nil {
+		log.WithError(err).Debug("Could not fetch light client updates")
+		s.writeErrorResponseToStream(responseCodeServerError, types.ErrGeneric.Error(), stream)
+		return err
+	}
+	for _, update := range updates {
+		SetStreamWriteDeadline(stream, defaultWriteDuration)
+		if err := s.writeLightClientChunk(stream, update.AttestedHeader.Beacon.Slot, update); err != nil {
+			return err
+		}
+	}
+	closeStream(stream, log)
+	return nil
+}
+
+// lightClientFinalityUpdateRPCHandler responds with the latest light client finality update known to the node.
+func (s *Service) lightClientFinalityUpdateRPCHandler(_ context.Context, _ interface{}, stream libp2pcore.Stream) error {
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", "light_client_finality_update")
+
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		return err
+	}
+	s.rateLimiter.add(stream, 1)
+
+	update := s.cfg.chain.LightClientFinalityUpdate()
+	if update == nil {
+		s.writeErrorResponseToStream(responseCodeResourceUnavailable, types.ErrResourceUnavailable.Error(), stream)
+		return nil
+	}
+	SetStreamWriteDeadline(stream, defaultWriteDuration)
+	if err := s.writeLightClientChunk(stream, update.AttestedHeader.Beacon.Slot, update); err != nil {
+		return err
+	}
+	closeStream(stream, log)
+	return nil
+}
+
+// lightClientOptimisticUpdateRPCHandler responds with the latest light client optimistic update known to the node.
+func (s *Service) lightClientOptimisticUpdateRPCHandler(_ context.Context, _ interface{}, stream libp2pcore.Stream) error {
+	SetRPCStreamDeadlines(stream)
+	log := log.WithField("handler", "light_client_optimistic_update")
+
+	if err := s.rateLimiter.validateRequest(stream, 1); err != nil {
+		return err
+	}
+	s.rateLimiter.add(stream, 1)
+
+	update := s.cfg.chain.LightClientOptimisticUpdate()
+	if update == nil {
+		s.writeErrorResponseToStream(responseCodeResourceUnavailable, types.ErrResourceUnavailable.Error(), stream)
+		return nil
+	}
+	SetStreamWriteDeadline(stream, defaultWriteDuration)
+	if err := s.writeLightClientChunk(stream, update.AttestedHeader.Beacon.Slot, update); err != nil {
+		return err
+	}
+	closeStream(stream, log)
+	return nil
+}
+
+// writeLightClientChunk writes a light client object to the stream. The context bytes of the chunk
+// are the fork digest of the epoch of the given slot.
+// response_chunk  ::= <result> | <context-bytes> | <encoding-dependent-header> | <encoded-payload>
+func (s *Service) writeLightClientChunk(stream libp2pcore.Stream, slot primitives.Slot, msg ssz.Marshaler) error {
+	valRoot := s.cfg.chain.GenesisValidatorsRoot()
+	digest, err := forks.ForkDigestFromEpoch(slots.ToEpoch(slot), valRoot[:])
+	if err != nil {
+		return err
+	}
+	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
+		return err
+	}
+	if _, err := stream.Write(digest[:]); err != nil {
+		return err
+	}
+	_, err = s.cfg.p2p.Encoding().EncodeWithMaxLength(stream, msg)
+	return err
+}
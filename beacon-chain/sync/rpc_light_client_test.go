@@ -0,0 +1,311 @@
+package sync
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/theQRL/zond/beacon-chain/blockchain/testing"
+	"github.com/theQRL/zond/beacon-chain/db"
+	dbtest "github.com/theQRL/zond/beacon-chain/db/testing"
+	"github.com/theQRL/zond/beacon-chain/p2p"
+	p2ptest "github.com/theQRL/zond/beacon-chain/p2p/testing"
+	p2ptypes "github.com/theQRL/zond/beacon-chain/p2p/types"
+	fieldparams "github.com/theQRL/zond/config/fieldparams"
+	"github.com/theQRL/zond/config/params"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	"github.com/theQRL/zond/network/forks"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+)
+
+func testLightClientHeader(slot types.Slot) *ethpb.LightClientHeader {
+	return &ethpb.LightClientHeader{Beacon: &ethpb.BeaconBlockHeader{
+		Slot:       slot,
+		ParentRoot: make([]byte, fieldparams.RootLength),
+		StateRoot:  make([]byte, fieldparams.RootLength),
+		BodyRoot:   make([]byte, fieldparams.RootLength),
+	}}
+}
+
+func testLightClientBranch(depth int) [][]byte {
+	branch := make([][]byte, depth)
+	for i := range branch {
+		branch[i] = make([]byte, fieldparams.RootLength)
+	}
+	return branch
+}
+
+func testSyncCommittee() *ethpb.SyncCommittee {
+	pubkeys := make([][]byte, params.BeaconConfig().SyncCommitteeSize)
+	for i := range pubkeys {
+		pubkeys[i] = make([]byte, fieldparams.BLSPubkeyLength)
+	}
+	return &ethpb.SyncCommittee{Pubkeys: pubkeys, AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength)}
+}
+
+func testSyncAggregate() *ethpb.SyncAggregate {
+	bits := bitfield.NewBitvector512()
+	bits.SetBitAt(0, true)
+	return &ethpb.SyncAggregate{SyncCommitteeBits: bits, SyncCommitteeSignature: make([]byte, 96)}
+}
+
+// testLightClientUpdate returns an update whose attested header is at the given slot.
+func testLightClientUpdate(slot types.Slot) *ethpb.LightClientUpdate {
+	return &ethpb.LightClientUpdate{
+		AttestedHeader:          testLightClientHeader(slot),
+		NextSyncCommittee:       testSyncCommittee(),
+		NextSyncCommitteeBranch: testLightClientBranch(5),
+		FinalizedHeader:         testLightClientHeader(0),
+		FinalityBranch:          testLightClientBranch(6),
+		SyncAggregate:           testSyncAggregate(),
+		SignatureSlot:           slot + 1,
+	}
+}
+
+// lightClientMessage is a light client object sent in the chunks of a response.
+type lightClientMessage interface {
+	ssz.Marshaler
+	ssz.Unmarshaler
+}
+
+// lightClientResponse holds the chunks read from a light client RPC response.
+type lightClientResponse struct {
+	code     uint8
+	errMsg   string
+	digests  [][4]byte
+	payloads [][]byte
+}
+
+var lightClientValidatorsRoot = [32]byte{'a'}
+
+// lightClientRPCService returns a service serving the light client RPC methods from the given
+// database, and the peer that sends it the requests.
+func lightClientRPCService(t *testing.T, beaconDB db.Database) (*Service, *p2ptest.TestP2P, *p2ptest.TestP2P) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	s := &Service{
+		cfg: &config{
+			p2p:      p1,
+			beaconDB: beaconDB,
+			chain:    &mock.ChainService{ValidatorsRoot: lightClientValidatorsRoot, Genesis: time.Now()},
+		},
+		rateLimiter: newRateLimiter(p1),
+	}
+	return s, p1, p2
+}
+
+// callLightClientRPC calls the handler on a stream to the requesting peer, which reads the
+// response chunks decoding each payload into a new message of the given type.
+func callLightClientRPC(
+	t *testing.T,
+	p1, p2 *p2ptest.TestP2P,
+	topic string,
+	newMsg func() lightClientMessage,
+	handler func(stream network.Stream) error,
+) (*lightClientResponse, error) {
+	pcl := protocol.ID(topic + p1.Encoding().ProtocolSuffix())
+	resp := &lightClientResponse{}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		for {
+			code, errMsg, err := ReadStatusCode(stream, p2.Encoding())
+			if errors.Is(err, io.EOF) {
+				return
+			}
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if code != responseCodeSuccess {
+				resp.code, resp.errMsg = code, errMsg
+				return
+			}
+			var digest [4]byte
+			if _, err := io.ReadFull(stream, digest[:]); err != nil {
+				t.Error(err)
+				return
+			}
+			msg := newMsg()
+			if err := p2.Encoding().DecodeWithMaxLength(stream, msg); err != nil {
+				t.Error(err)
+				return
+			}
+			enc, err := msg.MarshalSSZ()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			resp.digests = append(resp.digests, digest)
+			resp.payloads = append(resp.payloads, enc)
+		}
+	})
+	stream, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handlerErr := handler(stream)
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("did not receive the response within 1 second")
+	}
+	return resp, handlerErr
+}
+
+func TestLightClientUpdatesByRangeRPCHandler(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	for period := uint64(1); period <= 3; period++ {
+		if err := beaconDB.SaveLightClientUpdate(ctx, period, testLightClientUpdate(types.Slot(period))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	newUpdate := func() lightClientMessage { return &ethpb.LightClientUpdate{} }
+	// The updates are attested in the first epoch, so their context bytes are the genesis fork digest.
+	valRoot := lightClientValidatorsRoot
+	digest, err := forks.ForkDigestFromEpoch(0, valRoot[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		req   *ethpb.LightClientUpdatesByRangeRequest
+		slots []types.Slot
+	}{
+		{"range", &ethpb.LightClientUpdatesByRangeRequest{StartPeriod: 1, Count: 2}, []types.Slot{1, 2}},
+		{"range beyond the last update", &ethpb.LightClientUpdatesByRangeRequest{StartPeriod: 2, Count: 10}, []types.Slot{2, 3}},
+		{"count above the maximum", &ethpb.LightClientUpdatesByRangeRequest{StartPeriod: 1, Count: math.MaxUint64}, []types.Slot{1, 2, 3}},
+		{"range at the end of the periods", &ethpb.LightClientUpdatesByRangeRequest{StartPeriod: math.MaxUint64 - 10, Count: 5}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, p1, p2 := lightClientRPCService(t, beaconDB)
+			resp, err := callLightClientRPC(t, p1, p2, p2p.RPCLightClientUpdatesByRangeTopicV1, newUpdate, func(stream network.Stream) error {
+				return s.lightClientUpdatesByRangeRPCHandler(ctx, tt.req, stream)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.code != responseCodeSuccess {
+				t.Fatalf("unexpected response code %d: %s", resp.code, resp.errMsg)
+			}
+			if len(resp.payloads) != len(tt.slots) {
+				t.Fatalf("expected %d updates, got %d", len(tt.slots), len(resp.payloads))
+			}
+			for i, slot := range tt.slots {
+				want, err := testLightClientUpdate(slot).MarshalSSZ()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(resp.payloads[i]) != string(want) {
+					t.Errorf("unexpected update at position %d", i)
+				}
+				if resp.digests[i] != digest {
+					t.Errorf("unexpected fork digest %#x at position %d, want %#x", resp.digests[i], i, digest)
+				}
+			}
+		})
+	}
+}
+
+func TestLightClientUpdatesByRangeRPCHandler_InvalidRequests(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	newUpdate := func() lightClientMessage { return &ethpb.LightClientUpdate{} }
+
+	tests := []struct {
+		name         string
+		req          *ethpb.LightClientUpdatesByRangeRequest
+		badResponses int
+	}{
+		{"no updates requested", &ethpb.LightClientUpdatesByRangeRequest{StartPeriod: 1}, 0},
+		{"end period overflows", &ethpb.LightClientUpdatesByRangeRequest{StartPeriod: math.MaxUint64 - 5, Count: 10}, 1},
+		{"capped end period overflows", &ethpb.LightClientUpdatesByRangeRequest{StartPeriod: math.MaxUint64 - 100, Count: math.MaxUint64}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, p1, p2 := lightClientRPCService(t, beaconDB)
+			resp, err := callLightClientRPC(t, p1, p2, p2p.RPCLightClientUpdatesByRangeTopicV1, newUpdate, func(stream network.Stream) error {
+				return s.lightClientUpdatesByRangeRPCHandler(ctx, tt.req, stream)
+			})
+			if err == nil {
+				t.Error("expected the request to be rejected")
+			}
+			if resp.code != responseCodeInvalidRequest {
+				t.Errorf("unexpected response code %d: %s", resp.code, resp.errMsg)
+			}
+			if len(resp.payloads) != 0 {
+				t.Errorf("expected no updates, got %d", len(resp.payloads))
+			}
+			count, err := p1.Peers().Scorers().BadResponsesScorer().Count(p2.PeerID())
+			if tt.badResponses == 0 {
+				if err == nil && count != 0 {
+					t.Errorf("expected no bad responses, got %d", count)
+				}
+			} else if err != nil || count != tt.badResponses {
+				t.Errorf("expected %d bad responses, got %d (%v)", tt.badResponses, count, err)
+			}
+		})
+	}
+}
+
+func TestLightClientBootstrapRPCHandler(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	root := [32]byte{'b'}
+	bootstrap := &ethpb.LightClientBootstrap{
+		Header:                     testLightClientHeader(100),
+		CurrentSyncCommittee:       testSyncCommittee(),
+		CurrentSyncCommitteeBranch: testLightClientBranch(5),
+	}
+	if err := beaconDB.SaveLightClientBootstrap(ctx, root, bootstrap); err != nil {
+		t.Fatal(err)
+	}
+	newBootstrap := func() lightClientMessage { return &ethpb.LightClientBootstrap{} }
+
+	s, p1, p2 := lightClientRPCService(t, beaconDB)
+	req := p2ptypes.LightClientBootstrapReq(root)
+	resp, err := callLightClientRPC(t, p1, p2, p2p.RPCLightClientBootstrapTopicV1, newBootstrap, func(stream network.Stream) error {
+		return s.lightClientBootstrapRPCHandler(ctx, &req, stream)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := bootstrap.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.code != responseCodeSuccess || len(resp.payloads) != 1 || string(resp.payloads[0]) != string(want) {
+		t.Errorf("unexpected response with code %d and %d chunks", resp.code, len(resp.payloads))
+	}
+
+	// A block root without a bootstrap is answered as an unavailable resource.
+	s, p1, p2 = lightClientRPCService(t, beaconDB)
+	req = p2ptypes.LightClientBootstrapReq([32]byte{'c'})
+	resp, err = callLightClientRPC(t, p1, p2, p2p.RPCLightClientBootstrapTopicV1, newBootstrap, func(stream network.Stream) error {
+		return s.lightClientBootstrapRPCHandler(ctx, &req, stream)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.code != responseCodeResourceUnavailable || len(resp.payloads) != 0 {
+		t.Errorf("unexpected response with code %d and %d chunks", resp.code, len(resp.payloads))
+	}
+}
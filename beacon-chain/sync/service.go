@@ -99,6 +99,7 @@ type blockchainService interface {
 	blockchain.CanonicalFetcher
 	blockchain.OptimisticModeFetcher
 	blockchain.SlashingReceiver
+	blockchain.LightClientFetcher
 }
 
 // Service is responsible for handling all run time p2p related operations as the
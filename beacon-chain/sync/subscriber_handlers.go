@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"github.com/theQRL/zond/beacon-chain/core/feed"
+	opfeed "github.com/theQRL/zond/beacon-chain/core/feed/operation"
 	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
 	"google.golang.org/protobuf/proto"
 )
@@ -45,6 +47,12 @@ func (s *Service) attesterSlashingSubscriber(ctx context.Context, msg proto.Mess
 			return errors.Wrap(err, "could not insert attester slashing into pool")
 		}
 		s.setAttesterSlashingIndicesSeen(aSlashing.Attestation_1.AttestingIndices, aSlashing.Attestation_2.AttestingIndices)
+		s.cfg.operationNotifier.OperationFeed().Send(&feed.Event{
+			Type: opfeed.AttesterSlashingReceived,
+			Data: &opfeed.AttesterSlashingReceivedData{
+				AttesterSlashing: aSlashing,
+			},
+		})
 	}
 	return nil
 }
@@ -66,6 +74,12 @@ func (s *Service) proposerSlashingSubscriber(ctx context.Context, msg proto.Mess
 			return errors.Wrap(err, "could not insert proposer slashing into pool")
 		}
 		s.setProposerSlashingIndexSeen(pSlashing.Header_1.Header.ProposerIndex)
+		s.cfg.operationNotifier.OperationFeed().Send(&feed.Event{
+			Type: opfeed.ProposerSlashingReceived,
+			Data: &opfeed.ProposerSlashingReceivedData{
+				ProposerSlashing: pSlashing,
+			},
+		})
 	}
 	return nil
 }
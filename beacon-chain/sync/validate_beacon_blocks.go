@@ -12,6 +12,7 @@ import (
 	"github.com/theQRL/zond/beacon-chain/core/blocks"
 	"github.com/theQRL/zond/beacon-chain/core/feed"
 	blockfeed "github.com/theQRL/zond/beacon-chain/core/feed/block"
+	opfeed "github.com/theQRL/zond/beacon-chain/core/feed/operation"
 	"github.com/theQRL/zond/beacon-chain/core/helpers"
 	"github.com/theQRL/zond/beacon-chain/core/transition"
 	"github.com/theQRL/zond/beacon-chain/state"
@@ -206,6 +207,14 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		"graffiti":           string(graffiti[:]),
 	}).Debug("Received block")
 
+	// Notify other services in the beacon node of a valid gossip block, before it is imported.
+	s.cfg.operationNotifier.OperationFeed().Send(&feed.Event{
+		Type: opfeed.BlockGossipReceived,
+		Data: &opfeed.BlockGossipReceivedData{
+			SignedBlock: blk,
+		},
+	})
+
 	blockVerificationGossipSummary.Observe(float64(prysmTime.Since(receivedTime).Milliseconds()))
 	return pubsub.ValidationAccept, nil
 }
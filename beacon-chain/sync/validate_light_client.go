@@ -0,0 +1,107 @@
+package sync
+
+import (
+	"context"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/theQRL/zond/config/params"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	"github.com/theQRL/zond/monitoring/tracing"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+	prysmTime "github.com/theQRL/zond/time"
+	"github.com/theQRL/zond/time/slots"
+	"go.opencensus.io/trace"
+	"google.golang.org/protobuf/proto"
+)
+
+// validateLightClientFinalityUpdate forwards a light client finality update only if it was received
+// after a third of its signature slot and matches the finality update computed locally.
+func (s *Service) validateLightClientFinalityUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	ctx, span := trace.StartSpan(ctx, "sync.validateLightClientFinalityUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+	update, ok := m.(*ethpb.LightClientFinalityUpdate)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+	if update.AttestedHeader == nil || update.FinalizedHeader == nil || update.SyncAggregate == nil {
+		return pubsub.ValidationReject, errNilMessage
+	}
+	if s.isEarlyLightClientUpdate(update.SignatureSlot) {
+		return pubsub.ValidationIgnore, nil
+	}
+	if !proto.Equal(update, s.cfg.chain.LightClientFinalityUpdate()) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	msg.ValidatorData = update
+	return pubsub.ValidationAccept, nil
+}
+
+// validateLightClientOptimisticUpdate forwards a light client optimistic update only if it was received
+// after a third of its signature slot and matches the optimistic update computed locally.
+func (s *Service) validateLightClientOptimisticUpdate(ctx context.Context, pid peer.ID, msg *pubsub.Message) (pubsub.ValidationResult, error) {
+	// Validation runs on publish (not just subscriptions), so we should approve any message from
+	// ourselves.
+	if pid == s.cfg.p2p.PeerID() {
+		return pubsub.ValidationAccept, nil
+	}
+	if s.cfg.initialSync.Syncing() {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	ctx, span := trace.StartSpan(ctx, "sync.validateLightClientOptimisticUpdate")
+	defer span.End()
+
+	m, err := s.decodePubsubMessage(msg)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return pubsub.ValidationReject, err
+	}
+	update, ok := m.(*ethpb.LightClientOptimisticUpdate)
+	if !ok {
+		return pubsub.ValidationReject, errWrongMessage
+	}
+	if update.AttestedHeader == nil || update.SyncAggregate == nil {
+		return pubsub.ValidationReject, errNilMessage
+	}
+	if s.isEarlyLightClientUpdate(update.SignatureSlot) {
+		return pubsub.ValidationIgnore, nil
+	}
+	if !proto.Equal(update, s.cfg.chain.LightClientOptimisticUpdate()) {
+		return pubsub.ValidationIgnore, nil
+	}
+
+	msg.ValidatorData = update
+	return pubsub.ValidationAccept, nil
+}
+
+// isEarlyLightClientUpdate returns true if a light client update signed at the given slot is received
+// before a third of the slot has elapsed, accounting for the maximum gossip clock disparity.
+func (s *Service) isEarlyLightClientUpdate(signatureSlot types.Slot) bool {
+	earliest := slots.StartTime(uint64(s.cfg.chain.GenesisTime().Unix()), signatureSlot).
+		Add(slots.DivideSlotBy(3)).
+		Add(-params.BeaconNetworkConfig().MaximumGossipClockDisparity)
+	return prysmTime.Now().Before(earliest)
+}
+
+// lightClientUpdateSubscriber is a no-op, as the validated light client updates are the ones
+// computed by the node itself.
+func (s *Service) lightClientUpdateSubscriber(_ context.Context, _ proto.Message) error {
+	return nil
+}
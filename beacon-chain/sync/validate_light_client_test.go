@@ -0,0 +1,155 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ssz "github.com/prysmaticlabs/fastssz"
+	mock "github.com/theQRL/zond/beacon-chain/blockchain/testing"
+	"github.com/theQRL/zond/beacon-chain/p2p"
+	p2ptest "github.com/theQRL/zond/beacon-chain/p2p/testing"
+	mockSync "github.com/theQRL/zond/beacon-chain/sync/initial-sync/testing"
+	"github.com/theQRL/zond/config/params"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	"github.com/theQRL/zond/network/forks"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+	prysmTime "github.com/theQRL/zond/time"
+	"google.golang.org/protobuf/proto"
+)
+
+// lightClientGossipSlot is the current slot of the chain serving the gossiped light client updates.
+const lightClientGossipSlot = types.Slot(100)
+
+func testLightClientFinalityUpdate(signatureSlot types.Slot) *ethpb.LightClientFinalityUpdate {
+	return &ethpb.LightClientFinalityUpdate{
+		AttestedHeader:  testLightClientHeader(signatureSlot - 1),
+		FinalizedHeader: testLightClientHeader(0),
+		FinalityBranch:  testLightClientBranch(6),
+		SyncAggregate:   testSyncAggregate(),
+		SignatureSlot:   signatureSlot,
+	}
+}
+
+func testLightClientOptimisticUpdate(signatureSlot types.Slot) *ethpb.LightClientOptimisticUpdate {
+	return &ethpb.LightClientOptimisticUpdate{
+		AttestedHeader: testLightClientHeader(signatureSlot - 1),
+		SyncAggregate:  testSyncAggregate(),
+		SignatureSlot:  signatureSlot,
+	}
+}
+
+// lightClientGossipService returns a service whose chain is at the start of the current slot and
+// computed the finality and optimistic updates signed in the previous slot.
+func lightClientGossipService(t *testing.T, syncing bool) *Service {
+	secondsPerSlot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	chain := &mock.ChainService{
+		ValidatorsRoot:   lightClientValidatorsRoot,
+		Genesis:          prysmTime.Now().Add(-time.Duration(lightClientGossipSlot) * secondsPerSlot),
+		FinalityUpdate:   testLightClientFinalityUpdate(lightClientGossipSlot - 1),
+		OptimisticUpdate: testLightClientOptimisticUpdate(lightClientGossipSlot - 1),
+	}
+	return &Service{
+		cfg: &config{
+			p2p:         p2ptest.NewTestP2P(t),
+			chain:       chain,
+			initialSync: &mockSync.Sync{IsSyncing: syncing},
+		},
+	}
+}
+
+// lightClientGossipMessage returns the pubsub message of the given object on the given topic format.
+func lightClientGossipMessage(t *testing.T, s *Service, topicFormat string, msg ssz.Marshaler) *pubsub.Message {
+	digest, err := forks.ForkDigestFromEpoch(0, lightClientValidatorsRoot[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := new(bytes.Buffer)
+	if _, err := s.cfg.p2p.Encoding().EncodeGossip(buf, msg); err != nil {
+		t.Fatal(err)
+	}
+	topic := fmt.Sprintf(topicFormat, digest) + s.cfg.p2p.Encoding().ProtocolSuffix()
+	return &pubsub.Message{Message: &pubsubpb.Message{Data: buf.Bytes(), Topic: &topic}}
+}
+
+func TestValidateLightClientUpdates(t *testing.T) {
+	remote := peer.ID("remote")
+	validators := []struct {
+		name        string
+		topicFormat string
+		update      func(signatureSlot types.Slot) ssz.Marshaler
+		validate    func(s *Service) func(context.Context, peer.ID, *pubsub.Message) (pubsub.ValidationResult, error)
+	}{
+		{
+			name:        "finality update",
+			topicFormat: p2p.LightClientFinalityUpdateTopicFormat,
+			update: func(signatureSlot types.Slot) ssz.Marshaler {
+				return testLightClientFinalityUpdate(signatureSlot)
+			},
+			validate: func(s *Service) func(context.Context, peer.ID, *pubsub.Message) (pubsub.ValidationResult, error) {
+				return s.validateLightClientFinalityUpdate
+			},
+		},
+		{
+			name:        "optimistic update",
+			topicFormat: p2p.LightClientOptimisticUpdateTopicFormat,
+			update: func(signatureSlot types.Slot) ssz.Marshaler {
+				return testLightClientOptimisticUpdate(signatureSlot)
+			},
+			validate: func(s *Service) func(context.Context, peer.ID, *pubsub.Message) (pubsub.ValidationResult, error) {
+				return s.validateLightClientOptimisticUpdate
+			},
+		},
+	}
+	for _, v := range validators {
+		t.Run(v.name, func(t *testing.T) {
+			tests := []struct {
+				name    string
+				syncing bool
+				own     bool
+				data    []byte
+				slot    types.Slot
+				want    pubsub.ValidationResult
+				wantErr bool
+			}{
+				{name: "matching update", slot: lightClientGossipSlot - 1, want: pubsub.ValidationAccept},
+				{name: "own update", own: true, slot: lightClientGossipSlot, want: pubsub.ValidationAccept},
+				{name: "syncing", syncing: true, slot: lightClientGossipSlot - 1, want: pubsub.ValidationIgnore},
+				{name: "malformed update", data: []byte{0x01, 0x02}, want: pubsub.ValidationReject, wantErr: true},
+				{name: "early update", slot: lightClientGossipSlot, want: pubsub.ValidationIgnore},
+				{name: "different update", slot: lightClientGossipSlot - 2, want: pubsub.ValidationIgnore},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					s := lightClientGossipService(t, tt.syncing)
+					msg := lightClientGossipMessage(t, s, v.topicFormat, v.update(tt.slot))
+					if tt.data != nil {
+						msg.Data = tt.data
+					}
+					pid := remote
+					if tt.own {
+						pid = s.cfg.p2p.PeerID()
+					}
+					got, err := v.validate(s)(context.Background(), pid, msg)
+					if (err != nil) != tt.wantErr {
+						t.Fatalf("unexpected error %v", err)
+					}
+					if got != tt.want {
+						t.Errorf("unexpected validation result %v, want %v", got, tt.want)
+					}
+					if got == pubsub.ValidationAccept && !tt.own {
+						validated, ok := msg.ValidatorData.(proto.Message)
+						if !ok || !proto.Equal(validated, v.update(tt.slot).(proto.Message)) {
+							t.Errorf("unexpected validator data %v", msg.ValidatorData)
+						}
+					}
+				})
+			}
+		})
+	}
+}
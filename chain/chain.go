@@ -14,6 +14,7 @@ import (
 	"github.com/theQRL/zond/block"
 	"github.com/theQRL/zond/block/genesis"
 	"github.com/theQRL/zond/common"
+	"github.com/theQRL/zond/common/lru"
 	"github.com/theQRL/zond/config"
 	"github.com/theQRL/zond/core"
 	"github.com/theQRL/zond/core/rawdb"
@@ -45,8 +46,13 @@ type Chain struct {
 	txPool *pool.TransactionPool
 
 	lastBlock *block.Block
+
+	badBlocks *lru.Cache[common.Hash, *block.Block] // Blocks which failed to be processed
 }
 
+// maxBadBlocks is the number of blocks failing to be processed that are kept for debugging.
+const maxBadBlocks = 10
+
 // setDefaults to be removed after figuring out better way to call
 // runtime vmenv
 func setDefaults(cfg *runtime.Config) {
@@ -104,6 +110,12 @@ func (c *Chain) Config() *params.ChainConfig {
 	return cfg.ChainConfig
 }
 
+// ExecutionConfig returns the chain config the state processor executes blocks with.
+func (c *Chain) ExecutionConfig() *params.ChainConfig {
+	// TODO: chain id is currently hardcoded to 0, need to be loaded based on Network type
+	return &params.ChainConfig{ChainID: c.config.Dev.ChainID}
+}
+
 // GetVMConfig returns the chain VM config.
 func (c *Chain) GetVMConfig() *vm.Config {
 	return &vm.Config{
@@ -276,6 +288,63 @@ func (c *Chain) GetTransactionPool() *pool.TransactionPool {
 	return c.txPool
 }
 
+// TxPoolContent returns the transactions in the pool grouped by sender and
+// split into pending and queued. A transaction is pending if it is part of
+// a gapless nonce sequence starting at the sender's current state nonce.
+// Every other transaction is queued, either because it follows a nonce gap
+// or because its nonce has already been used.
+func (c *Chain) TxPoolContent() (map[common.Address][]*protos.Transaction, map[common.Address][]*protos.Transaction, error) {
+	statedb, err := c.AccountDB()
+	if err != nil {
+		return nil, nil, err
+	}
+	pending := make(map[common.Address][]*protos.Transaction)
+	queued := make(map[common.Address][]*protos.Transaction)
+	for addr, txs := range c.txPool.Content() {
+		p, q := splitPoolTransactions(statedb.GetNonce(addr), txs)
+		if len(p) > 0 {
+			pending[addr] = p
+		}
+		if len(q) > 0 {
+			queued[addr] = q
+		}
+	}
+	return pending, queued, nil
+}
+
+// TxPoolContentFrom returns the pending and queued transactions in the pool
+// for the given sender.
+func (c *Chain) TxPoolContentFrom(addr common.Address) ([]*protos.Transaction, []*protos.Transaction, error) {
+	statedb, err := c.AccountDB()
+	if err != nil {
+		return nil, nil, err
+	}
+	pending, queued := splitPoolTransactions(statedb.GetNonce(addr), c.txPool.ContentFrom(addr))
+	return pending, queued, nil
+}
+
+// splitPoolTransactions splits the nonce sorted transactions of a single
+// sender into the executable and non-executable sets. Transactions with a
+// nonce below the account nonce are stale and left out of both sets.
+func splitPoolTransactions(nonce uint64, txs []transactions.TransactionInterface) ([]*protos.Transaction, []*protos.Transaction) {
+	var (
+		pending, queued []*protos.Transaction
+		accountNonce    = nonce
+	)
+	for _, tx := range txs {
+		switch {
+		case tx.Nonce() < accountNonce:
+			continue
+		case tx.Nonce() == nonce:
+			pending = append(pending, tx.PBData())
+			nonce++
+		default:
+			queued = append(queued, tx.PBData())
+		}
+	}
+	return pending, queued
+}
+
 func (c *Chain) GetNonce(address common.Address) (uint64, error) {
 	// TODO (cyyber): doesn't consider the pending nonce for the address involved in Staking
 	nonce, found := c.txPool.GetNonceByAddress(address)
@@ -342,10 +411,14 @@ func (c *Chain) Height() uint64 {
 }
 
 func (c *Chain) Load() error {
-	db2, err := rawdb.NewLevelDBDatabaseWithFreezer(
-		path.Join(c.config.User.DataDir(), c.config.Dev.DB2Name), 16,
-		16, path.Join(c.config.User.DataDir(), c.config.Dev.DB2FreezerName),
-		c.config.Dev.DB2Name, false)
+	db2, err := rawdb.Open(rawdb.OpenOptions{
+		Type:              c.config.User.DBEngine,
+		Directory:         path.Join(c.config.User.DataDir(), c.config.Dev.DB2Name),
+		AncientsDirectory: path.Join(c.config.User.DataDir(), c.config.Dev.DB2FreezerName),
+		Namespace:         c.config.Dev.DB2Name,
+		Cache:             16,
+		Handles:           16,
+	})
 
 	if err != nil {
 		log.Error("Failed to create db2")
@@ -369,7 +442,7 @@ func (c *Chain) Load() error {
 			return err
 		}
 
-		stateProcessor := core.NewStateProcessorV1(&params.ChainConfig{ChainID: c.config.Dev.ChainID}, c.GetBlockHashBySlotNumber)
+		stateProcessor := core.NewStateProcessorV1(c.ExecutionConfig(), c.GetBlockHashBySlotNumber)
 
 		preState, err := genesis.LoadPreState()
 		if err != nil {
@@ -775,12 +848,12 @@ func (c *Chain) AddBlock(b *block.Block) bool {
 		mainChainMetaData.FinalizedBlockHeaderHash(), b.ParentHash(), b.Hash(),
 		b.PartialBlockSigningHash(), b.BlockSigningHash(), epochMetaData)
 
-	// TODO: chain id is currently hardcoded to 0, need to be loaded based on Network type
-	stateProcessor := core.NewStateProcessorV1(&params.ChainConfig{ChainID: c.config.Dev.ChainID}, c.GetBlockHashBySlotNumber)
+	stateProcessor := core.NewStateProcessorV1(c.ExecutionConfig(), c.GetBlockHashBySlotNumber)
 
 	//receipts, logs, usedGas, err := stateProcessor.Process(b, statedb, stateContext, validators, false, vm.Config{})
 	_, _, _, err = stateProcessor.Process(b, parentBlock, statedb, stateContext, validators, false, vm.Config{})
 	if err != nil {
+		c.badBlocks.Add(b.Hash(), b)
 		log.Error(fmt.Sprintf("Failed to process block #%d %s | Error %s", b.SlotNumber(),
 			misc.BytesToHexStr(bHash[:]), err.Error()))
 		return false
@@ -887,9 +960,27 @@ func NewChain(s *state.State) *Chain {
 		state:  s,
 		db2:    nil,
 		txPool: pool.CreateTransactionPool(),
+
+		badBlocks: lru.NewCache[common.Hash, *block.Block](maxBadBlocks),
 	}
 }
 
+// BadBlocks returns the blocks which recently failed to be processed.
+func (c *Chain) BadBlocks() []*block.Block {
+	var blocks []*block.Block
+	for _, hash := range c.badBlocks.Keys() {
+		if b, ok := c.badBlocks.Peek(hash); ok {
+			blocks = append(blocks, b)
+		}
+	}
+	return blocks
+}
+
+// GetBadBlock returns the block with the given hash if it recently failed to be processed.
+func (c *Chain) GetBadBlock(hash common.Hash) (*block.Block, bool) {
+	return c.badBlocks.Peek(hash)
+}
+
 func (c *Chain) CalculateEpochMetaData(statedb *state2.StateDB, slotNumber uint64,
 	parentHeaderHash common.Hash) (*metadata.EpochMetaData, error) {
 
@@ -341,3 +341,42 @@ func TestGetSlotLeaderDilithiumPKBySlotNumber(t *testing.T) {
 		t.Error("got unexpected error while fetching slot leader: ", err)
 	}
 }
+
+func TestSplitPoolTransactions(t *testing.T) {
+	newTx := func(nonce uint64) transactions.TransactionInterface {
+		return transactions.NewTransfer(1, nil, 0, 21000, big.NewInt(1), big.NewInt(1), nil, nonce, nil)
+	}
+	nonces := func(txs []*protos.Transaction) []uint64 {
+		var n []uint64
+		for _, tx := range txs {
+			n = append(n, tx.Nonce)
+		}
+		return n
+	}
+	tests := []struct {
+		stateNonce     uint64
+		poolNonces     []uint64
+		pending, queue []uint64
+	}{
+		{stateNonce: 0, poolNonces: []uint64{0, 1, 2}, pending: []uint64{0, 1, 2}},
+		{stateNonce: 1, poolNonces: []uint64{1, 3, 4}, pending: []uint64{1}, queue: []uint64{3, 4}},
+		{stateNonce: 2, poolNonces: []uint64{0, 2, 3}, pending: []uint64{2, 3}},
+		{stateNonce: 5, poolNonces: []uint64{6, 7}, queue: []uint64{6, 7}},
+		{stateNonce: 5, poolNonces: []uint64{3, 4}},
+		{stateNonce: 5, poolNonces: []uint64{1, 4, 6, 7}, queue: []uint64{6, 7}},
+		{stateNonce: 5, poolNonces: []uint64{4, 5, 7}, pending: []uint64{5}, queue: []uint64{7}},
+	}
+	for i, tt := range tests {
+		var txs []transactions.TransactionInterface
+		for _, n := range tt.poolNonces {
+			txs = append(txs, newTx(n))
+		}
+		pending, queue := splitPoolTransactions(tt.stateNonce, txs)
+		if got := nonces(pending); fmt.Sprint(got) != fmt.Sprint(tt.pending) {
+			t.Errorf("test %d: pending nonces mismatch: have %v, want %v", i, got, tt.pending)
+		}
+		if got := nonces(queue); fmt.Sprint(got) != fmt.Sprint(tt.queue) {
+			t.Errorf("test %d: queued nonces mismatch: have %v, want %v", i, got, tt.queue)
+		}
+	}
+}
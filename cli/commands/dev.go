@@ -20,15 +20,17 @@ func getDevSubCommands() []*cli.Command {
 	return []*cli.Command{
 		{
 			Name:  "genesis-bootstrap",
-			Usage: "Bootstraps the process of generating genesis file with required wallets and transactions",
+			Usage: "Bootstraps the process of generating genesis file with required wallets and transactions, writing unencrypted keys for development networks",
 			Action: func(c *cli.Context) error {
 				if _, err := os.Stat("bootstrap"); !os.IsNotExist(err) {
 					fmt.Println("bootstrap directory already exists, please delete it")
 					return nil
-				} else if err := os.Mkdir("bootstrap", os.ModePerm); err != nil {
+				} else if err := os.Mkdir("bootstrap", 0700); err != nil {
 					fmt.Println("failed to create bootstrap directory ", err.Error())
 					return nil
 				}
+				fmt.Println("Warning: the wallets and dilithium keys written to the bootstrap directory are not encrypted " +
+					"and must only be used for development networks, run 'wallet migrate' and 'dilithium-key migrate' to encrypt them")
 
 				dk := keys.NewDilithiumKeys(path.Join("bootstrap", "dilithium_keys"))
 
@@ -1,9 +1,11 @@
 package commands
 
 import (
+	"fmt"
+
 	"github.com/theQRL/zond/cli/flags"
+	"github.com/theQRL/zond/crypto/keystore"
 	"github.com/theQRL/zond/keys"
-	"github.com/theQRL/zond/wallet"
 	"github.com/urfave/cli/v2"
 )
 
@@ -14,6 +16,7 @@ func getDilithiumKeySubCommands() []*cli.Command {
 			Usage: "Adds dilithium key from existing wallet",
 			Flags: []cli.Flag{
 				flags.WalletFile,
+				flags.WalletPasswordFileFlag,
 				flags.AccountIndexFlag,
 				&cli.StringFlag{
 					Name:  "output",
@@ -21,7 +24,10 @@ func getDilithiumKeySubCommands() []*cli.Command {
 				},
 			},
 			Action: func(c *cli.Context) error {
-				w := wallet.NewWallet(c.String(flags.WalletFile.Name))
+				w, err := openWallet(c, false)
+				if err != nil {
+					return err
+				}
 				a, err := w.GetDilithiumAccountByIndex(c.Uint(flags.AccountIndexFlag.Name))
 				if err != nil {
 					return err
@@ -29,11 +35,42 @@ func getDilithiumKeySubCommands() []*cli.Command {
 
 				output := c.String("output")
 
-				d := keys.NewDilithiumKeys(output)
+				d, err := openStakingKeys(c, output, true)
+				if err != nil {
+					return err
+				}
 				d.Add(a)
 				return nil
 			},
 		},
+		{
+			Name:  "recover",
+			Usage: "Recovers the dilithium keys of validator accounts derived from a BIP-39 mnemonic",
+			Flags: []cli.Flag{
+				flags.MnemonicFileFlag,
+				flags.MnemonicPassphraseFlag,
+				flags.StartIndexFlag,
+				flags.CountFlag,
+				&cli.StringFlag{
+					Name:  "output",
+					Value: "dilithium_keys",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				d, err := openStakingKeys(c, c.String("output"), true)
+				if err != nil {
+					return err
+				}
+				mnemonic, err := inputMnemonic(c)
+				if err != nil {
+					return err
+				}
+				return d.RecoverFromMnemonic(
+					mnemonic,
+					c.String(flags.MnemonicPassphraseFlag.Name),
+					derivationIndices(c))
+			},
+		},
 		{
 			Name:  "list",
 			Usage: "List all dilithium keys",
@@ -44,11 +81,44 @@ func getDilithiumKeySubCommands() []*cli.Command {
 				},
 			},
 			Action: func(c *cli.Context) error {
-				w := keys.NewDilithiumKeys(c.String("src"))
+				w, err := openStakingKeys(c, c.String("src"), false)
+				if err != nil {
+					return err
+				}
 				w.List()
 				return nil
 			},
 		},
+		{
+			Name:  "migrate",
+			Usage: "Encrypts a plaintext dilithium keys file with a password",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "src",
+					Value: "dilithium_keys",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				name := c.String("src")
+				encrypted, err := keystore.IsEncryptedFile(name)
+				if err != nil {
+					return err
+				}
+				if encrypted {
+					return fmt.Errorf("dilithium keys file %s is already encrypted", name)
+				}
+				d := keys.NewDilithiumKeys(name)
+				password, err := inputNewPassword(c, flags.StakingKeysPasswordFileFlag, "dilithium keys file")
+				if err != nil {
+					return err
+				}
+				if err := d.Encrypt(password); err != nil {
+					return err
+				}
+				fmt.Println("Dilithium Keys Encrypted")
+				return nil
+			},
+		},
 	}
 }
 
@@ -58,6 +128,7 @@ func AddDilithiumKeyCommand(app *cli.App) {
 		Usage: "Commands to manage Dilithium Keys",
 		Flags: []cli.Flag{
 			flags.WalletFile,
+			flags.StakingKeysPasswordFileFlag,
 		},
 		Subcommands: getDilithiumKeySubCommands(),
 	})
@@ -3,7 +3,6 @@ package commands
 import (
 	"github.com/theQRL/zond/block/genesis"
 	"github.com/theQRL/zond/cli/flags"
-	"github.com/theQRL/zond/keys"
 	"github.com/urfave/cli/v2"
 )
 
@@ -22,6 +21,7 @@ func getGenesisSubCommands() []*cli.Command {
 					Name:  "validators-dilithium-keys",
 					Value: "dilithium_keys",
 				},
+				flags.StakingKeysPasswordFileFlag,
 				&cli.StringFlag{
 					Name:  "genesisFilename",
 					Value: "genesis.yml", // TODO: Move this to Dev Config
@@ -32,7 +32,10 @@ func getGenesisSubCommands() []*cli.Command {
 				},
 			},
 			Action: func(c *cli.Context) error {
-				d := keys.NewDilithiumKeys(c.String("validators-dilithium-keys"))
+				d, err := openStakingKeys(c, c.String("validators-dilithium-keys"), false)
+				if err != nil {
+					return err
+				}
 				return genesis.GenerateGenesis(c.Uint64("network-id"),
 					c.String("stake-txs-filename"),
 					d.GetDilithiumInfo(),
@@ -0,0 +1,110 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/theQRL/zond/cli/flags"
+	"github.com/theQRL/zond/crypto/keystore"
+	"github.com/theQRL/zond/io/prompt"
+	"github.com/theQRL/zond/keys"
+	"github.com/theQRL/zond/wallet"
+	"github.com/urfave/cli/v2"
+)
+
+// openWallet opens the wallet file given on the command line, prompting for its
+// password. A missing wallet is created encrypted if create is set, while a legacy
+// plaintext wallet is opened as is.
+func openWallet(c *cli.Context, create bool) (*wallet.Wallet, error) {
+	name := c.String(flags.WalletFile.Name)
+	encrypted, err := keystore.IsEncryptedFile(name)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		if !create {
+			return nil, fmt.Errorf("wallet %s does not exist", name)
+		}
+		password, err := inputNewPassword(c, flags.WalletPasswordFileFlag, "wallet")
+		if err != nil {
+			return nil, err
+		}
+		return wallet.OpenWallet(name, password)
+	case err != nil:
+		return nil, err
+	case !encrypted:
+		fmt.Printf("Warning: the wallet %s is not encrypted, run 'wallet migrate' to encrypt it\n", name)
+		return wallet.NewWallet(name), nil
+	}
+	password, err := inputPassword(c, flags.WalletPasswordFileFlag, "wallet")
+	if err != nil {
+		return nil, err
+	}
+	return wallet.OpenWallet(name, password)
+}
+
+// openStakingKeys opens the given dilithium keys file, prompting for its password.
+// A missing file is created encrypted if create is set, while a legacy plaintext file
+// is opened as is.
+func openStakingKeys(c *cli.Context, name string, create bool) (*keys.StakingKeys, error) {
+	encrypted, err := keystore.IsEncryptedFile(name)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		if !create {
+			return nil, fmt.Errorf("dilithium keys file %s does not exist", name)
+		}
+		password, err := inputNewPassword(c, flags.StakingKeysPasswordFileFlag, "dilithium keys file")
+		if err != nil {
+			return nil, err
+		}
+		return keys.OpenDilithiumKeys(name, password)
+	case err != nil:
+		return nil, err
+	case !encrypted:
+		fmt.Printf("Warning: the dilithium keys file %s is not encrypted, run 'dilithium-key migrate' to encrypt it\n", name)
+		return keys.NewDilithiumKeys(name), nil
+	}
+	password, err := inputPassword(c, flags.StakingKeysPasswordFileFlag, "dilithium keys file")
+	if err != nil {
+		return nil, err
+	}
+	return keys.OpenDilithiumKeys(name, password)
+}
+
+// inputPassword reads the password of an existing encrypted file from the given
+// password file flag, or prompts for it.
+func inputPassword(c *cli.Context, passwordFileFlag *cli.StringFlag, what string) (string, error) {
+	return prompt.InputPassword(
+		c,
+		passwordFileFlag,
+		fmt.Sprintf("Enter the password of the %s", what),
+		"",
+		false,
+		prompt.NotEmpty,
+	)
+}
+
+// inputMnemonic reads the mnemonic from the file given by the mnemonic file flag,
+// or prompts for it without echoing it.
+func inputMnemonic(c *cli.Context) (string, error) {
+	return prompt.InputPassword(
+		c,
+		flags.MnemonicFileFlag,
+		"Enter the mnemonic",
+		"",
+		false,
+		prompt.NotEmpty,
+	)
+}
+
+// inputNewPassword reads the password of a new encrypted file from the given password
+// file flag, or prompts for it and its confirmation.
+func inputNewPassword(c *cli.Context, passwordFileFlag *cli.StringFlag, what string) (string, error) {
+	return prompt.InputPassword(
+		c,
+		passwordFileFlag,
+		fmt.Sprintf("Enter a password for the new %s", what),
+		"Confirm the password",
+		true,
+		prompt.ValidatePasswordInput,
+	)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/theQRL/go-qrllib/xmss"
 	"github.com/theQRL/zond/cli/flags"
+	"github.com/theQRL/zond/crypto/keystore"
 	"github.com/theQRL/zond/wallet"
 	"github.com/urfave/cli/v2"
 )
@@ -22,7 +23,10 @@ func getWalletSubCommands() []*cli.Command {
 			},
 			Action: func(c *cli.Context) error {
 				hashType := xmss.SHA2_256
-				w := wallet.NewWallet(c.String(flags.WalletFile.Name))
+				w, err := openWallet(c, true)
+				if err != nil {
+					return err
+				}
 				w.AddXMSS(uint8(heightFlag.Value), hashType)
 
 				fmt.Println("Wallet Created")
@@ -34,18 +38,48 @@ func getWalletSubCommands() []*cli.Command {
 			Usage: "Adds a new Dilithium address into the Wallet",
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) error {
-				w := wallet.NewWallet(c.String(flags.WalletFile.Name))
+				w, err := openWallet(c, true)
+				if err != nil {
+					return err
+				}
 				w.AddDilithium()
 
 				fmt.Println("Wallet Created")
 				return nil
 			},
 		},
+		{
+			Name:  "recover-dilithium",
+			Usage: "Recovers the Dilithium addresses derived from a BIP-39 mnemonic into the Wallet",
+			Flags: []cli.Flag{
+				flags.MnemonicFileFlag,
+				flags.MnemonicPassphraseFlag,
+				flags.StartIndexFlag,
+				flags.CountFlag,
+			},
+			Action: func(c *cli.Context) error {
+				w, err := openWallet(c, true)
+				if err != nil {
+					return err
+				}
+				mnemonic, err := inputMnemonic(c)
+				if err != nil {
+					return err
+				}
+				return w.RecoverDilithiumFromMnemonic(
+					mnemonic,
+					c.String(flags.MnemonicPassphraseFlag.Name),
+					derivationIndices(c))
+			},
+		},
 		{
 			Name:  "list",
 			Usage: "List all addresses in a Wallet",
 			Action: func(c *cli.Context) error {
-				w := wallet.NewWallet(c.String(flags.WalletFile.Name))
+				w, err := openWallet(c, false)
+				if err != nil {
+					return err
+				}
 				w.List()
 				return nil
 			},
@@ -54,12 +88,49 @@ func getWalletSubCommands() []*cli.Command {
 			Name:  "secret",
 			Usage: "Show hexseed & mnemonic for the addresses in Wallet",
 			Action: func(c *cli.Context) error {
-				w := wallet.NewWallet(c.String(flags.WalletFile.Name))
+				w, err := openWallet(c, false)
+				if err != nil {
+					return err
+				}
 				w.Secret()
 				return nil
 			},
 		},
+		{
+			Name:  "migrate",
+			Usage: "Encrypts a plaintext Wallet with a password",
+			Action: func(c *cli.Context) error {
+				name := c.String(flags.WalletFile.Name)
+				encrypted, err := keystore.IsEncryptedFile(name)
+				if err != nil {
+					return err
+				}
+				if encrypted {
+					return fmt.Errorf("wallet %s is already encrypted", name)
+				}
+				w := wallet.NewWallet(name)
+				password, err := inputNewPassword(c, flags.WalletPasswordFileFlag, "wallet")
+				if err != nil {
+					return err
+				}
+				if err := w.Encrypt(password); err != nil {
+					return err
+				}
+				fmt.Println("Wallet Encrypted")
+				return nil
+			},
+		},
+	}
+}
+
+// derivationIndices returns the indices of the keys to derive from a mnemonic.
+func derivationIndices(c *cli.Context) []uint32 {
+	start := uint32(c.Uint(flags.StartIndexFlag.Name))
+	indices := make([]uint32, c.Uint(flags.CountFlag.Name))
+	for i := range indices {
+		indices[i] = start + uint32(i)
 	}
+	return indices
 }
 
 func AddWalletCommand(app *cli.App) {
@@ -68,6 +139,7 @@ func AddWalletCommand(app *cli.App) {
 		Usage: "Commands to manage Zond Wallet",
 		Flags: []cli.Flag{
 			flags.WalletFile,
+			flags.WalletPasswordFileFlag,
 		},
 		Subcommands: getWalletSubCommands(),
 	})
@@ -97,3 +97,42 @@ var TransactionStdOut = &cli.BoolFlag{
 	Name:  "std-out",
 	Value: true,
 }
+
+var MnemonicFileFlag = &cli.StringFlag{
+	Name:     "mnemonic-file",
+	Usage:    "Path to a file holding the BIP-39 mnemonic the Dilithium keys are derived from, instead of prompting for it",
+	Required: false,
+}
+
+var MnemonicPassphraseFlag = &cli.StringFlag{
+	Name:     "mnemonic-passphrase",
+	Usage:    "Optional BIP-39 passphrase of the mnemonic",
+	Value:    "",
+	Required: false,
+}
+
+var StartIndexFlag = &cli.UintFlag{
+	Name:     "start-index",
+	Usage:    "Index of the first key derived from the mnemonic",
+	Value:    0,
+	Required: false,
+}
+
+var CountFlag = &cli.UintFlag{
+	Name:     "count",
+	Usage:    "Number of keys derived from the mnemonic",
+	Value:    1,
+	Required: false,
+}
+
+var WalletPasswordFileFlag = &cli.StringFlag{
+	Name:     "wallet-password-file",
+	Usage:    "Path to a file holding the password of the wallet, instead of prompting for it",
+	Required: false,
+}
+
+var StakingKeysPasswordFileFlag = &cli.StringFlag{
+	Name:     "keys-password-file",
+	Usage:    "Path to a file holding the password of the dilithium keys file, instead of prompting for it",
+	Required: false,
+}
@@ -3,6 +3,7 @@ package execution
 import (
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -10,6 +11,8 @@ import (
 	"github.com/theQRL/zond/beacon-chain/execution"
 	"github.com/theQRL/zond/cmd/beacon-chain/flags"
 	"github.com/theQRL/zond/io/file"
+	"github.com/theQRL/zond/zond/gasprice"
+	"github.com/theQRL/zond/zond/zondconfig"
 	"github.com/urfave/cli/v2"
 )
 
@@ -28,6 +31,8 @@ func FlagOptions(c *cli.Context) ([]execution.Option, error) {
 		execution.WithHttpEndpoint(endpoint),
 		execution.WithEth1HeaderRequestLimit(c.Uint64(flags.Eth1HeaderReqLimit.Name)),
 		execution.WithHeaders(headers),
+		execution.WithAddressIndex(c.Bool(flags.ExecutionAddressIndex.Name)),
+		execution.WithGasPriceOracle(gasPriceOracleConfig(c)),
 	}
 	if len(jwtSecret) > 0 {
 		opts = append(opts, execution.WithHttpEndpointAndJWTSecret(endpoint, jwtSecret))
@@ -35,6 +40,25 @@ func FlagOptions(c *cli.Context) ([]execution.Option, error) {
 	return opts, nil
 }
 
+// gasPriceOracleConfig returns the default gas price oracle settings of the execution chain, overridden
+// by the flags that are set.
+func gasPriceOracleConfig(c *cli.Context) gasprice.Config {
+	cfg := zondconfig.Defaults.GPO
+	if c.IsSet(flags.ExecutionGPOBlocks.Name) {
+		cfg.Blocks = c.Int(flags.ExecutionGPOBlocks.Name)
+	}
+	if c.IsSet(flags.ExecutionGPOPercentile.Name) {
+		cfg.Percentile = c.Int(flags.ExecutionGPOPercentile.Name)
+	}
+	if c.IsSet(flags.ExecutionGPOMaxPrice.Name) {
+		cfg.MaxPrice = big.NewInt(c.Int64(flags.ExecutionGPOMaxPrice.Name))
+	}
+	if c.IsSet(flags.ExecutionGPOIgnorePrice.Name) {
+		cfg.IgnorePrice = big.NewInt(c.Int64(flags.ExecutionGPOIgnorePrice.Name))
+	}
+	return cfg
+}
+
 // Parses a JWT secret from a file path. This secret is required when connecting to execution nodes
 // over HTTP, and must be the same one used in Prysm and the execution node server Prysm is connecting to.
 // The engine API specification here https://github.com/ethereum/execution-apis/blob/main/src/engine/authentication.md
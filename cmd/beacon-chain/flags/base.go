@@ -38,6 +38,31 @@ var (
 		Usage: "A comma separated list of key value pairs to pass as HTTP headers for all execution " +
 			"client calls. Example: --execution-headers=key1=value1,key2=value2",
 	}
+	// ExecutionAddressIndex enables the index of the transactions touching each address on the execution chain.
+	ExecutionAddressIndex = &cli.BoolFlag{
+		Name:  "execution-address-index",
+		Usage: "Indexes the transactions touching each address on the execution chain, serving zond_getTransactionsByAddress",
+	}
+	// ExecutionGPOBlocks is the number of recent blocks the gas price oracle of the execution chain samples.
+	ExecutionGPOBlocks = &cli.IntFlag{
+		Name:  "execution-gpo-blocks",
+		Usage: "Number of recent execution blocks to check for gas prices (default: 20)",
+	}
+	// ExecutionGPOPercentile is the percentile of the sampled gas prices suggested by the gas price oracle.
+	ExecutionGPOPercentile = &cli.IntFlag{
+		Name:  "execution-gpo-percentile",
+		Usage: "Suggested gas price is the given percentile of a set of recent execution transaction gas prices (default: 60)",
+	}
+	// ExecutionGPOMaxPrice is the highest priority fee suggested by the gas price oracle.
+	ExecutionGPOMaxPrice = &cli.Int64Flag{
+		Name:  "execution-gpo-maxprice",
+		Usage: "Maximum transaction priority fee to be recommended by the gas price oracle (default: 500 gwei)",
+	}
+	// ExecutionGPOIgnorePrice is the gas price below which the gas price oracle ignores transactions.
+	ExecutionGPOIgnorePrice = &cli.Int64Flag{
+		Name:  "execution-gpo-ignoreprice",
+		Usage: "Gas price below which the gas price oracle will ignore transactions (default: 2 wei)",
+	}
 	// Deprecated: HTTPWeb3ProviderFlag is a deprecated flag and is an alias for the ExecutionEngineEndpoint flag.
 	HTTPWeb3ProviderFlag = &cli.StringFlag{
 		Name:   "http-web3provider",
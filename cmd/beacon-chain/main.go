@@ -18,6 +18,7 @@ import (
 	"github.com/theQRL/zond/cmd/beacon-chain/execution"
 	"github.com/theQRL/zond/cmd/beacon-chain/flags"
 	jwtcommands "github.com/theQRL/zond/cmd/beacon-chain/jwt"
+	backfillcmd "github.com/theQRL/zond/cmd/beacon-chain/sync/backfill"
 	"github.com/theQRL/zond/cmd/beacon-chain/sync/checkpoint"
 	"github.com/theQRL/zond/cmd/beacon-chain/sync/genesis"
 	"github.com/theQRL/zond/config/features"
@@ -37,6 +38,11 @@ var appFlags = []cli.Flag{
 	flags.DepositContractFlag,
 	flags.ExecutionEngineEndpoint,
 	flags.ExecutionEngineHeaders,
+	flags.ExecutionAddressIndex,
+	flags.ExecutionGPOBlocks,
+	flags.ExecutionGPOPercentile,
+	flags.ExecutionGPOMaxPrice,
+	flags.ExecutionGPOIgnorePrice,
 	flags.HTTPWeb3ProviderFlag,
 	flags.ExecutionJWTSecretFlag,
 	flags.RPCHost,
@@ -127,6 +133,8 @@ var appFlags = []cli.Flag{
 	checkpoint.BlockPath,
 	checkpoint.StatePath,
 	checkpoint.RemoteURL,
+	backfillcmd.OldestSlot,
+	backfillcmd.BatchSize,
 	genesis.StatePath,
 	genesis.BeaconAPIURL,
 }
@@ -264,10 +272,15 @@ func startNode(ctx *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	backfillFlagOpts, err := backfillcmd.FlagOptions(ctx)
+	if err != nil {
+		return err
+	}
 	opts := []node.Option{
 		node.WithBlockchainFlagOptions(blockchainFlagOpts),
 		node.WithExecutionChainOptions(executionFlagOpts),
 		node.WithBuilderFlagOptions(builderFlagOpts),
+		node.WithBackfillFlagOptions(backfillFlagOpts),
 	}
 
 	optFuncs := []func(*cli.Context) (node.Option, error){
@@ -0,0 +1,31 @@
+package backfill
+
+import (
+	"github.com/theQRL/zond/beacon-chain/sync/backfill"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// OldestSlot bounds how far back a checkpoint synced node downloads historical blocks.
+	OldestSlot = &cli.Uint64Flag{
+		Name: "backfill-oldest-slot",
+		Usage: "When the node was initialized via checkpoint sync, blocks older than the checkpoint are backfilled " +
+			"from peers down to this slot. Defaults to genesis.",
+	}
+	// BatchSize specifies the number of slots requested from a peer at once while backfilling.
+	BatchSize = &cli.Uint64Flag{
+		Name:  "backfill-batch-size",
+		Usage: "The number of slots requested from a peer in a single backfill request.",
+		Value: 64,
+	}
+)
+
+// FlagOptions for backfill service flag configurations.
+func FlagOptions(c *cli.Context) ([]backfill.ServiceOption, error) {
+	opts := []backfill.ServiceOption{
+		backfill.WithOldestSlot(types.Slot(c.Uint64(OldestSlot.Name))),
+		backfill.WithBatchSize(c.Uint64(BatchSize.Name)),
+	}
+	return opts, nil
+}
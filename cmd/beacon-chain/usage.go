@@ -7,6 +7,7 @@ import (
 
 	"github.com/theQRL/zond/cmd"
 	"github.com/theQRL/zond/cmd/beacon-chain/flags"
+	backfillcmd "github.com/theQRL/zond/cmd/beacon-chain/sync/backfill"
 	"github.com/theQRL/zond/cmd/beacon-chain/sync/checkpoint"
 	"github.com/theQRL/zond/cmd/beacon-chain/sync/genesis"
 	"github.com/theQRL/zond/config/features"
@@ -108,6 +109,11 @@ var appHelpFlagGroups = []flagGroup{
 			flags.GPRCGatewayCorsDomain,
 			flags.ExecutionEngineEndpoint,
 			flags.ExecutionEngineHeaders,
+			flags.ExecutionAddressIndex,
+			flags.ExecutionGPOBlocks,
+			flags.ExecutionGPOPercentile,
+			flags.ExecutionGPOMaxPrice,
+			flags.ExecutionGPOIgnorePrice,
 			flags.HTTPWeb3ProviderFlag,
 			flags.ExecutionJWTSecretFlag,
 			flags.SetGCPercent,
@@ -130,6 +136,8 @@ var appHelpFlagGroups = []flagGroup{
 			checkpoint.BlockPath,
 			checkpoint.StatePath,
 			checkpoint.RemoteURL,
+			backfillcmd.OldestSlot,
+			backfillcmd.BatchSize,
 			genesis.StatePath,
 			genesis.BeaconAPIURL,
 		},
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/theQRL/zond/core/rawdb"
+	"github.com/theQRL/zond/ethdb"
+	gethlog "github.com/theQRL/zond/log"
+	"github.com/theQRL/zond/node"
+	"github.com/theQRL/zond/zond/zondconfig"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	dataDirFlag = &cli.StringFlag{
+		Name:  "datadir",
+		Usage: "Data directory of the execution node, holding the chaindata database",
+		Value: node.DefaultDataDir(),
+	}
+	dbEngineFlag = &cli.StringFlag{
+		Name:  "db.engine",
+		Usage: "Backing database implementation to use ('leveldb' or 'pebble'), defaulting to the engine of the existing database",
+	}
+)
+
+// makeNodeConfig returns the configuration of the execution node using the data
+// directory given on the command line, so that paths are resolved the same way
+// the node resolves them, within its instance directory.
+func makeNodeConfig(ctx *cli.Context) *node.Config {
+	return &node.Config{DataDir: ctx.String(dataDirFlag.Name)}
+}
+
+// openChainDatabase opens the chaindata database of the execution node, together
+// with its ancient store, from the data directory given on the command line.
+func openChainDatabase(ctx *cli.Context, readonly bool) (ethdb.Database, error) {
+	config := zondconfig.Defaults
+	nodeConfig := makeNodeConfig(ctx)
+	chaindata := nodeConfig.ResolvePath("chaindata")
+	ancient := config.DatabaseFreezer
+	switch {
+	case ancient == "":
+		ancient = filepath.Join(chaindata, "ancient")
+	case !filepath.IsAbs(ancient):
+		ancient = nodeConfig.ResolvePath(ancient)
+	}
+	return rawdb.Open(rawdb.OpenOptions{
+		Type:              ctx.String(dbEngineFlag.Name),
+		Directory:         chaindata,
+		AncientsDirectory: ancient,
+		Namespace:         "zond/db/chaindata/",
+		Cache:             config.DatabaseCache,
+		Handles:           config.DatabaseHandles,
+		ReadOnly:          readonly,
+	})
+}
+
+// setupDatabaseLogging surfaces the logs of the execution layer packages, such as
+// the progress of long running database operations.
+func setupDatabaseLogging(*cli.Context) error {
+	glogger := gethlog.NewGlogHandler(gethlog.StreamHandler(os.Stderr, gethlog.TerminalFormat(true)))
+	glogger.Verbosity(gethlog.LvlInfo)
+	gethlog.Root().SetHandler(glogger)
+	return nil
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/theQRL/zond/common/hexutil"
+	"github.com/theQRL/zond/core/rawdb"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	outputFlag = &cli.StringFlag{
+		Name:  "output",
+		Usage: "Output format of the statistics ('text' or 'json')",
+		Value: "text",
+	}
+)
+
+var dbCommand = &cli.Command{
+	Name:  "db",
+	Usage: "Low level database operations",
+	Description: `
+The execution node must be stopped while any of these commands run.`,
+	Before: setupDatabaseLogging,
+	Subcommands: []*cli.Command{
+		{
+			Name:      "inspect",
+			Usage:     "Inspect the storage size for each type of data in the database",
+			ArgsUsage: "<prefix> <start>",
+			Action:    inspect,
+			Flags: []cli.Flag{
+				dataDirFlag,
+				dbEngineFlag,
+				outputFlag,
+			},
+			Description: `
+gzond db inspect [<prefix> [<start>]]
+will iterate the entire key-value store of the execution database, reporting
+the number and total size of the entries of each category, as well as the size
+of the ancient store tables. If the optional hex encoded 'prefix' and 'start'
+arguments are provided, the iteration is limited to the keys with the given
+prefix, starting at the given key.`,
+		},
+	},
+}
+
+func inspect(ctx *cli.Context) error {
+	var (
+		prefix []byte
+		start  []byte
+	)
+	if ctx.NArg() > 2 {
+		return fmt.Errorf("max 2 arguments: %v", ctx.Command.ArgsUsage)
+	}
+	if ctx.NArg() >= 1 {
+		d, err := hexutil.Decode(ctx.Args().Get(0))
+		if err != nil {
+			return fmt.Errorf("failed to hex-decode 'prefix': %v", err)
+		}
+		prefix = d
+	}
+	if ctx.NArg() >= 2 {
+		d, err := hexutil.Decode(ctx.Args().Get(1))
+		if err != nil {
+			return fmt.Errorf("failed to hex-decode 'start': %v", err)
+		}
+		start = d
+	}
+	output := ctx.String(outputFlag.Name)
+	if output != "text" && output != "json" {
+		return errors.New("output format must be 'text' or 'json'")
+	}
+	db, err := openChainDatabase(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	stats, err := rawdb.InspectDatabaseStats(db, prefix, start)
+	if err != nil {
+		return err
+	}
+	if output == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		encoder.SetEscapeHTML(false)
+		return encoder.Encode(stats)
+	}
+	stats.Render(os.Stdout)
+	return nil
+}
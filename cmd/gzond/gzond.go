@@ -1,7 +1,10 @@
 package main
 
 import (
+	"errors"
+	"fmt"
 	"io/ioutil"
+	"math/big"
 	"os"
 	"os/signal"
 	"time"
@@ -21,20 +24,85 @@ import (
 	"github.com/theQRL/zond/p2p"
 	"github.com/theQRL/zond/state"
 	"github.com/theQRL/zond/zond"
+	"github.com/theQRL/zond/zond/gasprice"
 	"github.com/theQRL/zond/zond/tracers"
+	_ "github.com/theQRL/zond/zond/tracers/native"
+	"github.com/theQRL/zond/zond/zondconfig"
+	"github.com/urfave/cli/v2"
 	prefixed "github.com/x-cray/logrus-prefixed-formatter"
 )
 
 var (
 	publicAPIServer *api.PublicAPIServer
+
+	publicAPIRateLimitFlag = &cli.Float64Flag{
+		Name:  "api.ratelimit",
+		Usage: "Requests per second allowed for each client IP by the public API",
+		Value: config.GetUserConfig().API.PublicAPI.RateLimit,
+	}
+	publicAPIRateLimitBurstFlag = &cli.IntFlag{
+		Name:  "api.ratelimit.burst",
+		Usage: "Maximum burst of requests allowed for each client IP by the public API",
+		Value: config.GetUserConfig().API.PublicAPI.RateLimitBurst,
+	}
+	publicAPICORSOriginsFlag = &cli.StringSliceFlag{
+		Name:  "api.corsorigins",
+		Usage: "Origins allowed to make cross-origin requests to the public API ('*' allows all), none by default",
+	}
+	gpoBlocksFlag = &cli.IntFlag{
+		Name:  "gpo.blocks",
+		Usage: "Number of recent blocks to check for gas prices",
+		Value: zondconfig.Defaults.GPO.Blocks,
+	}
+	gpoPercentileFlag = &cli.IntFlag{
+		Name:  "gpo.percentile",
+		Usage: "Suggested gas price is the given percentile of a set of recent transaction gas prices",
+		Value: zondconfig.Defaults.GPO.Percentile,
+	}
+	gpoMaxGasPriceFlag = &cli.Int64Flag{
+		Name:  "gpo.maxprice",
+		Usage: "Maximum transaction priority fee to be recommended by the gas price oracle",
+		Value: zondconfig.Defaults.GPO.MaxPrice.Int64(),
+	}
+	gpoIgnoreGasPriceFlag = &cli.Int64Flag{
+		Name:  "gpo.ignoreprice",
+		Usage: "Gas price below which the gas price oracle will ignore transactions",
+		Value: zondconfig.Defaults.GPO.IgnorePrice.Int64(),
+	}
+
+	nodeFlags = []cli.Flag{
+		publicAPIRateLimitFlag,
+		publicAPIRateLimitBurstFlag,
+		publicAPICORSOriginsFlag,
+		gpoBlocksFlag,
+		gpoPercentileFlag,
+		gpoMaxGasPriceFlag,
+		gpoIgnoreGasPriceFlag,
+		dbEngineFlag,
+	}
 )
 
+// applyAPIFlags overrides the public API settings with the values given on the command line.
+func applyAPIFlags(ctx *cli.Context, c *config.APIConfig) {
+	c.RateLimit = ctx.Float64(publicAPIRateLimitFlag.Name)
+	c.RateLimitBurst = ctx.Int(publicAPIRateLimitBurstFlag.Name)
+	c.CORSAllowedOrigins = ctx.StringSlice(publicAPICORSOriginsFlag.Name)
+}
+
+// applyGPOFlags overrides the gas price oracle settings with the values given on the command line.
+func applyGPOFlags(ctx *cli.Context, c *gasprice.Config) {
+	c.Blocks = ctx.Int(gpoBlocksFlag.Name)
+	c.Percentile = ctx.Int(gpoPercentileFlag.Name)
+	c.MaxPrice = big.NewInt(ctx.Int64(gpoMaxGasPriceFlag.Name))
+	c.IgnorePrice = big.NewInt(ctx.Int64(gpoIgnoreGasPriceFlag.Name))
+}
+
 func ConfigCheck() bool {
 	return true
 }
 
-// func run(c *chain.Chain, db *db.DB, keys crypto.PrivKey) error {
-func run(c *chain.Chain, db *db.DB, keys crypto.PrivKey) error {
+// func run(c *chain.Chain, db *db.DB, keys crypto.PrivKey, zondConfig *zondconfig.Config) error {
+func run(c *chain.Chain, db *db.DB, keys crypto.PrivKey, zondConfig *zondconfig.Config) error {
 	srv, err := p2p.NewServer(c)
 	if err != nil {
 		log.Error("Failed to initialize server")
@@ -61,7 +129,7 @@ func run(c *chain.Chain, db *db.DB, keys crypto.PrivKey) error {
 		return err
 	}
 
-	backend, err := zond.NewV1(stack, pos)
+	backend, err := zond.NewV1(stack, zondConfig, pos, srv)
 	if err != nil {
 		log.Error("Error creating zond backend")
 		return err
@@ -77,6 +145,7 @@ func run(c *chain.Chain, db *db.DB, keys crypto.PrivKey) error {
 
 	go pos.Run()
 	defer stack.Close()
+	defer backend.Stop()
 	defer pos.Stop()
 
 	quit := make(chan os.Signal, 1)
@@ -149,50 +218,70 @@ func loadP2PDilithiumKey(filePath string) (crypto.PrivKey, error) {
 	//return crypto2.UnmarshalDilithiumPrivateKey(data)
 }
 
+var app = cli.NewApp()
+
+func init() {
+	app.Name = "gzond"
+	app.Usage = "Zond node"
+	app.Action = gzond
+	app.Flags = nodeFlags
+	app.Commands = []*cli.Command{
+		snapshotCommand,
+		dbCommand,
+		exportHistoryCommand,
+		importHistoryCommand,
+	}
+}
+
 func main() {
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// gzond is the main entry point into the system if no special subcommand is run.
+func gzond(ctx *cli.Context) error {
 	userConfig := config.GetUserConfig()
 	devConfig := config.GetDevConfig()
+	applyAPIFlags(ctx, config.GetConfig().User.API.PublicAPI)
+	config.GetConfig().User.DBEngine = ctx.String(dbEngineFlag.Name)
 
 	err := CreateDirectoryIfNotExists(userConfig.DataDir())
 	if err != nil {
-		log.Error("Error creating data directory ", err.Error())
-		return
+		return fmt.Errorf("could not create data directory: %w", err)
 	}
 
 	err = SetLogOutput()
 	if err != nil {
-		log.Error("Error in Set Log Output ", err.Error())
-		return
+		return fmt.Errorf("could not set log output: %w", err)
 	}
 
 	if !ConfigCheck() {
-		log.Error("Invalid Config")
-		return
+		return errors.New("invalid config")
 	}
 
 	crypto2.LoadAllExtendedKeyTypes()
 	keys, err := loadP2PDilithiumKey(userConfig.GetAbsoluteNodeKeyFilePath())
 	if err != nil {
-		log.Error("Failed to loadP2PDilithiumKey ", err.Error())
-		return
+		return fmt.Errorf("could not load p2p key: %w", err)
 	}
 
 	s, err := state.NewState(userConfig.DataDir(), devConfig.DBName)
 	if err != nil {
-		log.Error("Error while loading state ", err.Error())
-		return
+		return fmt.Errorf("could not load state: %w", err)
 	}
 
 	c := chain.NewChain(s)
 	if err := c.Load(); err != nil {
-		log.Error("Error loading chain state ", err.Error())
-		return
+		return fmt.Errorf("could not load chain state: %w", err)
 	}
 	log.Info("Main Chain Loaded Successfully")
 
-	err = run(c, s.DB(), keys)
-	if err != nil {
-		log.Error("Initialization Error ", err.Error())
+	zondConfig := zondconfig.Defaults
+	applyGPOFlags(ctx, &zondConfig.GPO)
+	if err := run(c, s.DB(), keys, &zondConfig); err != nil {
+		return fmt.Errorf("initialization error: %w", err)
 	}
 
 	/*
@@ -201,4 +290,5 @@ func main() {
 		3.
 	*/
 	log.Info("Shutting Down Node")
+	return nil
 }
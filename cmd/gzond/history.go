@@ -0,0 +1,259 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/theQRL/zond/cmd/gzond/history"
+	"github.com/theQRL/zond/common"
+	"github.com/theQRL/zond/core/rawdb"
+	"github.com/theQRL/zond/internal/era"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	eraNetworkFlag = &cli.StringFlag{
+		Name:  "era.network",
+		Usage: "Network name used in the era1 archive file names",
+		Value: "zond",
+	}
+	eraStepFlag = &cli.Uint64Flag{
+		Name:  "era.step",
+		Usage: "Number of blocks per era1 archive",
+		Value: uint64(era.MaxEra1Size),
+	}
+)
+
+var (
+	exportHistoryCommand = &cli.Command{
+		Name:      "export-history",
+		Usage:     "Export blockchain history to era1 archives",
+		ArgsUsage: "<dir> <first> <last>",
+		Action:    exportHistory,
+		Before:    setupDatabaseLogging,
+		Flags: []cli.Flag{
+			dataDirFlag,
+			dbEngineFlag,
+			eraNetworkFlag,
+			eraStepFlag,
+		},
+		Description: `
+gzond export-history <dir> <first> <last>
+will export the blocks, receipts and total difficulties of the canonical chain
+in the range [first, last] to era1 archives in the given directory, each holding
+the blocks of one epoch of 'era.step' blocks. The archives are named
+<network>-<epoch>-<accumulator root>.era1, and their sha256 checksums are listed
+in checksums.txt. The first block must be the first block of an epoch.
+
+The execution node must be stopped while this command runs.`,
+	}
+	importHistoryCommand = &cli.Command{
+		Name:      "import-history",
+		Usage:     "Import blockchain history from era1 archives",
+		ArgsUsage: "<dir>",
+		Action:    importHistory,
+		Before:    setupDatabaseLogging,
+		Flags: []cli.Flag{
+			dataDirFlag,
+			dbEngineFlag,
+			eraNetworkFlag,
+		},
+		Description: `
+gzond import-history <dir>
+will import the era1 archives of the given network found in the directory into
+the ancient store of a database holding only the genesis block, such as the one
+of a newly initialized node. The node then syncs the state and the blocks after
+the imported history from its peers.
+
+The archives are checked against checksums.txt, if present, and every archive
+is verified before it is imported: its accumulator root is recomputed from the
+contained headers and total difficulties, the transactions and receipts are
+checked against the headers, and the blocks must extend the local genesis
+block. An interrupted import is resumed by running the command again.
+
+The execution node must be stopped while this command runs.`,
+	}
+)
+
+func exportHistory(ctx *cli.Context) error {
+	if ctx.NArg() != 3 {
+		return fmt.Errorf("expected 3 arguments: %v", ctx.Command.ArgsUsage)
+	}
+	dir := ctx.Args().Get(0)
+	first, ferr := strconv.ParseUint(ctx.Args().Get(1), 10, 64)
+	last, lerr := strconv.ParseUint(ctx.Args().Get(2), 10, 64)
+	if ferr != nil || lerr != nil {
+		return errors.New("export error in parsing parameters: block number not an integer")
+	}
+	step := ctx.Uint64(eraStepFlag.Name)
+	switch {
+	case step == 0 || step > uint64(era.MaxEra1Size):
+		return fmt.Errorf("era step must be between 1 and %d", era.MaxEra1Size)
+	case first > last:
+		return fmt.Errorf("invalid block range: first %d is after last %d", first, last)
+	case first%step != 0:
+		return fmt.Errorf("first block %d is not the first block of an epoch of %d blocks", first, step)
+	}
+	db, err := openChainDatabase(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	// Blocks are exported up to the most recent block whose body and receipts are
+	// present, which is ahead of the head block while a snap sync is running.
+	headHash := rawdb.ReadHeadFastBlockHash(db)
+	headNumber := rawdb.ReadHeaderNumber(db, headHash)
+	if headNumber == nil {
+		return errors.New("no head block")
+	}
+	if last > *headNumber {
+		log.WithFields(log.Fields{
+			"last": last,
+			"head": *headNumber,
+		}).Warn("Last block beyond head, exporting up to head")
+		last = *headNumber
+	}
+	if first > last {
+		return fmt.Errorf("first block %d is beyond head %d", first, last)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("error creating output directory: %w", err)
+	}
+	var (
+		network   = ctx.String(eraNetworkFlag.Name)
+		start     = time.Now()
+		reported  = time.Now()
+		checksums []string
+	)
+	for i := first; i <= last; i += step {
+		end := i + step - 1
+		if end > last {
+			end = last
+		}
+		checksum, err := history.ExportEra(db, dir, network, int(i/step), i, end)
+		if err != nil {
+			return err
+		}
+		checksums = append(checksums, checksum.Hex())
+
+		if time.Since(reported) >= 8*time.Second {
+			log.WithFields(log.Fields{
+				"exported": end,
+				"elapsed":  common.PrettyDuration(time.Since(start)),
+			}).Info("Exporting blocks")
+			reported = time.Now()
+		}
+		if end == last {
+			break
+		}
+	}
+	checksumsData := []byte(strings.Join(checksums, "\n") + "\n")
+	if err := os.WriteFile(filepath.Join(dir, history.ChecksumsFile), checksumsData, 0644); err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"dir":     dir,
+		"first":   first,
+		"last":    last,
+		"elapsed": common.PrettyDuration(time.Since(start)),
+	}).Info("Exported blockchain history")
+	return nil
+}
+
+func importHistory(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return fmt.Errorf("expected 1 argument: %v", ctx.Command.ArgsUsage)
+	}
+	dir := ctx.Args().First()
+	entries, err := era.ReadDir(dir, ctx.String(eraNetworkFlag.Name))
+	if err != nil {
+		return fmt.Errorf("error reading %s: %w", dir, err)
+	}
+	if len(entries) == 0 {
+		return fmt.Errorf("no era1 archives found in %s", dir)
+	}
+	checksums, err := history.ReadChecksums(dir)
+	if err != nil {
+		return err
+	}
+	if checksums != nil && len(checksums) != len(entries) {
+		return fmt.Errorf("mismatched number of checksums: have %d, want %d", len(checksums), len(entries))
+	}
+	db, err := openChainDatabase(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	// The history may only be imported below the data of the local chain, which
+	// therefore may hold nothing but the genesis block and previously imported
+	// archives.
+	frozen, err := db.Ancients()
+	if err != nil {
+		return err
+	}
+	genesis := rawdb.ReadCanonicalHash(db, 0)
+	if genesis == (common.Hash{}) {
+		return errors.New("database is not initialized with a genesis block")
+	}
+	if head := rawdb.ReadHeaderNumber(db, rawdb.ReadHeadBlockHash(db)); head == nil || *head != 0 {
+		return errors.New("history can only be imported into a database without blocks beyond genesis")
+	}
+	if head := rawdb.ReadHeaderNumber(db, rawdb.ReadHeadHeaderHash(db)); head == nil || (*head != 0 && *head+1 != frozen) {
+		return errors.New("history can only be imported into a database without headers beyond genesis")
+	}
+	var (
+		start    = time.Now()
+		reported = time.Now()
+		imported int
+	)
+	for i, name := range entries {
+		path := filepath.Join(dir, name)
+		if checksums != nil {
+			if err := history.VerifyChecksum(path, checksums[i]); err != nil {
+				return err
+			}
+		}
+		e, err := era.Open(path)
+		if err != nil {
+			return fmt.Errorf("error opening era file %s: %w", name, err)
+		}
+		err = history.ImportEra(db, e, frozen, genesis)
+		e.Close()
+		if err != nil {
+			return fmt.Errorf("error importing %s: %w", name, err)
+		}
+		if frozen, err = db.Ancients(); err != nil {
+			return err
+		}
+		imported++
+
+		if time.Since(reported) >= 8*time.Second {
+			log.WithFields(log.Fields{
+				"head":     frozen - 1,
+				"imported": imported,
+				"total":    len(entries),
+				"elapsed":  common.PrettyDuration(time.Since(start)),
+			}).Info("Importing era files")
+			reported = time.Now()
+		}
+	}
+	// Index the imported blocks, then mark them as the head of the header chain
+	// and of the fast synced blocks.
+	rawdb.InitDatabaseFromFreezer(db)
+	rawdb.IndexTransactions(db, 0, frozen, nil)
+
+	log.WithFields(log.Fields{
+		"dir":     dir,
+		"blocks":  frozen,
+		"elapsed": common.PrettyDuration(time.Since(start)),
+	}).Info("Imported blockchain history")
+	return nil
+}
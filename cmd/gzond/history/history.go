@@ -0,0 +1,231 @@
+// Package history exports the canonical chain to era1 archives and imports the
+// history of era1 archives into the ancient store.
+package history
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/theQRL/zond/common"
+	"github.com/theQRL/zond/core/rawdb"
+	"github.com/theQRL/zond/core/types"
+	"github.com/theQRL/zond/ethdb"
+	"github.com/theQRL/zond/internal/era"
+	"github.com/theQRL/zond/trie"
+)
+
+// ChecksumsFile is the file listing the sha256 checksums of the exported era1
+// archives, one per line in epoch order.
+const ChecksumsFile = "checksums.txt"
+
+// ExportEra writes the canonical blocks in the range [first, last] to the era1
+// archive of the given epoch, returning the sha256 checksum of the archive.
+func ExportEra(db ethdb.Database, dir, network string, epoch int, first, last uint64) (common.Hash, error) {
+	filename := filepath.Join(dir, era.Filename(network, epoch, common.Hash{}))
+	f, err := os.Create(filename)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("could not create era file: %w", err)
+	}
+	defer f.Close()
+
+	w := era.NewBuilder(f)
+	for number := first; number <= last; number++ {
+		hash := rawdb.ReadCanonicalHash(db, number)
+		block := rawdb.ReadBlock(db, hash, number)
+		if block == nil {
+			return common.Hash{}, fmt.Errorf("export failed on #%d: block not found", number)
+		}
+		td := rawdb.ReadTd(db, hash, number)
+		if td == nil {
+			return common.Hash{}, fmt.Errorf("export failed on #%d: total difficulty not found", number)
+		}
+		receipts := rawdb.ReadRawReceipts(db, hash, number)
+		if len(receipts) != len(block.Transactions()) {
+			return common.Hash{}, fmt.Errorf("export failed on #%d: receipts not found", number)
+		}
+		// The stored receipts lack their type, which is part of their consensus encoding
+		for j, tx := range block.Transactions() {
+			receipts[j].Type = tx.Type()
+		}
+		if err := w.Add(block, receipts, td); err != nil {
+			return common.Hash{}, fmt.Errorf("export failed on #%d: %w", number, err)
+		}
+	}
+	root, err := w.Finalize()
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("export failed to finalize epoch %d: %w", epoch, err)
+	}
+	// Compute the checksum of the entire archive, then name it after its root
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return common.Hash{}, err
+	}
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return common.Hash{}, fmt.Errorf("unable to calculate checksum: %w", err)
+	}
+	if err := os.Rename(filename, filepath.Join(dir, era.Filename(network, epoch, root))); err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(h.Sum(nil)), nil
+}
+
+// ImportEra verifies the given era1 archive and appends its blocks to the ancient
+// store, which holds the given number of blocks. Archives which were already
+// imported are skipped.
+//
+// The blocks are written into the ancient store directly rather than inserted
+// through the blockchain, which needs a consensus engine to verify the headers.
+// The headers are instead verified to form a chain extending the local genesis
+// block, whose total difficulties and accumulator root match the ones recorded
+// in the archive.
+func ImportEra(db ethdb.Database, e *era.Era, frozen uint64, genesis common.Hash) error {
+	if e.Start()+e.Count() <= frozen {
+		return nil
+	}
+	if e.Start() != frozen {
+		return fmt.Errorf("archive starts at block %d, expected %d", e.Start(), frozen)
+	}
+	var (
+		parent common.Hash
+		td     *big.Int
+	)
+	if frozen > 0 {
+		parent = rawdb.ReadCanonicalHash(db, frozen-1)
+		td = rawdb.ReadTd(db, parent, frozen-1)
+		if td == nil {
+			return fmt.Errorf("total difficulty of block %d not found", frozen-1)
+		}
+		td = new(big.Int).Set(td)
+	} else {
+		td = new(big.Int)
+	}
+	it, err := era.NewIterator(e)
+	if err != nil {
+		return err
+	}
+	var (
+		blocks   = make([]*types.Block, 0, e.Count())
+		receipts = make([]types.Receipts, 0, e.Count())
+		hashes   = make([]common.Hash, 0, e.Count())
+		tds      = make([]*big.Int, 0, e.Count())
+	)
+	for it.Next() {
+		if err := it.Error(); err != nil {
+			return err
+		}
+		block, blockReceipts, err := it.BlockAndReceipts()
+		if err != nil {
+			return fmt.Errorf("error reading block %d: %w", it.Number(), err)
+		}
+		recordedTd, err := it.TotalDifficulty()
+		if err != nil {
+			return fmt.Errorf("error reading total difficulty of block %d: %w", it.Number(), err)
+		}
+		if err := verifyEraBlock(block, blockReceipts, it.Number(), parent, genesis); err != nil {
+			return err
+		}
+		td.Add(td, block.Difficulty())
+		if td.Cmp(recordedTd) != 0 {
+			return fmt.Errorf("total difficulty mismatch of block %d: have %v, want %v", block.NumberU64(), recordedTd, td)
+		}
+		parent = block.Hash()
+
+		blocks = append(blocks, block)
+		receipts = append(receipts, blockReceipts)
+		hashes = append(hashes, block.Hash())
+		tds = append(tds, new(big.Int).Set(td))
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	want, err := e.Accumulator()
+	if err != nil {
+		return fmt.Errorf("error reading accumulator: %w", err)
+	}
+	root, err := era.ComputeAccumulator(hashes, tds)
+	if err != nil {
+		return fmt.Errorf("error computing accumulator: %w", err)
+	}
+	if root != want {
+		return fmt.Errorf("accumulator mismatch: have %x, want %x", root, want)
+	}
+	if _, err := rawdb.WriteAncientBlocks(db, blocks, receipts, tds[0]); err != nil {
+		return err
+	}
+	return db.Sync()
+}
+
+// verifyEraBlock checks that the block read from an era1 archive has the expected
+// number and parent, and that its transactions and receipts match its header.
+func verifyEraBlock(block *types.Block, receipts types.Receipts, number uint64, parent, genesis common.Hash) error {
+	if block.NumberU64() != number {
+		return fmt.Errorf("block number mismatch: have %d, want %d", block.NumberU64(), number)
+	}
+	if number == 0 {
+		if block.Hash() != genesis {
+			return fmt.Errorf("genesis mismatch: have %x, want %x", block.Hash(), genesis)
+		}
+	} else if block.ParentHash() != parent {
+		return fmt.Errorf("block %d does not extend block %x", number, parent)
+	}
+	if hash := types.DeriveSha(block.Transactions(), trie.NewStackTrie(nil)); hash != block.TxHash() {
+		return fmt.Errorf("transaction root mismatch of block %d: have %x, want %x", number, hash, block.TxHash())
+	}
+	if hash := types.DeriveSha(receipts, trie.NewStackTrie(nil)); hash != block.ReceiptHash() {
+		return fmt.Errorf("receipt root mismatch of block %d: have %x, want %x", number, hash, block.ReceiptHash())
+	}
+	return nil
+}
+
+// ReadChecksums reads the checksums of the era1 archives in the directory, or
+// returns nil if the directory holds no checksums file.
+func ReadChecksums(dir string) ([]common.Hash, error) {
+	f, err := os.Open(filepath.Join(dir, ChecksumsFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	checksums := []common.Hash{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		checksum := common.HexToHash(line)
+		if checksum.Hex() != strings.ToLower(line) {
+			return nil, fmt.Errorf("malformed checksum: %s", line)
+		}
+		checksums = append(checksums, checksum)
+	}
+	return checksums, scanner.Err()
+}
+
+// VerifyChecksum checks the sha256 checksum of the given era1 archive.
+func VerifyChecksum(path string, want common.Hash) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return fmt.Errorf("unable to calculate checksum: %w", err)
+	}
+	if have := common.BytesToHash(h.Sum(nil)); have != want {
+		return fmt.Errorf("checksum mismatch of %s: have %s, want %s", filepath.Base(path), have.Hex(), want.Hex())
+	}
+	return nil
+}
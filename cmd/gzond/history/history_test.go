@@ -0,0 +1,252 @@
+package history
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/theQRL/zond/common"
+	"github.com/theQRL/zond/core/rawdb"
+	"github.com/theQRL/zond/core/types"
+	"github.com/theQRL/zond/ethdb"
+	"github.com/theQRL/zond/ethdb/memorydb"
+	"github.com/theQRL/zond/internal/era"
+	"github.com/theQRL/zond/internal/era/e2store"
+)
+
+const historyTestBlocks = 8
+
+// newHistoryTestChain writes a canonical chain of empty blocks, each of
+// difficulty 2, and returns them with their total difficulties.
+func newHistoryTestChain(t *testing.T) (ethdb.Database, []*types.Block, []*big.Int) {
+	var (
+		db     = rawdb.NewMemoryDatabase()
+		blocks []*types.Block
+		tds    []*big.Int
+		parent common.Hash
+		td     = new(big.Int)
+	)
+	for number := uint64(0); number < historyTestBlocks; number++ {
+		block := types.NewBlockWithHeader(&types.Header{
+			ParentHash:  parent,
+			Number:      new(big.Int).SetUint64(number),
+			Difficulty:  big.NewInt(2),
+			TxHash:      types.EmptyRootHash,
+			ReceiptHash: types.EmptyRootHash,
+			Time:        number,
+		})
+		td = new(big.Int).Add(td, block.Difficulty())
+		rawdb.WriteBlock(db, block)
+		rawdb.WriteCanonicalHash(db, block.Hash(), number)
+		rawdb.WriteTd(db, block.Hash(), number, td)
+		rawdb.WriteReceipts(db, block.Hash(), number, nil)
+
+		blocks = append(blocks, block)
+		tds = append(tds, td)
+		parent = block.Hash()
+	}
+	rawdb.WriteHeadBlockHash(db, parent)
+	return db, blocks, tds
+}
+
+// newFreezerDatabase returns an empty database with a freezer to import into.
+func newFreezerDatabase(t *testing.T) ethdb.Database {
+	db, err := rawdb.NewDatabaseWithFreezer(memorydb.New(), t.TempDir(), "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// writeEra writes the blocks into an era1 archive with the given total
+// difficulties and returns its path.
+func writeEra(t *testing.T, blocks []*types.Block, tds []*big.Int) string {
+	path := filepath.Join(t.TempDir(), era.Filename("test", 0, common.Hash{}))
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	builder := era.NewBuilder(f)
+	for i, block := range blocks {
+		if err := builder.Add(block, nil, tds[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := builder.Finalize(); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func openEra(t *testing.T, path string) *era.Era {
+	e, err := era.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { e.Close() })
+	return e
+}
+
+func TestExportImportEra(t *testing.T) {
+	src, blocks, tds := newHistoryTestChain(t)
+	dir := t.TempDir()
+	checksum, err := ExportEra(src, dir, "test", 0, 0, historyTestBlocks-1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, err := era.ReadDir(dir, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 1 {
+		t.Fatalf("expected a single era1 archive, got %v", names)
+	}
+	path := filepath.Join(dir, names[0])
+	if err := VerifyChecksum(path, checksum); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyChecksum(path, common.Hash{}); err == nil {
+		t.Error("expected a checksum mismatch")
+	}
+
+	db := newFreezerDatabase(t)
+	e := openEra(t, path)
+	if err := ImportEra(db, e, 0, common.Hash{0x01}); err == nil || !strings.Contains(err.Error(), "genesis mismatch") {
+		t.Fatalf("expected a genesis mismatch, got %v", err)
+	}
+	if err := ImportEra(db, e, 1, blocks[0].Hash()); err == nil || !strings.Contains(err.Error(), "archive starts at block 0") {
+		t.Fatalf("expected an archive start mismatch, got %v", err)
+	}
+	if err := ImportEra(db, e, 0, blocks[0].Hash()); err != nil {
+		t.Fatal(err)
+	}
+	if frozen, err := db.Ancients(); err != nil || frozen != historyTestBlocks {
+		t.Fatalf("unexpected number %d of imported blocks: %v", frozen, err)
+	}
+	for i, block := range blocks {
+		number := block.NumberU64()
+		if hash := rawdb.ReadCanonicalHash(db, number); hash != block.Hash() {
+			t.Errorf("unexpected canonical hash %x of block %d, want %x", hash, number, block.Hash())
+		}
+		if td := rawdb.ReadTd(db, block.Hash(), number); td == nil || td.Cmp(tds[i]) != 0 {
+			t.Errorf("unexpected total difficulty %v of block %d, want %v", td, number, tds[i])
+		}
+	}
+
+	// Archives below the imported history are skipped
+	if err := ImportEra(db, e, historyTestBlocks, blocks[0].Hash()); err != nil {
+		t.Errorf("unexpected error importing an archive twice: %v", err)
+	}
+}
+
+func TestImportEraTamperedTd(t *testing.T) {
+	_, blocks, tds := newHistoryTestChain(t)
+	tampered := append([]*big.Int{}, tds...)
+	tampered[3] = new(big.Int).Add(tds[3], common.Big1)
+
+	db := newFreezerDatabase(t)
+	e := openEra(t, writeEra(t, blocks, tampered))
+	if err := ImportEra(db, e, 0, blocks[0].Hash()); err == nil || !strings.Contains(err.Error(), "total difficulty mismatch of block 3") {
+		t.Fatalf("expected a total difficulty mismatch, got %v", err)
+	}
+	if frozen, _ := db.Ancients(); frozen != 0 {
+		t.Errorf("%d blocks of a rejected archive imported", frozen)
+	}
+}
+
+func TestImportEraTamperedAccumulator(t *testing.T) {
+	_, blocks, tds := newHistoryTestChain(t)
+	path := writeEra(t, blocks, tds)
+
+	// Flip a byte of the accumulator entry
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		r   = e2store.NewReader(strings.NewReader(string(data)))
+		off int64
+	)
+	for {
+		typ, length, err := r.ReadMetadataAt(off)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if typ == era.TypeAccumulator {
+			data[off+8] ^= 0xff
+			break
+		}
+		off += 8 + int64(length)
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	db := newFreezerDatabase(t)
+	e := openEra(t, path)
+	if err := ImportEra(db, e, 0, blocks[0].Hash()); err == nil || !strings.Contains(err.Error(), "accumulator mismatch") {
+		t.Fatalf("expected an accumulator mismatch, got %v", err)
+	}
+	if frozen, _ := db.Ancients(); frozen != 0 {
+		t.Errorf("%d blocks of a rejected archive imported", frozen)
+	}
+}
+
+func TestImportEraTamperedReceipts(t *testing.T) {
+	_, blocks, tds := newHistoryTestChain(t)
+	path := filepath.Join(t.TempDir(), era.Filename("test", 0, common.Hash{}))
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	builder := era.NewBuilder(f)
+	for i, block := range blocks {
+		var receipts types.Receipts
+		if i == 5 {
+			receipts = types.Receipts{{Status: types.ReceiptStatusSuccessful, CumulativeGasUsed: 21000, Logs: []*types.Log{}}}
+		}
+		if err := builder.Add(block, receipts, tds[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := builder.Finalize(); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	db := newFreezerDatabase(t)
+	if err := ImportEra(db, openEra(t, path), 0, blocks[0].Hash()); err == nil || !strings.Contains(err.Error(), "receipt root mismatch of block 5") {
+		t.Fatalf("expected a receipt root mismatch, got %v", err)
+	}
+}
+
+func TestReadChecksums(t *testing.T) {
+	dir := t.TempDir()
+	if checksums, err := ReadChecksums(dir); err != nil || checksums != nil {
+		t.Fatalf("unexpected checksums %v without a checksums file: %v", checksums, err)
+	}
+
+	want := []common.Hash{{0x01}, {0x02}}
+	data := want[0].Hex() + "\n\n" + want[1].Hex() + "\n"
+	if err := os.WriteFile(filepath.Join(dir, ChecksumsFile), []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+	checksums, err := ReadChecksums(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(checksums) != len(want) || checksums[0] != want[0] || checksums[1] != want[1] {
+		t.Errorf("unexpected checksums %v, want %v", checksums, want)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, ChecksumsFile), []byte("0x1234\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadChecksums(dir); err == nil || !strings.Contains(err.Error(), "malformed checksum") {
+		t.Errorf("expected a malformed checksum error, got %v", err)
+	}
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/theQRL/zond/common"
+	"github.com/theQRL/zond/core/rawdb"
+	"github.com/theQRL/zond/core/state/pruner"
+	"github.com/theQRL/zond/core/state/snapshot"
+	"github.com/theQRL/zond/core/types"
+	"github.com/theQRL/zond/crypto"
+	"github.com/theQRL/zond/ethdb"
+	"github.com/theQRL/zond/rlp"
+	"github.com/theQRL/zond/trie"
+	"github.com/theQRL/zond/zond/zondconfig"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	bloomFilterSizeFlag = &cli.Uint64Flag{
+		Name:  "bloomfilter.size",
+		Usage: "Megabytes of memory allocated to bloom-filter for pruning",
+		Value: 2048,
+	}
+
+	// emptyCode is the known hash of the empty EVM bytecode.
+	emptyCode = crypto.Keccak256(nil)
+)
+
+var snapshotCommand = &cli.Command{
+	Name:  "snapshot",
+	Usage: "A set of commands based on the snapshot",
+	Description: `
+The execution node must be stopped while any of these commands run.`,
+	Before: setupDatabaseLogging,
+	Subcommands: []*cli.Command{
+		{
+			Name:      "prune-state",
+			Usage:     "Prune stale state data based on the snapshot",
+			ArgsUsage: "<root>",
+			Action:    pruneState,
+			Flags: []cli.Flag{
+				dataDirFlag,
+				dbEngineFlag,
+				bloomFilterSizeFlag,
+			},
+			Description: `
+gzond snapshot prune-state <state-root>
+will prune historical state data with the help of the state snapshot.
+All trie nodes and contract codes that do not belong to the specified
+version state will be deleted from the database. After pruning, only
+two version states are available: genesis and the specific one.
+
+The default pruning target is the HEAD-127 state.
+
+A pruning interrupted by a crash or a manual exit is resumed by the next
+run of this command, or when the node starts. The clean trie cache is
+deleted before the pruning starts, as it may refer to pruned state.`,
+		},
+		{
+			Name:      "verify-state",
+			Usage:     "Recalculate state hash based on the snapshot for verification",
+			ArgsUsage: "<root>",
+			Action:    verifyState,
+			Flags: []cli.Flag{
+				dataDirFlag,
+				dbEngineFlag,
+			},
+			Description: `
+gzond snapshot verify-state <state-root>
+will regenerate the state hash of the specified state from the snapshot and
+compare it with the given root, then traverse the state trie checking that
+every trie node and contract code is present and that every account and
+storage slot matches the snapshot. If no root is given, the state of the
+head block is verified.`,
+		},
+	},
+}
+
+func pruneState(ctx *cli.Context) error {
+	if ctx.NArg() > 1 {
+		return errors.New("too many arguments")
+	}
+	chaindb, err := openChainDatabase(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer chaindb.Close()
+
+	nodeConfig := makeNodeConfig(ctx)
+	prunerconfig := pruner.Config{
+		Datadir:   nodeConfig.ResolvePath(""),
+		Cachedir:  nodeConfig.ResolvePath(zondconfig.Defaults.TrieCleanCacheJournal),
+		BloomSize: ctx.Uint64(bloomFilterSizeFlag.Name),
+	}
+	pruner, err := pruner.NewPruner(chaindb, prunerconfig)
+	if err != nil {
+		log.Error("Failed to open snapshot tree ", err)
+		return err
+	}
+	var targetRoot common.Hash
+	if ctx.NArg() == 1 {
+		targetRoot, err = parseRoot(ctx.Args().First())
+		if err != nil {
+			log.Error("Failed to resolve state root ", err)
+			return err
+		}
+	}
+	if err = pruner.Prune(targetRoot); err != nil {
+		log.Error("Failed to prune state ", err)
+		return err
+	}
+	return nil
+}
+
+func verifyState(ctx *cli.Context) error {
+	if ctx.NArg() > 1 {
+		return errors.New("too many arguments")
+	}
+	chaindb, err := openChainDatabase(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer chaindb.Close()
+
+	headBlock := rawdb.ReadHeadBlock(chaindb)
+	if headBlock == nil {
+		log.Error("Failed to load head block")
+		return errors.New("no head block")
+	}
+	snapconfig := snapshot.Config{
+		CacheSize:  256,
+		Recovery:   false,
+		NoBuild:    true,
+		AsyncBuild: false,
+	}
+	snaptree, err := snapshot.New(snapconfig, chaindb, trie.NewDatabase(chaindb), headBlock.Root())
+	if err != nil {
+		log.Error("Failed to open snapshot tree ", err)
+		return err
+	}
+	root := headBlock.Root()
+	if ctx.NArg() == 1 {
+		root, err = parseRoot(ctx.Args().First())
+		if err != nil {
+			log.Error("Failed to resolve state root ", err)
+			return err
+		}
+	}
+	if err := snaptree.Verify(root); err != nil {
+		log.Error("Failed to verify state ", root.Hex(), " ", err)
+		return err
+	}
+	log.Info("Verified the state hash against the snapshot ", root.Hex())
+
+	snap := snaptree.Snapshot(root)
+	if snap == nil {
+		return fmt.Errorf("snapshot of state %x is not available", root)
+	}
+	if err := traverseState(chaindb, snap, root); err != nil {
+		log.Error("Failed to traverse state ", root.Hex(), " ", err)
+		return err
+	}
+	return nil
+}
+
+// traverseState walks the state trie of the given root, ensuring that all trie
+// nodes and contract codes are present, and that the accounts and storage slots
+// in the trie match the ones in the snapshot.
+func traverseState(chaindb ethdb.Database, snap snapshot.Snapshot, root common.Hash) error {
+	var (
+		triedb   = trie.NewDatabase(chaindb)
+		accounts int
+		slots    int
+		codes    int
+		nodes    int
+		start    = time.Now()
+		logged   = time.Now()
+	)
+	t, err := trie.NewStateTrie(trie.StateTrieID(root), triedb)
+	if err != nil {
+		return err
+	}
+	accIter := t.NodeIterator(nil)
+	for accIter.Next(true) {
+		nodes += 1
+		if !accIter.Leaf() {
+			continue
+		}
+		accounts += 1
+		accountHash := common.BytesToHash(accIter.LeafKey())
+
+		slim, err := snap.AccountRLP(accountHash)
+		if err != nil {
+			return err
+		}
+		full, err := snapshot.FullAccountRLP(slim)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(full, accIter.LeafBlob()) {
+			return fmt.Errorf("account %x mismatches the snapshot", accountHash)
+		}
+		var acc types.StateAccount
+		if err := rlp.DecodeBytes(accIter.LeafBlob(), &acc); err != nil {
+			return err
+		}
+		if acc.Root != types.EmptyRootHash {
+			id := trie.StorageTrieID(root, accountHash, acc.Root)
+			storageTrie, err := trie.NewStateTrie(id, triedb)
+			if err != nil {
+				return err
+			}
+			storageIter := storageTrie.NodeIterator(nil)
+			for storageIter.Next(true) {
+				nodes += 1
+				if !storageIter.Leaf() {
+					continue
+				}
+				slots += 1
+				slotHash := common.BytesToHash(storageIter.LeafKey())
+				value, err := snap.Storage(accountHash, slotHash)
+				if err != nil {
+					return err
+				}
+				if !bytes.Equal(value, storageIter.LeafBlob()) {
+					return fmt.Errorf("storage slot %x of account %x mismatches the snapshot", slotHash, accountHash)
+				}
+			}
+			if storageIter.Error() != nil {
+				return storageIter.Error()
+			}
+		}
+		if !bytes.Equal(acc.CodeHash, emptyCode) {
+			if len(rawdb.ReadCode(chaindb, common.BytesToHash(acc.CodeHash))) == 0 {
+				return fmt.Errorf("code %x of account %x is missing", acc.CodeHash, accountHash)
+			}
+			codes += 1
+		}
+		if time.Since(logged) > 8*time.Second {
+			log.WithField("accounts", accounts).WithField("slots", slots).WithField("codes", codes).
+				WithField("nodes", nodes).Info("Traversing state")
+			logged = time.Now()
+		}
+	}
+	if accIter.Error() != nil {
+		return accIter.Error()
+	}
+	log.WithField("accounts", accounts).WithField("slots", slots).WithField("codes", codes).
+		WithField("nodes", nodes).WithField("elapsed", common.PrettyDuration(time.Since(start))).
+		Info("Verified the state trie against the snapshot")
+	return nil
+}
+
+func parseRoot(input string) (common.Hash, error) {
+	var h common.Hash
+	if err := h.UnmarshalText([]byte(input)); err != nil {
+		return h, err
+	}
+	return h, nil
+}
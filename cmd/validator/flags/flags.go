@@ -168,6 +168,12 @@ var (
 		Name:  "mnemonic-file",
 		Usage: "File to retrieve mnemonic for non-interactively passing a mnemonic phrase into wallet recover.",
 	}
+	// KeySchemeFlag defines the signature scheme of the validating keys derived from a mnemonic.
+	KeySchemeFlag = &cli.StringFlag{
+		Name:  "key-scheme",
+		Usage: "Signature scheme of the validating keys derived from the mnemonic of HD wallets: bls|dilithium",
+		Value: "bls",
+	}
 	// MnemonicLanguageFlag is used to specify the language of the mnemonic.
 	MnemonicLanguageFlag = &cli.StringFlag{
 		Name:  "mnemonic-language",
@@ -328,6 +334,12 @@ var (
 		Usage: "Allows users to specify the output directory to export their slashing protection EIP-3076 standard JSON File",
 		Value: "",
 	}
+	// SlashingProtectionMergeFilesFlag specifies the EIP-3076 JSON files to combine
+	// into a single slashing protection history.
+	SlashingProtectionMergeFilesFlag = &cli.StringSliceFlag{
+		Name:  "slashing-protection-merge-files",
+		Usage: "Comma separated list of paths to EIP-3076 standard JSON files to merge into a single slashing protection history",
+	}
 	// GraffitiFileFlag specifies the file path to load graffiti values.
 	GraffitiFileFlag = &cli.StringFlag{
 		Name:  "graffiti-file",
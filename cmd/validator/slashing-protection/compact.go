@@ -0,0 +1,75 @@
+package historycmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/theQRL/zond/cmd"
+	"github.com/theQRL/zond/io/file"
+	"github.com/theQRL/zond/validator/accounts/userprompt"
+	"github.com/theQRL/zond/validator/db/kv"
+	"github.com/urfave/cli/v2"
+)
+
+// Prunes a validator's slashing protection history and rewrites its
+// database file so the space used by pruned records is given back.
+//
+// Steps:
+// 1. Parse a path to the validator's datadir from the CLI context.
+// 2. Open the validator database.
+// 3. Prune attesting and proposal history older than the pruning periods.
+// 4. Close the database and rewrite it into a compacted file.
+func compactSlashingProtectionDB(cliCtx *cli.Context) error {
+	var err error
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	if !cliCtx.IsSet(cmd.DataDirFlag.Name) {
+		dataDir, err = userprompt.InputDirectory(cliCtx, userprompt.DataDirDirPromptText, cmd.DataDirFlag)
+		if err != nil {
+			return errors.Wrapf(err, "could not read directory value from input")
+		}
+	}
+	if !file.FileExists(filepath.Join(dataDir, kv.ProtectionDbFileName)) {
+		return fmt.Errorf(
+			"validator.db file (validator database) was not found at path %s, so nothing to compact",
+			dataDir,
+		)
+	}
+
+	validatorDB, err := kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{})
+	if err != nil {
+		return errors.Wrapf(err, "could not access validator database at path %s", dataDir)
+	}
+	if err := pruneSlashingProtectionDB(cliCtx, validatorDB); err != nil {
+		if closeErr := validatorDB.Close(); closeErr != nil {
+			log.WithError(closeErr).Errorf("Could not close validator DB")
+		}
+		return err
+	}
+	if err := validatorDB.Close(); err != nil {
+		return errors.Wrap(err, "could not close validator database")
+	}
+
+	before, after, err := kv.Compact(cliCtx.Context, dataDir)
+	if err != nil {
+		return errors.Wrap(err, "could not compact validator database")
+	}
+	log.WithFields(logrus.Fields{
+		"sizeBefore": before,
+		"sizeAfter":  after,
+	}).Infof("Successfully compacted validator database in %s", dataDir)
+	return nil
+}
+
+func pruneSlashingProtectionDB(cliCtx *cli.Context, validatorDB *kv.Store) error {
+	log.Info("Pruning attesting history")
+	if err := validatorDB.PruneAttestations(cliCtx.Context); err != nil {
+		return errors.Wrap(err, "could not prune attesting history")
+	}
+	log.Info("Pruning proposal history")
+	if err := validatorDB.PruneProposalHistory(cliCtx.Context); err != nil {
+		return errors.Wrap(err, "could not prune proposal history")
+	}
+	return nil
+}
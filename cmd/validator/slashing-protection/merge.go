@@ -0,0 +1,86 @@
+package historycmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/zond/cmd/validator/flags"
+	"github.com/theQRL/zond/io/file"
+	"github.com/theQRL/zond/validator/accounts/userprompt"
+	slashingprotection "github.com/theQRL/zond/validator/slashing-protection-history"
+	"github.com/theQRL/zond/validator/slashing-protection-history/format"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	jsonMergeFileName = "slashing_protection_merged.json"
+)
+
+// Combines several EIP-3076 standard JSON files for the same chain into a single
+// file holding the minimal slashing protection history of every public key.
+//
+// Steps:
+// 1. Read and verify every JSON file passed by the user.
+// 2. Merge them, keeping only the highest signed slot and the highest
+// source and target epochs of each public key.
+// 3. Format and save the merged JSON file to a user's specified output directory.
+func mergeSlashingProtectionJSON(cliCtx *cli.Context) error {
+	paths := cliCtx.StringSlice(flags.SlashingProtectionMergeFilesFlag.Name)
+	if len(paths) == 0 {
+		return fmt.Errorf(
+			"no slashing protection JSON files to merge, please specify them with the %s flag",
+			flags.SlashingProtectionMergeFilesFlag.Name,
+		)
+	}
+	interchangeJSONs := make([]*format.EIPSlashingProtectionFormat, 0, len(paths))
+	for _, path := range paths {
+		interchangeJSON, err := readProtectionFile(path)
+		if err != nil {
+			return err
+		}
+		// The minimal history is safe even if an input is inconsistent, but such
+		// keys may already be slashable, so we let the user know about them.
+		if _, err := verifyProtectionJSON(cliCtx, path, interchangeJSON); err != nil {
+			return err
+		}
+		interchangeJSONs = append(interchangeJSONs, interchangeJSON)
+	}
+	merged, err := slashingprotection.MergeStandardProtectionJSON(cliCtx.Context, interchangeJSONs...)
+	if err != nil {
+		return errors.Wrap(err, "could not merge slashing protection history")
+	}
+
+	outputDir, err := userprompt.InputDirectory(
+		cliCtx,
+		"Enter your desired output directory for your merged slashing protection history file",
+		flags.SlashingProtectionExportDirFlag,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not get slashing protection json file")
+	}
+	if outputDir == "" {
+		return errors.New("output directory not specified")
+	}
+	exists, err := file.HasDir(outputDir)
+	if err != nil {
+		return errors.Wrapf(err, "could not check if output directory %s already exists", outputDir)
+	}
+	if !exists {
+		if err := file.MkdirAll(outputDir); err != nil {
+			return errors.Wrapf(err, "could not create output directory %s", outputDir)
+		}
+	}
+	outputFilePath := filepath.Join(outputDir, jsonMergeFileName)
+	log.Infof("Writing merged slashing protection JSON file to %s", outputFilePath)
+	encoded, err := json.MarshalIndent(merged, "", "\t")
+	if err != nil {
+		return errors.Wrap(err, "could not JSON marshal slashing protection history")
+	}
+	if err := file.WriteFile(outputFilePath, encoded); err != nil {
+		return errors.Wrapf(err, "could not write file to path %s", outputFilePath)
+	}
+	log.Infof("Successfully merged %d files into %s", len(paths), outputFilePath)
+	return nil
+}
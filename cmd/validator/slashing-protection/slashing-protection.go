@@ -12,6 +12,7 @@ import (
 // Commands for slashing protection.
 var Commands = &cli.Command{
 	Name:     "slashing-protection-history",
+	Aliases:  []string{"slashing-protection"},
 	Category: "slashing-protection-history",
 	Usage:    "defines commands for interacting your validator's slashing protection history",
 	Subcommands: []*cli.Command{
@@ -72,5 +73,87 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "verify",
+			Description: `checks an EIP-3076 compliant slashing protection JSON for double proposals, double votes and surround votes`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.SlashingProtectionJSONFileFlag,
+				features.Mainnet,
+				features.PraterTestnet,
+				features.RopstenTestnet,
+				features.SepoliaTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := features.ConfigureValidator(cliCtx); err != nil {
+					return err
+				}
+				if err := verifySlashingProtectionJSON(cliCtx); err != nil {
+					logrus.Fatalf("Could not verify slashing protection file: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "merge",
+			Description: `merges several EIP-3076 compliant slashing protection JSON files into a single minimal history`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.SlashingProtectionMergeFilesFlag,
+				flags.SlashingProtectionExportDirFlag,
+				features.Mainnet,
+				features.PraterTestnet,
+				features.RopstenTestnet,
+				features.SepoliaTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := features.ConfigureValidator(cliCtx); err != nil {
+					return err
+				}
+				if err := mergeSlashingProtectionJSON(cliCtx); err != nil {
+					logrus.Fatalf("Could not merge slashing protection files: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "compact",
+			Description: `prunes old attesting and proposal history from the validator database and rewrites it to reclaim disk space`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				features.Mainnet,
+				features.PraterTestnet,
+				features.RopstenTestnet,
+				features.SepoliaTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := features.ConfigureValidator(cliCtx); err != nil {
+					return err
+				}
+				if err := compactSlashingProtectionDB(cliCtx); err != nil {
+					logrus.Fatalf("Could not compact validator database: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
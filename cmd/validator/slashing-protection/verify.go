@@ -0,0 +1,83 @@
+package historycmd
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/theQRL/zond/cmd/validator/flags"
+	"github.com/theQRL/zond/encoding/bytesutil"
+	"github.com/theQRL/zond/io/file"
+	"github.com/theQRL/zond/validator/accounts/userprompt"
+	slashingprotection "github.com/theQRL/zond/validator/slashing-protection-history"
+	"github.com/theQRL/zond/validator/slashing-protection-history/format"
+	"github.com/urfave/cli/v2"
+)
+
+// Checks an EIP-3076 standard JSON file for records which are slashable
+// with respect to other records of the same public key in that file.
+//
+// Steps:
+// 1. Read the JSON file from user input.
+// 2. Validate its metadata.
+// 3. Check the signed blocks and attestations of every public key for
+// double proposals, double votes and surround votes.
+// 4. Report every public key with a slashable record.
+func verifySlashingProtectionJSON(cliCtx *cli.Context) error {
+	protectionFilePath, err := userprompt.InputDirectory(cliCtx, userprompt.SlashingProtectionJSONPromptText, flags.SlashingProtectionJSONFileFlag)
+	if err != nil {
+		return errors.Wrap(err, "could not get slashing protection json file")
+	}
+	if protectionFilePath == "" {
+		return fmt.Errorf(
+			"no path to a slashing_protection.json file specified, please retry or "+
+				"you can also specify it with the %s flag",
+			flags.SlashingProtectionJSONFileFlag.Name,
+		)
+	}
+	interchangeJSON, err := readProtectionFile(protectionFilePath)
+	if err != nil {
+		return err
+	}
+	violations, err := verifyProtectionJSON(cliCtx, protectionFilePath, interchangeJSON)
+	if err != nil {
+		return err
+	}
+	if len(violations) > 0 {
+		return fmt.Errorf("found %d slashable records in %s", len(violations), protectionFilePath)
+	}
+	log.Infof("No slashable records found in %s", protectionFilePath)
+	return nil
+}
+
+// Reads an EIP-3076 standard JSON file and validates its metadata.
+func readProtectionFile(path string) (*format.EIPSlashingProtectionFormat, error) {
+	enc, err := file.ReadFileAsBytes(path)
+	if err != nil {
+		return nil, err
+	}
+	interchangeJSON, err := slashingprotection.ReadStandardProtectionJSON(bytes.NewBuffer(enc))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read %s", path)
+	}
+	return interchangeJSON, nil
+}
+
+// Verifies the records of an EIP-3076 standard JSON file, logging every violation found.
+func verifyProtectionJSON(
+	cliCtx *cli.Context, path string, interchangeJSON *format.EIPSlashingProtectionFormat,
+) ([]*slashingprotection.Violation, error) {
+	violations, err := slashingprotection.VerifyStandardProtectionJSON(cliCtx.Context, interchangeJSON)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not verify %s", path)
+	}
+	for _, v := range violations {
+		log.WithFields(logrus.Fields{
+			"file":   path,
+			"pubKey": fmt.Sprintf("%#x", bytesutil.Trunc(v.PubKey[:])),
+			"kind":   v.Kind,
+		}).Warn(v.Detail)
+	}
+	return violations, nil
+}
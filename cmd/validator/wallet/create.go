@@ -87,6 +87,11 @@ func ConstructCLIManagerOpts(cliCtx *cli.Context, keymanagerKind keymanager.Kind
 			return []accounts.Option{}, errors.Wrap(err, "could not get number of accounts to generate")
 		}
 		cliOpts = append(cliOpts, accounts.WithNumAccounts(int(numAccounts)))
+		keyScheme, err := keymanager.ParseKeyScheme(cliCtx.String(flags.KeySchemeFlag.Name))
+		if err != nil {
+			return []accounts.Option{}, err
+		}
+		cliOpts = append(cliOpts, accounts.WithKeyScheme(keyScheme))
 	}
 	if keymanagerKind == keymanager.Derived && !skipMnemonic25thWord && !has25thWordFile {
 		resp, err := prompt.ValidatePrompt(
@@ -13,6 +13,7 @@ import (
 	"github.com/theQRL/zond/validator/accounts"
 	"github.com/theQRL/zond/validator/accounts/userprompt"
 	"github.com/theQRL/zond/validator/accounts/wallet"
+	"github.com/theQRL/zond/validator/keymanager"
 	"github.com/tyler-smith/go-bip39"
 	"github.com/tyler-smith/go-bip39/wordlists"
 	"github.com/urfave/cli/v2"
@@ -85,6 +86,11 @@ func walletRecover(c *cli.Context) error {
 	opts = append(opts, accounts.WithWalletDir(walletDir))
 	opts = append(opts, accounts.WithWalletPassword(walletPassword))
 	opts = append(opts, accounts.WithNumAccounts(int(numAccounts)))
+	keyScheme, err := keymanager.ParseKeyScheme(c.String(flags.KeySchemeFlag.Name))
+	if err != nil {
+		return err
+	}
+	opts = append(opts, accounts.WithKeyScheme(keyScheme))
 
 	acc, err := accounts.NewCLIManager(opts...)
 	if err != nil {
@@ -32,6 +32,7 @@ var Commands = &cli.Command{
 				flags.WalletPasswordFileFlag,
 				flags.Mnemonic25thWordFileFlag,
 				flags.SkipMnemonic25thWordCheckFlag,
+				flags.KeySchemeFlag,
 				features.Mainnet,
 				features.PraterTestnet,
 				features.RopstenTestnet,
@@ -97,6 +98,7 @@ var Commands = &cli.Command{
 				flags.NumAccountsFlag,
 				flags.Mnemonic25thWordFileFlag,
 				flags.SkipMnemonic25thWordCheckFlag,
+				flags.KeySchemeFlag,
 				features.Mainnet,
 				features.PraterTestnet,
 				features.RopstenTestnet,
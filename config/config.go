@@ -86,6 +86,7 @@ type UserConfig struct {
 	ChainFileDirectory   string
 	NodeKeyFileName      string
 	NodeECDSAKeyFileName string
+	DBEngine             string // Backing database implementation, "leveldb" or "pebble", defaulting to the existing one
 
 	API *API
 	//MongoProcessorConfig *MongoProcessorConfig
@@ -97,6 +98,10 @@ type APIConfig struct {
 	Port             uint32
 	Threads          uint32
 	MaxConcurrentRPC uint16
+
+	RateLimit          float64  // Requests per second allowed for each client IP
+	RateLimitBurst     int      // Maximum burst of requests allowed for each client IP
+	CORSAllowedOrigins []string // Origins allowed to make cross-origin requests, none by default
 }
 
 type DevConfig struct {
@@ -225,6 +230,9 @@ func GetUserConfig() (userConf *UserConfig) {
 		Port:             19009,
 		Threads:          1,
 		MaxConcurrentRPC: 100,
+
+		RateLimit:      5,
+		RateLimitBurst: 500,
 	}
 
 	publicRPCAPI := &APIConfig{
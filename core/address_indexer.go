@@ -0,0 +1,230 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/theQRL/zond/common"
+	"github.com/theQRL/zond/core/rawdb"
+	"github.com/theQRL/zond/core/types"
+	"github.com/theQRL/zond/ethdb"
+	"github.com/theQRL/zond/params"
+)
+
+const (
+	// AddressIndexSectionSize is the number of blocks indexed at once by the
+	// address transaction indexer.
+	AddressIndexSectionSize = 256
+
+	// AddressIndexConfirms is the number of confirmation blocks before a section
+	// is considered final and indexed.
+	AddressIndexConfirms = 64
+
+	// addressIndexThrottling is the time to wait between processing two consecutive
+	// index sections. It's useful during chain upgrades to prevent disk overload.
+	addressIndexThrottling = 100 * time.Millisecond
+
+	// maxAddressIndexTail is the maximum number of recent blocks not yet covered by
+	// indexed sections that are scanned directly when serving a query.
+	maxAddressIndexTail = 2*AddressIndexSectionSize + AddressIndexConfirms
+)
+
+var (
+	// errAddressIndexSyncing is returned if the indexed sections lag too far behind
+	// the chain head, as is the case while the index is initially built.
+	errAddressIndexSyncing = errors.New("address transaction index is still being built")
+
+	// errInvalidAddressIndexCursor is returned if a query cursor is malformed.
+	errInvalidAddressIndexCursor = errors.New("invalid address transaction index cursor")
+)
+
+// AddressIndex maintains an index of the transactions touching each address, and
+// serves the transaction history of addresses from it. Senders, recipients, created
+// contracts and contracts emitting logs are indexed.
+//
+// The index is built in sections of confirmed canonical blocks by a ChainIndexer,
+// which re-indexes the affected sections on reorgs. Entries left behind by blocks
+// that are no longer canonical are skipped when the index is queried.
+type AddressIndex struct {
+	db      ethdb.Database
+	config  *params.ChainConfig
+	indexer *ChainIndexer
+}
+
+// NewAddressIndex returns an address index over the given chain database. The
+// index is not updated until it is started.
+func NewAddressIndex(db ethdb.Database, config *params.ChainConfig) *AddressIndex {
+	backend := &addressIndexerBackend{
+		db:     db,
+		config: config,
+	}
+	table := rawdb.NewTable(db, string(rawdb.AddressTxIndexTablePrefix))
+
+	return &AddressIndex{
+		db:      db,
+		config:  config,
+		indexer: NewChainIndexer(db, table, backend, AddressIndexSectionSize, AddressIndexConfirms, addressIndexThrottling, "address"),
+	}
+}
+
+// Start starts indexing the canonical blocks of the given chain.
+func (i *AddressIndex) Start(chain ChainIndexerChain) {
+	i.indexer.Start(chain)
+}
+
+// Close stops indexing.
+func (i *AddressIndex) Close() error {
+	return i.indexer.Close()
+}
+
+// Transactions retrieves at most limit canonical transactions touching the given
+// address, from the newest to the oldest, starting at the given cursor. A nil cursor
+// starts at the given head. The cursor of the next page is returned, or nil if there
+// are no more transactions.
+func (i *AddressIndex) Transactions(head *types.Header, address common.Address, cursor []byte, limit int) ([]*rawdb.AddressTxIndexEntry, []byte, error) {
+	if cursor != nil && len(cursor) != rawdb.AddressTxPositionLength {
+		return nil, nil, errInvalidAddressIndexCursor
+	}
+	sections, _, _ := i.indexer.Sections()
+	indexed := sections * AddressIndexSectionSize
+	number := head.Number.Uint64()
+	if number >= indexed && number-indexed >= maxAddressIndexTail {
+		return nil, nil, errAddressIndexSyncing
+	}
+
+	// Scan the blocks above the indexed sections directly, skipping those above the cursor
+	if cursor != nil {
+		if cursorNumber := ^binary.BigEndian.Uint64(cursor); cursorNumber < number {
+			number = cursorNumber
+		}
+	}
+	var entries []*rawdb.AddressTxIndexEntry
+	for ; number >= indexed; number-- {
+		hash := rawdb.ReadCanonicalHash(i.db, number)
+		block := rawdb.ReadBlock(i.db, hash, number)
+		if block == nil {
+			return nil, nil, fmt.Errorf("block #%d [%x..] not found", number, hash[:4])
+		}
+		receipts := rawdb.ReadReceipts(i.db, hash, number, i.config)
+		blockEntries := addressTxIndexEntries(block, receipts, types.MakeSigner(i.config, block.Number()))[address]
+		for j := len(blockEntries) - 1; j >= 0; j-- {
+			entry := blockEntries[j]
+			if cursor != nil && bytes.Compare(entry.Position(), cursor) < 0 {
+				continue
+			}
+			if len(entries) == limit {
+				return entries, entry.Position(), nil
+			}
+			entries = append(entries, entry)
+		}
+		if number == 0 {
+			return entries, nil, nil
+		}
+	}
+	if indexed == 0 {
+		return entries, nil, nil
+	}
+
+	// Read the remaining transactions from the indexed sections
+	start := rawdb.AddressTxPosition(indexed-1, ^uint32(0))
+	if cursor != nil && bytes.Compare(cursor, start) > 0 {
+		start = cursor
+	}
+	for start != nil && len(entries) < limit {
+		var batch []*rawdb.AddressTxIndexEntry
+		batch, start = rawdb.ReadAddressTxIndexEntries(i.db, address, start, limit-len(entries))
+		for _, entry := range batch {
+			if rawdb.ReadCanonicalHash(i.db, entry.BlockNumber) == entry.BlockHash {
+				entries = append(entries, entry)
+			}
+		}
+	}
+	return entries, start, nil
+}
+
+// addressIndexerBackend implements ChainIndexerBackend, writing the address index
+// entries of the transactions of each processed header.
+type addressIndexerBackend struct {
+	db     ethdb.Database
+	config *params.ChainConfig
+	batch  ethdb.Batch
+}
+
+// Reset implements ChainIndexerBackend, starting a new address index section.
+func (b *addressIndexerBackend) Reset(ctx context.Context, section uint64, lastSectionHead common.Hash) error {
+	b.batch = b.db.NewBatch()
+	return nil
+}
+
+// Process implements ChainIndexerBackend, adding the address index entries of the
+// transactions of a new header to the section.
+func (b *addressIndexerBackend) Process(ctx context.Context, header *types.Header) error {
+	hash, number := header.Hash(), header.Number.Uint64()
+	block := rawdb.ReadBlock(b.db, hash, number)
+	if block == nil {
+		return fmt.Errorf("block #%d [%x..] not found", number, hash[:4])
+	}
+	receipts := rawdb.ReadReceipts(b.db, hash, number, b.config)
+	for address, entries := range addressTxIndexEntries(block, receipts, types.MakeSigner(b.config, header.Number)) {
+		for _, entry := range entries {
+			rawdb.WriteAddressTxIndexEntry(b.batch, address, entry)
+		}
+	}
+	if b.batch.ValueSize() > ethdb.IdealBatchSize {
+		if err := b.batch.Write(); err != nil {
+			return err
+		}
+		b.batch.Reset()
+	}
+	return nil
+}
+
+// Commit implements ChainIndexerBackend, finalizing the address index section
+// and writing it into the database.
+func (b *addressIndexerBackend) Commit() error {
+	return b.batch.Write()
+}
+
+// Prune implements ChainIndexerBackend, deleting the address index entries of the
+// blocks older than the given threshold.
+func (b *addressIndexerBackend) Prune(threshold uint64) error {
+	rawdb.DeleteAddressTxIndexEntries(b.db, threshold)
+	return nil
+}
+
+// addressTxIndexEntries returns the address index entries of the transactions of
+// the given block, grouped by address and ordered by transaction index.
+func addressTxIndexEntries(block *types.Block, receipts types.Receipts, signer types.Signer) map[common.Address][]*rawdb.AddressTxIndexEntry {
+	entries := make(map[common.Address][]*rawdb.AddressTxIndexEntry)
+	for i, tx := range block.Transactions() {
+		roles := make(map[common.Address]rawdb.AddressTxRole)
+		if from, err := types.Sender(signer, tx); err == nil {
+			roles[from] |= rawdb.AddressTxSender
+		}
+		if to := tx.To(); to != nil {
+			roles[*to] |= rawdb.AddressTxRecipient
+		}
+		if i < len(receipts) {
+			receipt := receipts[i]
+			if tx.To() == nil && receipt.ContractAddress != (common.Address{}) {
+				roles[receipt.ContractAddress] |= rawdb.AddressTxContractCreation
+			}
+			for _, log := range receipt.Logs {
+				roles[log.Address] |= rawdb.AddressTxLogEmitter
+			}
+		}
+		for address, role := range roles {
+			entries[address] = append(entries[address], &rawdb.AddressTxIndexEntry{
+				BlockNumber: block.NumberU64(),
+				BlockHash:   block.Hash(),
+				TxIndex:     uint32(i),
+				Roles:       role,
+			})
+		}
+	}
+	return entries
+}
@@ -0,0 +1,322 @@
+package core
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/zond/common"
+	"github.com/theQRL/zond/core/rawdb"
+	"github.com/theQRL/zond/core/types"
+	"github.com/theQRL/zond/crypto"
+	"github.com/theQRL/zond/ethdb"
+	"github.com/theQRL/zond/params"
+)
+
+var (
+	addressIndexTestRecipient = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	addressIndexTestOther     = common.HexToAddress("0x2000000000000000000000000000000000000003")
+	addressIndexTestEmitter   = common.HexToAddress("0x2000000000000000000000000000000000000004")
+)
+
+// addressIndexTestChain is a canonical chain of empty blocks, except for the
+// transactions of a single sender at a few heights.
+type addressIndexTestChain struct {
+	db      ethdb.Database
+	headers []*types.Header
+	sender  common.Address
+	created common.Address // Contract created at block 550
+}
+
+// newAddressIndexTestChain writes a chain of 601 blocks, whose sections 0 and 1 are
+// indexed at its head, leaving the blocks 512 to 600 as the unindexed tail.
+func newAddressIndexTestChain(t *testing.T) *addressIndexTestChain {
+	var (
+		db     = rawdb.NewMemoryDatabase()
+		config = params.TestChainConfig
+		signer = types.LatestSigner(config)
+		key    = dilithium.New()
+		nonce  uint64
+	)
+	newTx := func(to *common.Address) *types.Transaction {
+		tx, err := types.SignNewDilithiumTx(key, signer, &types.DilithiumTx{
+			ChainID:   config.ChainID,
+			Nonce:     nonce,
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(1),
+			Gas:       21000,
+			To:        to,
+			Value:     big.NewInt(1),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		nonce++
+		return tx
+	}
+	txs := map[uint64][]*types.Transaction{
+		100: {newTx(&addressIndexTestRecipient)},
+		300: {newTx(&addressIndexTestRecipient), newTx(&addressIndexTestOther)},
+		511: {newTx(&addressIndexTestRecipient)},
+		512: {newTx(&addressIndexTestRecipient)},
+		550: {newTx(nil)},
+		600: {newTx(&addressIndexTestRecipient)},
+	}
+	sender, err := types.Sender(signer, txs[100][0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain := &addressIndexTestChain{
+		db:      db,
+		sender:  sender,
+		created: crypto.CreateAddress(sender, txs[550][0].Nonce()),
+	}
+
+	parent := common.Hash{}
+	for number := uint64(0); number <= 600; number++ {
+		header := &types.Header{
+			ParentHash: parent,
+			Number:     new(big.Int).SetUint64(number),
+			Difficulty: new(big.Int),
+			BaseFee:    big.NewInt(1),
+		}
+		block := types.NewBlockWithHeader(header).WithBody(txs[number], nil)
+		receipts := make(types.Receipts, len(txs[number]))
+		for i := range receipts {
+			receipts[i] = &types.Receipt{Status: types.ReceiptStatusSuccessful}
+		}
+		if number == 550 {
+			receipts[0].Logs = []*types.Log{{Address: addressIndexTestEmitter}}
+		}
+		rawdb.WriteBlock(db, block)
+		rawdb.WriteReceipts(db, block.Hash(), number, receipts)
+		rawdb.WriteCanonicalHash(db, block.Hash(), number)
+		chain.headers = append(chain.headers, block.Header())
+		parent = block.Hash()
+	}
+	return chain
+}
+
+// newAddressIndex returns an address index over the chain, with the given number of
+// sections indexed.
+func (c *addressIndexTestChain) newAddressIndex(t *testing.T, sections uint64) *AddressIndex {
+	index := NewAddressIndex(c.db, params.TestChainConfig)
+	t.Cleanup(func() { index.Close() })
+	if sections == 0 {
+		return index
+	}
+	index.indexer.newHead(sections*AddressIndexSectionSize+AddressIndexConfirms-1, false)
+	for deadline := time.Now().Add(10 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		if indexed, _, _ := index.indexer.Sections(); indexed == sections {
+			return index
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d sections not indexed in time", sections)
+		}
+	}
+}
+
+// positions returns the <block number>:<transaction index> positions of the entries.
+func positions(entries []*rawdb.AddressTxIndexEntry) []string {
+	var positions []string
+	for _, entry := range entries {
+		positions = append(positions, fmt.Sprintf("%d:%d", entry.BlockNumber, entry.TxIndex))
+	}
+	return positions
+}
+
+// history returns the positions of the transactions of the address, read in pages
+// of the given size from the given head.
+func history(t *testing.T, index *AddressIndex, head *types.Header, address common.Address, limit int) []string {
+	t.Helper()
+	var (
+		all    []string
+		cursor []byte
+	)
+	for pages := 0; pages < 100; pages++ {
+		entries, next, err := index.Transactions(head, address, cursor, limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(entries) > limit {
+			t.Fatalf("page of %d entries exceeds the limit of %d", len(entries), limit)
+		}
+		all = append(all, positions(entries)...)
+		if next == nil {
+			return all
+		}
+		cursor = next
+	}
+	t.Fatal("pagination did not terminate")
+	return nil
+}
+
+func TestAddressIndexSections(t *testing.T) {
+	chain := newAddressIndexTestChain(t)
+	index := chain.newAddressIndex(t, 2)
+
+	// Only the blocks of the indexed sections are written to the index
+	tests := []struct {
+		address common.Address
+		want    []string
+		roles   rawdb.AddressTxRole
+	}{
+		{addressIndexTestRecipient, []string{"511:0", "300:0", "100:0"}, rawdb.AddressTxRecipient},
+		{addressIndexTestOther, []string{"300:1"}, rawdb.AddressTxRecipient},
+		{chain.sender, []string{"511:0", "300:1", "300:0", "100:0"}, rawdb.AddressTxSender},
+		{chain.created, nil, 0},
+	}
+	for _, tt := range tests {
+		entries, next := rawdb.ReadAddressTxIndexEntries(chain.db, tt.address, nil, 10)
+		if got := positions(entries); !reflect.DeepEqual(got, tt.want) || next != nil {
+			t.Errorf("address %x: unexpected indexed entries %v, want %v", tt.address, got, tt.want)
+		}
+		for _, entry := range entries {
+			if entry.Roles != tt.roles || entry.BlockHash != chain.headers[entry.BlockNumber].Hash() {
+				t.Errorf("address %x: unexpected entry %+v", tt.address, entry)
+			}
+		}
+	}
+
+	// Queries combine the indexed sections with the unindexed tail
+	head := chain.headers[600]
+	entries, _, err := index.Transactions(head, chain.sender, nil, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := positions(entries), []string{"600:0", "550:0", "512:0", "511:0", "300:1", "300:0", "100:0"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected transactions %v, want %v", got, want)
+	}
+	for _, tt := range []struct {
+		address common.Address
+		roles   rawdb.AddressTxRole
+	}{
+		{chain.created, rawdb.AddressTxContractCreation},
+		{addressIndexTestEmitter, rawdb.AddressTxLogEmitter},
+	} {
+		entries, next, err := index.Transactions(head, tt.address, nil, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(entries) != 1 || entries[0].BlockNumber != 550 || entries[0].Roles != tt.roles || next != nil {
+			t.Errorf("address %x: unexpected transactions %v", tt.address, positions(entries))
+		}
+	}
+}
+
+func TestAddressIndexUnindexedTail(t *testing.T) {
+	chain := newAddressIndexTestChain(t)
+	index := chain.newAddressIndex(t, 0)
+
+	// Without sections, heads within the tail limit are served by scanning the chain
+	head := chain.headers[maxAddressIndexTail-1]
+	entries, next, err := index.Transactions(head, addressIndexTestRecipient, nil, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := positions(entries), []string{"512:0", "511:0", "300:0", "100:0"}; !reflect.DeepEqual(got, want) || next != nil {
+		t.Errorf("unexpected transactions %v, want %v", got, want)
+	}
+	for _, entry := range entries {
+		if entry.Roles != rawdb.AddressTxRecipient || entry.BlockHash != chain.headers[entry.BlockNumber].Hash() {
+			t.Errorf("unexpected entry %+v", entry)
+		}
+	}
+	if entries, _ := rawdb.ReadAddressTxIndexEntries(chain.db, addressIndexTestRecipient, nil, 10); len(entries) != 0 {
+		t.Errorf("unexpected indexed entries %v", positions(entries))
+	}
+}
+
+func TestAddressIndexPagination(t *testing.T) {
+	chain := newAddressIndexTestChain(t)
+	index := chain.newAddressIndex(t, 2)
+	head := chain.headers[600]
+
+	// Pages end both in the tail and in the sections, and cross the boundary at block 512
+	tests := []struct {
+		address common.Address
+		want    []string
+	}{
+		{addressIndexTestRecipient, []string{"600:0", "512:0", "511:0", "300:0", "100:0"}},
+		{chain.sender, []string{"600:0", "550:0", "512:0", "511:0", "300:1", "300:0", "100:0"}},
+	}
+	for _, tt := range tests {
+		for limit := 1; limit <= len(tt.want)+1; limit++ {
+			if got := history(t, index, head, tt.address, limit); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("address %x, limit %d: unexpected transactions %v, want %v", tt.address, limit, got, tt.want)
+			}
+		}
+	}
+
+	// A cursor below the head skips the newer blocks of the tail
+	entries, next, err := index.Transactions(head, addressIndexTestRecipient, rawdb.AddressTxPosition(550, 0), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := positions(entries); !reflect.DeepEqual(got, []string{"512:0"}) {
+		t.Errorf("unexpected transactions %v", got)
+	}
+	if !reflect.DeepEqual(next, rawdb.AddressTxPosition(511, ^uint32(0))) {
+		t.Errorf("unexpected cursor %x at the end of the tail", next)
+	}
+}
+
+func TestAddressIndexReorg(t *testing.T) {
+	chain := newAddressIndexTestChain(t)
+	index := chain.newAddressIndex(t, 2)
+
+	// Replace block 300 by an empty block, leaving its indexed entries behind
+	header := types.CopyHeader(chain.headers[300])
+	header.Extra = []byte("reorg")
+	block := types.NewBlockWithHeader(header)
+	rawdb.WriteBlock(chain.db, block)
+	rawdb.WriteCanonicalHash(chain.db, block.Hash(), 300)
+	if entries, _ := rawdb.ReadAddressTxIndexEntries(chain.db, addressIndexTestOther, nil, 10); len(entries) != 1 {
+		t.Fatalf("expected the entry of the reorged block to be left in the index, got %v", positions(entries))
+	}
+
+	head := chain.headers[600]
+	tests := []struct {
+		address common.Address
+		want    []string
+	}{
+		{addressIndexTestRecipient, []string{"600:0", "512:0", "511:0", "100:0"}},
+		{addressIndexTestOther, nil},
+		{chain.sender, []string{"600:0", "550:0", "512:0", "511:0", "100:0"}},
+	}
+	for _, tt := range tests {
+		for _, limit := range []int{1, 2, 10} {
+			if got := history(t, index, head, tt.address, limit); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("address %x, limit %d: unexpected transactions %v, want %v", tt.address, limit, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestAddressIndexErrors(t *testing.T) {
+	chain := newAddressIndexTestChain(t)
+	index := chain.newAddressIndex(t, 0)
+
+	// The tail of an unindexed chain is too long to be scanned
+	if _, _, err := index.Transactions(chain.headers[maxAddressIndexTail], addressIndexTestRecipient, nil, 10); err != errAddressIndexSyncing {
+		t.Errorf("expected %v, got %v", errAddressIndexSyncing, err)
+	}
+	for _, cursor := range [][]byte{{}, {0x01, 0x02, 0x03}, make([]byte, rawdb.AddressTxPositionLength+1)} {
+		if _, _, err := index.Transactions(chain.headers[100], addressIndexTestRecipient, cursor, 10); err != errInvalidAddressIndexCursor {
+			t.Errorf("cursor %x: expected %v, got %v", cursor, errInvalidAddressIndexCursor, err)
+		}
+	}
+
+	// Once sections are indexed, the syncing error only returns if the head gets too far ahead
+	index = chain.newAddressIndex(t, 2)
+	if _, _, err := index.Transactions(chain.headers[600], addressIndexTestRecipient, nil, 10); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	head := &types.Header{Number: big.NewInt(2*AddressIndexSectionSize + maxAddressIndexTail)}
+	if _, _, err := index.Transactions(head, addressIndexTestRecipient, nil, 10); err != errAddressIndexSyncing {
+		t.Errorf("expected %v, got %v", errAddressIndexSyncing, err)
+	}
+}
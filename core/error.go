@@ -32,6 +32,10 @@ var (
 	// ErrNoGenesis is returned when there is no Genesis Block.
 	ErrNoGenesis = errors.New("genesis not found in chain")
 
+	// ErrNotEVMTransaction is returned when a message is requested for a transaction
+	// which is not executed by the EVM, such as a stake transaction.
+	ErrNotEVMTransaction = errors.New("transaction is not executed by the evm")
+
 	errSideChainReceipts = errors.New("side blocks can't be accepted as ancient chain data")
 )
 
@@ -19,11 +19,15 @@ package core
 import (
 	"github.com/theQRL/zond/common"
 	"github.com/theQRL/zond/core/types"
+	"github.com/theQRL/zond/protos"
 )
 
 // NewTxsEvent is posted when a batch of transactions enter the transaction pool.
 type NewTxsEvent struct{ Txs []*types.Transaction }
 
+// NewTxsEventV1 is posted when a batch of transactions enter the V1 transaction pool.
+type NewTxsEventV1 struct{ Txs []*protos.Transaction }
+
 // NewMinedBlockEvent is posted when a block has been imported.
 type NewMinedBlockEvent struct{ Block *types.Block }
 
@@ -18,6 +18,7 @@ package rawdb
 
 import (
 	"bytes"
+	"encoding/binary"
 	"math/big"
 
 	"github.com/theQRL/zond/common"
@@ -175,3 +176,112 @@ func DeleteBloombits(db ethdb.Database, bit uint, from uint64, to uint64) {
 		log.Crit("Failed to delete bloom bits", "err", it.Error())
 	}
 }
+
+// AddressTxRole is a bit set of the ways in which a transaction touches an address.
+type AddressTxRole uint8
+
+const (
+	AddressTxSender           AddressTxRole = 1 << iota // The address sent the transaction
+	AddressTxRecipient                                  // The address is the recipient of the transaction
+	AddressTxContractCreation                           // The address is the contract created by the transaction
+	AddressTxLogEmitter                                 // The address is a contract that emitted a log in the transaction
+)
+
+// AddressTxPositionLength is the length of the position of an address index entry,
+// which is also the length of the cursors used to paginate the address index.
+const AddressTxPositionLength = 8 + 4
+
+// AddressTxIndexEntry is the address index entry of a transaction touching an address.
+type AddressTxIndexEntry struct {
+	BlockNumber uint64
+	BlockHash   common.Hash
+	TxIndex     uint32
+	Roles       AddressTxRole
+}
+
+// Position returns the position of the entry in the address index. Positions are
+// ordered from the newest to the oldest transaction.
+func (e *AddressTxIndexEntry) Position() []byte {
+	return AddressTxPosition(e.BlockNumber, e.TxIndex)
+}
+
+// AddressTxPosition returns the position of the transaction at the given index of
+// the given block in the address index. The block number and transaction index are
+// stored inverted so that iterating the index yields the newest transactions first.
+func AddressTxPosition(number uint64, txIndex uint32) []byte {
+	position := make([]byte, AddressTxPositionLength)
+	binary.BigEndian.PutUint64(position, ^number)
+	binary.BigEndian.PutUint32(position[8:], ^txIndex)
+	return position
+}
+
+// WriteAddressTxIndexEntry stores the address index entry of a transaction touching
+// the given address.
+func WriteAddressTxIndexEntry(db ethdb.KeyValueWriter, address common.Address, entry *AddressTxIndexEntry) {
+	value := append(entry.BlockHash.Bytes(), byte(entry.Roles))
+	if err := db.Put(addressTxIndexKey(address, entry.Position()), value); err != nil {
+		log.Crit("Failed to store address transaction index entry", "err", err)
+	}
+}
+
+// ReadAddressTxIndexEntries retrieves at most limit address index entries of the given
+// address, from the newest to the oldest, starting at the given position. The position
+// of the entry following the last returned one is returned, or nil if there is none.
+func ReadAddressTxIndexEntries(db ethdb.Iteratee, address common.Address, start []byte, limit int) ([]*AddressTxIndexEntry, []byte) {
+	prefix := addressTxIndexKey(address, nil)
+	it := db.NewIterator(prefix, start)
+	defer it.Release()
+
+	var entries []*AddressTxIndexEntry
+	for it.Next() {
+		key, value := it.Key(), it.Value()
+		if len(key) != len(prefix)+AddressTxPositionLength || len(value) != common.HashLength+1 {
+			continue
+		}
+		position := key[len(prefix):]
+		if len(entries) == limit {
+			return entries, common.CopyBytes(position)
+		}
+		entries = append(entries, &AddressTxIndexEntry{
+			BlockNumber: ^binary.BigEndian.Uint64(position),
+			BlockHash:   common.BytesToHash(value[:common.HashLength]),
+			TxIndex:     ^binary.BigEndian.Uint32(position[8:]),
+			Roles:       AddressTxRole(value[common.HashLength]),
+		})
+	}
+	if it.Error() != nil {
+		log.Error("Failed to iterate address transaction index", "address", address, "err", it.Error())
+	}
+	return entries, nil
+}
+
+// DeleteAddressTxIndexEntries removes the address index entries of all transactions
+// included in blocks older than the given threshold.
+func DeleteAddressTxIndexEntries(db ethdb.Database, threshold uint64) {
+	it := db.NewIterator(addressTxIndexPrefix, nil)
+	defer it.Release()
+
+	batch := db.NewBatch()
+	for it.Next() {
+		key := it.Key()
+		if len(key) != len(addressTxIndexPrefix)+common.AddressLength+AddressTxPositionLength {
+			continue
+		}
+		if ^binary.BigEndian.Uint64(key[len(addressTxIndexPrefix)+common.AddressLength:]) >= threshold {
+			continue
+		}
+		batch.Delete(key)
+		if batch.ValueSize() > ethdb.IdealBatchSize {
+			if err := batch.Write(); err != nil {
+				log.Crit("Failed to delete address transaction index entries", "err", err)
+			}
+			batch.Reset()
+		}
+	}
+	if it.Error() != nil {
+		log.Crit("Failed to iterate address transaction index", "err", it.Error())
+	}
+	if err := batch.Write(); err != nil {
+		log.Crit("Failed to delete address transaction index entries", "err", err)
+	}
+}
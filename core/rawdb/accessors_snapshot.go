@@ -208,3 +208,29 @@ func WriteSnapshotSyncStatus(db ethdb.KeyValueWriter, status []byte) {
 		log.Crit("Failed to store snapshot sync status", "err", err)
 	}
 }
+
+// ReadStatePruningRoot retrieves the target state root of an unfinished offline
+// state pruning, or the zero hash if no pruning was interrupted.
+func ReadStatePruningRoot(db ethdb.KeyValueReader) common.Hash {
+	data, _ := db.Get(statePruningKey)
+	if len(data) != common.HashLength {
+		return common.Hash{}
+	}
+	return common.BytesToHash(data)
+}
+
+// WriteStatePruningRoot stores the target state root of an offline state pruning
+// before any state is deleted, so an interrupted pruning can be resumed.
+func WriteStatePruningRoot(db ethdb.KeyValueWriter, root common.Hash) {
+	if err := db.Put(statePruningKey, root[:]); err != nil {
+		log.Crit("Failed to store state pruning root", "err", err)
+	}
+}
+
+// DeleteStatePruningRoot deletes the target state root of an offline state pruning,
+// marking the pruning as finished.
+func DeleteStatePruningRoot(db ethdb.KeyValueWriter) {
+	if err := db.Delete(statePruningKey); err != nil {
+		log.Crit("Failed to remove state pruning root", "err", err)
+	}
+}
@@ -20,7 +20,10 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -282,6 +285,129 @@ func NewLevelDBDatabaseWithFreezer(file string, cache int, handles int, freezer
 	return frdb, nil
 }
 
+const (
+	dbPebble  = "pebble"
+	dbLeveldb = "leveldb"
+
+	// dbEngineFile is the file recording the engine of a database in its directory.
+	dbEngineFile = "DBENGINE"
+)
+
+// hasPreexistingDb checks the given data directory whether a database is already
+// instantiated at that location, and if so, returns the type of database (or the
+// empty string). The engine recorded in the directory takes precedence over the
+// one detected from the files of the database.
+func hasPreexistingDb(path string) string {
+	if engine, err := os.ReadFile(filepath.Join(path, dbEngineFile)); err == nil {
+		return strings.TrimSpace(string(engine))
+	}
+	if _, err := os.Stat(filepath.Join(path, "CURRENT")); err != nil {
+		return "" // No pre-existing db
+	}
+	if matches, err := filepath.Glob(filepath.Join(path, "OPTIONS*")); len(matches) > 0 || err != nil {
+		if err != nil {
+			panic(err) // only possible if the pattern is malformed
+		}
+		return dbPebble
+	}
+	return dbLeveldb
+}
+
+// OpenOptions contains the options to apply when opening a database.
+// OBS: If AncientsDirectory is empty, it indicates that no freezer is to be used.
+type OpenOptions struct {
+	Type              string // "leveldb" | "pebble"
+	Directory         string // the datadir
+	AncientsDirectory string // the ancients-dir
+	Namespace         string // the namespace for database relevant metrics
+	Cache             int    // the capacity(in megabytes) of the data caching
+	Handles           int    // number of files to be open simultaneously
+	ReadOnly          bool
+}
+
+// openKeyValueDatabase opens a disk-based key-value database, e.g. leveldb or pebble.
+// The engine of an existing database is detected from the files it keeps in its
+// directory, and must match the requested one.
+//
+//	                      type == null          type != null
+//	                   +----------------------------------------
+//	db is non-existent |  leveldb default  |  specified type
+//	db is existent     |  from db          |  specified type (if compatible)
+func openKeyValueDatabase(o OpenOptions) (ethdb.Database, error) {
+	existingDb := hasPreexistingDb(o.Directory)
+	if len(existingDb) != 0 && len(o.Type) != 0 && o.Type != existingDb {
+		return nil, fmt.Errorf("db.engine choice was %v but found pre-existing %v database in specified data directory", o.Type, existingDb)
+	}
+	var (
+		db     ethdb.Database
+		engine string
+		err    error
+	)
+	switch {
+	case o.Type == dbPebble || existingDb == dbPebble:
+		if !PebbleEnabled {
+			return nil, errors.New("db.engine 'pebble' not supported on this platform")
+		}
+		log.Info("Using pebble as the backing database")
+		engine = dbPebble
+		db, err = NewPebbleDBDatabase(o.Directory, o.Cache, o.Handles, o.Namespace, o.ReadOnly)
+	case len(o.Type) != 0 && o.Type != dbLeveldb:
+		return nil, fmt.Errorf("unknown db.engine %v", o.Type)
+	case len(existingDb) != 0 && existingDb != dbLeveldb:
+		return nil, fmt.Errorf("unknown db.engine %v recorded in specified data directory", existingDb)
+	default:
+		log.Info("Using leveldb as the backing database")
+		// Use leveldb, either as default (no explicit choice), or pre-existing, or chosen explicitly
+		engine = dbLeveldb
+		db, err = NewLevelDBDatabase(o.Directory, o.Cache, o.Handles, o.Namespace, o.ReadOnly)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// Record the engine, so that it is not mistaken for another one on reopen
+	if !o.ReadOnly {
+		if err := writeDatabaseEngine(o.Directory, engine); err != nil {
+			db.Close()
+			return nil, err
+		}
+	}
+	return db, nil
+}
+
+// writeDatabaseEngine records the engine of the database in the given directory,
+// if it is not recorded yet.
+func writeDatabaseEngine(path string, engine string) error {
+	file := filepath.Join(path, dbEngineFile)
+	if _, err := os.Stat(file); err == nil {
+		return nil
+	}
+	if err := os.WriteFile(file, []byte(engine+"\n"), 0644); err != nil {
+		return fmt.Errorf("failed to record db.engine: %w", err)
+	}
+	return nil
+}
+
+// Open opens both a disk-based key-value database such as leveldb or pebble, but also
+// integrates it with a freezer database -- if the AncientDir option has been
+// set on the provided OpenOptions.
+// The passed o.AncientDir indicates the path of root ancient directory where
+// the chain freezer can be opened.
+func Open(o OpenOptions) (ethdb.Database, error) {
+	kvdb, err := openKeyValueDatabase(o)
+	if err != nil {
+		return nil, err
+	}
+	if len(o.AncientsDirectory) == 0 {
+		return kvdb, nil
+	}
+	frdb, err := NewDatabaseWithFreezer(kvdb, o.AncientsDirectory, o.Namespace, o.ReadOnly)
+	if err != nil {
+		kvdb.Close()
+		return nil, err
+	}
+	return frdb, nil
+}
+
 type counter uint64
 
 func (c counter) String() string {
@@ -312,9 +438,47 @@ func (s *stat) Count() string {
 	return s.count.String()
 }
 
-// InspectDatabase traverses the entire database and checks the size
-// of all different categories of data.
+// DatabaseStat is the total size and the number of items of a category of entries
+// in the database.
+type DatabaseStat struct {
+	Database string `json:"database"`
+	Category string `json:"category"`
+	Size     uint64 `json:"size"`
+	Items    uint64 `json:"items"`
+}
+
+// DatabaseStats is the result of a database inspection.
+type DatabaseStats struct {
+	Stats []DatabaseStat `json:"stats"`
+	Total uint64         `json:"total"`
+}
+
+// Render writes the database statistics into the given writer as a table.
+func (s *DatabaseStats) Render(w io.Writer) {
+	table := tablewriter.NewWriter(w)
+	table.SetHeader([]string{"Database", "Category", "Size", "Items"})
+	table.SetFooter([]string{"", "Total", common.StorageSize(s.Total).String(), " "})
+	for _, stat := range s.Stats {
+		table.Append([]string{stat.Database, stat.Category, common.StorageSize(stat.Size).String(), counter(stat.Items).String()})
+	}
+	table.Render()
+}
+
+// InspectDatabase traverses the entire database and prints the size of all
+// different categories of data.
 func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
+	stats, err := InspectDatabaseStats(db, keyPrefix, keyStart)
+	if err != nil {
+		return err
+	}
+	stats.Render(os.Stdout)
+	return nil
+}
+
+// InspectDatabaseStats traverses the key-value entries of the database with the
+// given prefix, starting at the given key, and returns the size of all different
+// categories of data, together with the size of the ancient store tables.
+func InspectDatabaseStats(db ethdb.Database, keyPrefix, keyStart []byte) (*DatabaseStats, error) {
 	it := db.NewIterator(keyPrefix, keyStart)
 	defer it.Release()
 
@@ -333,6 +497,7 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 		tries           stat
 		codes           stat
 		txLookups       stat
+		addressTxs      stat
 		accountSnaps    stat
 		storageSnaps    stat
 		preimages       stat
@@ -384,6 +549,10 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 			codes.Add(size)
 		case bytes.HasPrefix(key, txLookupPrefix) && len(key) == (len(txLookupPrefix)+common.HashLength):
 			txLookups.Add(size)
+		case bytes.HasPrefix(key, addressTxIndexPrefix) && len(key) == (len(addressTxIndexPrefix)+common.AddressLength+AddressTxPositionLength):
+			addressTxs.Add(size)
+		case bytes.HasPrefix(key, AddressTxIndexTablePrefix):
+			addressTxs.Add(size)
 		case bytes.HasPrefix(key, SnapshotAccountPrefix) && len(key) == (len(SnapshotAccountPrefix)+common.HashLength):
 			accountSnaps.Add(size)
 		case bytes.HasPrefix(key, SnapshotStoragePrefix) && len(key) == (len(SnapshotStoragePrefix)+2*common.HashLength):
@@ -417,6 +586,7 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 				fastTrieProgressKey, snapshotDisabledKey, SnapshotRootKey, snapshotJournalKey,
 				snapshotGeneratorKey, snapshotRecoveryKey, txIndexTailKey, fastTxLookupLimitKey,
 				uncleanShutdownKey, badBlockKey, transitionStatusKey, skeletonSyncStatusKey,
+				headFinalizedBlockKey, snapshotSyncStatusKey, statePruningKey,
 			} {
 				if bytes.Equal(key, meta) {
 					metadata.Add(size)
@@ -434,6 +604,9 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 			logged = time.Now()
 		}
 	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
 	// Inspect append-only file store then.
 	ancientSizes := []*common.StorageSize{&ancientHeadersSize, &ancientBodiesSize, &ancientReceiptsSize, &ancientHashesSize, &ancientTdsSize}
 	for i, category := range []string{freezerHeaderTable, freezerBodiesTable, freezerReceiptTable, freezerHashTable, freezerDifficultyTable} {
@@ -442,46 +615,55 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 			total += common.StorageSize(size)
 		}
 	}
-	// Get number of ancient rows inside the freezer
+	// Get number of ancient rows inside the freezer, excluding the pruned ones
 	ancients := counter(0)
 	if count, err := db.Ancients(); err == nil {
 		ancients = counter(count)
+		if tail, err := db.Tail(); err == nil && tail <= count {
+			ancients = counter(count - tail)
+		}
+	}
+	kvStat := func(category string, s stat) DatabaseStat {
+		return DatabaseStat{Database: "Key-Value store", Category: category, Size: uint64(s.size), Items: uint64(s.count)}
+	}
+	ancientStat := func(category string, size common.StorageSize) DatabaseStat {
+		return DatabaseStat{Database: "Ancient store", Category: category, Size: uint64(size), Items: uint64(ancients)}
+	}
+	lightStat := func(category string, s stat) DatabaseStat {
+		return DatabaseStat{Database: "Light client", Category: category, Size: uint64(s.size), Items: uint64(s.count)}
+	}
+	stats := &DatabaseStats{
+		Stats: []DatabaseStat{
+			kvStat("Headers", headers),
+			kvStat("Bodies", bodies),
+			kvStat("Receipt lists", receipts),
+			kvStat("Difficulties", tds),
+			kvStat("Block number->hash", numHashPairings),
+			kvStat("Block hash->number", hashNumPairings),
+			kvStat("Transaction index", txLookups),
+			kvStat("Bloombit index", bloomBits),
+			kvStat("Address transaction index", addressTxs),
+			kvStat("Contract codes", codes),
+			kvStat("Trie nodes", tries),
+			kvStat("Trie preimages", preimages),
+			kvStat("Account snapshot", accountSnaps),
+			kvStat("Storage snapshot", storageSnaps),
+			kvStat("Beacon sync headers", beaconHeaders),
+			kvStat("Clique snapshots", cliqueSnaps),
+			kvStat("Singleton metadata", metadata),
+			kvStat("Unaccounted", unaccounted),
+			ancientStat("Headers", ancientHeadersSize),
+			ancientStat("Bodies", ancientBodiesSize),
+			ancientStat("Receipt lists", ancientReceiptsSize),
+			ancientStat("Difficulties", ancientTdsSize),
+			ancientStat("Block number->hash", ancientHashesSize),
+			lightStat("CHT trie nodes", chtTrieNodes),
+			lightStat("Bloom trie nodes", bloomTrieNodes),
+		},
+		Total: uint64(total),
 	}
-	// Display the database statistic.
-	stats := [][]string{
-		{"Key-Value store", "Headers", headers.Size(), headers.Count()},
-		{"Key-Value store", "Bodies", bodies.Size(), bodies.Count()},
-		{"Key-Value store", "Receipt lists", receipts.Size(), receipts.Count()},
-		{"Key-Value store", "Difficulties", tds.Size(), tds.Count()},
-		{"Key-Value store", "Block number->hash", numHashPairings.Size(), numHashPairings.Count()},
-		{"Key-Value store", "Block hash->number", hashNumPairings.Size(), hashNumPairings.Count()},
-		{"Key-Value store", "Transaction index", txLookups.Size(), txLookups.Count()},
-		{"Key-Value store", "Bloombit index", bloomBits.Size(), bloomBits.Count()},
-		{"Key-Value store", "Contract codes", codes.Size(), codes.Count()},
-		{"Key-Value store", "Trie nodes", tries.Size(), tries.Count()},
-		{"Key-Value store", "Trie preimages", preimages.Size(), preimages.Count()},
-		{"Key-Value store", "Account snapshot", accountSnaps.Size(), accountSnaps.Count()},
-		{"Key-Value store", "Storage snapshot", storageSnaps.Size(), storageSnaps.Count()},
-		{"Key-Value store", "Beacon sync headers", beaconHeaders.Size(), beaconHeaders.Count()},
-		{"Key-Value store", "Clique snapshots", cliqueSnaps.Size(), cliqueSnaps.Count()},
-		{"Key-Value store", "Singleton metadata", metadata.Size(), metadata.Count()},
-		{"Ancient store", "Headers", ancientHeadersSize.String(), ancients.String()},
-		{"Ancient store", "Bodies", ancientBodiesSize.String(), ancients.String()},
-		{"Ancient store", "Receipt lists", ancientReceiptsSize.String(), ancients.String()},
-		{"Ancient store", "Difficulties", ancientTdsSize.String(), ancients.String()},
-		{"Ancient store", "Block number->hash", ancientHashesSize.String(), ancients.String()},
-		{"Light client", "CHT trie nodes", chtTrieNodes.Size(), chtTrieNodes.Count()},
-		{"Light client", "Bloom trie nodes", bloomTrieNodes.Size(), bloomTrieNodes.Count()},
-	}
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"Database", "Category", "Size", "Items"})
-	table.SetFooter([]string{"", "Total", total.String(), " "})
-	table.AppendBulk(stats)
-	table.Render()
-
 	if unaccounted.size > 0 {
 		log.Error("Database contains unaccounted data", "size", unaccounted.size, "count", unaccounted.count)
 	}
-
-	return nil
+	return stats, nil
 }
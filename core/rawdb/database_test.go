@@ -0,0 +1,250 @@
+// Copyright 2023 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rawdb
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/theQRL/zond/common"
+)
+
+// findStat returns the statistic of the given category of the key-value store.
+func findStat(t *testing.T, stats *DatabaseStats, category string) DatabaseStat {
+	t.Helper()
+	for _, stat := range stats.Stats {
+		if stat.Database == "Key-Value store" && stat.Category == category {
+			return stat
+		}
+	}
+	t.Fatalf("category %q not found", category)
+	return DatabaseStat{}
+}
+
+func TestInspectDatabaseStats(t *testing.T) {
+	db := NewMemoryDatabase()
+	hash := common.HexToHash("0x01")
+	address := common.HexToAddress("0x02")
+
+	entries := []struct {
+		key      []byte
+		value    []byte
+		category string
+	}{
+		{headerKey(1, hash), []byte{1, 2, 3}, "Headers"},
+		{headerKey(2, hash), []byte{1, 2, 3}, "Headers"},
+		{blockBodyKey(1, hash), []byte{1}, "Bodies"},
+		{blockReceiptsKey(1, hash), []byte{1}, "Receipt lists"},
+		{headerTDKey(1, hash), []byte{1}, "Difficulties"},
+		{headerHashKey(1), hash.Bytes(), "Block number->hash"},
+		{headerNumberKey(hash), []byte{1}, "Block hash->number"},
+		{txLookupKey(hash), []byte{1}, "Transaction index"},
+		{addressTxIndexKey(address, make([]byte, AddressTxPositionLength)), []byte{1}, "Address transaction index"},
+		{codeKey(hash), []byte{0x60, 0x00}, "Contract codes"},
+		{hash.Bytes(), []byte{0xc0}, "Trie nodes"},
+		{preimageKey(hash), []byte{1}, "Trie preimages"},
+		{accountSnapshotKey(hash), []byte{1}, "Account snapshot"},
+		{storageSnapshotKey(hash, hash), []byte{1}, "Storage snapshot"},
+		{headHeaderKey, hash.Bytes(), "Singleton metadata"},
+		{configKey(hash), []byte("{}"), "Singleton metadata"},
+		{[]byte("unknown-key"), []byte{1}, "Unaccounted"},
+	}
+	var total uint64
+	for _, e := range entries {
+		if err := db.Put(e.key, e.value); err != nil {
+			t.Fatal(err)
+		}
+		total += uint64(len(e.key) + len(e.value))
+	}
+	stats, err := InspectDatabaseStats(db, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Total != total {
+		t.Errorf("unexpected total size: have %d, want %d", stats.Total, total)
+	}
+	want := make(map[string]DatabaseStat)
+	for _, e := range entries {
+		stat := want[e.category]
+		stat.Size += uint64(len(e.key) + len(e.value))
+		stat.Items++
+		want[e.category] = stat
+	}
+	for category, w := range want {
+		have := findStat(t, stats, category)
+		if have.Size != w.Size || have.Items != w.Items {
+			t.Errorf("%s: have %d items of %d bytes, want %d items of %d bytes", category, have.Items, have.Size, w.Items, w.Size)
+		}
+	}
+	if stat := findStat(t, stats, "Bloombit index"); stat.Items != 0 || stat.Size != 0 {
+		t.Errorf("unexpected bloombit entries %+v", stat)
+	}
+	// A memory database has no ancient store.
+	for _, stat := range stats.Stats {
+		if stat.Database == "Ancient store" && (stat.Size != 0 || stat.Items != 0) {
+			t.Errorf("unexpected ancient entries %+v", stat)
+		}
+	}
+}
+
+func TestInspectDatabaseStatsPrefix(t *testing.T) {
+	db := NewMemoryDatabase()
+	hash := common.HexToHash("0x01")
+	for i := uint64(0); i < 4; i++ {
+		if err := db.Put(headerKey(i, hash), []byte{1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.Put(codeKey(hash), []byte{1}); err != nil {
+		t.Fatal(err)
+	}
+	// Only the headers from number 2 onwards are inspected.
+	stats, err := InspectDatabaseStats(db, headerPrefix, encodeBlockNumber(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stat := findStat(t, stats, "Headers"); stat.Items != 2 {
+		t.Errorf("expected 2 headers, got %d", stat.Items)
+	}
+	if stat := findStat(t, stats, "Contract codes"); stat.Items != 0 {
+		t.Errorf("expected no codes outside the prefix, got %d", stat.Items)
+	}
+}
+
+func TestDatabaseStatsOutput(t *testing.T) {
+	stats := &DatabaseStats{
+		Stats: []DatabaseStat{{Database: "Key-Value store", Category: "Headers", Size: 2048, Items: 3}},
+		Total: 2048,
+	}
+	var buf bytes.Buffer
+	stats.Render(&buf)
+	for _, want := range []string{"Headers", "2.00 KiB", "3"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("rendered table misses %q:\n%s", want, buf.String())
+		}
+	}
+	blob, err := json.Marshal(stats)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"stats":[{"database":"Key-Value store","category":"Headers","size":2048,"items":3}],"total":2048}`
+	if string(blob) != want {
+		t.Errorf("unexpected JSON output: have %s, want %s", blob, want)
+	}
+}
+
+func TestHasPreexistingDb(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		want  string
+	}{
+		{"empty directory", nil, ""},
+		{"leveldb files", map[string]string{"CURRENT": "MANIFEST-000001\n"}, dbLeveldb},
+		{"pebble files", map[string]string{"CURRENT": "MANIFEST-000001\n", "OPTIONS-000002": ""}, dbPebble},
+		{"options without a database", map[string]string{"OPTIONS-000002": ""}, ""},
+		{"recorded engine", map[string]string{dbEngineFile: "pebble\n"}, dbPebble},
+		{"recorded engine overrides the files", map[string]string{"CURRENT": "MANIFEST-000001\n", dbEngineFile: "pebble\n"}, dbPebble},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range tt.files {
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if got := hasPreexistingDb(dir); got != tt.want {
+				t.Errorf("have %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteDatabaseEngine(t *testing.T) {
+	dir := t.TempDir()
+	if err := writeDatabaseEngine(dir, dbPebble); err != nil {
+		t.Fatal(err)
+	}
+	// The engine is only recorded once.
+	if err := writeDatabaseEngine(dir, dbLeveldb); err != nil {
+		t.Fatal(err)
+	}
+	if got := hasPreexistingDb(dir); got != dbPebble {
+		t.Errorf("have engine %q, want %q", got, dbPebble)
+	}
+	if err := writeDatabaseEngine(filepath.Join(dir, "missing"), dbPebble); err == nil {
+		t.Error("expected recording the engine in a missing directory to fail")
+	}
+}
+
+func TestOpenKeyValueDatabaseEngine(t *testing.T) {
+	open := func(dir, engine string) error {
+		db, err := openKeyValueDatabase(OpenOptions{Type: engine, Directory: dir})
+		if err != nil {
+			return err
+		}
+		return db.Close()
+	}
+	engines := []string{dbLeveldb}
+	if PebbleEnabled {
+		engines = append(engines, dbPebble)
+	}
+	for _, engine := range engines {
+		t.Run(engine, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := open(dir, engine); err != nil {
+				t.Fatal(err)
+			}
+			if got := hasPreexistingDb(dir); got != engine {
+				t.Fatalf("have recorded engine %q, want %q", got, engine)
+			}
+			// The database is reopened with its own engine, whether or not it's requested.
+			for _, requested := range []string{"", engine} {
+				if err := open(dir, requested); err != nil {
+					t.Errorf("reopening with %q: %v", requested, err)
+				}
+			}
+			for _, other := range []string{dbLeveldb, dbPebble} {
+				if other == engine {
+					continue
+				}
+				if err := open(dir, other); err == nil || !strings.Contains(err.Error(), "pre-existing "+engine) {
+					t.Errorf("reopening with %q: unexpected error %v", other, err)
+				}
+			}
+		})
+	}
+
+	if err := open(t.TempDir(), "rocksdb"); err == nil || !strings.Contains(err.Error(), "unknown db.engine rocksdb") {
+		t.Errorf("unexpected error %v for an unknown engine", err)
+	}
+	dir := t.TempDir()
+	if err := writeDatabaseEngine(dir, "rocksdb"); err != nil {
+		t.Fatal(err)
+	}
+	if err := open(dir, ""); err == nil || !strings.Contains(err.Error(), "unknown db.engine rocksdb recorded") {
+		t.Errorf("unexpected error %v for an unknown recorded engine", err)
+	}
+	if err := open(dir, dbLeveldb); err == nil || !strings.Contains(err.Error(), "pre-existing rocksdb") {
+		t.Errorf("unexpected error %v for a mismatch with an unknown recorded engine", err)
+	}
+}
@@ -66,6 +66,10 @@ var (
 	// snapshotSyncStatusKey tracks the snapshot sync status across restarts.
 	snapshotSyncStatusKey = []byte("SnapshotSyncStatus")
 
+	// statePruningKey tracks the target state root of an offline state pruning
+	// across crashes, marking the pruning as unfinished.
+	statePruningKey = []byte("StatePruning")
+
 	// skeletonSyncStatusKey tracks the skeleton sync status across restarts.
 	skeletonSyncStatusKey = []byte("SkeletonSyncStatus")
 
@@ -99,13 +103,15 @@ var (
 	SnapshotStoragePrefix = []byte("o") // SnapshotStoragePrefix + account hash + storage hash -> storage trie value
 	CodePrefix            = []byte("c") // CodePrefix + code hash -> account code
 	skeletonHeaderPrefix  = []byte("S") // skeletonHeaderPrefix + num (uint64 big endian) -> header
+	addressTxIndexPrefix  = []byte("x") // addressTxIndexPrefix + address + position (^num uint64, ^tx index uint32 big endian) -> block hash + roles
 
 	PreimagePrefix = []byte("secure-key-")       // PreimagePrefix + hash -> preimage
 	configPrefix   = []byte("ethereum-config-")  // config prefix for the db
 	genesisPrefix  = []byte("ethereum-genesis-") // genesis state prefix for the db
 
 	// Chain index prefixes (use `i` + single byte to avoid mixing data types).
-	BloomBitsIndexPrefix = []byte("iB") // BloomBitsIndexPrefix is the data table of a chain indexer to track its progress
+	BloomBitsIndexPrefix      = []byte("iB") // BloomBitsIndexPrefix is the data table of a chain indexer to track its progress
+	AddressTxIndexTablePrefix = []byte("iA") // AddressTxIndexTablePrefix is the data table of the address transaction indexer to track its progress
 
 	preimageCounter    = metrics.NewRegisteredCounter("db/preimage/total", nil)
 	preimageHitCounter = metrics.NewRegisteredCounter("db/preimage/hits", nil)
@@ -208,6 +214,11 @@ func storageSnapshotsKey(accountHash common.Hash) []byte {
 	return append(SnapshotStoragePrefix, accountHash.Bytes()...)
 }
 
+// addressTxIndexKey = addressTxIndexPrefix + address + position
+func addressTxIndexKey(address common.Address, position []byte) []byte {
+	return append(append(append([]byte{}, addressTxIndexPrefix...), address.Bytes()...), position...)
+}
+
 // bloomBitsKey = bloomBitsPrefix + bit (uint16 big endian) + section (uint64 big endian) + hash
 func bloomBitsKey(bit uint, section uint64, hash common.Hash) []byte {
 	key := append(append(bloomBitsPrefix, make([]byte, 10)...), hash.Bytes()...)
@@ -0,0 +1,243 @@
+package pruner
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/theQRL/zond/common"
+	"github.com/theQRL/zond/core/rawdb"
+	"github.com/theQRL/zond/core/state"
+	"github.com/theQRL/zond/core/state/snapshot"
+	"github.com/theQRL/zond/core/types"
+	"github.com/theQRL/zond/crypto"
+	"github.com/theQRL/zond/ethdb"
+)
+
+var (
+	genesisAccount = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	contract       = common.HexToAddress("0x1000000000000000000000000000000000000002")
+	newAccount     = common.HexToAddress("0x1000000000000000000000000000000000000003")
+
+	staleCode  = []byte{0x60, 0x01, 0x60, 0x00, 0x55}
+	targetCode = []byte{0x60, 0x02, 0x60, 0x00, 0x55}
+)
+
+// pruningTest is a database holding the genesis state, a stale state and the target
+// state of the head block, along with the snapshot of the target state.
+type pruningTest struct {
+	db                             ethdb.Database
+	genesisRoot, staleRoot, target common.Hash
+}
+
+func commitState(t *testing.T, sdb state.Database, root common.Hash, modify func(*state.StateDB)) common.Hash {
+	statedb, err := state.New(root, sdb, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	modify(statedb)
+	root, err = statedb.Commit(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sdb.TrieDB().Commit(root, false, nil); err != nil {
+		t.Fatal(err)
+	}
+	return root
+}
+
+func newPruningTest(t *testing.T) *pruningTest {
+	var (
+		db  = rawdb.NewMemoryDatabase()
+		sdb = state.NewDatabase(db)
+	)
+	genesisRoot := commitState(t, sdb, common.Hash{}, func(statedb *state.StateDB) {
+		statedb.SetBalance(genesisAccount, big.NewInt(1000))
+	})
+	staleRoot := commitState(t, sdb, genesisRoot, func(statedb *state.StateDB) {
+		statedb.SetBalance(contract, big.NewInt(1))
+		statedb.SetCode(contract, staleCode)
+		for i := byte(1); i <= 20; i++ {
+			statedb.SetState(contract, common.Hash{i}, common.Hash{i})
+		}
+	})
+	target := commitState(t, sdb, staleRoot, func(statedb *state.StateDB) {
+		statedb.SetBalance(contract, big.NewInt(2))
+		statedb.SetCode(contract, targetCode)
+		for i := byte(1); i <= 10; i++ {
+			statedb.SetState(contract, common.Hash{i}, common.Hash{})
+		}
+		statedb.SetBalance(newAccount, big.NewInt(3))
+	})
+
+	genesis := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(0), Difficulty: new(big.Int), Root: genesisRoot})
+	head := types.NewBlockWithHeader(&types.Header{ParentHash: genesis.Hash(), Number: big.NewInt(1), Difficulty: new(big.Int), Root: target})
+	for _, block := range []*types.Block{genesis, head} {
+		rawdb.WriteBlock(db, block)
+		rawdb.WriteCanonicalHash(db, block.Hash(), block.NumberU64())
+	}
+	rawdb.WriteHeadBlockHash(db, head.Hash())
+
+	snaptree, err := snapshot.New(snapshot.Config{CacheSize: 16}, db, sdb.TrieDB(), target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := snaptree.Journal(target); err != nil {
+		t.Fatal(err)
+	}
+	return &pruningTest{db: db, genesisRoot: genesisRoot, staleRoot: staleRoot, target: target}
+}
+
+// newPruner returns a pruner over the test database, with a bloom filter small
+// enough to keep the test fast.
+func (pt *pruningTest) newPruner(t *testing.T, datadir string) *Pruner {
+	p, err := NewPruner(pt.db, Config{Datadir: datadir, Cachedir: filepath.Join(datadir, "triecache")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.stateBloom, err = newStateBloomWithSize(1); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// checkPruned checks that the target and genesis states are intact, that the stale
+// state is gone and that no pruning is left to resume.
+func (pt *pruningTest) checkPruned(t *testing.T, datadir string) {
+	t.Helper()
+	statedb, err := state.New(pt.target, state.NewDatabase(pt.db), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance := statedb.GetBalance(genesisAccount); balance.Cmp(big.NewInt(1000)) != 0 {
+		t.Errorf("unexpected balance %v of the genesis account", balance)
+	}
+	if balance := statedb.GetBalance(contract); balance.Cmp(big.NewInt(2)) != 0 {
+		t.Errorf("unexpected balance %v of the contract", balance)
+	}
+	if balance := statedb.GetBalance(newAccount); balance.Cmp(big.NewInt(3)) != 0 {
+		t.Errorf("unexpected balance %v of the new account", balance)
+	}
+	if code := statedb.GetCode(contract); string(code) != string(targetCode) {
+		t.Errorf("unexpected code %x of the contract", code)
+	}
+	for i := byte(1); i <= 20; i++ {
+		want := common.Hash{}
+		if i > 10 {
+			want = common.Hash{i}
+		}
+		if got := statedb.GetState(contract, common.Hash{i}); got != want {
+			t.Errorf("unexpected storage slot %d: %x, want %x", i, got, want)
+		}
+	}
+	if err := statedb.Error(); err != nil {
+		t.Fatalf("target state not readable: %v", err)
+	}
+	if !rawdb.HasTrieNode(pt.db, pt.genesisRoot) {
+		t.Error("genesis state pruned")
+	}
+
+	if rawdb.HasTrieNode(pt.db, pt.staleRoot) {
+		t.Error("stale state root not pruned")
+	}
+	if rawdb.HasCode(pt.db, crypto.Keccak256Hash(staleCode)) {
+		t.Error("stale contract code not pruned")
+	}
+	if root := rawdb.ReadStatePruningRoot(pt.db); root != (common.Hash{}) {
+		t.Errorf("pruning marker %x left in the database", root)
+	}
+	if common.FileExist(bloomFilterName(datadir, pt.target)) {
+		t.Error("state bloom filter left on disk")
+	}
+	if common.FileExist(filepath.Join(datadir, "triecache")) {
+		t.Error("clean trie cache not deleted")
+	}
+}
+
+func TestPrune(t *testing.T) {
+	pt := newPruningTest(t)
+	datadir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(datadir, "triecache"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if !rawdb.HasTrieNode(pt.db, pt.staleRoot) || !rawdb.HasCode(pt.db, crypto.Keccak256Hash(staleCode)) {
+		t.Fatal("stale state missing before pruning")
+	}
+
+	// Without enough snapshot layers, the default target is rejected
+	if err := pt.newPruner(t, datadir).Prune(common.Hash{}); err == nil {
+		t.Fatal("expected pruning without snapshot diff layers to fail")
+	}
+	if err := pt.newPruner(t, datadir).Prune(pt.target); err != nil {
+		t.Fatal(err)
+	}
+	pt.checkPruned(t, datadir)
+
+	// The snapshot is flattened into a disk layer of the target
+	p := pt.newPruner(t, datadir)
+	if root := p.snaptree.DiskRoot(); root != pt.target {
+		t.Errorf("unexpected snapshot disk root %x, want %x", root, pt.target)
+	}
+}
+
+func TestPruneResume(t *testing.T) {
+	pt := newPruningTest(t)
+	datadir := t.TempDir()
+
+	// Nothing is resumed without a pruning marker
+	if err := RecoverPruning(datadir, pt.db, filepath.Join(datadir, "triecache")); err != nil {
+		t.Fatal(err)
+	}
+	if !rawdb.HasTrieNode(pt.db, pt.staleRoot) {
+		t.Fatal("state pruned without a pruning marker")
+	}
+
+	// Interrupt a pruning after committing its bloom filter and recording its target
+	p := pt.newPruner(t, datadir)
+	if err := snapshot.GenerateTrie(p.snaptree, pt.target, pt.db, p.stateBloom); err != nil {
+		t.Fatal(err)
+	}
+	if err := extractGenesis(pt.db, p.stateBloom); err != nil {
+		t.Fatal(err)
+	}
+	filterName := bloomFilterName(datadir, pt.target)
+	if err := p.stateBloom.Commit(filterName, filterName+stateBloomFileTempSuffix); err != nil {
+		t.Fatal(err)
+	}
+	rawdb.WriteStatePruningRoot(pt.db, pt.target)
+	rawdb.DeleteCode(pt.db, crypto.Keccak256Hash(staleCode))
+
+	// A new pruning resumes the interrupted one, whatever its own target
+	if err := pt.newPruner(t, datadir).Prune(common.Hash{}); err != nil {
+		t.Fatal(err)
+	}
+	pt.checkPruned(t, datadir)
+}
+
+func TestRecoverPruning(t *testing.T) {
+	pt := newPruningTest(t)
+	datadir := t.TempDir()
+
+	// A marker without the committed bloom filter can't be resumed
+	rawdb.WriteStatePruningRoot(pt.db, pt.target)
+	if err := RecoverPruning(datadir, pt.db, filepath.Join(datadir, "triecache")); err == nil {
+		t.Fatal("expected recovery without a bloom filter to fail")
+	}
+
+	p := pt.newPruner(t, datadir)
+	if err := snapshot.GenerateTrie(p.snaptree, pt.target, pt.db, p.stateBloom); err != nil {
+		t.Fatal(err)
+	}
+	if err := extractGenesis(pt.db, p.stateBloom); err != nil {
+		t.Fatal(err)
+	}
+	filterName := bloomFilterName(datadir, pt.target)
+	if err := p.stateBloom.Commit(filterName, filterName+stateBloomFileTempSuffix); err != nil {
+		t.Fatal(err)
+	}
+	if err := RecoverPruning(datadir, pt.db, filepath.Join(datadir, "triecache")); err != nil {
+		t.Fatal(err)
+	}
+	pt.checkPruned(t, datadir)
+}
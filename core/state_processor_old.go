@@ -168,71 +168,25 @@ func (p *StateProcessorV1) ProcessGenesis(b *block.Block, statedb *state.StateDB
 	// Iterate over and process the individual transactions
 	for i, protoTx := range b.Transactions() {
 		tx := transactions.ProtoToTransaction(protoTx)
-		statedb.SetTxContext(tx.Hash(), i)
-
-		var receipt *types.Receipt
-		var err error
 
 		switch protoTx.Type.(type) {
 		case *protos.Transaction_Stake:
-			stakeTx := transactions.StakeTransactionFromPBData(protoTx)
-
-			if err := ValidateStakeTxn(stakeTx, statedb); err != nil {
+			if err := ValidateStakeTxn(transactions.StakeTransactionFromPBData(protoTx), statedb); err != nil {
 				return nil, nil, 0, err
 			}
-			receipt, err = applyStakeTransaction(gp, statedb, blockNumber, blockHash, stakeTx, usedGas, b.Minter())
-			if err != nil {
-				return nil, nil, 0, fmt.Errorf("could not apply stake tx %d [%v]: %w", i, tx.Hash().Hex(), err)
-			}
-
 		case *protos.Transaction_Transfer:
-			transferTx := transactions.TransferTransactionFromPBData(protoTx)
-			if err := ValidateTransferTxn(transferTx, statedb); err != nil {
+			if err := ValidateTransferTxn(transactions.TransferTransactionFromPBData(protoTx), statedb); err != nil {
 				return nil, nil, 0, err
 			}
-			msg, err := TransferTxAsMessage(transferTx, header.BaseFee())
-			if err != nil {
-				return nil, nil, 0, fmt.Errorf("could not apply tx %d [%v]: %w", i, tx.Hash().Hex(), err)
-			}
-			receipt, err = applyTransactionV1(msg, gp, statedb, blockNumber, blockHash, tx, usedGas, vmenv)
-			if err != nil {
-				return nil, nil, 0, fmt.Errorf("could not apply transfer tx %d [%v]: %w", i, tx.Hash().Hex(), err)
-			}
+		}
+		receipt, err := ApplyTransactionV1(vmenv, gp, statedb, b, i, usedGas)
+		if err != nil {
+			return nil, nil, 0, fmt.Errorf("could not apply tx %d [%v]: %w", i, tx.Hash().Hex(), err)
 		}
 		txReceipts = append(txReceipts, receipt)
 		allLogs = append(allLogs, receipt.Logs...)
-
-		// If not a contract and an xmss address then update the ots bitfield
-		if statedb.GetCodeSize(tx.AddrFrom()) == 0 && xmss.IsValidXMSSAddress(tx.AddrFrom()) {
-			statedb.SetOTSBitfield(tx.AddrFrom(), misc.GetOTSIndexFromSignature(tx.Signature()), false)
-		}
-
-	}
-
-	epochMetaData := stateContext.GetEpochMetaData()
-	err := ProcessEpochMetaData(b, statedb, epochMetaData)
-	if err != nil {
-		log.Error("Failed to Process Epoch MetaData")
-		return nil, nil, 0, err
-	}
-
-	pendingStakeValidatorsUpdate := b.GetPendingValidatorsUpdate()
-
-	err = UpdateStakeValidators(statedb, pendingStakeValidatorsUpdate, epochMetaData)
-	if err != nil {
-		log.Error("Failed to update stake validators for genesis")
-		return nil, nil, 0, err
 	}
 
-	// For Genesis Block total stake found and alloted must be same
-	epochMetaData.UpdatePrevEpochStakeData(epochMetaData.TotalStakeAmountFound(),
-		epochMetaData.TotalStakeAmountFound())
-
-	randomSeed := GetRandomSeed(b.ParentHash())
-
-	currentEpoch := uint64(0)
-	epochMetaData.AllotSlots(randomSeed, currentEpoch, b.ParentHash())
-
 	// Finalize the block, applying any consensus engine specific extras (e.g. block rewards)
 	//p.engine.Finalize(header, statedb)
 
@@ -574,6 +528,72 @@ func (p *StateProcessorV1) Commit(s *state2.StateContext, b *block.Block, protoc
 	})
 }
 
+// ApplyTransactionV1 applies the transaction at the given index of b to statedb, without validating it.
+// Transfer transactions are executed by evm, which must have been created with the block context of b.
+func ApplyTransactionV1(evm *vm.EVM, gp *GasPool, statedb *state.StateDB, b *block.Block, index int, usedGas *uint64) (*types.Receipt, error) {
+	var (
+		protoTx     = b.Transactions()[index]
+		tx          = transactions.ProtoToTransaction(protoTx)
+		blockNumber = big.NewInt(int64(b.Number()))
+		receipt     *types.Receipt
+		err         error
+	)
+	statedb.SetTxContext(tx.Hash(), index)
+
+	switch protoTx.Type.(type) {
+	case *protos.Transaction_Stake:
+		receipt, err = applyStakeTransaction(gp, statedb, blockNumber, b.Hash(), transactions.StakeTransactionFromPBData(protoTx), usedGas, b.Minter())
+	case *protos.Transaction_Transfer:
+		var msg types.Message
+		msg, err = TransferTxAsMessage(transactions.TransferTransactionFromPBData(protoTx), b.Header().BaseFee())
+		if err != nil {
+			return nil, err
+		}
+		receipt, err = applyTransactionV1(msg, gp, statedb, blockNumber, b.Hash(), tx, usedGas, evm)
+	default:
+		err = fmt.Errorf("unsupported transaction type %T", protoTx.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// If code size is 0 in that case it is an account and not a contract
+	if statedb.GetCodeSize(tx.AddrFrom()) == 0 && xmss.IsValidXMSSAddress(tx.AddrFrom()) {
+		statedb.SetOTSBitfield(tx.AddrFrom(), misc.GetOTSIndexFromSignature(tx.Signature()), false)
+	}
+	return receipt, nil
+}
+
+// TransactionAsMessageV1 returns the message executed by the EVM for the given transaction.
+// Only transfer transactions are executed by the EVM.
+func TransactionAsMessageV1(protoTx *protos.Transaction, baseFee *big.Int) (types.Message, error) {
+	transferTx, ok := protoTx.Type.(*protos.Transaction_Transfer)
+	if !ok || transferTx == nil {
+		return types.Message{}, ErrNotEVMTransaction
+	}
+	return TransferTxAsMessage(transactions.TransferTransactionFromPBData(protoTx), baseFee)
+}
+
+// ReplayProtocolTransactionsV1 applies the rewards paid out by the protocol transactions of b to statedb.
+// Unlike Process, it neither validates the transactions nor tracks the block proposer and attestors in a
+// state context, so it is only suited to re-executing blocks that have already been imported.
+func ReplayProtocolTransactionsV1(b *block.Block, statedb *state.StateDB) {
+	protocolTxs := b.ProtocolTransactions()
+	if len(protocolTxs) == 0 {
+		return
+	}
+	attestorReward := protocolTxs[0].GetCoinBase().GetAttestorReward()
+	for _, protoTx := range protocolTxs {
+		statedb.Finalise(true)
+		switch protoTx.Type.(type) {
+		case *protos.ProtocolTransaction_CoinBase:
+			creditBlockProposer(statedb, transactions.CoinBaseTransactionFromPBData(protoTx))
+		case *protos.ProtocolTransaction_Attest:
+			creditAttestor(statedb, transactions.AttestTransactionFromPBData(protoTx), attestorReward)
+		}
+	}
+}
+
 func applyTransactionV1(msg types.Message, gp *GasPool, statedb *state.StateDB, blockNumber *big.Int, blockHash common.Hash, tx transactions.TransactionInterface, usedGas *uint64, evm *vm.EVM) (*types.Receipt, error) {
 	// Create a new context to be used in the EVM environment.
 	txContext := NewEVMTxContext(msg)
@@ -695,14 +715,7 @@ func applyCoinBaseTransaction(statedb *state.StateDB, stateContext *state2.State
 		return nil, fmt.Errorf("failed to process block proposer %s | Reason: %w", misc.BytesToHexStr(tx.PK()), err)
 	}
 
-	blockReward := big.NewInt(int64(tx.BlockProposerReward()))
-	accountState.AddBalance(blockReward)
-	accountState.AddBalance(big.NewInt(int64(tx.FeeReward())))
-	accountState.SetNonce(statedb.GetNonce(address) + 1)
-
-	coinBaseAddress := config.GetDevConfig().Genesis.CoinBaseAddress
-	coinBaseAccountState := statedb.GetOrNewStateObject(coinBaseAddress)
-	coinBaseAccountState.SubBalance(blockReward)
+	creditBlockProposer(statedb, tx)
 
 	signedMessage := tx.GetSigningHash(stateContext.BlockSigningHash())
 	txHash := tx.TxHash(signedMessage)
@@ -740,13 +753,7 @@ func applyAttestTransaction(statedb *state.StateDB, stateContext *state2.StateCo
 		return nil, fmt.Errorf("failed to process attest transaction for attestor  %s | Reason: %w", misc.BytesToHexStr(tx.PK()), err)
 	}
 
-	reward := big.NewInt(int64(attestorReward))
-	accountState.AddBalance(reward)
-	accountState.SetNonce(statedb.GetNonce(address) + 1)
-
-	coinBaseAddress := config.GetDevConfig().Genesis.CoinBaseAddress
-	coinBaseAccountState := statedb.GetOrNewStateObject(coinBaseAddress)
-	coinBaseAccountState.SubBalance(reward)
+	creditAttestor(statedb, tx, attestorReward)
 	/* -- Attest Transaction changes ends -- */
 
 	// Create a new receipt for the transaction, storing the intermediate root and gas used
@@ -764,6 +771,35 @@ func applyAttestTransaction(statedb *state.StateDB, stateContext *state2.StateCo
 	return receipt, nil
 }
 
+// creditBlockProposer pays the block proposer reward and the fees out of the coinbase account.
+func creditBlockProposer(statedb *state.StateDB, tx *transactions.CoinBase) {
+	address := misc.GetDilithiumAddressFromUnSizedPK(tx.PK())
+	accountState := statedb.GetOrNewStateObject(address)
+
+	blockReward := big.NewInt(int64(tx.BlockProposerReward()))
+	accountState.AddBalance(blockReward)
+	accountState.AddBalance(big.NewInt(int64(tx.FeeReward())))
+	accountState.SetNonce(statedb.GetNonce(address) + 1)
+
+	coinBaseAddress := config.GetDevConfig().Genesis.CoinBaseAddress
+	coinBaseAccountState := statedb.GetOrNewStateObject(coinBaseAddress)
+	coinBaseAccountState.SubBalance(blockReward)
+}
+
+// creditAttestor pays the attestor reward out of the coinbase account.
+func creditAttestor(statedb *state.StateDB, tx *transactions.Attest, attestorReward uint64) {
+	address := misc.GetDilithiumAddressFromUnSizedPK(tx.PK())
+	accountState := statedb.GetOrNewStateObject(address)
+
+	reward := big.NewInt(int64(attestorReward))
+	accountState.AddBalance(reward)
+	accountState.SetNonce(statedb.GetNonce(address) + 1)
+
+	coinBaseAddress := config.GetDevConfig().Genesis.CoinBaseAddress
+	coinBaseAccountState := statedb.GetOrNewStateObject(coinBaseAddress)
+	coinBaseAccountState.SubBalance(reward)
+}
+
 func ValidateTransferTxn(tx *transactions.Transfer, statedb *state.StateDB) error {
 	txHash := tx.GenerateTxHash()
 
@@ -594,6 +594,11 @@ func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
 	// if !pool.eip1559 && tx.Type() == types.DynamicFeeTxType {
 	// 	return ErrTxTypeNotSupported
 	// }
+	// Reject Dilithium transactions until the fee market activates, they
+	// are priced like dynamic fee transactions.
+	if !pool.eip1559 && tx.Type() == types.DilithiumTxType {
+		return core.ErrTxTypeNotSupported
+	}
 	// Reject transactions over defined size to prevent DOS attacks
 
 	// data, err := proto.Marshal(tx.PbTx)
@@ -0,0 +1,129 @@
+// Copyright 2021 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"math/big"
+
+	"github.com/theQRL/zond/common"
+	"github.com/theQRL/zond/protos"
+	"github.com/theQRL/zond/transactions"
+)
+
+// DilithiumTx is a dynamic fee transaction signed with a Dilithium key. The
+// sender is derived from PublicKey, so the transaction carries no V, R, S
+// values. It has no access list, as the transfer transactions it is converted
+// into have none.
+type DilithiumTx struct {
+	ChainID   *big.Int
+	Nonce     uint64
+	GasTipCap *big.Int // a.k.a. maxPriorityFeePerGas
+	GasFeeCap *big.Int // a.k.a. maxFeePerGas
+	Gas       uint64
+	To        *common.Address `rlp:"nil"` // nil means contract creation
+	Value     *big.Int
+	Data      []byte
+
+	PublicKey []byte
+	Signature []byte
+}
+
+// copy creates a deep copy of the transaction data and initializes all fields.
+func (tx *DilithiumTx) copy() TxData {
+	cpy := &DilithiumTx{
+		Nonce:     tx.Nonce,
+		To:        copyAddressPtr(tx.To),
+		Data:      common.CopyBytes(tx.Data),
+		Gas:       tx.Gas,
+		PublicKey: common.CopyBytes(tx.PublicKey),
+		Signature: common.CopyBytes(tx.Signature),
+		// These are copied below.
+		Value:     new(big.Int),
+		ChainID:   new(big.Int),
+		GasTipCap: new(big.Int),
+		GasFeeCap: new(big.Int),
+	}
+	if tx.Value != nil {
+		cpy.Value.Set(tx.Value)
+	}
+	if tx.ChainID != nil {
+		cpy.ChainID.Set(tx.ChainID)
+	}
+	if tx.GasTipCap != nil {
+		cpy.GasTipCap.Set(tx.GasTipCap)
+	}
+	if tx.GasFeeCap != nil {
+		cpy.GasFeeCap.Set(tx.GasFeeCap)
+	}
+	return cpy
+}
+
+// accessors for innerTx.
+func (tx *DilithiumTx) txType() byte                     { return DilithiumTxType }
+func (tx *DilithiumTx) chainID() *big.Int                { return tx.ChainID }
+func (tx *DilithiumTx) accessList() AccessList           { return nil }
+func (tx *DilithiumTx) data() []byte                     { return tx.Data }
+func (tx *DilithiumTx) gas() uint64                      { return tx.Gas }
+func (tx *DilithiumTx) gasFeeCap() *big.Int              { return tx.GasFeeCap }
+func (tx *DilithiumTx) gasTipCap() *big.Int              { return tx.GasTipCap }
+func (tx *DilithiumTx) gasPrice() *big.Int               { return tx.GasFeeCap }
+func (tx *DilithiumTx) value() *big.Int                  { return tx.Value }
+func (tx *DilithiumTx) nonce() uint64                    { return tx.Nonce }
+func (tx *DilithiumTx) to() *common.Address              { return tx.To }
+func (tx *DilithiumTx) InnerTXType() transactions.TxType { return transactions.TypeTransfer }
+func (tx *DilithiumTx) pk() []byte                       { return tx.PublicKey }
+func (tx *DilithiumTx) signature() []byte                { return tx.Signature }
+
+// rawSignatureValues returns zero values, Dilithium signatures have no V, R, S
+// representation.
+func (tx *DilithiumTx) rawSignatureValues() (v, r, s *big.Int) {
+	return new(big.Int), new(big.Int), new(big.Int)
+}
+
+func (tx *DilithiumTx) setSignatureValues(signature []byte) {
+	tx.Signature = signature
+}
+
+// ToProto converts a Dilithium transaction into the transfer transaction
+// representation used by the transaction pool and the block body.
+func (tx *Transaction) ToProto() (*protos.Transaction, error) {
+	if tx.Type() != DilithiumTxType {
+		return nil, ErrTxTypeNotSupported
+	}
+	if !tx.Value().IsUint64() {
+		return nil, ErrInvalidTxValue
+	}
+	transfer := &protos.Transfer{
+		Value: tx.Value().Uint64(),
+		Data:  tx.Data(),
+	}
+	if to := tx.To(); to != nil {
+		transfer.To = to.Bytes()
+	}
+	hash := tx.Hash()
+	return &protos.Transaction{
+		ChainId:   tx.ChainId().Uint64(),
+		Nonce:     tx.Nonce(),
+		Pk:        common.CopyBytes(tx.PK()),
+		Signature: common.CopyBytes(tx.Signature()),
+		Gas:       tx.Gas(),
+		GasFeeCap: tx.GasFeeCap().Bytes(),
+		GasFeeTip: tx.GasTipCap().Bytes(),
+		Hash:      hash[:],
+		Type:      &protos.Transaction_Transfer{Transfer: transfer},
+	}, nil
+}
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/zond/common"
+	"github.com/theQRL/zond/misc"
+	"github.com/theQRL/zond/transactions"
+)
+
+func signedDilithiumTx(t *testing.T, d *dilithium.Dilithium, signer Signer) *Transaction {
+	to := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	tx, err := SignNewDilithiumTx(d, signer, &DilithiumTx{
+		ChainID:   big.NewInt(1),
+		Nonce:     3,
+		GasTipCap: big.NewInt(2),
+		GasFeeCap: big.NewInt(10),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(1000),
+		Data:      []byte{0xca, 0xfe},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tx
+}
+
+func TestDilithiumTxRoundTrip(t *testing.T) {
+	d := dilithium.New()
+	pk := d.GetPK()
+	want := misc.GetDilithiumAddressFromUnSizedPK(pk[:])
+	signer := NewDilithiumSigner(big.NewInt(1))
+	tx := signedDilithiumTx(t, d, signer)
+
+	from, err := Sender(signer, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if from != want {
+		t.Fatalf("expected sender %x, got %x", want, from)
+	}
+
+	// RLP
+	enc, err := tx.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dec := new(Transaction)
+	if err := dec.UnmarshalBinary(enc); err != nil {
+		t.Fatal(err)
+	}
+	if dec.Hash() != tx.Hash() {
+		t.Errorf("RLP round trip changed the hash from %x to %x", tx.Hash(), dec.Hash())
+	}
+	if from, err := Sender(signer, dec); err != nil || from != want {
+		t.Errorf("expected sender %x of the RLP decoded transaction, got %x, %v", want, from, err)
+	}
+
+	// JSON
+	jsonEnc, err := json.Marshal(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dec = new(Transaction)
+	if err := json.Unmarshal(jsonEnc, dec); err != nil {
+		t.Fatal(err)
+	}
+	if dec.Hash() != tx.Hash() {
+		t.Errorf("JSON round trip changed the hash from %x to %x", tx.Hash(), dec.Hash())
+	}
+	if from, err := Sender(signer, dec); err != nil || from != want {
+		t.Errorf("expected sender %x of the JSON decoded transaction, got %x, %v", want, from, err)
+	}
+
+	// Proto
+	protoTx, err := tx.ToProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+	transfer := transactions.TransferTransactionFromPBData(protoTx)
+	if transfer.GetSigningHash() != signer.Hash(tx) {
+		t.Errorf("transfer signing hash %x does not match %x", transfer.GetSigningHash(), signer.Hash(tx))
+	}
+	if transfer.GenerateTxHash() != tx.Hash() {
+		t.Errorf("transfer hash %x does not match %x", transfer.GenerateTxHash(), tx.Hash())
+	}
+	if !bytes.Equal(protoTx.Pk, pk[:]) || !bytes.Equal(protoTx.Signature, tx.Signature()) {
+		t.Error("public key or signature not carried over to the proto transaction")
+	}
+	if *transfer.To() != *tx.To() || transfer.Value() != tx.Value().Uint64() || !bytes.Equal(transfer.Data(), tx.Data()) {
+		t.Error("transfer fields not carried over to the proto transaction")
+	}
+}
+
+func TestDilithiumTxTampered(t *testing.T) {
+	signer := NewDilithiumSigner(big.NewInt(1))
+	tx := signedDilithiumTx(t, dilithium.New(), signer)
+
+	tampered := tx.inner.copy().(*DilithiumTx)
+	tampered.Nonce++
+	if _, err := Sender(signer, NewTx(tampered)); err != ErrInvalidDilithiumSig {
+		t.Errorf("expected %v, got %v", ErrInvalidDilithiumSig, err)
+	}
+	if _, err := Sender(NewDilithiumSigner(big.NewInt(2)), tx); err != ErrInvalidChainId {
+		t.Errorf("expected %v, got %v", ErrInvalidChainId, err)
+	}
+}
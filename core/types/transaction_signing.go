@@ -32,7 +32,10 @@ import (
 	"github.com/theQRL/zond/params"
 )
 
-var ErrInvalidChainId = errors.New("invalid chain id for signer")
+var (
+	ErrInvalidChainId      = errors.New("invalid chain id for signer")
+	ErrInvalidDilithiumSig = errors.New("invalid dilithium transaction signature")
+)
 
 // sigCache is used to cache the derived sender and contains
 // the signer used to derive it.
@@ -46,7 +49,7 @@ func MakeSigner(config *params.ChainConfig, blockNumber *big.Int) Signer {
 	var signer Signer
 	switch {
 	case config.IsLondon(blockNumber):
-		signer = NewLondonSigner(config.ChainID)
+		signer = NewDilithiumSigner(config.ChainID)
 	case config.IsBerlin(blockNumber):
 		signer = NewEIP2930Signer(config.ChainID)
 	case config.IsEIP155(blockNumber):
@@ -69,7 +72,7 @@ func MakeSigner(config *params.ChainConfig, blockNumber *big.Int) Signer {
 func LatestSigner(config *params.ChainConfig) Signer {
 	if config.ChainID != nil {
 		if config.LondonBlock != nil {
-			return NewLondonSigner(config.ChainID)
+			return NewDilithiumSigner(config.ChainID)
 		}
 		if config.BerlinBlock != nil {
 			return NewEIP2930Signer(config.ChainID)
@@ -92,7 +95,7 @@ func LatestSignerForChainID(chainID *big.Int) Signer {
 	if chainID == nil {
 		return HomesteadSigner{}
 	}
-	return NewLondonSigner(chainID)
+	return NewDilithiumSigner(chainID)
 }
 
 // SignTx signs the transaction using the given signer and private key.
@@ -141,6 +144,17 @@ func SignDilithium(tx *Transaction, d *dilithium.Dilithium) {
 	tx.hash.Store(txHash)
 }
 
+// SignNewDilithiumTx creates a Dilithium transaction carrying the public key of
+// d and signs it. The signer must accept Dilithium transactions.
+func SignNewDilithiumTx(d *dilithium.Dilithium, s Signer, txdata *DilithiumTx) (*Transaction, error) {
+	pk := d.GetPK()
+	inner := txdata.copy().(*DilithiumTx)
+	inner.PublicKey = pk[:]
+	tx := NewTx(inner)
+	h := s.Hash(tx)
+	return tx.WithSignature(s, d.Sign(h[:]))
+}
+
 // SignNewTx creates a transaction and signs it.
 func SignNewTx(prv *ecdsa.PrivateKey, s Signer, txdata TxData) (*Transaction, error) {
 	tx := NewTx(txdata)
@@ -170,7 +184,23 @@ func MustSignNewTx(prv *ecdsa.PrivateKey, s Signer, txdata TxData) *Transaction
 // signing method. The cache is invalidated if the cached signer does
 // not match the signer used in the current call.
 func Sender(signer Signer, tx *Transaction) (common.Address, error) {
-	//
+	if tx.Type() == DilithiumTxType {
+		if sc := tx.from.Load(); sc != nil {
+			sigCache := sc.(sigCache)
+			// If the signer used to derive from in a previous
+			// call is not the same as used current, invalidate
+			// the cache.
+			if sigCache.signer.Equal(signer) {
+				return sigCache.from, nil
+			}
+		}
+		addr, err := signer.Sender(tx)
+		if err != nil {
+			return common.Address{}, err
+		}
+		tx.from.Store(sigCache{signer: signer, from: addr})
+		return addr, nil
+	}
 	tx.Nonce()
 	sender := misc.GetAddressFromUnSizedPK(tx.PK())
 	return sender, nil
@@ -205,6 +235,60 @@ type Signer interface {
 	Equal(Signer) bool
 }
 
+type dilithiumSigner struct{ londonSigner }
+
+// NewDilithiumSigner returns a signer that accepts
+// - Dilithium signed transactions, and
+// - all transactions accepted by the London signer.
+func NewDilithiumSigner(chainId *big.Int) Signer {
+	return dilithiumSigner{londonSigner{eip2930Signer{NewEIP155Signer(chainId)}}}
+}
+
+// Sender verifies the Dilithium signature against the signing hash and derives
+// the sender address from the public key carried by the transaction.
+func (s dilithiumSigner) Sender(tx *Transaction) (common.Address, error) {
+	if tx.Type() != DilithiumTxType {
+		return s.londonSigner.Sender(tx)
+	}
+	if tx.ChainId().Cmp(s.chainId) != 0 {
+		return common.Address{}, ErrInvalidChainId
+	}
+	if !tx.Value().IsUint64() {
+		return common.Address{}, ErrInvalidTxValue
+	}
+	if len(tx.PK()) != dilithium.PKSizePacked {
+		return common.Address{}, ErrInvalidDilithiumSig
+	}
+	h := s.Hash(tx)
+	pk := misc.UnSizedDilithiumPKToSizedPK(tx.PK())
+	if !dilithium.Verify(h[:], tx.Signature(), &pk) {
+		return common.Address{}, ErrInvalidDilithiumSig
+	}
+	return misc.GetDilithiumAddressFromUnSizedPK(tx.PK()), nil
+}
+
+func (s dilithiumSigner) Equal(s2 Signer) bool {
+	x, ok := s2.(dilithiumSigner)
+	return ok && x.chainId.Cmp(s.chainId) == 0
+}
+
+func (s dilithiumSigner) SignatureValues(tx *Transaction, sig []byte) (R, S, V *big.Int, err error) {
+	if tx.Type() != DilithiumTxType {
+		return s.londonSigner.SignatureValues(tx, sig)
+	}
+	return nil, nil, nil, ErrInvalidTxType
+}
+
+// Hash returns the hash to be signed by the sender.
+// It does not uniquely identify the transaction.
+func (s dilithiumSigner) Hash(tx *Transaction) common.Hash {
+	if tx.Type() != DilithiumTxType {
+		return s.londonSigner.Hash(tx)
+	}
+	return transactions.GetTransferSigningHash(s.chainId.Uint64(), tx.Nonce(), tx.Value().Uint64(),
+		tx.Gas(), tx.GasFeeCap(), tx.GasTipCap(), tx.To(), tx.Data())
+}
+
 type londonSigner struct{ eip2930Signer }
 
 // NewLondonSigner returns a signer that accepts
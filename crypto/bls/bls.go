@@ -1,11 +1,22 @@
 // Package bls implements a go-wrapper around a library implementing the
 // the BLS12-381 curve and signature scheme. This package exposes a public API for
 // verifying and aggregating BLS signatures used by Ethereum.
+//
+// Dilithium keys and signatures are served by the same API. The scheme is picked from the
+// length of the encoded key or signature, or from the type of a decoded one, so BLS and
+// Dilithium validators can be verified side by side. Dilithium signatures cannot be aggregated.
+//
+// The consensus types still size validator public keys at 48 bytes and signatures at 96 bytes,
+// so Dilithium keys and signatures cannot enter the beacon state or blocks yet, and only BLS
+// reaches these paths during consensus.
 package bls
 
 import (
+	"github.com/pkg/errors"
+	fieldparams "github.com/theQRL/zond/config/fieldparams"
 	"github.com/theQRL/zond/crypto/bls/blst"
 	"github.com/theQRL/zond/crypto/bls/common"
+	"github.com/theQRL/zond/crypto/bls/dilithium"
 	"github.com/theQRL/zond/crypto/bls/herumi"
 )
 
@@ -14,49 +25,97 @@ func init() {
 	herumi.HerumiInit()
 }
 
-// SecretKeyFromBytes creates a BLS private key from a BigEndian byte slice.
+// SecretKeyFromBytes creates a BLS private key from a BigEndian byte slice, or a
+// Dilithium private key from its seed.
 func SecretKeyFromBytes(privKey []byte) (SecretKey, error) {
+	if len(privKey) == dilithium.SecretKeyLength {
+		return dilithium.SecretKeyFromBytes(privKey)
+	}
 	return blst.SecretKeyFromBytes(privKey)
 }
 
-// PublicKeyFromBytes creates a BLS public key from a  BigEndian byte slice.
+// PublicKeyFromBytes creates a BLS public key from a  BigEndian byte slice, or a
+// Dilithium public key from its packed representation.
 func PublicKeyFromBytes(pubKey []byte) (PublicKey, error) {
+	if len(pubKey) == dilithium.PublicKeyLength {
+		return dilithium.PublicKeyFromBytes(pubKey)
+	}
 	return blst.PublicKeyFromBytes(pubKey)
 }
 
-// SignatureFromBytes creates a BLS signature from a LittleEndian byte slice.
+// SignatureFromBytes creates a BLS signature from a LittleEndian byte slice, or a
+// Dilithium signature from a detached signature.
 func SignatureFromBytes(sig []byte) (Signature, error) {
+	if isDilithiumSignature(sig) {
+		return dilithium.SignatureFromBytes(sig)
+	}
 	return blst.SignatureFromBytes(sig)
 }
 
 // MultipleSignaturesFromBytes creates a slice of BLS signatures from a LittleEndian 2d-byte slice.
 func MultipleSignaturesFromBytes(sigs [][]byte) ([]Signature, error) {
+	if len(sigs) > 0 && isDilithiumSignature(sigs[0]) {
+		return dilithium.MultipleSignaturesFromBytes(sigs)
+	}
 	return blst.MultipleSignaturesFromBytes(sigs)
 }
 
 // AggregatePublicKeys aggregates the provided raw public keys into a single key.
 func AggregatePublicKeys(pubs [][]byte) (PublicKey, error) {
+	if len(pubs) > 0 && len(pubs[0]) == dilithium.PublicKeyLength {
+		return dilithium.AggregatePublicKeys(pubs)
+	}
 	return blst.AggregatePublicKeys(pubs)
 }
 
 // AggregateMultiplePubkeys aggregates the provided decompressed keys into a single key.
 func AggregateMultiplePubkeys(pubs []PublicKey) PublicKey {
+	if len(pubs) > 0 && isDilithiumPublicKey(pubs[0]) {
+		return dilithium.AggregateMultiplePubkeys(pubs)
+	}
 	return blst.AggregateMultiplePubkeys(pubs)
 }
 
 // AggregateSignatures converts a list of signatures into a single, aggregated sig.
 func AggregateSignatures(sigs []common.Signature) common.Signature {
+	if len(sigs) > 0 {
+		if _, ok := sigs[0].(*dilithium.Signature); ok {
+			return dilithium.AggregateSignatures(sigs)
+		}
+	}
 	return blst.AggregateSignatures(sigs)
 }
 
 // AggregateCompressedSignatures converts a list of compressed signatures into a single, aggregated sig.
 func AggregateCompressedSignatures(multiSigs [][]byte) (common.Signature, error) {
+	if len(multiSigs) > 0 && isDilithiumSignature(multiSigs[0]) {
+		return dilithium.AggregateCompressedSignatures(multiSigs)
+	}
 	return blst.AggregateCompressedSignatures(multiSigs)
 }
 
 // VerifyMultipleSignatures verifies multiple signatures for distinct messages securely.
+// Dilithium signatures cannot be combined with BLS ones, so a set holding any Dilithium
+// key is verified one signature at a time with the scheme of each key.
 func VerifyMultipleSignatures(sigs [][]byte, msgs [][32]byte, pubKeys []common.PublicKey) (bool, error) {
-	return blst.VerifyMultipleSignatures(sigs, msgs, pubKeys)
+	if !containsDilithiumPublicKey(pubKeys) {
+		return blst.VerifyMultipleSignatures(sigs, msgs, pubKeys)
+	}
+	if len(sigs) != len(pubKeys) || len(sigs) != len(msgs) {
+		return false, errors.Errorf("provided signatures, pubkeys and messages have differing lengths. S: %d, P: %d,M %d",
+			len(sigs), len(pubKeys), len(msgs))
+	}
+	for i := range sigs {
+		verify := blst.VerifyMultipleSignatures
+		if isDilithiumPublicKey(pubKeys[i]) {
+			verify = dilithium.VerifyMultipleSignatures
+		}
+		valid, err := verify(sigs[i:i+1], msgs[i:i+1], pubKeys[i:i+1])
+		if err != nil || !valid {
+			return false, err
+		}
+	}
+	return true, nil
 }
 
 // NewAggregateSignature creates a blank aggregate signature.
@@ -68,3 +127,22 @@ func NewAggregateSignature() common.Signature {
 func RandKey() (common.SecretKey, error) {
 	return blst.RandKey()
 }
+
+// A BLS signature always has a fixed length, which is shorter than any Dilithium signature.
+func isDilithiumSignature(sig []byte) bool {
+	return len(sig) > fieldparams.BLSSignatureLength
+}
+
+func isDilithiumPublicKey(pubKey common.PublicKey) bool {
+	_, ok := pubKey.(*dilithium.PublicKey)
+	return ok
+}
+
+func containsDilithiumPublicKey(pubKeys []common.PublicKey) bool {
+	for _, pubKey := range pubKeys {
+		if isDilithiumPublicKey(pubKey) {
+			return true
+		}
+	}
+	return false
+}
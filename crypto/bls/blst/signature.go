@@ -91,8 +91,12 @@ func MultipleSignaturesFromBytes(multiSigs [][]byte) ([]common.Signature, error)
 // In the Ethereum proof of stake specification:
 // def Verify(PK: BLSPubkey, message: Bytes, signature: BLSSignature) -> bool
 func (s *Signature) Verify(pubKey common.PublicKey, msg []byte) bool {
+	pub, ok := pubKey.(*PublicKey)
+	if !ok {
+		return false
+	}
 	// Signature and PKs are assumed to have been validated upon decompression!
-	return s.s.Verify(false, pubKey.(*PublicKey).p, false, msg, dst)
+	return s.s.Verify(false, pub.p, false, msg, dst)
 }
 
 // AggregateVerify verifies each public key against its respective message. This is vulnerable to
@@ -123,8 +127,12 @@ func (s *Signature) AggregateVerify(pubKeys []common.PublicKey, msgs [][32]byte)
 	msgSlices := make([][]byte, len(msgs))
 	rawKeys := make([]*blstPublicKey, len(msgs))
 	for i := 0; i < size; i++ {
+		pub, ok := pubKeys[i].(*PublicKey)
+		if !ok {
+			return false
+		}
 		msgSlices[i] = msgs[i][:]
-		rawKeys[i] = pubKeys[i].(*PublicKey).p
+		rawKeys[i] = pub.p
 	}
 	// Signature and PKs are assumed to have been validated upon decompression!
 	return s.s.AggregateVerify(false, rawKeys, false, msgSlices, dst)
@@ -146,7 +154,11 @@ func (s *Signature) FastAggregateVerify(pubKeys []common.PublicKey, msg [32]byte
 	}
 	rawKeys := make([]*blstPublicKey, len(pubKeys))
 	for i := 0; i < len(pubKeys); i++ {
-		rawKeys[i] = pubKeys[i].(*PublicKey).p
+		pub, ok := pubKeys[i].(*PublicKey)
+		if !ok {
+			return false
+		}
+		rawKeys[i] = pub.p
 	}
 	return s.s.FastAggregateVerify(true, rawKeys, msg[:], dst)
 }
@@ -0,0 +1,180 @@
+package dilithium
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/theQRL/zond/crypto/bls/common"
+)
+
+func TestSignVerify(t *testing.T) {
+	priv, err := RandKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub := priv.PublicKey()
+	msg := [32]byte{'h', 'e', 'l', 'l', 'o'}
+	sig := priv.Sign(msg[:])
+
+	if !sig.Verify(pub, msg[:]) {
+		t.Error("signature did not verify")
+	}
+	if sig.Verify(pub, []byte("world")) {
+		t.Error("signature verified for another message")
+	}
+	if !sig.FastAggregateVerify([]common.PublicKey{pub}, msg) {
+		t.Error("signature did not verify with a single public key")
+	}
+	if sig.FastAggregateVerify([]common.PublicKey{pub, pub}, msg) {
+		t.Error("signature verified against several public keys")
+	}
+	if sig.Eth2FastAggregateVerify(nil, msg) {
+		t.Error("signature verified against an empty set of public keys")
+	}
+}
+
+func TestSecretKeyFromBytes(t *testing.T) {
+	priv, err := RandKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	restored, err := SecretKeyFromBytes(priv.Marshal())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !restored.PublicKey().Equals(priv.PublicKey()) {
+		t.Error("restored key has a different public key")
+	}
+
+	if _, err := SecretKeyFromBytes(make([]byte, SecretKeyLength)); !errors.Is(err, common.ErrZeroKey) {
+		t.Errorf("expected %v, got %v", common.ErrZeroKey, err)
+	}
+	if _, err := SecretKeyFromBytes(make([]byte, 32)); err == nil {
+		t.Error("expected an error for a short secret key")
+	}
+}
+
+func TestPublicKeyFromBytes(t *testing.T) {
+	priv, err := RandKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, err := PublicKeyFromBytes(priv.PublicKey().Marshal())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pub.Equals(priv.PublicKey()) {
+		t.Error("unmarshalled public key differs")
+	}
+
+	if _, err := PublicKeyFromBytes(make([]byte, PublicKeyLength)); !errors.Is(err, common.ErrInfinitePubKey) {
+		t.Errorf("expected %v, got %v", common.ErrInfinitePubKey, err)
+	}
+	if _, err := PublicKeyFromBytes(make([]byte, 48)); err == nil {
+		t.Error("expected an error for a BLS sized public key")
+	}
+}
+
+func TestSignatureFromBytes(t *testing.T) {
+	priv, err := RandKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := [32]byte{1}
+	raw := priv.Sign(msg[:]).Marshal()
+
+	sig, err := SignatureFromBytes(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(sig.Marshal(), raw) {
+		t.Error("unmarshalled signature differs")
+	}
+	if !sig.Verify(priv.PublicKey(), msg[:]) {
+		t.Error("unmarshalled signature did not verify")
+	}
+
+	tests := []struct {
+		name string
+		sig  []byte
+	}{
+		{name: "BLS sized", sig: make([]byte, 96)},
+		{name: "too long", sig: make([]byte, SignatureLength+1)},
+		{name: "truncated", sig: raw[:len(raw)-1]},
+		{name: "too many hints", sig: func() []byte {
+			s := append([]byte{}, raw...)
+			s[hintsOffset] = 0xff
+			return s
+		}()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := SignatureFromBytes(tt.sig); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestVerifyMultipleSignatures(t *testing.T) {
+	var sigs [][]byte
+	var msgs [][32]byte
+	var pubs []common.PublicKey
+	for i := 0; i < 3; i++ {
+		priv, err := RandKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg := [32]byte{byte(i)}
+		sigs = append(sigs, priv.Sign(msg[:]).Marshal())
+		msgs = append(msgs, msg)
+		pubs = append(pubs, priv.PublicKey())
+	}
+
+	valid, err := VerifyMultipleSignatures(sigs, msgs, pubs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !valid {
+		t.Error("expected the signatures to verify")
+	}
+
+	msgs[1] = [32]byte{'x'}
+	valid, err = VerifyMultipleSignatures(sigs, msgs, pubs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if valid {
+		t.Error("expected the signatures not to verify")
+	}
+
+	if _, err := VerifyMultipleSignatures(sigs, msgs[:2], pubs); err == nil {
+		t.Error("expected an error for differing lengths")
+	}
+}
+
+func TestAggregation(t *testing.T) {
+	priv, err := RandKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := [32]byte{2}
+	sig := priv.Sign(msg[:])
+
+	if _, err := AggregateCompressedSignatures([][]byte{sig.Marshal(), sig.Marshal()}); !errors.Is(err, ErrAggregationNotSupported) {
+		t.Errorf("expected %v, got %v", ErrAggregationNotSupported, err)
+	}
+	if AggregateSignatures([]common.Signature{sig, sig}) != nil {
+		t.Error("expected no aggregate for several signatures")
+	}
+	if AggregateMultiplePubkeys([]common.PublicKey{priv.PublicKey(), priv.PublicKey()}) != nil {
+		t.Error("expected no aggregate for several public keys")
+	}
+	if agg := AggregateSignatures([]common.Signature{sig}); !agg.Verify(priv.PublicKey(), msg[:]) {
+		t.Error("expected a single signature to aggregate to itself")
+	}
+	if NewAggregateSignature().Verify(priv.PublicKey(), msg[:]) {
+		t.Error("blank signature verified")
+	}
+}
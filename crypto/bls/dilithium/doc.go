@@ -0,0 +1,8 @@
+// Package dilithium implements the secret key, public key and signature contracts of
+// github.com/theQRL/zond/crypto/bls/common on top of the Dilithium post-quantum signature
+// scheme provided by go-qrllib.
+//
+// Unlike BLS, Dilithium signatures cannot be aggregated. The aggregation helpers of this
+// package therefore only accept a single key or signature, and signature batches are
+// verified one signature at a time.
+package dilithium
@@ -0,0 +1,86 @@
+package dilithium
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/zond/crypto/bls/common"
+)
+
+// PublicKeyLength is the byte length of a packed Dilithium public key.
+const PublicKeyLength = dilithium.PKSizePacked
+
+// PublicKey used in the Dilithium signature scheme.
+type PublicKey struct {
+	p [PublicKeyLength]uint8
+}
+
+// PublicKeyFromBytes creates a Dilithium public key from its packed representation.
+func PublicKeyFromBytes(pubKey []byte) (common.PublicKey, error) {
+	if len(pubKey) != PublicKeyLength {
+		return nil, fmt.Errorf("public key must be %d bytes", PublicKeyLength)
+	}
+	pub := &PublicKey{}
+	copy(pub.p[:], pubKey)
+	if pub.IsInfinite() {
+		return nil, common.ErrInfinitePubKey
+	}
+	return pub, nil
+}
+
+// AggregatePublicKeys mirrors the BLS API. Dilithium keys cannot be aggregated, so only a single
+// raw public key is accepted.
+func AggregatePublicKeys(pubs [][]byte) (common.PublicKey, error) {
+	if len(pubs) == 0 {
+		return nil, errors.New("nil or empty public keys")
+	}
+	if len(pubs) > 1 {
+		return nil, ErrAggregationNotSupported
+	}
+	return PublicKeyFromBytes(pubs[0])
+}
+
+// AggregateMultiplePubkeys mirrors the BLS API. Dilithium keys cannot be aggregated, so nil is
+// returned unless exactly one key is provided.
+func AggregateMultiplePubkeys(pubkeys []common.PublicKey) common.PublicKey {
+	if len(pubkeys) != 1 {
+		return nil
+	}
+	return pubkeys[0].Copy()
+}
+
+// Marshal a public key into its packed representation.
+func (p *PublicKey) Marshal() []byte {
+	return p.p[:]
+}
+
+// Copy the public key to a new pointer reference.
+func (p *PublicKey) Copy() common.PublicKey {
+	np := *p
+	return &np
+}
+
+// IsInfinite checks if the public key is made of zero bytes only, which is never a valid
+// Dilithium public key.
+func (p *PublicKey) IsInfinite() bool {
+	return isZero(p.p[:])
+}
+
+// Equals checks if the provided public key is equal to the current one.
+func (p *PublicKey) Equals(p2 common.PublicKey) bool {
+	if p2 == nil {
+		return false
+	}
+	return bytes.Equal(p.Marshal(), p2.Marshal())
+}
+
+// Aggregate is not supported by Dilithium: the key is returned unchanged when p2 is nil,
+// and nil is returned otherwise so that the misuse is caught by the verification.
+func (p *PublicKey) Aggregate(p2 common.PublicKey) common.PublicKey {
+	if p2 == nil {
+		return p
+	}
+	return nil
+}
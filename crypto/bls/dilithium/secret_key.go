@@ -0,0 +1,69 @@
+package dilithium
+
+import (
+	"fmt"
+
+	qrllibcommon "github.com/theQRL/go-qrllib/common"
+	"github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/zond/crypto/bls/common"
+	"github.com/theQRL/zond/crypto/rand"
+)
+
+// SecretKeyLength is the byte length of a Dilithium secret key. The key is stored as
+// the seed the Dilithium key pair is expanded from.
+const SecretKeyLength = qrllibcommon.SeedSize
+
+// dilithiumKey used in the Dilithium signature scheme.
+type dilithiumKey struct {
+	d *dilithium.Dilithium
+}
+
+// RandKey creates a new private key using a random seed.
+func RandKey() (common.SecretKey, error) {
+	var seed [SecretKeyLength]uint8
+	if _, err := rand.NewGenerator().Read(seed[:]); err != nil {
+		return nil, err
+	}
+	if isZero(seed[:]) {
+		return nil, common.ErrZeroKey
+	}
+	return &dilithiumKey{d: dilithium.NewDilithiumFromSeed(seed)}, nil
+}
+
+// SecretKeyFromBytes creates a Dilithium private key from its seed.
+func SecretKeyFromBytes(privKey []byte) (common.SecretKey, error) {
+	if len(privKey) != SecretKeyLength {
+		return nil, fmt.Errorf("secret key must be %d bytes", SecretKeyLength)
+	}
+	if isZero(privKey) {
+		return nil, common.ErrZeroKey
+	}
+	var seed [SecretKeyLength]uint8
+	copy(seed[:], privKey)
+	return &dilithiumKey{d: dilithium.NewDilithiumFromSeed(seed)}, nil
+}
+
+// PublicKey obtains the public key corresponding to the Dilithium secret key.
+func (s *dilithiumKey) PublicKey() common.PublicKey {
+	return &PublicKey{p: s.d.GetPK()}
+}
+
+// Sign a message using a secret key and return the detached signature.
+func (s *dilithiumKey) Sign(msg []byte) common.Signature {
+	return &Signature{s: s.d.Sign(msg)}
+}
+
+// Marshal a secret key into its seed.
+func (s *dilithiumKey) Marshal() []byte {
+	seed := s.d.GetSeed()
+	return seed[:]
+}
+
+func isZero(b []byte) bool {
+	for _, v := range b {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,171 @@
+package dilithium
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/zond/crypto/bls/common"
+	"github.com/theQRL/zond/encoding/bytesutil"
+)
+
+// SignatureLength is the upper bound of a detached Dilithium signature's byte length.
+// Detached signatures are variable sized and tend to be a bit smaller.
+const SignatureLength = dilithium.SigSizePacked
+
+// hintsOffset is the position of the hint counts in a detached signature.
+const hintsOffset = dilithium.L * dilithium.PolZSizePacked
+
+// minSignatureLength is the length of a detached signature without any hint.
+const minSignatureLength = hintsOffset + dilithium.K + dilithium.N/8 + 8
+
+// ErrAggregationNotSupported is returned when more than one key or signature is passed to an
+// aggregation method, since Dilithium has no aggregation scheme.
+var ErrAggregationNotSupported = errors.New("dilithium keys and signatures cannot be aggregated")
+
+// Signature used in the Dilithium signature scheme.
+type Signature struct {
+	s []byte
+}
+
+// SignatureFromBytes creates a Dilithium signature from a detached signature byte slice.
+func SignatureFromBytes(sig []byte) (common.Signature, error) {
+	if err := validateSignatureEncoding(sig); err != nil {
+		return nil, err
+	}
+	return &Signature{s: bytesutil.SafeCopyBytes(sig)}, nil
+}
+
+// MultipleSignaturesFromBytes creates a group of Dilithium signatures from a 2d-byte slice.
+func MultipleSignaturesFromBytes(multiSigs [][]byte) ([]common.Signature, error) {
+	if len(multiSigs) == 0 {
+		return nil, fmt.Errorf("0 signatures provided to the method")
+	}
+	wrappedSigs := make([]common.Signature, len(multiSigs))
+	for i, s := range multiSigs {
+		sig, err := SignatureFromBytes(s)
+		if err != nil {
+			return nil, err
+		}
+		wrappedSigs[i] = sig
+	}
+	return wrappedSigs, nil
+}
+
+// AggregateSignatures mirrors the BLS API. Dilithium signatures cannot be aggregated, so nil is
+// returned unless exactly one signature is provided.
+func AggregateSignatures(sigs []common.Signature) common.Signature {
+	if len(sigs) != 1 {
+		return nil
+	}
+	return sigs[0].Copy()
+}
+
+// AggregateCompressedSignatures mirrors the BLS API. Dilithium signatures cannot be aggregated,
+// so only a single signature is accepted.
+func AggregateCompressedSignatures(multiSigs [][]byte) (common.Signature, error) {
+	if len(multiSigs) > 1 {
+		return nil, ErrAggregationNotSupported
+	}
+	if len(multiSigs) == 0 {
+		return nil, errors.New("0 signatures provided to the method")
+	}
+	return SignatureFromBytes(multiSigs[0])
+}
+
+// NewAggregateSignature creates a blank signature, which never verifies.
+func NewAggregateSignature() common.Signature {
+	return &Signature{}
+}
+
+// VerifyMultipleSignatures verifies a non-singular set of signatures and its respective pubkeys
+// and messages. As Dilithium signatures cannot be combined, each signature is verified on its own.
+func VerifyMultipleSignatures(sigs [][]byte, msgs [][32]byte, pubKeys []common.PublicKey) (bool, error) {
+	if len(sigs) == 0 || len(pubKeys) == 0 {
+		return false, nil
+	}
+	length := len(sigs)
+	if length != len(pubKeys) || length != len(msgs) {
+		return false, errors.Errorf("provided signatures, pubkeys and messages have differing lengths. S: %d, P: %d,M %d",
+			length, len(pubKeys), len(msgs))
+	}
+	for i := 0; i < length; i++ {
+		sig, err := SignatureFromBytes(sigs[i])
+		if err != nil {
+			return false, err
+		}
+		if !sig.Verify(pubKeys[i], msgs[i][:]) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Verify a Dilithium signature given a public key and a message.
+func (s *Signature) Verify(pubKey common.PublicKey, msg []byte) bool {
+	if pubKey == nil || len(s.s) == 0 {
+		return false
+	}
+	pub, ok := pubKey.(*PublicKey)
+	if !ok {
+		return false
+	}
+	return dilithium.Verify(msg, s.s, &pub.p)
+}
+
+// AggregateVerify mirrors the BLS API. It only succeeds for a single public key and message,
+// as a Dilithium signature cannot cover several of them.
+//
+// Deprecated: Use FastAggregateVerify or use this method in spectests only.
+func (s *Signature) AggregateVerify(pubKeys []common.PublicKey, msgs [][32]byte) bool {
+	if len(pubKeys) != 1 || len(msgs) != 1 {
+		return false
+	}
+	return s.Verify(pubKeys[0], msgs[0][:])
+}
+
+// FastAggregateVerify mirrors the BLS API. It only succeeds for a single public key, as a
+// Dilithium signature cannot cover several of them.
+func (s *Signature) FastAggregateVerify(pubKeys []common.PublicKey, msg [32]byte) bool {
+	if len(pubKeys) != 1 {
+		return false
+	}
+	return s.Verify(pubKeys[0], msg[:])
+}
+
+// Eth2FastAggregateVerify is the same as FastAggregateVerify. There is no infinite signature
+// in Dilithium, so an empty set of public keys never verifies.
+func (s *Signature) Eth2FastAggregateVerify(pubKeys []common.PublicKey, msg [32]byte) bool {
+	return s.FastAggregateVerify(pubKeys, msg)
+}
+
+// Marshal a signature into a byte slice.
+func (s *Signature) Marshal() []byte {
+	return s.s
+}
+
+// Copy returns a full deep copy of a signature.
+func (s *Signature) Copy() common.Signature {
+	return &Signature{s: bytesutil.SafeCopyBytes(s.s)}
+}
+
+// validateSignatureEncoding checks the layout of a detached signature so that malformed input
+// is rejected before it reaches the unpacking code of the Dilithium library.
+func validateSignatureEncoding(sig []byte) error {
+	if len(sig) < minSignatureLength || len(sig) > SignatureLength {
+		return fmt.Errorf("signature must be between %d and %d bytes", minSignatureLength, SignatureLength)
+	}
+	hints := sig[hintsOffset:]
+	prev := 0
+	for i := 0; i < dilithium.K; i++ {
+		count := int(hints[i])
+		if count < prev || count > dilithium.OMEGA {
+			return errors.New("could not unmarshal bytes into signature")
+		}
+		prev = count
+	}
+	if len(hints) != dilithium.K+prev+dilithium.N/8+8 {
+		return errors.New("could not unmarshal bytes into signature")
+	}
+	return nil
+}
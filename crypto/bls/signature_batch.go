@@ -101,11 +101,15 @@ func (s *SignatureBatch) RemoveDuplicates() (int, *SignatureBatch, error) {
 
 // AggregateBatch aggregates common messages in the provided batch to
 // reduce the number of pairings required when we finally verify the
-// whole batch.
+// whole batch. Dilithium signatures cannot be aggregated, so the batch
+// is returned as is when it holds any Dilithium key.
 func (s *SignatureBatch) AggregateBatch() (*SignatureBatch, error) {
 	if len(s.Signatures) == 0 || len(s.PublicKeys) == 0 || len(s.Messages) == 0 {
 		return s, nil
 	}
+	if containsDilithiumPublicKey(s.PublicKeys) {
+		return s, nil
+	}
 	if len(s.Signatures) != len(s.PublicKeys) || len(s.Signatures) != len(s.Messages) {
 		return s, errors.Errorf("mismatch number of signatures, publickeys and messages in signature batch. "+
 			"Signatures %d, Public Keys %d , Messages %d", s.Signatures, s.PublicKeys, s.Messages)
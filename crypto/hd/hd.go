@@ -0,0 +1,133 @@
+// Package hd implements the hierarchical deterministic derivation of Dilithium keys
+// from a BIP-39 mnemonic, so that all the staking and spending keys of a user can be
+// recovered from a single mnemonic.
+//
+// Dilithium key pairs are expanded from a seed, and unlike elliptic curve keys, a child
+// public key cannot be derived from its parent public key. Keys are therefore derived
+// with the hardened-only scheme of SLIP-0010: the master node is the HMAC-SHA512 of the
+// BIP-39 seed keyed by "Dilithium seed", and the node at index i of a parent is
+//
+//	HMAC-SHA512(parent chain code, 0x00 || parent key || ser32(i + 2^31))
+//
+// where the first half of the MAC is the key and the second half the chain code of the
+// node. The Dilithium seed of a path is the SHAKE256 expansion of the key of its node,
+// prefixed by "Dilithium key". Every element of a path is hardened, whether or not it
+// is marked as such, as in EIP-2334.
+package hd
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	qrllibcommon "github.com/theQRL/go-qrllib/common"
+	"github.com/tyler-smith/go-bip39"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	// SeedLength is the byte length of the derived Dilithium seeds.
+	SeedLength = qrllibcommon.SeedSize
+
+	// HardenedOffset is added to the indices of the path elements.
+	HardenedOffset uint32 = 0x80000000
+
+	// ValidatingKeyPathTemplate is the path of the validating key of a validator account,
+	// following the structure of EIP-2334 with the QRL coin type:
+	// m / purpose / coin_type / account_index / withdrawal_key / validating_key
+	ValidatingKeyPathTemplate = "m/12381/238/%d/0/0"
+
+	// SpendingKeyPathTemplate is the path of the spending key of a wallet address,
+	// following the structure of BIP-44 with the QRL coin type:
+	// m / purpose / coin_type / account / change / address_index
+	SpendingKeyPathTemplate = "m/44/238/0/0/%d"
+
+	masterKey  = "Dilithium seed"
+	seedPrefix = "Dilithium key"
+
+	minSeedLength = 16
+	maxSeedLength = 64
+)
+
+var (
+	// ErrInvalidPath is returned if a derivation path is malformed.
+	ErrInvalidPath = errors.New("invalid derivation path")
+
+	// ErrInvalidSeedLength is returned if a BIP-39 seed is too short or too long.
+	ErrInvalidSeedLength = fmt.Errorf("seed must be between %d and %d bytes", minSeedLength, maxSeedLength)
+)
+
+// SeedFromMnemonic returns the BIP-39 seed of the given mnemonic and passphrase.
+func SeedFromMnemonic(mnemonic, passphrase string) ([]byte, error) {
+	return bip39.NewSeedWithErrorChecking(mnemonic, passphrase)
+}
+
+// DilithiumSeedFromMnemonic derives the Dilithium seed of the given path from a BIP-39
+// mnemonic and passphrase.
+func DilithiumSeedFromMnemonic(mnemonic, passphrase, path string) ([SeedLength]byte, error) {
+	seed, err := SeedFromMnemonic(mnemonic, passphrase)
+	if err != nil {
+		return [SeedLength]byte{}, err
+	}
+	return DilithiumSeedFromSeedAndPath(seed, path)
+}
+
+// DilithiumSeedFromSeedAndPath derives the Dilithium seed of the given path from a
+// BIP-39 seed.
+func DilithiumSeedFromSeedAndPath(seed []byte, path string) ([SeedLength]byte, error) {
+	var out [SeedLength]byte
+	if len(seed) < minSeedLength || len(seed) > maxSeedLength {
+		return out, ErrInvalidSeedLength
+	}
+	indices, err := ParsePath(path)
+	if err != nil {
+		return out, err
+	}
+	key, chainCode := hmacSHA512([]byte(masterKey), seed)
+	for _, index := range indices {
+		data := make([]byte, 1+len(key)+4)
+		copy(data[1:], key)
+		binary.BigEndian.PutUint32(data[1+len(key):], index)
+		key, chainCode = hmacSHA512(chainCode, data)
+	}
+	h := sha3.NewShake256()
+	h.Write([]byte(seedPrefix))
+	h.Write(key)
+	if _, err := h.Read(out[:]); err != nil {
+		return out, err
+	}
+	return out, nil
+}
+
+// ParsePath parses a derivation path such as "m/44/238/0/0/0" into the indices of its
+// elements, which are all hardened. Elements may be marked as hardened with a trailing
+// "'" or "h".
+func ParsePath(path string) ([]uint32, error) {
+	elements := strings.Split(strings.TrimSpace(path), "/")
+	if elements[0] != "m" {
+		return nil, fmt.Errorf("%w: %q does not start with m", ErrInvalidPath, path)
+	}
+	indices := make([]uint32, 0, len(elements)-1)
+	for _, element := range elements[1:] {
+		if strings.HasSuffix(element, "'") || strings.HasSuffix(element, "h") {
+			element = element[:len(element)-1]
+		}
+		index, err := strconv.ParseUint(element, 10, 32)
+		if err != nil || uint32(index) >= HardenedOffset {
+			return nil, fmt.Errorf("%w: invalid element %q in %q", ErrInvalidPath, element, path)
+		}
+		indices = append(indices, uint32(index)+HardenedOffset)
+	}
+	return indices, nil
+}
+
+func hmacSHA512(key, data []byte) ([]byte, []byte) {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+	return sum[:32], sum[32:]
+}
@@ -0,0 +1,107 @@
+package hd
+
+import (
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+const (
+	testMnemonic1 = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	testMnemonic2 = "legal winner thank year wave sausage worth useful legal winner thank yellow"
+)
+
+func TestDilithiumSeedFromMnemonic(t *testing.T) {
+	tests := []struct {
+		mnemonic   string
+		passphrase string
+		path       string
+		want       string
+	}{
+		{testMnemonic1, "", "m", "58edd8e5d4915b8e7574c8814a9795cfb5ea1452c7028d5b05799df163aae36bd3dcfc75a5e13b1224efa52bbcabe063"},
+		{testMnemonic1, "", "m/12381/238/0/0/0", "98390824f59e82ba6507e086733422c1627c25c65ab4bf23387ef166b6fa16df5e3e3f915b0240a7fa22c2b508188ce6"},
+		{testMnemonic1, "", "m/12381/238/1/0/0", "af3d395d8c4a7d2de7c41ce82b764f2d3c7c749fa732ca411b1f2e5a4755f7f87c1ba3cead1b4a661695556f4315160d"},
+		{testMnemonic1, "", "m/44/238/0/0/0", "7c6839915255da895a30c1b0330b6b286103a89e7d60cae0fa92c5a72fa36773c27b72576e4d3b6878690fbd5af9bec1"},
+		{testMnemonic1, "", "m/44/238/0/0/1", "2b014aba2e75890bf20d49c8fe051be1872895b52a1a031b4abd4ef28d418e0878e2770f8d4695cd073299dbeec1ca24"},
+		{testMnemonic1, "TREZOR", "m/12381/238/0/0/0", "2686c393edd49f307451823e78fa2612fed6cd1f1ed7ce7ac96a8dec78a9a693581d036c052c0a90953c0eb932855633"},
+		{testMnemonic1, "TREZOR", "m/44/238/0/0/0", "f37a843f608cb6373a22308ce710049f37e4c91edd5b048890180bc543e40fbdd3a05207f62925a9762c9873ff1ec587"},
+		{testMnemonic2, "", "m/12381/238/0/0/0", "1ea7220e5725daf718e1b5d1cc2c01a269d18bc65da41d64792a9055fecf6061015b114445d58e4d73d3ec784be6441c"},
+		{testMnemonic2, "", "m/44/238/0/0/1", "f83e3b15e4bc809b832fc005fbe8327d67ca012c695e3dc3e75f71a7bbcf12c0e47ba1333feddb148bcc006e553bb026"},
+	}
+	for _, tt := range tests {
+		seed, err := DilithiumSeedFromMnemonic(tt.mnemonic, tt.passphrase, tt.path)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.path, err)
+		}
+		if have := hex.EncodeToString(seed[:]); have != tt.want {
+			t.Errorf("%q %s: have %s, want %s", tt.passphrase, tt.path, have, tt.want)
+		}
+	}
+}
+
+func TestDilithiumSeedHardenedMarks(t *testing.T) {
+	seed, err := SeedFromMnemonic(testMnemonic1, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	plain, err := DilithiumSeedFromSeedAndPath(seed, "m/12381/238/0/0/0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{"m/12381'/238'/0'/0'/0'", "m/12381h/238h/0h/0h/0h"} {
+		marked, err := DilithiumSeedFromSeedAndPath(seed, path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if marked != plain {
+			t.Errorf("%s derived a different seed", path)
+		}
+	}
+}
+
+func TestDilithiumSeedErrors(t *testing.T) {
+	if _, err := DilithiumSeedFromMnemonic("abandon abandon abandon", "", "m/0"); err == nil {
+		t.Error("expected an error for an invalid mnemonic")
+	}
+	if _, err := DilithiumSeedFromSeedAndPath(make([]byte, 8), "m/0"); !errors.Is(err, ErrInvalidSeedLength) {
+		t.Errorf("expected %v, got %v", ErrInvalidSeedLength, err)
+	}
+	if _, err := DilithiumSeedFromSeedAndPath(make([]byte, 64), "0/1"); !errors.Is(err, ErrInvalidPath) {
+		t.Errorf("expected %v, got %v", ErrInvalidPath, err)
+	}
+}
+
+func TestParsePath(t *testing.T) {
+	tests := []struct {
+		path    string
+		want    []uint32
+		wantErr bool
+	}{
+		{path: "m", want: []uint32{}},
+		{path: "m/0", want: []uint32{HardenedOffset}},
+		{path: "m/44'/238h/5", want: []uint32{HardenedOffset + 44, HardenedOffset + 238, HardenedOffset + 5}},
+		{path: "m/2147483647", want: []uint32{HardenedOffset + 2147483647}},
+		{path: "m/2147483648", wantErr: true},
+		{path: "m/-1", wantErr: true},
+		{path: "m/1''", wantErr: true},
+		{path: "m/", wantErr: true},
+		{path: "m//1", wantErr: true},
+		{path: "n/1", wantErr: true},
+		{path: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		indices, err := ParsePath(tt.path)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidPath) {
+				t.Errorf("%q: expected %v, got %v", tt.path, ErrInvalidPath, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: %v", tt.path, err)
+		} else if !reflect.DeepEqual(indices, tt.want) {
+			t.Errorf("%q: have %v, want %v", tt.path, indices, tt.want)
+		}
+	}
+}
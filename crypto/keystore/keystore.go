@@ -0,0 +1,276 @@
+// Package keystore implements the encrypted file format of the wallet and staking keys
+// files, which hold the secret seeds of Zond accounts.
+//
+// The encryption key of a file is derived from a password with scrypt, and each account
+// is stored as a separate versioned entry, sealed with AES-256-GCM under its own random
+// nonce. The public identifier of an entry, such as the address of a wallet account, is
+// stored in the clear and authenticated as additional data of its ciphertext.
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/theQRL/zond/crypto/rand"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// Version is the version of the encrypted file format.
+	Version = 1
+
+	// EntryVersion is the version of the encrypted entries.
+	EntryVersion = 1
+
+	// StandardScryptN is the N parameter of scrypt, using 256MB of memory and
+	// taking approximately 1s CPU time on a modern processor.
+	StandardScryptN = 1 << 18
+
+	// StandardScryptP is the P parameter of scrypt, using 256MB of memory and
+	// taking approximately 1s CPU time on a modern processor.
+	StandardScryptP = 1
+
+	// LightScryptN is the N parameter of scrypt, using 4MB of memory and taking
+	// approximately 100ms CPU time on a modern processor.
+	LightScryptN = 1 << 12
+
+	// LightScryptP is the P parameter of scrypt, using 4MB of memory and taking
+	// approximately 100ms CPU time on a modern processor.
+	LightScryptP = 6
+
+	kdfScrypt    = "scrypt"
+	scryptR      = 8
+	scryptKeyLen = 32
+	saltLength   = 32
+)
+
+var (
+	// ErrDecrypt is returned if an entry cannot be decrypted, which is most likely
+	// caused by a wrong password.
+	ErrDecrypt = errors.New("could not decrypt key with given password")
+
+	// ErrUnsupportedVersion is returned if a file or an entry has an unknown version.
+	ErrUnsupportedVersion = errors.New("unsupported keystore version")
+
+	// ErrInvalidKDFParams is returned if the scrypt parameters of a file are out of
+	// bounds, which would make deriving its key exhaust the memory or the CPU.
+	ErrInvalidKDFParams = errors.New("invalid key derivation function parameters")
+)
+
+// KDFParams are the parameters of the key derivation function of a file.
+type KDFParams struct {
+	Function string `json:"function"`
+	N        int    `json:"n"`
+	R        int    `json:"r"`
+	P        int    `json:"p"`
+	Salt     string `json:"salt"`
+}
+
+// Entry is an encrypted account of a file.
+type Entry struct {
+	Version    int    `json:"version"`
+	ID         string `json:"id"`
+	Nonce      string `json:"nonce"`
+	Ciphertext string `json:"ciphertext"`
+}
+
+// File is the JSON encoding of an encrypted file.
+type File struct {
+	Version int        `json:"version"`
+	KDF     *KDFParams `json:"kdf"`
+	Entries []*Entry   `json:"entries"`
+}
+
+// Item is a decrypted account of a file, holding its public identifier and its
+// secret data.
+type Item struct {
+	ID   string
+	Data []byte
+}
+
+// Key is the encryption key of a file, derived from its password.
+type Key struct {
+	kdf *KDFParams
+	key []byte
+}
+
+// NewKey derives a new encryption key from the given password and a random salt,
+// using the given scrypt parameters.
+func NewKey(password string, scryptN, scryptP int) (*Key, error) {
+	salt := make([]byte, saltLength)
+	if _, err := rand.NewGenerator().Read(salt); err != nil {
+		return nil, err
+	}
+	kdf := &KDFParams{
+		Function: kdfScrypt,
+		N:        scryptN,
+		R:        scryptR,
+		P:        scryptP,
+		Salt:     hex.EncodeToString(salt),
+	}
+	return deriveKey(password, kdf)
+}
+
+func deriveKey(password string, kdf *KDFParams) (*Key, error) {
+	if kdf == nil || kdf.Function != kdfScrypt {
+		return nil, errors.New("unsupported key derivation function")
+	}
+	// The parameters are read from the file, so cap them at the ones used when
+	// encrypting, rather than letting the file dictate the cost of decrypting it.
+	if kdf.N <= 1 || kdf.N > StandardScryptN || kdf.R < 1 || kdf.R > scryptR || kdf.P < 1 || kdf.P > LightScryptP {
+		return nil, fmt.Errorf("%w: n=%d r=%d p=%d", ErrInvalidKDFParams, kdf.N, kdf.R, kdf.P)
+	}
+	salt, err := hex.DecodeString(kdf.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid salt: %w", err)
+	}
+	key, err := scrypt.Key([]byte(password), salt, kdf.N, kdf.R, kdf.P, scryptKeyLen)
+	if err != nil {
+		return nil, err
+	}
+	return &Key{kdf: kdf, key: key}, nil
+}
+
+// Encrypt seals the given items into an encrypted file.
+func (k *Key) Encrypt(items []*Item) (*File, error) {
+	aead, err := k.aead()
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]*Entry, 0, len(items))
+	for _, item := range items {
+		nonce := make([]byte, aead.NonceSize())
+		if _, err := rand.NewGenerator().Read(nonce); err != nil {
+			return nil, err
+		}
+		entries = append(entries, &Entry{
+			Version:    EntryVersion,
+			ID:         item.ID,
+			Nonce:      hex.EncodeToString(nonce),
+			Ciphertext: hex.EncodeToString(aead.Seal(nil, nonce, item.Data, []byte(item.ID))),
+		})
+	}
+	return &File{
+		Version: Version,
+		KDF:     k.kdf,
+		Entries: entries,
+	}, nil
+}
+
+// Decrypt derives the encryption key of the file from the given password, and opens
+// its entries. The key is returned in order to encrypt the updated items again.
+func Decrypt(f *File, password string) (*Key, []*Item, error) {
+	if f.Version != Version {
+		return nil, nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, f.Version)
+	}
+	key, err := deriveKey(password, f.KDF)
+	if err != nil {
+		return nil, nil, err
+	}
+	aead, err := key.aead()
+	if err != nil {
+		return nil, nil, err
+	}
+	items := make([]*Item, 0, len(f.Entries))
+	for _, entry := range f.Entries {
+		if entry.Version != EntryVersion {
+			return nil, nil, fmt.Errorf("%w: entry %s has version %d", ErrUnsupportedVersion, entry.ID, entry.Version)
+		}
+		nonce, err := hex.DecodeString(entry.Nonce)
+		if err != nil || len(nonce) != aead.NonceSize() {
+			return nil, nil, fmt.Errorf("invalid nonce of entry %s", entry.ID)
+		}
+		ciphertext, err := hex.DecodeString(entry.Ciphertext)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid ciphertext of entry %s", entry.ID)
+		}
+		data, err := aead.Open(nil, nonce, ciphertext, []byte(entry.ID))
+		if err != nil {
+			return nil, nil, ErrDecrypt
+		}
+		items = append(items, &Item{ID: entry.ID, Data: data})
+	}
+	return key, items, nil
+}
+
+func (k *Key) aead() (cipher.AEAD, error) {
+	block, err := aes.NewCipher(k.key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// IsEncrypted reports whether the given file contents are an encrypted file.
+func IsEncrypted(data []byte) bool {
+	var f struct {
+		Version int        `json:"version"`
+		KDF     *KDFParams `json:"kdf"`
+	}
+	return json.Unmarshal(data, &f) == nil && f.KDF != nil
+}
+
+// IsEncryptedFile reports whether the file with the given name is an encrypted file.
+func IsEncryptedFile(name string) (bool, error) {
+	data, err := os.ReadFile(name) // #nosec G304
+	if err != nil {
+		return false, err
+	}
+	return IsEncrypted(data), nil
+}
+
+// ReadFile reads the encrypted file with the given name.
+func ReadFile(name string) (*File, error) {
+	data, err := os.ReadFile(name) // #nosec G304
+	if err != nil {
+		return nil, err
+	}
+	f := new(File)
+	if err := json.Unmarshal(data, f); err != nil {
+		return nil, err
+	}
+	if f.KDF == nil {
+		return nil, fmt.Errorf("%s is not an encrypted file", name)
+	}
+	return f, nil
+}
+
+// WriteFile writes the encrypted file with the given name, readable only by its owner.
+// The file is replaced atomically, so that it is never left partially written.
+func WriteFile(name string, f *File) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	return WriteFileAtomic(name, data)
+}
+
+// WriteFileAtomic writes the given data to a temporary file readable only by its
+// owner, then renames it to the given name.
+func WriteFileAtomic(name string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), name)
+}
@@ -0,0 +1,142 @@
+package keystore
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func testItems() []*Item {
+	return []*Item{
+		{ID: "0x2001", Data: []byte("first secret")},
+		{ID: "0x2002", Data: []byte("second secret")},
+	}
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	key, err := NewKey("password", LightScryptN, LightScryptP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := key.Encrypt(testItems())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Version != Version || len(f.Entries) != 2 {
+		t.Fatalf("unexpected file: version %d, %d entries", f.Version, len(f.Entries))
+	}
+	for _, entry := range f.Entries {
+		if bytes.Contains([]byte(entry.Ciphertext), []byte(hex.EncodeToString([]byte("secret")))) {
+			t.Errorf("entry %s is not encrypted", entry.ID)
+		}
+	}
+
+	_, items, err := Decrypt(f, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range testItems() {
+		if items[i].ID != want.ID || !bytes.Equal(items[i].Data, want.Data) {
+			t.Errorf("item %d: have %s %q, want %s %q", i, items[i].ID, items[i].Data, want.ID, want.Data)
+		}
+	}
+	if _, _, err := Decrypt(f, "wrong password"); !errors.Is(err, ErrDecrypt) {
+		t.Errorf("wrong password: have error %v, want %v", err, ErrDecrypt)
+	}
+}
+
+// tamperKDF returns a function tampering with a copy of the KDF parameters of a
+// file, leaving those of the key it was encrypted with untouched.
+func tamperKDF(tamper func(kdf *KDFParams)) func(f *File) {
+	return func(f *File) {
+		kdf := *f.KDF
+		tamper(&kdf)
+		f.KDF = &kdf
+	}
+}
+
+func TestDecryptTampered(t *testing.T) {
+	key, err := NewKey("password", LightScryptN, LightScryptP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		tamper func(f *File)
+		want   error
+	}{
+		{"id", func(f *File) { f.Entries[0].ID = "0x2003" }, ErrDecrypt},
+		{"swapped ids", func(f *File) { f.Entries[0].ID, f.Entries[1].ID = f.Entries[1].ID, f.Entries[0].ID }, ErrDecrypt},
+		{"ciphertext", func(f *File) { f.Entries[1].Ciphertext = "00" + f.Entries[1].Ciphertext[2:] }, ErrDecrypt},
+		{"file version", func(f *File) { f.Version = Version + 1 }, ErrUnsupportedVersion},
+		{"entry version", func(f *File) { f.Entries[0].Version = EntryVersion + 1 }, ErrUnsupportedVersion},
+		{"scrypt n", tamperKDF(func(kdf *KDFParams) { kdf.N = StandardScryptN << 1 }), ErrInvalidKDFParams},
+		{"scrypt r", tamperKDF(func(kdf *KDFParams) { kdf.R = scryptR << 10 }), ErrInvalidKDFParams},
+		{"scrypt p", tamperKDF(func(kdf *KDFParams) { kdf.P = 1 << 20 }), ErrInvalidKDFParams},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := key.Encrypt(testItems())
+			if err != nil {
+				t.Fatal(err)
+			}
+			tt.tamper(f)
+			if _, _, err := Decrypt(f, "password"); !errors.Is(err, tt.want) {
+				t.Errorf("have error %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteReadFile(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "wallet.json")
+	key, err := NewKey("password", LightScryptN, LightScryptP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := key.Encrypt(testItems())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteFile(name, f); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0600 {
+		t.Errorf("have file mode %o, want 600", perm)
+	}
+	if encrypted, err := IsEncryptedFile(name); err != nil || !encrypted {
+		t.Errorf("IsEncryptedFile: have %v, %v, want true", encrypted, err)
+	}
+
+	f, err = ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, items, err := Decrypt(f, "password"); err != nil || len(items) != 2 {
+		t.Errorf("have %d items, error %v, want 2 items", len(items), err)
+	}
+}
+
+func TestIsEncrypted(t *testing.T) {
+	tests := []struct {
+		data string
+		want bool
+	}{
+		{`{"version":"1","info":[{"address":"0x2001"}]}`, false},
+		{`{"version":1,"kdf":{"function":"scrypt"},"entries":[]}`, true},
+		{`not json`, false},
+		{``, false},
+	}
+	for _, tt := range tests {
+		if have := IsEncrypted([]byte(tt.data)); have != tt.want {
+			t.Errorf("IsEncrypted(%q): have %v, want %v", tt.data, have, tt.want)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2024 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package era
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/theQRL/zond/common"
+	"github.com/theQRL/zond/crypto/hash"
+	"github.com/theQRL/zond/encoding/ssz"
+)
+
+// ComputeAccumulator calculates the SSZ hash tree root of the Era1
+// accumulator of header records.
+func ComputeAccumulator(hashes []common.Hash, tds []*big.Int) (common.Hash, error) {
+	if len(hashes) != len(tds) {
+		return common.Hash{}, errors.New("must have equal number hashes as td values")
+	}
+	if len(hashes) > MaxEra1Size {
+		return common.Hash{}, fmt.Errorf("too many records: have %d, max %d", len(hashes), MaxEra1Size)
+	}
+	hasher := hash.CustomSHA256Hasher()
+	records := make([][32]byte, len(hashes))
+	for i := range hashes {
+		records[i] = headerRecord{Hash: hashes[i], TotalDifficulty: tds[i]}.hashTreeRoot(hasher)
+	}
+	root, err := ssz.BitwiseMerkleize(hasher, records, uint64(len(records)), uint64(MaxEra1Size))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	length := make([]byte, 32)
+	binary.LittleEndian.PutUint64(length, uint64(len(records)))
+	return ssz.MixInLength(root, length), nil
+}
+
+// headerRecord is an individual record for a historical header.
+//
+// See https://github.com/ethereum/portal-network-specs/blob/master/history-network.md#the-header-accumulator
+// for more information.
+type headerRecord struct {
+	Hash            common.Hash
+	TotalDifficulty *big.Int
+}
+
+// hashTreeRoot computes the SSZ hash tree root of the header record, a container
+// of the block hash and the little-endian uint256 total difficulty.
+func (h headerRecord) hashTreeRoot(hasher ssz.HashFn) [32]byte {
+	td := bigToBytes32(h.TotalDifficulty)
+	return hasher(append(h.Hash.Bytes(), td[:]...))
+}
+
+// bigToBytes32 converts a big.Int into a little-endian 32-byte array.
+func bigToBytes32(n *big.Int) (b [32]byte) {
+	n.FillBytes(b[:])
+	reverseOrder(b[:])
+	return
+}
+
+// reverseOrder reverses the byte order of a slice.
+func reverseOrder(b []byte) []byte {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+	return b
+}
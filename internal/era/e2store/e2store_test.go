@@ -0,0 +1,177 @@
+// Copyright 2024 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package e2store
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/theQRL/zond/common"
+)
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []Entry
+		want    string
+	}{
+		{
+			name:    "emptyEntry",
+			entries: []Entry{{0xffff, nil}},
+			want:    "ffff000000000000",
+		},
+		{
+			name:    "beef",
+			entries: []Entry{{42, common.Hex2Bytes("beef")}},
+			want:    "2a00020000000000beef",
+		},
+		{
+			name: "twoEntries",
+			entries: []Entry{
+				{42, common.Hex2Bytes("beef")},
+				{9, common.Hex2Bytes("abcdabcd")},
+			},
+			want: "2a00020000000000beef0900040000000000abcdabcd",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				b = bytes.NewBuffer(nil)
+				w = NewWriter(b)
+			)
+			for _, e := range tt.entries {
+				n, err := w.Write(e.Type, e.Value)
+				if err != nil {
+					t.Fatalf("encoding error: %v", err)
+				}
+				if n != headerSize+len(e.Value) {
+					t.Errorf("unexpected write size %d, want %d", n, headerSize+len(e.Value))
+				}
+			}
+			if want, have := common.FromHex(tt.want), b.Bytes(); !bytes.Equal(want, have) {
+				t.Fatalf("encoding mismatch: have %x, want %x", have, want)
+			}
+			r := NewReader(bytes.NewReader(b.Bytes()))
+			for _, want := range tt.entries {
+				have, err := r.Read()
+				if err != nil {
+					t.Fatalf("decoding error: %v", err)
+				}
+				if have.Type != want.Type {
+					t.Errorf("decoded entry type mismatch: have %d, want %d", have.Type, want.Type)
+				}
+				if !bytes.Equal(have.Value, want.Value) {
+					t.Errorf("decoded entry value mismatch: have %x, want %x", have.Value, want.Value)
+				}
+			}
+			if _, err := r.Read(); err != io.EOF {
+				t.Errorf("expected EOF after the last entry, got %v", err)
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name string
+		have string
+		err  string
+	}{
+		{"valid entry", "ffff000000000000", ""},
+		{"non-zero reserved bytes", "ffff000000000001", "reserved bytes are non-zero"},
+		{"no more entries", "", io.EOF.Error()},
+		{"malformed type", "bad0", io.ErrUnexpectedEOF.Error()},
+		{"malformed length", "badbeef0", io.ErrUnexpectedEOF.Error()},
+		{"length beyond the value", "beef010000000000", io.ErrUnexpectedEOF.Error()},
+		{"length beyond the size limit", "beef000000040000", "item larger than item size limit"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewReader(bytes.NewReader(common.FromHex(tt.have)))
+			_, err := r.Read()
+			if tt.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("expected error %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestReaderAt(t *testing.T) {
+	var (
+		b = bytes.NewBuffer(nil)
+		w = NewWriter(b)
+	)
+	for _, e := range []Entry{{1, []byte{0x01}}, {2, []byte{0x02, 0x02}}, {1, []byte{0x03}}} {
+		if _, err := w.Write(e.Type, e.Value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r := NewReader(bytes.NewReader(b.Bytes()))
+
+	// The second entry starts after the header and value of the first
+	off := int64(headerSize + 1)
+	if length, err := r.LengthAt(off); err != nil || length != headerSize+2 {
+		t.Fatalf("unexpected length %d of the second entry: %v", length, err)
+	}
+	value, n, err := r.ReaderAt(2, off)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != headerSize+2 {
+		t.Errorf("unexpected entry size %d", n)
+	}
+	if v, _ := io.ReadAll(value); !bytes.Equal(v, []byte{0x02, 0x02}) {
+		t.Errorf("unexpected value %x of the second entry", v)
+	}
+	if _, _, err := r.ReaderAt(1, off); err == nil || !strings.Contains(err.Error(), "wrong type") {
+		t.Errorf("expected a wrong type error, got %v", err)
+	}
+
+	entry, err := r.Find(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(entry.Value, []byte{0x01}) {
+		t.Errorf("unexpected value %x of the first entry of type 1", entry.Value)
+	}
+	if _, err := r.Find(3); err != io.EOF {
+		t.Errorf("expected EOF finding a missing type, got %v", err)
+	}
+	entries, err := r.FindAll(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 || !bytes.Equal(entries[1].Value, []byte{0x03}) {
+		t.Errorf("unexpected entries %v of type 1", entries)
+	}
+
+	// A truncated trailing entry is reported while searching
+	truncated := NewReader(bytes.NewReader(b.Bytes()[:b.Len()-4]))
+	if _, err := truncated.FindAll(1); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("expected an unexpected EOF, got %v", err)
+	}
+}
@@ -0,0 +1,372 @@
+// Copyright 2024 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package era
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/zond/common"
+	"github.com/theQRL/zond/core/types"
+	"github.com/theQRL/zond/params"
+	"github.com/theQRL/zond/trie"
+)
+
+// referenceAccumulator merkleizes the header records over all MaxEra1Size leaves,
+// without the zero-hash shortcuts of the ssz package.
+func referenceAccumulator(hashes []common.Hash, tds []*big.Int) common.Hash {
+	layer := make([][32]byte, MaxEra1Size)
+	for i := range hashes {
+		var td [32]byte
+		tds[i].FillBytes(td[:])
+		layer[i] = sha256.Sum256(append(hashes[i].Bytes(), reverseOrder(td[:])...))
+	}
+	for len(layer) > 1 {
+		next := make([][32]byte, len(layer)/2)
+		for i := range next {
+			next[i] = sha256.Sum256(append(layer[2*i][:], layer[2*i+1][:]...))
+		}
+		layer = next
+	}
+	length := make([]byte, 32)
+	binary.LittleEndian.PutUint64(length, uint64(len(hashes)))
+	return sha256.Sum256(append(layer[0][:], length...))
+}
+
+func TestComputeAccumulator(t *testing.T) {
+	var (
+		hashes []common.Hash
+		tds    []*big.Int
+	)
+	for i := 0; i < 5; i++ {
+		hashes = append(hashes, common.Hash{byte(i + 1)})
+		tds = append(tds, big.NewInt(int64(1000*(i+1))))
+	}
+	for _, n := range []int{1, 2, 5} {
+		root, err := ComputeAccumulator(hashes[:n], tds[:n])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := referenceAccumulator(hashes[:n], tds[:n]); root != want {
+			t.Errorf("unexpected accumulator of %d records: have %x, want %x", n, root, want)
+		}
+	}
+
+	// Every record is bound by the accumulator
+	root, _ := ComputeAccumulator(hashes, tds)
+	tampered := append([]*big.Int{}, tds...)
+	tampered[2] = big.NewInt(3001)
+	if other, _ := ComputeAccumulator(hashes, tampered); other == root {
+		t.Error("accumulator unchanged by a different total difficulty")
+	}
+	swapped := append([]common.Hash{}, hashes...)
+	swapped[0], swapped[1] = swapped[1], swapped[0]
+	if other, _ := ComputeAccumulator(swapped, tds); other == root {
+		t.Error("accumulator unchanged by reordered hashes")
+	}
+
+	if _, err := ComputeAccumulator(hashes, tds[:4]); err == nil {
+		t.Error("expected an error for mismatched hashes and total difficulties")
+	}
+	many := make([]common.Hash, MaxEra1Size+1)
+	manyTds := make([]*big.Int, MaxEra1Size+1)
+	for i := range manyTds {
+		manyTds[i] = new(big.Int)
+	}
+	if _, err := ComputeAccumulator(many, manyTds); err == nil || !strings.Contains(err.Error(), "too many records") {
+		t.Errorf("expected a too many records error, got %v", err)
+	}
+}
+
+// testEra holds the blocks, receipts and total difficulties written to an era1 file.
+type testEra struct {
+	path     string
+	blocks   []*types.Block
+	receipts []types.Receipts
+	tds      []*big.Int
+	root     common.Hash
+}
+
+// newTestEra writes an era1 file of 16 blocks starting at block 100, with a
+// transaction and its receipt in block 105.
+func newTestEra(t *testing.T) *testEra {
+	var (
+		config = params.TestChainConfig
+		signer = types.LatestSigner(config)
+		to     = common.HexToAddress("0x3000000000000000000000000000000000000003")
+		td     = big.NewInt(500)
+		parent = common.Hash{0x01}
+		te     = &testEra{path: filepath.Join(t.TempDir(), Filename("test", 0, common.Hash{}))}
+	)
+	tx, err := types.SignNewDilithiumTx(dilithium.New(), signer, &types.DilithiumTx{
+		ChainID:   config.ChainID,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(1),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(1),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for number := uint64(100); number < 116; number++ {
+		header := &types.Header{
+			ParentHash: parent,
+			Number:     new(big.Int).SetUint64(number),
+			Difficulty: big.NewInt(int64(number)),
+			GasLimit:   30_000_000,
+			Time:       number,
+		}
+		var (
+			txs      []*types.Transaction
+			receipts types.Receipts
+		)
+		if number == 105 {
+			txs = []*types.Transaction{tx}
+			receipts = types.Receipts{{Status: types.ReceiptStatusSuccessful, CumulativeGasUsed: 21000, Logs: []*types.Log{}}}
+			header.GasUsed = 21000
+		}
+		block := types.NewBlock(header, txs, nil, receipts, trie.NewStackTrie(nil))
+		td = new(big.Int).Add(td, block.Difficulty())
+
+		te.blocks = append(te.blocks, block)
+		te.receipts = append(te.receipts, receipts)
+		te.tds = append(te.tds, td)
+		parent = block.Hash()
+	}
+
+	f, err := os.Create(te.path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	builder := NewBuilder(f)
+	for i, block := range te.blocks {
+		if err := builder.Add(block, te.receipts[i], te.tds[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if te.root, err = builder.Finalize(); err != nil {
+		t.Fatal(err)
+	}
+	return te
+}
+
+func TestBuilder(t *testing.T) {
+	te := newTestEra(t)
+	e, err := Open(te.path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+
+	if e.Start() != 100 || e.Count() != uint64(len(te.blocks)) {
+		t.Fatalf("unexpected range of %d blocks from %d", e.Count(), e.Start())
+	}
+	hashes := make([]common.Hash, len(te.blocks))
+	for i, block := range te.blocks {
+		hashes[i] = block.Hash()
+	}
+	want, err := ComputeAccumulator(hashes, te.tds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if te.root != want {
+		t.Errorf("unexpected finalized root %x, want %x", te.root, want)
+	}
+	if root, err := e.Accumulator(); err != nil || root != want {
+		t.Errorf("unexpected accumulator %x, want %x: %v", root, want, err)
+	}
+	if td, err := e.InitialTD(); err != nil || td.Cmp(big.NewInt(500)) != 0 {
+		t.Errorf("unexpected initial total difficulty %v: %v", td, err)
+	}
+
+	for _, block := range te.blocks {
+		have, err := e.GetBlockByNumber(block.NumberU64())
+		if err != nil {
+			t.Fatalf("error reading block %d: %v", block.NumberU64(), err)
+		}
+		if have.Hash() != block.Hash() || len(have.Transactions()) != len(block.Transactions()) {
+			t.Errorf("unexpected block %d: hash %x with %d transactions", block.NumberU64(), have.Hash(), len(have.Transactions()))
+		}
+	}
+	for _, number := range []uint64{99, 116} {
+		if _, err := e.GetBlockByNumber(number); err == nil {
+			t.Errorf("expected an out-of-bounds error reading block %d", number)
+		}
+	}
+
+	if _, err := NewBuilder(io.Discard).Finalize(); err == nil {
+		t.Error("expected an error finalizing an empty builder")
+	}
+}
+
+func TestIterator(t *testing.T) {
+	te := newTestEra(t)
+	e, err := Open(te.path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+
+	it, err := NewIterator(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := 0
+	for it.Next() {
+		if err := it.Error(); err != nil {
+			t.Fatal(err)
+		}
+		if i >= len(te.blocks) {
+			t.Fatalf("more than %d blocks iterated", len(te.blocks))
+		}
+		if it.Number() != te.blocks[i].NumberU64() {
+			t.Errorf("unexpected iterator position %d, want %d", it.Number(), te.blocks[i].NumberU64())
+		}
+		block, receipts, err := it.BlockAndReceipts()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if block.Hash() != te.blocks[i].Hash() {
+			t.Errorf("unexpected block %d hash %x, want %x", it.Number(), block.Hash(), te.blocks[i].Hash())
+		}
+		if hash := types.DeriveSha(receipts, trie.NewStackTrie(nil)); hash != block.ReceiptHash() {
+			t.Errorf("unexpected receipts of block %d: root %x, want %x", it.Number(), hash, block.ReceiptHash())
+		}
+		td, err := it.TotalDifficulty()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if td.Cmp(te.tds[i]) != 0 {
+			t.Errorf("unexpected total difficulty %v of block %d, want %v", td, it.Number(), te.tds[i])
+		}
+		i++
+	}
+	if err := it.Error(); err != nil {
+		t.Fatal(err)
+	}
+	if i != len(te.blocks) {
+		t.Errorf("iterated %d blocks, want %d", i, len(te.blocks))
+	}
+}
+
+func TestRawIterator(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "raw.era1")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	builder := NewBuilder(f)
+	for i := 0; i < 128; i++ {
+		err := builder.AddRLP([]byte{'h', byte(i)}, []byte{'b', byte(i)}, []byte{'r', byte(i)}, uint64(i), common.Hash{byte(i)}, big.NewInt(int64(i+1)), big.NewInt(1))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := builder.Finalize(); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	e, err := Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+	it, err := NewRawIterator(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 128; i++ {
+		if !it.Next() {
+			t.Fatalf("iteration stopped at entry %d: %v", i, it.Error())
+		}
+		for _, entry := range []struct {
+			r    io.Reader
+			want []byte
+		}{
+			{it.Header, []byte{'h', byte(i)}},
+			{it.Body, []byte{'b', byte(i)}},
+			{it.Receipts, []byte{'r', byte(i)}},
+		} {
+			have, err := io.ReadAll(entry.r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(have, entry.want) {
+				t.Errorf("unexpected entry %q of block %d, want %q", have, i, entry.want)
+			}
+		}
+		rawTd, err := io.ReadAll(it.TotalDifficulty)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if td := new(big.Int).SetBytes(reverseOrder(rawTd)); td.Cmp(big.NewInt(int64(i+1))) != 0 {
+			t.Errorf("unexpected total difficulty %v of block %d", td, i)
+		}
+	}
+	if it.Next() {
+		t.Error("iteration continued past the last block")
+	}
+}
+
+func TestReadDir(t *testing.T) {
+	root := common.HexToHash("0x1234567890abcdef")
+	if name := Filename("zond", 3, root); name != "zond-00003-00000000.era1" {
+		t.Errorf("unexpected file name %s", name)
+	}
+	root = common.HexToHash("0xabcdef1200000000000000000000000000000000000000000000000000000000")
+	if name := Filename("zond", 12, root); name != "zond-00012-abcdef12.era1" {
+		t.Errorf("unexpected file name %s", name)
+	}
+
+	dir := t.TempDir()
+	for _, name := range []string{
+		Filename("zond", 0, root),
+		Filename("zond", 1, root),
+		Filename("other", 0, root),
+		"checksums.txt",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	names, err := ReadDir(dir, "zond")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{Filename("zond", 0, root), Filename("zond", 1, root)}; !reflect.DeepEqual(names, want) {
+		t.Errorf("unexpected era1 files %v, want %v", names, want)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, Filename("zond", 3, root)), nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadDir(dir, "zond"); err == nil || !strings.Contains(err.Error(), "missing epoch 2") {
+		t.Errorf("expected a missing epoch error, got %v", err)
+	}
+}
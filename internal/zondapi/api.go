@@ -17,10 +17,13 @@
 package zondapi
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -30,16 +33,221 @@ import (
 	"github.com/theQRL/zond/common/math"
 	"github.com/theQRL/zond/config"
 	"github.com/theQRL/zond/core"
+	"github.com/theQRL/zond/core/rawdb"
 	"github.com/theQRL/zond/core/state"
 	"github.com/theQRL/zond/core/types"
 	"github.com/theQRL/zond/core/vm"
+	"github.com/theQRL/zond/crypto"
 	"github.com/theQRL/zond/misc"
 	"github.com/theQRL/zond/params"
 	"github.com/theQRL/zond/protos"
 	"github.com/theQRL/zond/rpc"
 	"github.com/theQRL/zond/transactions"
+	"github.com/theQRL/zond/zond/tracers/logger"
 )
 
+// ZondAPI provides an API to access Zond related information.
+type ZondAPI struct {
+	b Backend
+}
+
+// NewZondAPI creates a new Zond protocol API.
+func NewZondAPI(b Backend) *ZondAPI {
+	return &ZondAPI{b}
+}
+
+// GasPrice returns a suggestion for a gas price for legacy transactions.
+func (s *ZondAPI) GasPrice(ctx context.Context) (*hexutil.Big, error) {
+	tipcap, err := s.b.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if head, _ := s.b.HeaderByNumberV1(ctx, rpc.LatestBlockNumber); head != nil {
+		tipcap.Add(tipcap, new(big.Int).SetUint64(head.BaseFee))
+	}
+	return (*hexutil.Big)(tipcap), err
+}
+
+// MaxPriorityFeePerGas returns a suggestion for a gas tip cap for dynamic fee transactions.
+func (s *ZondAPI) MaxPriorityFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	tipcap, err := s.b.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(tipcap), err
+}
+
+type feeHistoryResult struct {
+	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
+	Reward       [][]*hexutil.Big `json:"reward,omitempty"`
+	BaseFee      []*hexutil.Big   `json:"baseFeePerGas,omitempty"`
+	GasUsedRatio []float64        `json:"gasUsedRatio"`
+}
+
+// FeeHistory returns the fee market history.
+func (s *ZondAPI) FeeHistory(ctx context.Context, blockCount rpc.DecimalOrHex, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*feeHistoryResult, error) {
+	oldest, reward, baseFee, gasUsed, err := s.b.FeeHistory(ctx, int(blockCount), lastBlock, rewardPercentiles)
+	if err != nil {
+		return nil, err
+	}
+	results := &feeHistoryResult{
+		OldestBlock:  (*hexutil.Big)(oldest),
+		GasUsedRatio: gasUsed,
+	}
+	if reward != nil {
+		results.Reward = make([][]*hexutil.Big, len(reward))
+		for i, w := range reward {
+			results.Reward[i] = make([]*hexutil.Big, len(w))
+			for j, v := range w {
+				results.Reward[i][j] = (*hexutil.Big)(v)
+			}
+		}
+	}
+	if baseFee != nil {
+		results.BaseFee = make([]*hexutil.Big, len(baseFee))
+		for i, v := range baseFee {
+			results.BaseFee[i] = (*hexutil.Big)(v)
+		}
+	}
+	return results, nil
+}
+
+// TxPoolAPI offers and API for the transaction pool. It only operates on data that is non confidential.
+type TxPoolAPI struct {
+	b Backend
+}
+
+// NewTxPoolAPI creates a new tx pool service that gives information about the transaction pool.
+func NewTxPoolAPI(b Backend) *TxPoolAPI {
+	return &TxPoolAPI{b}
+}
+
+// Content returns the transactions contained within the transaction pool.
+func (s *TxPoolAPI) Content(ctx context.Context) (map[string]map[string]map[string]*RPCTransaction, error) {
+	content := map[string]map[string]map[string]*RPCTransaction{
+		"pending": make(map[string]map[string]*RPCTransaction),
+		"queued":  make(map[string]map[string]*RPCTransaction),
+	}
+	pending, queue, err := s.b.TxPoolContentV1()
+	if err != nil {
+		return nil, err
+	}
+	curHeader, err := s.b.HeaderByNumberV1(ctx, rpc.LatestBlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	// Flatten the pending transactions
+	for account, txs := range pending {
+		dump := make(map[string]*RPCTransaction)
+		for _, tx := range txs {
+			dump[fmt.Sprintf("%d", tx.Nonce)] = NewRPCPendingTransaction(tx, curHeader, s.b.ChainConfig())
+		}
+		content["pending"][account.Hex()] = dump
+	}
+	// Flatten the queued transactions
+	for account, txs := range queue {
+		dump := make(map[string]*RPCTransaction)
+		for _, tx := range txs {
+			dump[fmt.Sprintf("%d", tx.Nonce)] = NewRPCPendingTransaction(tx, curHeader, s.b.ChainConfig())
+		}
+		content["queued"][account.Hex()] = dump
+	}
+	return content, nil
+}
+
+// ContentFrom returns the transactions contained within the transaction pool.
+func (s *TxPoolAPI) ContentFrom(ctx context.Context, addr common.Address) (map[string]map[string]*RPCTransaction, error) {
+	content := make(map[string]map[string]*RPCTransaction, 2)
+	pending, queue, err := s.b.TxPoolContentFromV1(addr)
+	if err != nil {
+		return nil, err
+	}
+	curHeader, err := s.b.HeaderByNumberV1(ctx, rpc.LatestBlockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the pending transactions
+	dump := make(map[string]*RPCTransaction, len(pending))
+	for _, tx := range pending {
+		dump[fmt.Sprintf("%d", tx.Nonce)] = NewRPCPendingTransaction(tx, curHeader, s.b.ChainConfig())
+	}
+	content["pending"] = dump
+
+	// Build the queued transactions
+	dump = make(map[string]*RPCTransaction, len(queue))
+	for _, tx := range queue {
+		dump[fmt.Sprintf("%d", tx.Nonce)] = NewRPCPendingTransaction(tx, curHeader, s.b.ChainConfig())
+	}
+	content["queued"] = dump
+
+	return content, nil
+}
+
+// Status returns the number of pending and queued transaction in the pool.
+func (s *TxPoolAPI) Status() (map[string]hexutil.Uint, error) {
+	pending, queue, err := s.b.TxPoolContentV1()
+	if err != nil {
+		return nil, err
+	}
+	var numPending, numQueued int
+	for _, txs := range pending {
+		numPending += len(txs)
+	}
+	for _, txs := range queue {
+		numQueued += len(txs)
+	}
+	return map[string]hexutil.Uint{
+		"pending": hexutil.Uint(numPending),
+		"queued":  hexutil.Uint(numQueued),
+	}, nil
+}
+
+// Inspect retrieves the content of the transaction pool and flattens it into an
+// easily inspectable list.
+func (s *TxPoolAPI) Inspect() (map[string]map[string]map[string]string, error) {
+	content := map[string]map[string]map[string]string{
+		"pending": make(map[string]map[string]string),
+		"queued":  make(map[string]map[string]string),
+	}
+	pending, queue, err := s.b.TxPoolContentV1()
+	if err != nil {
+		return nil, err
+	}
+
+	// Define a formatter to flatten a transaction into a string
+	var format = func(pbTx *protos.Transaction) string {
+		tx := transactions.ProtoToTransaction(pbTx)
+		switch pbTx.Type.(type) {
+		case *protos.Transaction_Transfer:
+			if to := pbTx.GetTransfer().GetTo(); to != nil {
+				return fmt.Sprintf("%s: %d wei + %d gas × %v wei", common.BytesToAddress(to).Hex(), pbTx.GetTransfer().Value, tx.Gas(), tx.GasFeeCap())
+			}
+			return fmt.Sprintf("contract creation: %d wei + %d gas × %v wei", pbTx.GetTransfer().Value, tx.Gas(), tx.GasFeeCap())
+		case *protos.Transaction_Stake:
+			return fmt.Sprintf("stake: %d wei + %d gas × %v wei", pbTx.GetStake().Amount, tx.Gas(), tx.GasFeeCap())
+		}
+		return fmt.Sprintf("unknown: %d gas × %v wei", tx.Gas(), tx.GasFeeCap())
+	}
+	// Flatten the pending transactions
+	for account, txs := range pending {
+		dump := make(map[string]string)
+		for _, tx := range txs {
+			dump[fmt.Sprintf("%d", tx.Nonce)] = format(tx)
+		}
+		content["pending"][account.Hex()] = dump
+	}
+	// Flatten the queued transactions
+	for account, txs := range queue {
+		dump := make(map[string]string)
+		for _, tx := range txs {
+			dump[fmt.Sprintf("%d", tx.Nonce)] = format(tx)
+		}
+		content["queued"][account.Hex()] = dump
+	}
+	return content, nil
+}
+
 // BlockChainAPI provides an API to access Ethereum blockchain data.
 type BlockChainAPI struct {
 	b Backend
@@ -117,54 +325,54 @@ type SlotValidators struct {
 }
 
 // GetProof returns the Merkle-proof for a given account and optionally some storage keys.
-//func (s *BlockChainAPI) GetProof(ctx context.Context, address common.Address, storageKeys []string, blockNrOrHash rpc.BlockNumberOrHash) (*AccountResult, error) {
-//	state, _, err := s.b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
-//	if state == nil || err != nil {
-//		return nil, err
-//	}
-//
-//	storageTrie := state.StorageTrie(address)
-//	storageHash := types.EmptyRootHash
-//	codeHash := state.GetCodeHash(address)
-//	storageProof := make([]StorageResult, len(storageKeys))
-//
-//	// if we have a storageTrie, (which means the account exists), we can update the storagehash
-//	if storageTrie != nil {
-//		storageHash = storageTrie.Hash()
-//	} else {
-//		// no storageTrie means the account does not exist, so the codeHash is the hash of an empty bytearray.
-//		codeHash = crypto.Keccak256Hash(nil)
-//	}
-//
-//	// create the proof for the storageKeys
-//	for i, key := range storageKeys {
-//		if storageTrie != nil {
-//			proof, storageError := state.GetStorageProof(address, common.HexToHash(key))
-//			if storageError != nil {
-//				return nil, storageError
-//			}
-//			storageProof[i] = StorageResult{key, (*hexutil.Big)(state.GetState(address, common.HexToHash(key)).Big()), toHexSlice(proof)}
-//		} else {
-//			storageProof[i] = StorageResult{key, &hexutil.Big{}, []string{}}
-//		}
-//	}
-//
-//	// create the accountProof
-//	accountProof, proofErr := state.GetProof(address)
-//	if proofErr != nil {
-//		return nil, proofErr
-//	}
-//
-//	return &AccountResult{
-//		Address:      address,
-//		AccountProof: toHexSlice(accountProof),
-//		Balance:      (*hexutil.Big)(state.GetBalance(address)),
-//		CodeHash:     codeHash,
-//		Nonce:        hexutil.Uint64(state.GetNonce(address)),
-//		StorageHash:  storageHash,
-//		StorageProof: storageProof,
-//	}, state.Error()
-//}
+func (s *BlockChainAPI) GetProof(ctx context.Context, address common.Address, storageKeys []string, blockNrOrHash rpc.BlockNumberOrHash) (*AccountResult, error) {
+	state, _, err := s.b.StateAndHeaderByNumberOrHashV1(ctx, blockNrOrHash)
+	if state == nil || err != nil {
+		return nil, err
+	}
+
+	storageTrie := state.StorageTrie(address)
+	storageHash := types.EmptyRootHash
+	codeHash := state.GetCodeHash(address)
+	storageProof := make([]StorageResult, len(storageKeys))
+
+	// if we have a storageTrie, (which means the account exists), we can update the storagehash
+	if storageTrie != nil {
+		storageHash = storageTrie.Hash()
+	} else {
+		// no storageTrie means the account does not exist, so the codeHash is the hash of an empty bytearray.
+		codeHash = crypto.Keccak256Hash(nil)
+	}
+
+	// create the proof for the storageKeys
+	for i, key := range storageKeys {
+		if storageTrie != nil {
+			proof, storageError := state.GetStorageProof(address, common.HexToHash(key))
+			if storageError != nil {
+				return nil, storageError
+			}
+			storageProof[i] = StorageResult{key, (*hexutil.Big)(state.GetState(address, common.HexToHash(key)).Big()), toHexSlice(proof)}
+		} else {
+			storageProof[i] = StorageResult{key, &hexutil.Big{}, []string{}}
+		}
+	}
+
+	// create the accountProof
+	accountProof, proofErr := state.GetProof(address)
+	if proofErr != nil {
+		return nil, proofErr
+	}
+
+	return &AccountResult{
+		Address:      address,
+		AccountProof: toHexSlice(accountProof),
+		Balance:      (*hexutil.Big)(state.GetBalance(address)),
+		CodeHash:     codeHash,
+		Nonce:        hexutil.Uint64(state.GetNonce(address)),
+		StorageHash:  storageHash,
+		StorageProof: storageProof,
+	}, state.Error()
+}
 
 func (s *BlockChainAPI) GetValidators(ctx context.Context) (*ValidatorsResult, error) {
 	epochMetaData, err := s.b.GetValidators(ctx)
@@ -871,16 +1079,16 @@ func newRPCProtocolTransaction(tx *protos.ProtocolTransaction, blockHash common.
 	return result
 }
 
-// newRPCPendingTransaction returns a pending transaction that will serialize to the RPC representation
-//func newRPCPendingTransaction(tx *protos.Transaction, current *types.Header, config *params.ChainConfig) *RPCTransaction {
-//	var baseFee *big.Int
-//	blockNumber := uint64(0)
-//	if current != nil {
-//		baseFee = misc.CalcBaseFee(config, current)
-//		blockNumber = current.Number.Uint64()
-//	}
-//	return newRPCTransaction(tx, common.Hash{}, blockNumber, 0, baseFee, config)
-//}
+// NewRPCPendingTransaction returns a pending transaction that will serialize to the RPC representation
+func NewRPCPendingTransaction(tx *protos.Transaction, current *protos.BlockHeader, config *params.ChainConfig) *RPCTransaction {
+	var baseFee *big.Int
+	blockNumber := uint64(0)
+	if current != nil {
+		baseFee = new(big.Int).SetUint64(current.BaseFee)
+		blockNumber = current.SlotNumber
+	}
+	return newRPCTransaction(tx, common.Hash{}, blockNumber, 0, baseFee, config)
+}
 
 // newRPCTransactionFromBlockIndex returns a transaction that will serialize to the RPC representation.
 func newRPCTransactionFromBlockIndex(b *protos.Block, index uint64, config *params.ChainConfig) *RPCTransaction {
@@ -932,105 +1140,111 @@ func newRPCProtocolTransactionFromBlockHash(b *protos.Block, hash common.Hash, c
 	return nil
 }
 
-//// accessListResult returns an optional accesslist
-//// Its the result of the `debug_createAccessList` RPC call.
-//// It contains an error if the transaction itself failed.
-//type accessListResult struct {
-//	Accesslist *types.AccessList `json:"accessList"`
-//	Error      string            `json:"error,omitempty"`
-//	GasUsed    hexutil.Uint64    `json:"gasUsed"`
-//}
-//
-//// CreateAccessList creates a EIP-2930 type AccessList for the given transaction.
-//// Reexec and BlockNrOrHash can be specified to create the accessList on top of a certain state.
-//func (s *BlockChainAPI) CreateAccessList(ctx context.Context, args TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (*accessListResult, error) {
-//	bNrOrHash := rpc.BlockNumberOrHashWithNumber(rpc.PendingBlockNumber)
-//	if blockNrOrHash != nil {
-//		bNrOrHash = *blockNrOrHash
-//	}
-//	acl, gasUsed, vmerr, err := AccessList(ctx, s.b, bNrOrHash, args)
-//	if err != nil {
-//		return nil, err
-//	}
-//	result := &accessListResult{Accesslist: &acl, GasUsed: hexutil.Uint64(gasUsed)}
-//	if vmerr != nil {
-//		result.Error = vmerr.Error()
-//	}
-//	return result, nil
-//}
-//
-//// AccessList creates an access list for the given transaction.
-//// If the accesslist creation fails an error is returned.
-//// If the transaction itself fails, an vmErr is returned.
-//func AccessList(ctx context.Context, b Backend, blockNrOrHash rpc.BlockNumberOrHash, args TransactionArgs) (acl types.AccessList, gasUsed uint64, vmErr error, err error) {
-//	// Retrieve the execution context
-//	db, header, err := b.StateAndHeaderByNumberOrHash(ctx, blockNrOrHash)
-//	if db == nil || err != nil {
-//		return nil, 0, nil, err
-//	}
-//	// If the gas amount is not set, extract this as it will depend on access
-//	// lists and we'll need to reestimate every time
-//	nogas := args.Gas == nil
-//
-//	// Ensure any missing fields are filled, extract the recipient and input data
-//	if err := args.setDefaults(ctx, b); err != nil {
-//		return nil, 0, nil, err
-//	}
-//	var to common.Address
-//	if args.To != nil {
-//		to = *args.To
-//	} else {
-//		to = crypto.CreateAddress(args.from(), uint64(*args.Nonce))
-//	}
-//	isPostMerge := header.Difficulty.Cmp(common.Big0) == 0
-//	// Retrieve the precompiles since they don't need to be added to the access list
-//	precompiles := vm.ActivePrecompiles(b.ChainConfig().Rules(header.Number, isPostMerge))
-//
-//	// Create an initial tracer
-//	prevTracer := logger.NewAccessListTracer(nil, args.from(), to, precompiles)
-//	if args.AccessList != nil {
-//		prevTracer = logger.NewAccessListTracer(*args.AccessList, args.from(), to, precompiles)
-//	}
-//	for {
-//		// Retrieve the current access list to expand
-//		accessList := prevTracer.AccessList()
-//		log.Trace("Creating access list", "input", accessList)
-//
-//		// If no gas amount was specified, each unique access list needs it's own
-//		// gas calculation. This is quite expensive, but we need to be accurate
-//		// and it's convered by the sender only anyway.
-//		if nogas {
-//			args.Gas = nil
-//			if err := args.setDefaults(ctx, b); err != nil {
-//				return nil, 0, nil, err // shouldn't happen, just in case
-//			}
-//		}
-//		// Copy the original db so we don't modify it
-//		statedb := db.Copy()
-//		// Set the accesslist to the last al
-//		args.AccessList = &accessList
-//		msg, err := args.ToMessage(b.RPCGasCap(), header.BaseFee)
-//		if err != nil {
-//			return nil, 0, nil, err
-//		}
-//
-//		// Apply the transaction with the access list tracer
-//		tracer := logger.NewAccessListTracer(accessList, args.from(), to, precompiles)
-//		config := vm.Config{Tracer: tracer, Debug: true, NoBaseFee: true}
-//		vmenv, _, err := b.GetEVM(ctx, msg, statedb, header, &config)
-//		if err != nil {
-//			return nil, 0, nil, err
-//		}
-//		res, err := core.ApplyMessage(vmenv, msg, new(core.GasPool).AddGas(msg.Gas()))
-//		if err != nil {
-//			return nil, 0, nil, fmt.Errorf("failed to apply transaction: %v err: %v", args.toTransaction().Hash(), err)
-//		}
-//		if tracer.Equal(prevTracer) {
-//			return accessList, res.UsedGas, res.Err, nil
-//		}
-//		prevTracer = tracer
-//	}
-//}
+// accessListResult returns an optional accesslist
+// Its the result of the `zond_createAccessList` RPC call.
+// It contains an error if the transaction itself failed.
+type accessListResult struct {
+	Accesslist *types.AccessList `json:"accessList"`
+	Error      string            `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64    `json:"gasUsed"`
+}
+
+// CreateAccessList creates a EIP-2930 type AccessList for the given transaction.
+// Reexec and BlockNrOrHash can be specified to create the accessList on top of a certain state.
+func (s *BlockChainAPI) CreateAccessList(ctx context.Context, args TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (*accessListResult, error) {
+	bNrOrHash := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+	if blockNrOrHash != nil {
+		bNrOrHash = *blockNrOrHash
+	}
+	acl, gasUsed, vmerr, err := AccessList(ctx, s.b, bNrOrHash, args)
+	if err != nil {
+		return nil, err
+	}
+	result := &accessListResult{Accesslist: &acl, GasUsed: hexutil.Uint64(gasUsed)}
+	if vmerr != nil {
+		result.Error = vmerr.Error()
+	}
+	return result, nil
+}
+
+// AccessList creates an access list for the given transaction.
+// If the accesslist creation fails an error is returned.
+// If the transaction itself fails, an vmErr is returned.
+func AccessList(ctx context.Context, b Backend, blockNrOrHash rpc.BlockNumberOrHash, args TransactionArgs) (acl types.AccessList, gasUsed uint64, vmErr error, err error) {
+	// Retrieve the execution context
+	db, header, err := b.StateAndHeaderByNumberOrHashV1(ctx, blockNrOrHash)
+	if db == nil || err != nil {
+		return nil, 0, nil, err
+	}
+	// If the gas amount is not set, extract this as it will depend on access
+	// lists and we'll need to reestimate every time
+	nogas := args.Gas == nil
+
+	// Ensure any missing fields are filled, extract the recipient and input data
+	if args.Data == nil {
+		args.Data = new(hexutil.Bytes)
+	}
+	if args.Nonce == nil {
+		nonce := hexutil.Uint64(db.GetNonce(args.from()))
+		args.Nonce = &nonce
+	}
+	var to common.Address
+	if args.To != nil {
+		to = *args.To
+	} else {
+		to = crypto.CreateAddress(args.from(), uint64(*args.Nonce))
+	}
+	// Retrieve the precompiles since they don't need to be added to the access list
+	precompiles := vm.ActivePrecompiles(b.ChainConfig().Rules(new(big.Int).SetUint64(header.SlotNumber), true))
+
+	// Create an initial tracer
+	prevTracer := logger.NewAccessListTracer(nil, args.from(), to, precompiles)
+	if args.AccessList != nil {
+		prevTracer = logger.NewAccessListTracer(*args.AccessList, args.from(), to, precompiles)
+	}
+	for {
+		// Retrieve the current access list to expand
+		accessList := prevTracer.AccessList()
+		log.WithField("input", accessList).Trace("Creating access list")
+
+		// Set the accesslist to the last al
+		args.AccessList = &accessList
+
+		// If no gas amount was specified, each unique access list needs it's own
+		// gas calculation. This is quite expensive, but we need to be accurate
+		// and it's convered by the sender only anyway.
+		if nogas {
+			args.Gas = nil
+			gas, err := DoEstimateGas(ctx, b, args, blockNrOrHash, b.RPCGasCap())
+			if err != nil {
+				return nil, 0, nil, err
+			}
+			args.Gas = &gas
+		}
+		// Copy the original db so we don't modify it
+		statedb := db.Copy()
+		msg, err := args.ToMessage(b.RPCGasCap(), new(big.Int).SetUint64(header.BaseFee))
+		if err != nil {
+			return nil, 0, nil, err
+		}
+
+		// Apply the transaction with the access list tracer
+		tracer := logger.NewAccessListTracer(accessList, args.from(), to, precompiles)
+		config := vm.Config{Tracer: tracer, Debug: true, NoBaseFee: true}
+		vmenv, _, err := b.GetEVMV1(ctx, msg, statedb, header, &config)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		res, err := core.ApplyMessage(vmenv, msg, new(core.GasPool).AddGas(msg.Gas()))
+		if err != nil {
+			return nil, 0, nil, fmt.Errorf("failed to apply transaction: %v err: %v", args.toTransaction().Hash(), err)
+		}
+		if tracer.Equal(prevTracer) {
+			return accessList, res.UsedGas, res.Err, nil
+		}
+		prevTracer = tracer
+	}
+}
 
 // TransactionAPI exposes methods for reading and creating transaction data.
 type TransactionAPI struct {
@@ -1158,6 +1372,97 @@ func (s *TransactionAPI) GetTransactionCount(ctx context.Context, address common
 //	return tx.MarshalBinary()
 //}
 
+const (
+	defaultAddressTransactionsLimit = 100  // Default number of transactions returned by GetTransactionsByAddress
+	maxAddressTransactionsLimit     = 1000 // Maximum number of transactions returned by GetTransactionsByAddress
+)
+
+// AddressTransaction is a transaction touching an address, as returned by GetTransactionsByAddress.
+type AddressTransaction struct {
+	BlockHash        common.Hash    `json:"blockHash"`
+	BlockNumber      hexutil.Uint64 `json:"blockNumber"`
+	TransactionHash  common.Hash    `json:"transactionHash"`
+	TransactionIndex hexutil.Uint64 `json:"transactionIndex"`
+	Roles            []string       `json:"roles"`
+}
+
+// AddressTransactionsResult is a page of the transaction history of an address.
+type AddressTransactionsResult struct {
+	Transactions []*AddressTransaction `json:"transactions"`
+	NextCursor   *hexutil.Bytes        `json:"nextCursor"`
+}
+
+// GetTransactionsByAddress returns the canonical transactions sent or received by the
+// given address, creating it or emitting logs from it, from the newest to the oldest.
+// The next page is requested by passing the returned nextCursor, which is null on the
+// last page. The node must run with the address transaction index enabled.
+func (s *TransactionAPI) GetTransactionsByAddress(ctx context.Context, address common.Address, cursor *hexutil.Bytes, limit *hexutil.Uint64) (*AddressTransactionsResult, error) {
+	count := defaultAddressTransactionsLimit
+	if limit != nil {
+		if *limit == 0 || *limit > maxAddressTransactionsLimit {
+			return nil, fmt.Errorf("limit must be between 1 and %d", maxAddressTransactionsLimit)
+		}
+		count = int(*limit)
+	}
+	var start []byte
+	if cursor != nil {
+		start = *cursor
+	}
+	entries, next, err := s.b.GetTransactionsByAddress(ctx, address, start, count)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &AddressTransactionsResult{Transactions: make([]*AddressTransaction, 0, len(entries))}
+	blocks := make(map[common.Hash]*types.Block)
+	for _, entry := range entries {
+		block, ok := blocks[entry.BlockHash]
+		if !ok {
+			if block, err = s.b.BlockByHash(ctx, entry.BlockHash); err != nil {
+				return nil, err
+			}
+			if block == nil {
+				return nil, fmt.Errorf("block %#x not found", entry.BlockHash)
+			}
+			blocks[entry.BlockHash] = block
+		}
+		txs := block.Transactions()
+		if int(entry.TxIndex) >= len(txs) {
+			return nil, fmt.Errorf("transaction %d of block %#x not found", entry.TxIndex, entry.BlockHash)
+		}
+		result.Transactions = append(result.Transactions, &AddressTransaction{
+			BlockHash:        entry.BlockHash,
+			BlockNumber:      hexutil.Uint64(entry.BlockNumber),
+			TransactionHash:  txs[entry.TxIndex].Hash(),
+			TransactionIndex: hexutil.Uint64(entry.TxIndex),
+			Roles:            addressTxRoles(entry.Roles),
+		})
+	}
+	if next != nil {
+		nextCursor := hexutil.Bytes(next)
+		result.NextCursor = &nextCursor
+	}
+	return result, nil
+}
+
+// addressTxRoles returns the names of the ways in which a transaction touches an address.
+func addressTxRoles(roles rawdb.AddressTxRole) []string {
+	names := make([]string, 0)
+	if roles&rawdb.AddressTxSender != 0 {
+		names = append(names, "sender")
+	}
+	if roles&rawdb.AddressTxRecipient != 0 {
+		names = append(names, "recipient")
+	}
+	if roles&rawdb.AddressTxContractCreation != 0 {
+		names = append(names, "contractCreation")
+	}
+	if roles&rawdb.AddressTxLogEmitter != 0 {
+		names = append(names, "logEmitter")
+	}
+	return names
+}
+
 // GetTransactionReceipt returns the transaction receipt for the given transaction hash.
 func (s *TransactionAPI) GetTransactionReceipt(ctx context.Context, hash common.Hash) (map[string]interface{}, error) {
 	protoTx, blockHash, blockNumber, index, err := s.b.GetTransactionV1(ctx, hash)
@@ -1314,8 +1619,18 @@ func (s *TransactionAPI) GetTransactionReceipt(ctx context.Context, hash common.
 //}
 
 // SendRawTransaction will add the signed transaction to the transaction pool.
+// The transaction is either the canonical encoding of a Dilithium transaction,
+// as returned by types.Transaction.MarshalBinary, or an RPCIncomingTransaction.
 // The sender is responsible for signing the transaction and using the correct nonce.
-func (s *TransactionAPI) SendRawTransaction(ctx context.Context, rpcTx RPCIncomingTransaction) (common.Hash, error) {
+func (s *TransactionAPI) SendRawTransaction(ctx context.Context, input json.RawMessage) (common.Hash, error) {
+	var encoded hexutil.Bytes
+	if err := json.Unmarshal(input, &encoded); err == nil {
+		return s.sendDilithiumTransaction(ctx, encoded)
+	}
+	var rpcTx RPCIncomingTransaction
+	if err := json.Unmarshal(input, &rpcTx); err != nil {
+		return common.Hash{}, err
+	}
 	tx, err := rpcTx.ToTransaction()
 
 	if err != nil {
@@ -1328,6 +1643,50 @@ func (s *TransactionAPI) SendRawTransaction(ctx context.Context, rpcTx RPCIncomi
 	//return SubmitTransaction(ctx, s.b, tx)
 }
 
+// sendDilithiumTransaction decodes a Dilithium transaction, verifies its
+// signature and adds it to the transaction pool as a transfer transaction.
+func (s *TransactionAPI) sendDilithiumTransaction(ctx context.Context, input hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return common.Hash{}, err
+	}
+	if tx.Type() != types.DilithiumTxType {
+		return common.Hash{}, types.ErrTxTypeNotSupported
+	}
+	if tx.GasFeeCapIntCmp(tx.GasTipCap()) < 0 {
+		return common.Hash{}, core.ErrTipAboveFeeCap
+	}
+	signer := types.LatestSignerForChainID(s.b.ChainConfig().ChainID)
+	from, err := types.Sender(signer, tx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	protoTx, err := tx.ToProto()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if err := s.b.SendTxV1(ctx, transactions.TransferTransactionFromPBData(protoTx)); err != nil {
+		return tx.Hash(), err
+	}
+	if tx.To() == nil {
+		log.WithFields(log.Fields{
+			"hash":  tx.Hash().Hex(),
+			"from":  from,
+			"nonce": tx.Nonce(),
+			"value": tx.Value(),
+		}).Info("Submitted contract creation")
+	} else {
+		log.WithFields(log.Fields{
+			"hash":      tx.Hash().Hex(),
+			"from":      from,
+			"nonce":     tx.Nonce(),
+			"recipient": tx.To(),
+			"value":     tx.Value(),
+		}).Info("Submitted transaction")
+	}
+	return tx.Hash(), nil
+}
+
 // Sign calculates an ECDSA signature for:
 // keccak256("\x19Ethereum Signed Message:\n" + len(message) + message).
 //
@@ -1391,29 +1750,32 @@ type SignTransactionResult struct {
 //	return &SignTransactionResult{data, signed}, nil
 //}
 
-// PendingTransactions returns the transactions that are in the transaction pool
-// and have a from address that is one of the accounts this node manages.
-//func (s *TransactionAPI) PendingTransactions() ([]*RPCTransaction, error) {
-//	pending, err := s.b.GetPoolTransactions()
-//	if err != nil {
-//		return nil, err
-//	}
-//	accounts := make(map[common.Address]struct{})
-//	for _, wallet := range s.b.AccountManager().Wallets() {
-//		for _, account := range wallet.Accounts() {
-//			accounts[account.Address] = struct{}{}
-//		}
-//	}
-//	curHeader := s.b.CurrentHeader()
-//	transactions := make([]*RPCTransaction, 0, len(pending))
-//	for _, tx := range pending {
-//		from, _ := types.Sender(s.signer, tx)
-//		if _, exists := accounts[from]; exists {
-//			transactions = append(transactions, newRPCPendingTransaction(tx, curHeader, s.b.ChainConfig()))
-//		}
-//	}
-//	return transactions, nil
-//}
+// PendingTransactions returns the transactions that are in the pending set of
+// the transaction pool, ordered by sender and nonce.
+func (s *TransactionAPI) PendingTransactions(ctx context.Context) ([]*RPCTransaction, error) {
+	pending, _, err := s.b.TxPoolContentV1()
+	if err != nil {
+		return nil, err
+	}
+	addrs := make([]common.Address, 0, len(pending))
+	for addr := range pending {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i][:], addrs[j][:]) < 0
+	})
+	curHeader, err := s.b.HeaderByNumberV1(ctx, rpc.LatestBlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	transactions := make([]*RPCTransaction, 0, len(pending))
+	for _, addr := range addrs {
+		for _, tx := range pending[addr] {
+			transactions = append(transactions, NewRPCPendingTransaction(tx, curHeader, s.b.ChainConfig()))
+		}
+	}
+	return transactions, nil
+}
 
 // Resend accepts an existing transaction and a new gas price and limit. It will remove
 // the given transaction from the pool and reinsert it with the new gas price and limit.
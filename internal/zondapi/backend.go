@@ -19,13 +19,16 @@ package zondapi
 
 import (
 	"context"
+	"math/big"
 	"time"
 
 	"github.com/theQRL/zond/common"
 	"github.com/theQRL/zond/core"
+	"github.com/theQRL/zond/core/rawdb"
 	"github.com/theQRL/zond/core/state"
 	"github.com/theQRL/zond/core/types"
 	"github.com/theQRL/zond/core/vm"
+	"github.com/theQRL/zond/event"
 	"github.com/theQRL/zond/metadata"
 	"github.com/theQRL/zond/params"
 	"github.com/theQRL/zond/protos"
@@ -39,8 +42,8 @@ type Backend interface {
 	// General Ethereum API
 	//SyncProgress() ethereum.SyncProgress
 
-	//SuggestGasTipCap(ctx context.Context) (*big.Int, error)
-	//FeeHistory(ctx context.Context, blockCount int, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*big.Int, [][]*big.Int, []*big.Int, []float64, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	FeeHistory(ctx context.Context, blockCount int, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (*big.Int, [][]*big.Int, []*big.Int, []float64, error)
 	//ChainDb() ethdb.Database
 	//AccountManager() *accounts.Manager
 	//ExtRPCEnabled() bool
@@ -82,14 +85,18 @@ type Backend interface {
 	SendTxV1(ctx context.Context, signedTx transactions.TransactionInterface) error
 	GetTransactionV1(ctx context.Context, txHash common.Hash) (*protos.Transaction, common.Hash, uint64, uint64, error)
 	GetTransaction(ctx context.Context, txHash common.Hash) (*types.Transaction, common.Hash, uint64, uint64, error)
+	GetTransactionsByAddress(ctx context.Context, address common.Address, cursor []byte, limit int) ([]*rawdb.AddressTxIndexEntry, []byte, error)
 	SendTx(ctx context.Context, signedTx *types.Transaction) error
 	GetPoolTransactions() (types.Transactions, error)
 	GetPoolTransaction(txHash common.Hash) *types.Transaction
 	GetPoolNonceV1(ctx context.Context, addr common.Address) (uint64, error)
 	GetPoolNonce(ctx context.Context, addr common.Address) (uint64, error)
 	//Stats() (pending int, queued int)
+	TxPoolContentV1() (map[common.Address][]*protos.Transaction, map[common.Address][]*protos.Transaction, error)
 	//TxPoolContent() (map[common.Address]types.Transactions, map[common.Address]types.Transactions)
+	TxPoolContentFromV1(addr common.Address) ([]*protos.Transaction, []*protos.Transaction, error)
 	//TxPoolContentFrom(addr common.Address) (types.Transactions, types.Transactions)
+	SubscribeNewTxsEventV1(chan<- core.NewTxsEventV1) event.Subscription
 	//SubscribeNewTxsEvent(chan<- core.NewTxsEvent) event.Subscription
 
 	// Filter API
@@ -108,6 +115,11 @@ type Backend interface {
 func GetAPIs(apiBackend Backend) []rpc.API {
 	//nonceLock := new(AddrLocker)
 	return []rpc.API{
+		{
+			Namespace: "zond",
+			Version:   "0.1",
+			Service:   NewZondAPI(apiBackend),
+		},
 		{
 			Namespace: "zond",
 			Version:   "0.1",
@@ -118,5 +130,10 @@ func GetAPIs(apiBackend Backend) []rpc.API {
 			Version:   "0.1",
 			Service:   NewTransactionAPI(apiBackend, nil),
 		},
+		{
+			Namespace: "txpool",
+			Version:   "0.1",
+			Service:   NewTxPoolAPI(apiBackend),
+		},
 	}
 }
@@ -216,7 +216,10 @@ func (args *TransactionArgs) ToMessage(globalGasCap uint64, baseFee *big.Int) (t
 	if args.Value != nil {
 		value = args.Value.ToInt()
 	}
-	data := *args.Data
+	var data []byte
+	if args.Data != nil {
+		data = *args.Data
+	}
 	var accessList types.AccessList
 	if args.AccessList != nil {
 		accessList = *args.AccessList
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/zond/crypto/hd"
+	"github.com/theQRL/zond/crypto/keystore"
 	"github.com/theQRL/zond/misc"
 	"github.com/theQRL/zond/protos"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -11,10 +13,19 @@ import (
 	"reflect"
 )
 
+// Scrypt parameters used to encrypt new staking keys files, which are lowered by tests.
+var (
+	scryptN = keystore.StandardScryptN
+	scryptP = keystore.StandardScryptP
+)
+
 type StakingKeys struct {
 	outFileName string
 
 	pbData *protos.StakingKeys
+
+	// key encrypts the staking keys file. It is nil for legacy plaintext files.
+	key *keystore.Key
 }
 
 func (dk *StakingKeys) GetDilithiumInfo() []*protos.DilithiumInfo {
@@ -45,6 +56,23 @@ func (dk *StakingKeys) Add(dilithiumAccount *dilithium.Dilithium) {
 	dk.Save()
 }
 
+// RecoverFromMnemonic adds the Dilithium staking keys derived from a BIP-39 mnemonic
+// for the given validator accounts, at the validating key path.
+func (dk *StakingKeys) RecoverFromMnemonic(mnemonic, passphrase string, accounts []uint32) error {
+	seed, err := hd.SeedFromMnemonic(mnemonic, passphrase)
+	if err != nil {
+		return err
+	}
+	for _, account := range accounts {
+		dilithiumSeed, err := hd.DilithiumSeedFromSeedAndPath(seed, fmt.Sprintf(hd.ValidatingKeyPathTemplate, account))
+		if err != nil {
+			return err
+		}
+		dk.Add(dilithium.NewDilithiumFromSeed(dilithiumSeed))
+	}
+	return nil
+}
+
 func (dk *StakingKeys) List() {
 	for i, dilithiumInfo := range dk.pbData.DilithiumInfo {
 		fmt.Println(fmt.Sprintf("Index #%d\tPK: %s\tSK: %s", i, dilithiumInfo.PK, dilithiumInfo.SK))
@@ -74,17 +102,33 @@ func (dk *StakingKeys) findDilithiumAccountIndex(dilithiumAccount *dilithium.Dil
 }
 
 func (dk *StakingKeys) Save() {
-	jsonData, err := protojson.Marshal(dk.pbData)
-	if err != nil {
+	if err := dk.save(); err != nil {
 		fmt.Println("Error: ", err)
-		return
 	}
-	err = ioutil.WriteFile(dk.outFileName, jsonData, 0644)
+}
+
+// save writes the staking keys file, encrypting each key if the file has a key.
+func (dk *StakingKeys) save() error {
+	if dk.key == nil {
+		jsonData, err := protojson.Marshal(dk.pbData)
+		if err != nil {
+			return err
+		}
+		return keystore.WriteFileAtomic(dk.outFileName, jsonData)
+	}
+	items := make([]*keystore.Item, 0, len(dk.pbData.DilithiumInfo))
+	for _, info := range dk.pbData.DilithiumInfo {
+		data, err := protojson.Marshal(info)
+		if err != nil {
+			return err
+		}
+		items = append(items, &keystore.Item{ID: info.PK, Data: data})
+	}
+	f, err := dk.key.Encrypt(items)
 	if err != nil {
-		fmt.Println("Error: ", err)
-		return
+		return err
 	}
-	return
+	return keystore.WriteFile(dk.outFileName, f)
 }
 
 func (dk *StakingKeys) GetDilithiumByIndex(index uint) (*protos.DilithiumInfo, error) {
@@ -105,6 +149,10 @@ func (dk *StakingKeys) Load() {
 		fmt.Println("Error reading file: ", err)
 		return
 	}
+	if keystore.IsEncrypted(data) {
+		fmt.Println("Error: staking keys file is encrypted, a password is required to open it")
+		return
+	}
 	err = protojson.Unmarshal(data, dk.pbData)
 	if err != nil {
 		fmt.Println("Error while decoding file: ", err)
@@ -112,6 +160,59 @@ func (dk *StakingKeys) Load() {
 	}
 }
 
+// loadEncrypted decrypts the encrypted staking keys file with the given password. If
+// the file does not exist, a new key is derived from the password to create it.
+func (dk *StakingKeys) loadEncrypted(password string) error {
+	dk.pbData = &protos.StakingKeys{}
+
+	if !misc.FileExists(dk.outFileName) {
+		key, err := keystore.NewKey(password, scryptN, scryptP)
+		if err != nil {
+			return err
+		}
+		dk.key = key
+		return nil
+	}
+	f, err := keystore.ReadFile(dk.outFileName)
+	if err != nil {
+		return err
+	}
+	key, items, err := keystore.Decrypt(f, password)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		info := &protos.DilithiumInfo{}
+		if err := protojson.Unmarshal(item.Data, info); err != nil {
+			return fmt.Errorf("error while decoding dilithium key: %w", err)
+		}
+		if info.PK != item.ID {
+			return errors.New("dilithium key does not match its public key")
+		}
+		dk.pbData.DilithiumInfo = append(dk.pbData.DilithiumInfo, info)
+	}
+	dk.key = key
+	return nil
+}
+
+// IsEncrypted reports whether the staking keys file is encrypted.
+func (dk *StakingKeys) IsEncrypted() bool {
+	return dk.key != nil
+}
+
+// Encrypt encrypts the staking keys file with the given password, converting a legacy
+// plaintext file into the encrypted format.
+func (dk *StakingKeys) Encrypt(password string) error {
+	key, err := keystore.NewKey(password, scryptN, scryptP)
+	if err != nil {
+		return err
+	}
+	dk.key = key
+	return dk.save()
+}
+
+// NewDilithiumKeys opens a legacy plaintext staking keys file. Use OpenDilithiumKeys
+// to open an encrypted staking keys file.
 func NewDilithiumKeys(outFileName string) *StakingKeys {
 	w := &StakingKeys{
 		outFileName: outFileName,
@@ -120,3 +221,15 @@ func NewDilithiumKeys(outFileName string) *StakingKeys {
 
 	return w
 }
+
+// OpenDilithiumKeys opens the encrypted staking keys file with the given password. If
+// the file does not exist, it is created encrypted with the password when a key is added.
+func OpenDilithiumKeys(outFileName, password string) (*StakingKeys, error) {
+	dk := &StakingKeys{
+		outFileName: outFileName,
+	}
+	if err := dk.loadEncrypted(password); err != nil {
+		return nil, err
+	}
+	return dk, nil
+}
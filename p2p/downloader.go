@@ -89,9 +89,11 @@ func NewRequestTracker() *RequestTracker {
 type Downloader struct {
 	lock sync.Mutex
 
-	isSyncing bool
-	chain     *chain.Chain
-	ntp       ntp.NTPInterface
+	isSyncing    bool
+	startingSlot uint64 // Slot of the local head when the current sync began
+	highestSlot  uint64 // Highest slot advertised by the peers being synced from
+	chain        *chain.Chain
+	ntp          ntp.NTPInterface
 
 	blockAndPeerChannel chan *BlockAndPeer
 
@@ -446,6 +448,14 @@ func NewDownloader(c *chain.Chain) (d *Downloader) {
 	return
 }
 
+// SyncProgress reports whether a sync is in progress, along with the slot the
+// sync started from and the highest slot advertised by the peers synced from.
+func (d *Downloader) SyncProgress() (syncing bool, startingSlot, highestSlot uint64) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.isSyncing, d.startingSlot, d.highestSlot
+}
+
 func (d *Downloader) Exit() {
 	log.Debug("Shutting Down Downloader")
 	// TODO: Check if done is already closed
@@ -560,7 +570,16 @@ func (d *Downloader) Initialize(peerGroup []*PeerV1,
 	for _, peer := range peerGroup {
 		peer.SendEBHReq(startingNonFinalizedEpoch, finalizedHeaderHash[:])
 	}
+	d.lock.Lock()
 	d.isSyncing = true
+	d.startingSlot = d.chain.Height()
+	d.highestSlot = 0
+	for _, peer := range peerGroup {
+		if slot := peer.ChainState().GetSlotNumber(); slot > d.highestSlot {
+			d.highestSlot = slot
+		}
+	}
+	d.lock.Unlock()
 	d.done = make(chan struct{})
 
 	// Delay 10 seconds to receive hashes from peers
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"sort"
 	"sync"
 	"time"
 
@@ -59,6 +60,7 @@ type ServerV1 struct {
 	inboundCount     uint16
 	totalConnections uint16
 	peers            *peers.Status
+	connected        map[string]*PeerV1 // Connected peers by stream ID, written by run under peerInfoLock
 	privateKey       *ecdsa.PrivateKey
 	localnode        *znode.LocalNode
 
@@ -346,9 +348,7 @@ func (srv *ServerV1) startListening(keys crypto.PrivKey) error {
 }
 
 func (srv *ServerV1) run() {
-	var (
-		peers = make(map[string]*PeerV1)
-	)
+	peers := srv.connected
 
 	srv.loopWG.Add(1)
 	defer srv.loopWG.Done()
@@ -674,6 +674,7 @@ func NewServer(chain *chain.Chain) (*ServerV1, error) {
 		ntp:        ntp.GetNTP(),
 		peerData:   peerData,
 		ipCount:    make(map[string]int),
+		connected:  make(map[string]*PeerV1),
 		mr:         CreateMRV1(),
 		downloader: NewDownloader(chain),
 
@@ -715,46 +716,90 @@ func NewServer(chain *chain.Chain) (*ServerV1, error) {
 
 	return srv, nil
 }
+
+// NodeInfo gathers and returns a collection of metadata known about the host.
 func (srv *ServerV1) NodeInfo() *NodeInfoV1 {
-	// Gather and assemble the generic node infos
-	node := srv.Self()
+	srv.lock.Lock()
+	defer srv.lock.Unlock()
+
 	info := &NodeInfoV1{
-		// Name:       srv.Name,
-		Znode: node.URLv4(),
-		ID:    node.ID().String(),
-		IP:    node.IP().String(),
-		// ListenAddr: srv.ListenAddr,
 		Protocols: make(map[string]interface{}),
 	}
-	info.Ports.Discovery = node.UDP()
-	info.Ports.Listener = node.TCP()
-	info.ZNR = node.String()
-
-	// Gather all the running protocol infos (only once per protocol type)
-	// for _, proto := range srv.Protocols {
-	// 	if _, ok := info.Protocols[proto.Name]; !ok {
-	// 		nodeInfo := interface{}("unknown")
-	// 		if query := proto.NodeInfo; query != nil {
-	// 			nodeInfo = proto.NodeInfo()
-	// 		}
-	// 		info.Protocols[proto.Name] = nodeInfo
-	// 	}
-	// }
+	if srv.host == nil {
+		return info
+	}
+	info.ID = srv.host.ID().Pretty()
+	for _, addr := range srv.host.Addrs() {
+		info.ListenAddrs = append(info.ListenAddrs, fmt.Sprintf("%s/p2p/%s", addr, info.ID))
+	}
+	if len(info.ListenAddrs) > 0 {
+		info.Znode = info.ListenAddrs[0]
+		info.IP = misc.IPFromMultiAddr(info.Znode)
+	}
+	info.ListenAddr = fmt.Sprintf("%s:%d", srv.config.User.Node.BindingIP, srv.config.User.Node.LocalPort)
+	info.Protocols[string(config.GetDevConfig().ProtocolID)] = srv.chain.Height()
 	return info
 }
 
 type NodeInfoV1 struct {
-	ID    string `json:"id"`    // Unique node identifier (also the encryption key)
-	Name  string `json:"name"`  // Name of the node, including client type, version, OS, custom data
-	Znode string `json:"znode"` // Znode URL for adding this peer from remote peers
-	ZNR   string `json:"znr"`   // Ethereum Node Record
-	IP    string `json:"ip"`    // IP address of the node
-	Ports struct {
-		Discovery int `json:"discovery"` // UDP listening port for discovery protocol
-		Listener  int `json:"listener"`  // TCP listening port for RLPx
-	} `json:"ports"`
-	ListenAddr string                 `json:"listenAddr"`
-	Protocols  map[string]interface{} `json:"protocols"`
+	ID          string                 `json:"id"`    // Libp2p peer identifier
+	Name        string                 `json:"name"`  // Name of the node, including client type, version, OS, custom data
+	Znode       string                 `json:"znode"` // Multiaddr for adding this peer from remote peers
+	IP          string                 `json:"ip"`    // IP address of the node
+	ListenAddr  string                 `json:"listenAddr"`
+	ListenAddrs []string               `json:"listenAddrs"` // All multiaddrs the host is reachable on
+	Protocols   map[string]interface{} `json:"protocols"`
+}
+
+// PeerInfoV1 represents a short summary of a connected peer.
+type PeerInfoV1 struct {
+	ID            string `json:"id"`            // Libp2p peer identifier
+	IP            string `json:"ip"`            // IP address of the peer
+	RemoteAddress string `json:"remoteAddress"` // Multiaddr of the connection
+	Inbound       bool   `json:"inbound"`
+	ConnectedAt   uint64 `json:"connectedAt"` // Unix time the connection was established
+	SlotNumber    uint64 `json:"slotNumber"`  // Slot of the last chain state reported by the peer
+}
+
+// PeersInfo returns an array of metadata objects describing connected peers.
+func (srv *ServerV1) PeersInfo() []*PeerInfoV1 {
+	srv.peerInfoLock.Lock()
+	defer srv.peerInfoLock.Unlock()
+
+	infos := make([]*PeerInfoV1, 0, len(srv.connected))
+	for _, p := range srv.connected {
+		info := &PeerInfoV1{
+			ID:            p.ID(),
+			IP:            p.IP(),
+			RemoteAddress: p.stream.Conn().RemoteMultiaddr().String(),
+			Inbound:       p.inbound,
+			ConnectedAt:   p.connectionTime,
+			SlotNumber:    p.ChainState().GetSlotNumber(),
+		}
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].ID < infos[j].ID })
+	return infos
+}
+
+// PeerCount returns the number of connected peers.
+func (srv *ServerV1) PeerCount() int {
+	srv.peerInfoLock.Lock()
+	defer srv.peerInfoLock.Unlock()
+	return len(srv.connected)
+}
+
+// SyncProgress reports whether the node is downloading blocks from its peers,
+// along with the slot the sync started from and the highest known slot.
+func (srv *ServerV1) SyncProgress() (syncing bool, startingSlot, highestSlot uint64) {
+	return srv.downloader.SyncProgress()
+}
+
+// Listening returns true if the server is accepting inbound connections.
+func (srv *ServerV1) Listening() bool {
+	srv.lock.Lock()
+	defer srv.lock.Unlock()
+	return srv.running
 }
 
 // Self returns the local node's endpoint information.
@@ -6,9 +6,13 @@ import (
 	"github.com/theQRL/zond/block"
 	"github.com/theQRL/zond/common"
 	"github.com/theQRL/zond/config"
+	"github.com/theQRL/zond/core"
+	"github.com/theQRL/zond/event"
 	"github.com/theQRL/zond/misc"
 	"github.com/theQRL/zond/ntp"
+	"github.com/theQRL/zond/protos"
 	"github.com/theQRL/zond/transactions"
+	"sort"
 	"sync"
 )
 
@@ -18,6 +22,9 @@ type TransactionPool struct {
 	config *config.Config
 	ntp    ntp.NTPInterface
 	nonce  map[common.Address]uint64
+
+	txFeed event.Feed
+	scope  event.SubscriptionScope
 }
 
 func (t *TransactionPool) isFull() bool {
@@ -35,7 +42,19 @@ func (t *TransactionPool) Contains(tx *TransactionInfo) bool {
 	return t.txPool.Contains(tx)
 }
 
+// Add inserts the transaction into the pool and announces it to the
+// subscribers of the new transactions feed.
 func (t *TransactionPool) Add(tx transactions.TransactionInterface, txHash common.Hash,
+	slotNumber uint64, timestamp uint64) error {
+	if err := t.add(tx, txHash, slotNumber, timestamp); err != nil {
+		return err
+	}
+	t.txFeed.Send(core.NewTxsEventV1{Txs: []*protos.Transaction{tx.PBData()}})
+
+	return nil
+}
+
+func (t *TransactionPool) add(tx transactions.TransactionInterface, txHash common.Hash,
 	slotNumber uint64, timestamp uint64) error {
 	t.lock.Lock()
 	defer t.lock.Unlock()
@@ -124,6 +143,64 @@ func (t *TransactionPool) AddNonceData(pk []byte, nonce uint64) {
 	}
 }
 
+// Get returns the transaction with the given hash, or nil if it is not in the pool.
+func (t *TransactionPool) Get(txHash common.Hash) transactions.TransactionInterface {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for _, ti := range *t.txPool {
+		if ti.TxHash() == txHash {
+			return ti.Transaction()
+		}
+	}
+	return nil
+}
+
+// Content returns the transactions contained within the pool, grouped by
+// sender and sorted by nonce.
+func (t *TransactionPool) Content() map[common.Address][]transactions.TransactionInterface {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	content := make(map[common.Address][]transactions.TransactionInterface)
+	for _, ti := range *t.txPool {
+		addr := ti.Transaction().AddrFrom()
+		content[addr] = append(content[addr], ti.Transaction())
+	}
+	for _, txs := range content {
+		sortByNonce(txs)
+	}
+	return content
+}
+
+// ContentFrom returns the transactions contained within the pool for the
+// given sender, sorted by nonce.
+func (t *TransactionPool) ContentFrom(addr common.Address) []transactions.TransactionInterface {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	var txs []transactions.TransactionInterface
+	for _, ti := range *t.txPool {
+		if ti.Transaction().AddrFrom() == addr {
+			txs = append(txs, ti.Transaction())
+		}
+	}
+	sortByNonce(txs)
+	return txs
+}
+
+// SubscribeNewTxsEvent registers a subscription of NewTxsEventV1 and
+// starts sending event to the given channel.
+func (t *TransactionPool) SubscribeNewTxsEvent(ch chan<- core.NewTxsEventV1) event.Subscription {
+	return t.scope.Track(t.txFeed.Subscribe(ch))
+}
+
+func sortByNonce(txs []transactions.TransactionInterface) {
+	sort.SliceStable(txs, func(i, j int) bool {
+		return txs[i].Nonce() < txs[j].Nonce()
+	})
+}
+
 func (t *TransactionPool) GetNonceByAddress(address common.Address) (uint64, bool) {
 	nonce, ok := t.nonce[address]
 	return nonce, ok
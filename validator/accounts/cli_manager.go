@@ -60,6 +60,7 @@ type AccountsCLIManager struct {
 	mnemonic             string
 	numAccounts          int
 	mnemonic25thWord     string
+	keyScheme            keymanager.KeyScheme
 	beaconApiEndpoint    string
 	beaconApiTimeout     time.Duration
 }
@@ -246,6 +246,14 @@ func WithMnemonic25thWord(mnemonic25thWord string) Option {
 	}
 }
 
+// WithKeyScheme specifies the signature scheme of the keys derived from a mnemonic.
+func WithKeyScheme(keyScheme keymanager.KeyScheme) Option {
+	return func(acc *AccountsCLIManager) error {
+		acc.keyScheme = keyScheme
+		return nil
+	}
+}
+
 // WithNumAccounts specifies the number of accounts.
 func WithNumAccounts(numAccounts int) Option {
 	return func(acc *AccountsCLIManager) error {
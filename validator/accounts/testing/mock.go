@@ -209,3 +209,8 @@ func (m *MockValidator) ProposerSettings() *validatorserviceconfig.ProposerSetti
 func (m *MockValidator) SetProposerSettings(settings *validatorserviceconfig.ProposerSettings) {
 	m.proposerSettings = settings
 }
+
+// DefaultGraffiti for mocking
+func (_ MockValidator) DefaultGraffiti(_ context.Context, _ [48]byte) ([]byte, error) {
+	panic("implement me")
+}
@@ -58,6 +58,7 @@ func (acm *AccountsCLIManager) WalletCreate(ctx context.Context) (*wallet.Wallet
 			acm.mnemonicLanguage,
 			acm.skipMnemonicConfirm,
 			acm.numAccounts,
+			acm.keyScheme,
 		); err != nil {
 			return nil, errors.Wrap(err, "could not initialize wallet")
 		}
@@ -96,6 +97,7 @@ func createDerivedKeymanagerWallet(
 	mnemonicLanguage string,
 	skipMnemonicConfirm bool,
 	numAccounts int,
+	keyScheme keymanager.KeyScheme,
 ) error {
 	if wallet == nil {
 		return errors.New("nil wallet")
@@ -106,6 +108,7 @@ func createDerivedKeymanagerWallet(
 	km, err := derived.NewKeymanager(ctx, &derived.SetupConfig{
 		Wallet:           wallet,
 		ListenForChanges: true,
+		KeyScheme:        keyScheme,
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize HD keymanager")
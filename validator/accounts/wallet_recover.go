@@ -41,6 +41,7 @@ func (acm *AccountsCLIManager) WalletRecover(ctx context.Context) (*wallet.Walle
 	km, err := derived.NewKeymanager(ctx, &derived.SetupConfig{
 		Wallet:           w,
 		ListenForChanges: false,
+		KeyScheme:        acm.keyScheme,
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "could not make keymanager for given phrase")
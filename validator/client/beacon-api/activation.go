@@ -0,0 +1,61 @@
+//go:build use_beacon_api
+// +build use_beacon_api
+
+package beacon_api
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/zond/config/params"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+	"google.golang.org/grpc"
+)
+
+// waitForActivationStream mimics the gRPC WaitForActivation stream by polling the validator statuses
+// at most once per slot.
+type waitForActivationStream struct {
+	grpc.ClientStream
+	ctx             context.Context
+	validatorClient *beaconApiValidatorClient
+	request         *ethpb.ValidatorActivationRequest
+	lastRecvTime    time.Time
+}
+
+func (c *beaconApiValidatorClient) waitForActivation(ctx context.Context, in *ethpb.ValidatorActivationRequest) (ethpb.BeaconNodeValidator_WaitForActivationClient, error) {
+	return &waitForActivationStream{
+		ctx:             ctx,
+		validatorClient: c,
+		request:         in,
+	}, nil
+}
+
+func (s *waitForActivationStream) Recv() (*ethpb.ValidatorActivationResponse, error) {
+	// Like the gRPC server, only send an update once per slot
+	if !s.lastRecvTime.IsZero() {
+		nextRecvTime := s.lastRecvTime.Add(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+		select {
+		case <-s.ctx.Done():
+			return nil, errors.Wrap(s.ctx.Err(), "context canceled while waiting for activation")
+		case <-time.After(time.Until(nextRecvTime)):
+		}
+	}
+	s.lastRecvTime = time.Now()
+
+	pubkeys, indices, statuses, err := s.validatorClient.getValidatorsStatusResponse(s.ctx, s.request.PublicKeys, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get validators status response")
+	}
+
+	activationStatuses := make([]*ethpb.ValidatorActivationResponse_Status, len(statuses))
+	for i := range statuses {
+		activationStatuses[i] = &ethpb.ValidatorActivationResponse_Status{
+			PublicKey: pubkeys[i],
+			Index:     indices[i],
+			Status:    statuses[i],
+		}
+	}
+
+	return &ethpb.ValidatorActivationResponse{Statuses: activationStatuses}, nil
+}
@@ -0,0 +1,194 @@
+//go:build use_beacon_api
+// +build use_beacon_api
+
+package beacon_api
+
+import (
+	"context"
+	neturl "net/url"
+
+	"github.com/pkg/errors"
+	rpcmiddleware "github.com/theQRL/zond/beacon-chain/rpc/apimiddleware"
+	"github.com/theQRL/zond/common/hexutil"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+	"github.com/theQRL/zond/time/slots"
+)
+
+func (c *beaconApiValidatorClient) getAttestationData(ctx context.Context, slot types.Slot, committeeIndex types.CommitteeIndex) (*ethpb.AttestationData, error) {
+	params := neturl.Values{}
+	params.Add("slot", uint64ToString(slot))
+	params.Add("committee_index", uint64ToString(committeeIndex))
+
+	attestationDataJson := &rpcmiddleware.ProduceAttestationDataResponseJson{}
+	if _, err := c.jsonRestHandler.GetRestJsonResponse(ctx, buildURL("/zond/v1/validator/attestation_data", params), attestationDataJson); err != nil {
+		return nil, errors.Wrap(err, "failed to get json response")
+	}
+
+	d := &jsonDecoder{}
+	attestationData := d.attestationData(attestationDataJson.Data)
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	return attestationData, nil
+}
+
+func (c *beaconApiValidatorClient) proposeAttestation(ctx context.Context, attestation *ethpb.Attestation) (*ethpb.AttestResponse, error) {
+	if attestation == nil || attestation.Data == nil {
+		return nil, errors.New("attestation is nil")
+	}
+
+	body, err := marshalJsonBody([]*rpcmiddleware.AttestationJson{jsonifyAttestation(attestation)})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.jsonRestHandler.PostRestJson(ctx, "/zond/v1/beacon/pool/attestations", nil, body, nil); err != nil {
+		return nil, errors.Wrap(err, "failed to send POST data to REST endpoint")
+	}
+
+	attestationDataRoot, err := attestation.Data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute attestation data root")
+	}
+
+	return &ethpb.AttestResponse{AttestationDataRoot: attestationDataRoot[:]}, nil
+}
+
+// submitAggregateSelectionProof builds the aggregate that the validator will sign. The beacon node REST API
+// identifies the aggregate by the root of its attestation data, so we first need to fetch the attestation data
+// of the committee.
+func (c *beaconApiValidatorClient) submitAggregateSelectionProof(ctx context.Context, in *ethpb.AggregateSelectionRequest) (*ethpb.AggregateSelectionResponse, error) {
+	validatorIndexResponse, err := c.validatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: in.PublicKey})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get validator index")
+	}
+
+	attestationData, err := c.getAttestationData(ctx, in.Slot, in.CommitteeIndex)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get attestation data for slot %d and committee index %d", in.Slot, in.CommitteeIndex)
+	}
+
+	attestationDataRoot, err := attestationData.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute attestation data root")
+	}
+
+	params := neturl.Values{}
+	params.Add("attestation_data_root", hexutil.Encode(attestationDataRoot[:]))
+	params.Add("slot", uint64ToString(in.Slot))
+
+	aggregateAttestationJson := &rpcmiddleware.AggregateAttestationResponseJson{}
+	if _, err := c.jsonRestHandler.GetRestJsonResponse(ctx, buildURL("/zond/v1/validator/aggregate_attestation", params), aggregateAttestationJson); err != nil {
+		return nil, errors.Wrap(err, "failed to get aggregate attestation")
+	}
+
+	d := &jsonDecoder{}
+	aggregate := d.attestation(aggregateAttestationJson.Data)
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	return &ethpb.AggregateSelectionResponse{
+		AggregateAndProof: &ethpb.AggregateAttestationAndProof{
+			AggregatorIndex: validatorIndexResponse.Index,
+			Aggregate:       aggregate,
+			SelectionProof:  in.SlotSignature,
+		},
+	}, nil
+}
+
+func (c *beaconApiValidatorClient) submitSignedAggregateSelectionProof(ctx context.Context, in *ethpb.SignedAggregateSubmitRequest) (*ethpb.SignedAggregateSubmitResponse, error) {
+	signedAggregateAndProof := in.SignedAggregateAndProof
+	if signedAggregateAndProof == nil || signedAggregateAndProof.Message == nil ||
+		signedAggregateAndProof.Message.Aggregate == nil || signedAggregateAndProof.Message.Aggregate.Data == nil {
+		return nil, errors.New("signed aggregate and proof is nil")
+	}
+
+	body, err := marshalJsonBody([]*rpcmiddleware.SignedAggregateAttestationAndProofJson{
+		{
+			Message: &rpcmiddleware.AggregateAttestationAndProofJson{
+				AggregatorIndex: uint64ToString(signedAggregateAndProof.Message.AggregatorIndex),
+				Aggregate:       jsonifyAttestation(signedAggregateAndProof.Message.Aggregate),
+				SelectionProof:  hexutil.Encode(signedAggregateAndProof.Message.SelectionProof),
+			},
+			Signature: hexutil.Encode(signedAggregateAndProof.Signature),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.jsonRestHandler.PostRestJson(ctx, "/zond/v1/validator/aggregate_and_proofs", nil, body, nil); err != nil {
+		return nil, errors.Wrap(err, "failed to send POST data to REST endpoint")
+	}
+
+	attestationDataRoot, err := signedAggregateAndProof.Message.Aggregate.Data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute attestation data root")
+	}
+
+	return &ethpb.SignedAggregateSubmitResponse{AttestationDataRoot: attestationDataRoot[:]}, nil
+}
+
+// subscribeCommitteeSubnets asks the beacon node to subscribe to the attestation subnets of the given committees.
+// The REST API additionally needs the number of committees at each slot, which is part of the attester duties.
+func (c *beaconApiValidatorClient) subscribeCommitteeSubnets(ctx context.Context, in *ethpb.CommitteeSubnetsSubscribeRequest, validatorIndices []types.ValidatorIndex) error {
+	if in == nil {
+		return errors.New("committee subnets subscribe request is nil")
+	}
+	if len(in.CommitteeIds) != len(in.Slots) || len(in.IsAggregator) != len(in.Slots) || len(validatorIndices) != len(in.Slots) {
+		return errors.New("arrays `in.CommitteeIds`, `in.Slots`, `in.IsAggregator` and `validatorIndices` don't have the same length")
+	}
+
+	// Fetch the attester duties of every epoch spanned by the subscriptions, once per epoch
+	committeesAtSlot := make(map[types.Slot]string)
+	fetchedEpochs := make(map[types.Epoch]bool)
+	for _, slot := range in.Slots {
+		epoch := slots.ToEpoch(slot)
+		if fetchedEpochs[epoch] {
+			continue
+		}
+		fetchedEpochs[epoch] = true
+
+		attesterDuties, err := c.getAttesterDuties(ctx, epoch, validatorIndices)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get attester duties for epoch %d", epoch)
+		}
+		d := &jsonDecoder{}
+		for _, attesterDuty := range attesterDuties {
+			dutySlot := types.Slot(d.uint64("attester slot", attesterDuty.Slot))
+			committeesAtSlot[dutySlot] = attesterDuty.CommitteesAtSlot
+		}
+		if d.err != nil {
+			return d.err
+		}
+	}
+
+	subscriptions := make([]*rpcmiddleware.BeaconCommitteeSubscribeJson, len(in.Slots))
+	for i, slot := range in.Slots {
+		committees, ok := committeesAtSlot[slot]
+		if !ok {
+			return errors.Errorf("failed to get committees for slot %d", slot)
+		}
+		subscriptions[i] = &rpcmiddleware.BeaconCommitteeSubscribeJson{
+			ValidatorIndex:   uint64ToString(validatorIndices[i]),
+			CommitteeIndex:   uint64ToString(in.CommitteeIds[i]),
+			CommitteesAtSlot: committees,
+			Slot:             uint64ToString(slot),
+			IsAggregator:     in.IsAggregator[i],
+		}
+	}
+
+	body, err := marshalJsonBody(subscriptions)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.jsonRestHandler.PostRestJson(ctx, "/zond/v1/validator/beacon_committee_subscriptions", nil, body, nil); err != nil {
+		return errors.Wrap(err, "failed to send POST data to REST endpoint")
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+//go:build use_beacon_api
+// +build use_beacon_api
+
+package beacon_api
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	neturl "net/url"
+	"regexp"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/zond/common/hexutil"
+	"github.com/theQRL/zond/encoding/bytesutil"
+)
+
+func validRoot(root string) bool {
+	matchesRegex, err := regexp.MatchString("^0x[a-fA-F0-9]{64}$", root)
+	if err != nil {
+		return false
+	}
+	return matchesRegex
+}
+
+func uint64ToString[T ~uint64](val T) string {
+	return strconv.FormatUint(uint64(val), 10)
+}
+
+func buildURL(path string, queryParams ...neturl.Values) string {
+	if len(queryParams) == 0 {
+		return path
+	}
+
+	return path + "?" + queryParams[0].Encode()
+}
+
+// marshalJsonBody serializes obj into a buffer that can be used as the body of a POST request.
+func marshalJsonBody(obj interface{}) (*bytes.Buffer, error) {
+	marshalled, err := json.Marshal(obj)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal json")
+	}
+	return bytes.NewBuffer(marshalled), nil
+}
+
+// jsonDecoder accumulates the first error encountered while decoding the string encoded numbers and hex
+// values of a JSON object, so that long conversions don't need to check every field individually.
+type jsonDecoder struct {
+	err error
+}
+
+func (d *jsonDecoder) uint64(name, value string) uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		d.err = errors.Wrapf(err, "failed to parse %s `%s`", name, value)
+		return 0
+	}
+	return v
+}
+
+func (d *jsonDecoder) hex(name, value string) []byte {
+	if d.err != nil {
+		return nil
+	}
+	v, err := hexutil.Decode(value)
+	if err != nil {
+		d.err = errors.Wrapf(err, "failed to decode %s `%s`", name, value)
+		return nil
+	}
+	return v
+}
+
+func (d *jsonDecoder) hexArray(name string, values []string) [][]byte {
+	if d.err != nil {
+		return nil
+	}
+	decoded := make([][]byte, len(values))
+	for i, value := range values {
+		decoded[i] = d.hex(name, value)
+	}
+	return decoded
+}
+
+func (d *jsonDecoder) uint64Array(name string, values []string) []uint64 {
+	if d.err != nil {
+		return nil
+	}
+	decoded := make([]uint64, len(values))
+	for i, value := range values {
+		decoded[i] = d.uint64(name, value)
+	}
+	return decoded
+}
+
+// uint256 decodes a base 10 encoded uint256 into its little-endian, 32 bytes SSZ representation.
+func (d *jsonDecoder) uint256(name, value string) []byte {
+	if d.err != nil {
+		return nil
+	}
+	v, ok := new(big.Int).SetString(value, 10)
+	if !ok || v.Sign() < 0 || v.BitLen() > 256 {
+		d.err = errors.Errorf("failed to parse %s `%s` as uint256", name, value)
+		return nil
+	}
+	return bytesutil.PadTo(bytesutil.ReverseByteOrder(v.Bytes()), 32)
+}
+
+// uint256ToString encodes the little-endian, 32 bytes SSZ representation of an uint256 in base 10.
+func uint256ToString(value []byte) string {
+	return new(big.Int).SetBytes(bytesutil.ReverseByteOrder(value)).String()
+}
@@ -9,145 +9,138 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	"github.com/theQRL/zond/beacon-chain/core/signing"
+	"github.com/theQRL/zond/common/hexutil"
+	types "github.com/theQRL/zond/consensus-types/primitives"
 	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
 	iface "github.com/theQRL/zond/validator/client/iface"
 )
 
 type beaconApiValidatorClient struct {
-	url        string
-	httpClient http.Client
+	jsonRestHandler jsonRestHandler
 }
 
-func (*beaconApiValidatorClient) GetDuties(_ context.Context, _ *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.GetDuties is not implemented")
+func NewBeaconApiValidatorClient(host string, timeout time.Duration) iface.ValidatorClient {
+	jsonRestHandler := beaconApiJsonRestHandler{
+		httpClient: http.Client{Timeout: timeout},
+		host:       host,
+	}
+
+	return &beaconApiValidatorClient{
+		jsonRestHandler: jsonRestHandler,
+	}
 }
 
-func (*beaconApiValidatorClient) CheckDoppelGanger(_ context.Context, _ *ethpb.DoppelGangerRequest) (*ethpb.DoppelGangerResponse, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.CheckDoppelGanger is not implemented")
+func (c *beaconApiValidatorClient) GetDuties(ctx context.Context, in *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
+	return c.getDuties(ctx, in)
 }
 
-func (*beaconApiValidatorClient) DomainData(_ context.Context, _ *ethpb.DomainRequest) (*ethpb.DomainResponse, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.DomainData is not implemented")
+func (c *beaconApiValidatorClient) CheckDoppelGanger(ctx context.Context, in *ethpb.DoppelGangerRequest) (*ethpb.DoppelGangerResponse, error) {
+	return c.checkDoppelGanger(ctx, in)
 }
 
-func (*beaconApiValidatorClient) GetAttestationData(_ context.Context, _ *ethpb.AttestationDataRequest) (*ethpb.AttestationData, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.GetAttestationData is not implemented")
+func (c *beaconApiValidatorClient) DomainData(ctx context.Context, in *ethpb.DomainRequest) (*ethpb.DomainResponse, error) {
+	if len(in.Domain) != signing.DomainByteLength {
+		return nil, errors.Errorf("invalid domain type: %s", hexutil.Encode(in.Domain))
+	}
+
+	var domainType [signing.DomainByteLength]byte
+	copy(domainType[:], in.Domain)
+	return c.getDomainData(ctx, in.Epoch, domainType)
 }
 
-func (*beaconApiValidatorClient) GetBeaconBlock(_ context.Context, _ *ethpb.BlockRequest) (*ethpb.GenericBeaconBlock, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.GetBeaconBlock is not implemented")
+func (c *beaconApiValidatorClient) GetAttestationData(ctx context.Context, in *ethpb.AttestationDataRequest) (*ethpb.AttestationData, error) {
+	return c.getAttestationData(ctx, in.Slot, in.CommitteeIndex)
 }
 
-func (*beaconApiValidatorClient) GetFeeRecipientByPubKey(_ context.Context, _ *ethpb.FeeRecipientByPubKeyRequest) (*ethpb.FeeRecipientByPubKeyResponse, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.GetFeeRecipientByPubKey is not implemented")
+func (c *beaconApiValidatorClient) GetBeaconBlock(ctx context.Context, in *ethpb.BlockRequest) (*ethpb.GenericBeaconBlock, error) {
+	return c.getBeaconBlock(ctx, in)
 }
 
-func (*beaconApiValidatorClient) GetSyncCommitteeContribution(_ context.Context, _ *ethpb.SyncCommitteeContributionRequest) (*ethpb.SyncCommitteeContribution, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.GetSyncCommitteeContribution is not implemented")
+// GetFeeRecipientByPubKey returns an empty fee recipient, as the beacon API doesn't expose the fee recipients
+// known to the beacon node. Callers then fall back to the fee recipient configured in the validator client.
+func (*beaconApiValidatorClient) GetFeeRecipientByPubKey(_ context.Context, _ *ethpb.FeeRecipientByPubKeyRequest) (*ethpb.FeeRecipientByPubKeyResponse, error) {
+	return &ethpb.FeeRecipientByPubKeyResponse{}, nil
 }
 
-func (*beaconApiValidatorClient) GetSyncMessageBlockRoot(_ context.Context, _ *empty.Empty) (*ethpb.SyncMessageBlockRootResponse, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.GetSyncMessageBlockRoot is not implemented")
+func (c *beaconApiValidatorClient) GetSyncCommitteeContribution(ctx context.Context, in *ethpb.SyncCommitteeContributionRequest) (*ethpb.SyncCommitteeContribution, error) {
+	return c.getSyncCommitteeContribution(ctx, in)
 }
 
-func (*beaconApiValidatorClient) GetSyncSubcommitteeIndex(_ context.Context, _ *ethpb.SyncSubcommitteeIndexRequest) (*ethpb.SyncSubcommitteeIndexResponse, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.GetSyncSubcommitteeIndex is not implemented")
+func (c *beaconApiValidatorClient) GetSyncMessageBlockRoot(ctx context.Context, _ *empty.Empty) (*ethpb.SyncMessageBlockRootResponse, error) {
+	return c.getSyncMessageBlockRoot(ctx)
 }
 
-func (*beaconApiValidatorClient) MultipleValidatorStatus(_ context.Context, _ *ethpb.MultipleValidatorStatusRequest) (*ethpb.MultipleValidatorStatusResponse, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.MultipleValidatorStatus is not implemented")
+func (c *beaconApiValidatorClient) GetSyncSubcommitteeIndex(ctx context.Context, in *ethpb.SyncSubcommitteeIndexRequest) (*ethpb.SyncSubcommitteeIndexResponse, error) {
+	return c.getSyncSubcommitteeIndex(ctx, in)
 }
 
-func (*beaconApiValidatorClient) PrepareBeaconProposer(_ context.Context, _ *ethpb.PrepareBeaconProposerRequest) (*empty.Empty, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.PrepareBeaconProposer is not implemented")
+func (c *beaconApiValidatorClient) MultipleValidatorStatus(ctx context.Context, in *ethpb.MultipleValidatorStatusRequest) (*ethpb.MultipleValidatorStatusResponse, error) {
+	return c.multipleValidatorStatus(ctx, in)
 }
 
-func (*beaconApiValidatorClient) ProposeAttestation(_ context.Context, _ *ethpb.Attestation) (*ethpb.AttestResponse, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.ProposeAttestation is not implemented")
+func (c *beaconApiValidatorClient) PrepareBeaconProposer(ctx context.Context, in *ethpb.PrepareBeaconProposerRequest) (*empty.Empty, error) {
+	return new(empty.Empty), c.prepareBeaconProposer(ctx, in.Recipients)
 }
 
-func (*beaconApiValidatorClient) ProposeBeaconBlock(_ context.Context, _ *ethpb.GenericSignedBeaconBlock) (*ethpb.ProposeResponse, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.ProposeBeaconBlock is not implemented")
+func (c *beaconApiValidatorClient) ProposeAttestation(ctx context.Context, in *ethpb.Attestation) (*ethpb.AttestResponse, error) {
+	return c.proposeAttestation(ctx, in)
 }
 
-func (*beaconApiValidatorClient) ProposeExit(_ context.Context, _ *ethpb.SignedVoluntaryExit) (*ethpb.ProposeExitResponse, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.ProposeExit is not implemented")
+func (c *beaconApiValidatorClient) ProposeBeaconBlock(ctx context.Context, in *ethpb.GenericSignedBeaconBlock) (*ethpb.ProposeResponse, error) {
+	return c.proposeBeaconBlock(ctx, in)
 }
 
-func (*beaconApiValidatorClient) StreamBlocksAltair(_ context.Context, _ *ethpb.StreamBlocksRequest) (ethpb.BeaconNodeValidator_StreamBlocksAltairClient, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.StreamBlocksAltair is not implemented")
+func (c *beaconApiValidatorClient) ProposeExit(ctx context.Context, in *ethpb.SignedVoluntaryExit) (*ethpb.ProposeExitResponse, error) {
+	return c.proposeExit(ctx, in)
 }
 
-func (*beaconApiValidatorClient) StreamDuties(_ context.Context, _ *ethpb.DutiesRequest) (ethpb.BeaconNodeValidator_StreamDutiesClient, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.StreamDuties is not implemented")
+func (c *beaconApiValidatorClient) StreamBlocksAltair(ctx context.Context, _ *ethpb.StreamBlocksRequest) (ethpb.BeaconNodeValidator_StreamBlocksAltairClient, error) {
+	return c.streamBlocks(ctx, blockPollingInterval()), nil
 }
 
-func (*beaconApiValidatorClient) SubmitAggregateSelectionProof(_ context.Context, _ *ethpb.AggregateSelectionRequest) (*ethpb.AggregateSelectionResponse, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.SubmitAggregateSelectionProof is not implemented")
+func (c *beaconApiValidatorClient) StreamDuties(ctx context.Context, in *ethpb.DutiesRequest) (ethpb.BeaconNodeValidator_StreamDutiesClient, error) {
+	return c.streamDuties(ctx, in)
 }
 
-func (*beaconApiValidatorClient) SubmitSignedAggregateSelectionProof(_ context.Context, _ *ethpb.SignedAggregateSubmitRequest) (*ethpb.SignedAggregateSubmitResponse, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.SubmitSignedAggregateSelectionProof is not implemented")
+func (c *beaconApiValidatorClient) SubmitAggregateSelectionProof(ctx context.Context, in *ethpb.AggregateSelectionRequest) (*ethpb.AggregateSelectionResponse, error) {
+	return c.submitAggregateSelectionProof(ctx, in)
 }
 
-func (*beaconApiValidatorClient) SubmitSignedContributionAndProof(_ context.Context, _ *ethpb.SignedContributionAndProof) (*empty.Empty, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.SubmitSignedContributionAndProof is not implemented")
+func (c *beaconApiValidatorClient) SubmitSignedAggregateSelectionProof(ctx context.Context, in *ethpb.SignedAggregateSubmitRequest) (*ethpb.SignedAggregateSubmitResponse, error) {
+	return c.submitSignedAggregateSelectionProof(ctx, in)
 }
 
-func (*beaconApiValidatorClient) SubmitSyncMessage(_ context.Context, _ *ethpb.SyncCommitteeMessage) (*empty.Empty, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.SubmitSyncMessage is not implemented")
+func (c *beaconApiValidatorClient) SubmitSignedContributionAndProof(ctx context.Context, in *ethpb.SignedContributionAndProof) (*empty.Empty, error) {
+	return new(empty.Empty), c.submitSignedContributionAndProof(ctx, in)
 }
 
-func (*beaconApiValidatorClient) SubmitValidatorRegistrations(_ context.Context, _ *ethpb.SignedValidatorRegistrationsV1) (*empty.Empty, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.SubmitValidatorRegistrations is not implemented")
+func (c *beaconApiValidatorClient) SubmitSyncMessage(ctx context.Context, in *ethpb.SyncCommitteeMessage) (*empty.Empty, error) {
+	return new(empty.Empty), c.submitSyncMessage(ctx, in)
 }
 
-func (*beaconApiValidatorClient) SubscribeCommitteeSubnets(_ context.Context, _ *ethpb.CommitteeSubnetsSubscribeRequest) (*empty.Empty, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.SubscribeCommitteeSubnets is not implemented")
+func (c *beaconApiValidatorClient) SubmitValidatorRegistrations(ctx context.Context, in *ethpb.SignedValidatorRegistrationsV1) (*empty.Empty, error) {
+	return new(empty.Empty), c.submitValidatorRegistrations(ctx, in.Messages)
 }
 
-func (*beaconApiValidatorClient) ValidatorIndex(_ context.Context, _ *ethpb.ValidatorIndexRequest) (*ethpb.ValidatorIndexResponse, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.ValidatorIndex is not implemented")
+func (c *beaconApiValidatorClient) SubscribeCommitteeSubnets(ctx context.Context, in *ethpb.CommitteeSubnetsSubscribeRequest, validatorIndices []types.ValidatorIndex) (*empty.Empty, error) {
+	return new(empty.Empty), c.subscribeCommitteeSubnets(ctx, in, validatorIndices)
 }
 
-func (*beaconApiValidatorClient) ValidatorStatus(_ context.Context, _ *ethpb.ValidatorStatusRequest) (*ethpb.ValidatorStatusResponse, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.ValidatorStatus is not implemented")
+func (c *beaconApiValidatorClient) ValidatorIndex(ctx context.Context, in *ethpb.ValidatorIndexRequest) (*ethpb.ValidatorIndexResponse, error) {
+	return c.validatorIndex(ctx, in)
 }
 
-func (*beaconApiValidatorClient) WaitForActivation(_ context.Context, _ *ethpb.ValidatorActivationRequest) (ethpb.BeaconNodeValidator_WaitForActivationClient, error) {
-	// TODO: Implement me
-	panic("beaconApiValidatorClient.WaitForActivation is not implemented")
+func (c *beaconApiValidatorClient) ValidatorStatus(ctx context.Context, in *ethpb.ValidatorStatusRequest) (*ethpb.ValidatorStatusResponse, error) {
+	return c.validatorStatus(ctx, in)
 }
 
-// Deprecated: Do not use.
-func (*beaconApiValidatorClient) WaitForChainStart(_ context.Context, _ *empty.Empty) (ethpb.BeaconNodeValidator_WaitForChainStartClient, error) {
-	panic("beaconApiValidatorClient.WaitForChainStart is not implemented")
+func (c *beaconApiValidatorClient) WaitForActivation(ctx context.Context, in *ethpb.ValidatorActivationRequest) (ethpb.BeaconNodeValidator_WaitForActivationClient, error) {
+	return c.waitForActivation(ctx, in)
 }
 
-func NewBeaconApiValidatorClient(url string, timeout time.Duration) iface.ValidatorClient {
-	return &beaconApiValidatorClient{url, http.Client{Timeout: timeout}}
+// Deprecated: Do not use.
+func (c *beaconApiValidatorClient) WaitForChainStart(ctx context.Context, _ *empty.Empty) (ethpb.BeaconNodeValidator_WaitForChainStartClient, error) {
+	return c.waitForChainStart(ctx)
 }
@@ -0,0 +1,509 @@
+//go:build use_beacon_api
+// +build use_beacon_api
+
+package beacon_api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/theQRL/zond/common/hexutil"
+	"github.com/theQRL/zond/config/params"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+)
+
+const testGenesisValidatorsRoot = "0xcf8e0d4e9587369b2301d0790347320302cc0943d5a1884560367e8208d920f2"
+
+// newTestClient starts a stand-in beacon node serving the given handlers and returns a client connected to it.
+func newTestClient(t *testing.T, handlers map[string]http.HandlerFunc) *beaconApiValidatorClient {
+	mux := http.NewServeMux()
+	for pattern, handler := range handlers {
+		mux.HandleFunc(pattern, handler)
+	}
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return NewBeaconApiValidatorClient(server.URL, time.Second).(*beaconApiValidatorClient)
+}
+
+func writeJson(t *testing.T, w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWaitForChainStart(t *testing.T) {
+	previousInterval := chainStartPollingInterval
+	chainStartPollingInterval = time.Millisecond
+	t.Cleanup(func() { chainStartPollingInterval = previousInterval })
+
+	calls := 0
+	client := newTestClient(t, map[string]http.HandlerFunc{
+		"/zond/v1/beacon/genesis": func(w http.ResponseWriter, _ *http.Request) {
+			calls++
+			if calls == 1 {
+				writeJson(t, w, http.StatusNotFound, map[string]interface{}{"code": 404, "message": "chain not started"})
+				return
+			}
+			writeJson(t, w, http.StatusOK, map[string]interface{}{
+				"data": map[string]string{
+					"genesis_time":            "1654824000",
+					"genesis_validators_root": testGenesisValidatorsRoot,
+					"genesis_fork_version":    "0x00000000",
+				},
+			})
+		},
+	})
+
+	stream, err := client.WaitForChainStart(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 genesis queries, got %d", calls)
+	}
+	if !resp.Started || resp.GenesisTime != 1654824000 {
+		t.Errorf("unexpected chain start response %v", resp)
+	}
+	if hexutil.Encode(resp.GenesisValidatorsRoot) != testGenesisValidatorsRoot {
+		t.Errorf("unexpected genesis validators root %#x", resp.GenesisValidatorsRoot)
+	}
+}
+
+func TestWaitForChainStart_ServerError(t *testing.T) {
+	client := newTestClient(t, map[string]http.HandlerFunc{
+		"/zond/v1/beacon/genesis": func(w http.ResponseWriter, _ *http.Request) {
+			writeJson(t, w, http.StatusInternalServerError, map[string]interface{}{"code": 500, "message": "internal error"})
+		},
+	})
+
+	stream, err := client.WaitForChainStart(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stream.Recv(); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestMultipleValidatorStatus(t *testing.T) {
+	knownPubkey := make([]byte, 48)
+	knownPubkey[0] = 1
+	unknownPubkey := make([]byte, 48)
+	unknownPubkey[0] = 2
+	indexOnlyPubkey := make([]byte, 48)
+	indexOnlyPubkey[0] = 3
+
+	validator := func(index string, pubkey []byte, status string) map[string]interface{} {
+		return map[string]interface{}{
+			"index":   index,
+			"balance": "32000000000",
+			"status":  status,
+			"validator": map[string]string{
+				"pubkey":                       hexutil.Encode(pubkey),
+				"activation_eligibility_epoch": "1",
+				"activation_epoch":             "2",
+			},
+		}
+	}
+
+	client := newTestClient(t, map[string]http.HandlerFunc{
+		"/zond/v1/beacon/states/head/validators": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("status") != "" {
+				t.Fatal("the activation queue shouldn't be queried without pending validators")
+			}
+			ids := r.URL.Query()["id"]
+			expectedIds := []string{hexutil.Encode(knownPubkey), hexutil.Encode(unknownPubkey), "7"}
+			if !reflect.DeepEqual(ids, expectedIds) {
+				t.Errorf("unexpected ids %v", ids)
+			}
+			writeJson(t, w, http.StatusOK, map[string]interface{}{
+				"data": []interface{}{
+					validator("7", indexOnlyPubkey, "exited_slashed"),
+					validator("5", knownPubkey, "active_ongoing"),
+				},
+			})
+		},
+	})
+
+	resp, err := client.MultipleValidatorStatus(context.Background(), &ethpb.MultipleValidatorStatusRequest{
+		PublicKeys: [][]byte{knownPubkey, unknownPubkey},
+		Indices:    []int64{7},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(resp.PublicKeys, [][]byte{knownPubkey, unknownPubkey, indexOnlyPubkey}) {
+		t.Errorf("unexpected public keys %v", resp.PublicKeys)
+	}
+	if !reflect.DeepEqual(resp.Indices, []types.ValidatorIndex{5, nonexistentIndex, 7}) {
+		t.Errorf("unexpected indices %v", resp.Indices)
+	}
+	expectedStatuses := []ethpb.ValidatorStatus{ethpb.ValidatorStatus_ACTIVE, ethpb.ValidatorStatus_UNKNOWN_STATUS, ethpb.ValidatorStatus_EXITED}
+	for i, status := range resp.Statuses {
+		if status.Status != expectedStatuses[i] {
+			t.Errorf("unexpected status %s at index %d", status.Status, i)
+		}
+	}
+}
+
+func TestCheckDoppelGanger(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+
+	// Start the chain 10 epochs ago.
+	epochDuration := uint64(cfg.SlotsPerEpoch) * cfg.SecondsPerSlot
+	genesisTime := uint64(time.Now().Unix()) - 10*epochDuration - 1
+
+	livePubkey := make([]byte, 48)
+	livePubkey[0] = 1
+	idlePubkey := make([]byte, 48)
+	idlePubkey[0] = 2
+	recentPubkey := make([]byte, 48)
+	recentPubkey[0] = 3
+	unknownPubkey := make([]byte, 48)
+	unknownPubkey[0] = 4
+
+	var livenessEpochs []string
+	client := newTestClient(t, map[string]http.HandlerFunc{
+		"/zond/v1/beacon/genesis": func(w http.ResponseWriter, _ *http.Request) {
+			writeJson(t, w, http.StatusOK, map[string]interface{}{
+				"data": map[string]string{
+					"genesis_time":            strconv.FormatUint(genesisTime, 10),
+					"genesis_validators_root": testGenesisValidatorsRoot,
+					"genesis_fork_version":    "0x00000000",
+				},
+			})
+		},
+		"/zond/v1/beacon/states/head/validators": func(w http.ResponseWriter, r *http.Request) {
+			ids := r.URL.Query()["id"]
+			if len(ids) != 3 {
+				t.Errorf("expected 3 requested validators, got %v", ids)
+			}
+			writeJson(t, w, http.StatusOK, map[string]interface{}{
+				"data": []map[string]interface{}{
+					{"index": "5", "status": "active_ongoing", "validator": map[string]string{"pubkey": hexutil.Encode(livePubkey)}},
+					{"index": "6", "status": "active_ongoing", "validator": map[string]string{"pubkey": hexutil.Encode(idlePubkey)}},
+				},
+			})
+		},
+		"/eth/v1/validator/liveness/": func(w http.ResponseWriter, r *http.Request) {
+			livenessEpochs = append(livenessEpochs, strings.TrimPrefix(r.URL.Path, "/eth/v1/validator/liveness/"))
+			writeJson(t, w, http.StatusOK, map[string]interface{}{
+				"data": []map[string]interface{}{
+					{"index": "5", "is_live": true},
+					{"index": "6", "is_live": false},
+				},
+			})
+		},
+	})
+
+	resp, err := client.CheckDoppelGanger(context.Background(), &ethpb.DoppelGangerRequest{
+		ValidatorRequests: []*ethpb.DoppelGangerRequest_ValidatorRequest{
+			{PublicKey: livePubkey, Epoch: 1},
+			{PublicKey: idlePubkey, Epoch: 1},
+			{PublicKey: recentPubkey, Epoch: 9},
+			{PublicKey: unknownPubkey, Epoch: 1},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(livenessEpochs, []string{"8", "9", "10"}) {
+		t.Errorf("unexpected liveness epochs %v", livenessEpochs)
+	}
+	expected := []bool{true, false, false, false}
+	if len(resp.Responses) != len(expected) {
+		t.Fatalf("expected %d responses, got %d", len(expected), len(resp.Responses))
+	}
+	for i, r := range resp.Responses {
+		if r.DuplicateExists != expected[i] {
+			t.Errorf("unexpected duplicate status %v for public key %#x", r.DuplicateExists, r.PublicKey)
+		}
+	}
+}
+
+func TestStreamDuties(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = params.BeaconConfig().FarFutureEpoch
+	params.OverrideBeaconConfig(cfg)
+
+	// Start the chain 10 epochs ago, so that the current epoch is 10.
+	epochDuration := uint64(cfg.SlotsPerEpoch) * cfg.SecondsPerSlot
+	genesisTime := uint64(time.Now().Unix()) - 10*epochDuration - 1
+	firstSlot := uint64(cfg.SlotsPerEpoch) * 10
+
+	knownPubkey := make([]byte, 48)
+	knownPubkey[0] = 1
+	unknownPubkey := make([]byte, 48)
+	unknownPubkey[0] = 2
+
+	var attesterEpochs, proposerEpochs []string
+	client := newTestClient(t, map[string]http.HandlerFunc{
+		"/zond/v1/beacon/genesis": func(w http.ResponseWriter, _ *http.Request) {
+			writeJson(t, w, http.StatusOK, map[string]interface{}{
+				"data": map[string]string{
+					"genesis_time":            strconv.FormatUint(genesisTime, 10),
+					"genesis_validators_root": testGenesisValidatorsRoot,
+					"genesis_fork_version":    "0x00000000",
+				},
+			})
+		},
+		"/zond/v1/beacon/states/head/validators": func(w http.ResponseWriter, _ *http.Request) {
+			writeJson(t, w, http.StatusOK, map[string]interface{}{
+				"data": []map[string]interface{}{
+					{"index": "5", "status": "active_ongoing", "validator": map[string]string{"pubkey": hexutil.Encode(knownPubkey)}},
+				},
+			})
+		},
+		"/zond/v1/validator/duties/attester/": func(w http.ResponseWriter, r *http.Request) {
+			epoch := strings.TrimPrefix(r.URL.Path, "/zond/v1/validator/duties/attester/")
+			attesterEpochs = append(attesterEpochs, epoch)
+			writeJson(t, w, http.StatusOK, map[string]interface{}{
+				"data": []map[string]string{
+					{"pubkey": hexutil.Encode(knownPubkey), "validator_index": "5", "committee_index": "1", "slot": strconv.FormatUint(firstSlot+3, 10)},
+				},
+			})
+		},
+		"/zond/v1/validator/duties/proposer/": func(w http.ResponseWriter, r *http.Request) {
+			proposerEpochs = append(proposerEpochs, strings.TrimPrefix(r.URL.Path, "/zond/v1/validator/duties/proposer/"))
+			writeJson(t, w, http.StatusOK, map[string]interface{}{
+				"data": []map[string]string{
+					{"pubkey": hexutil.Encode(knownPubkey), "validator_index": "5", "slot": strconv.FormatUint(firstSlot+7, 10)},
+				},
+			})
+		},
+		"/zond/v1/beacon/states/head/committees": func(w http.ResponseWriter, _ *http.Request) {
+			writeJson(t, w, http.StatusOK, map[string]interface{}{
+				"data": []map[string]interface{}{
+					{"index": "1", "slot": strconv.FormatUint(firstSlot+3, 10), "validators": []string{"4", "5"}},
+				},
+			})
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := client.StreamDuties(ctx, &ethpb.DutiesRequest{PublicKeys: [][]byte{knownPubkey, unknownPubkey}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(attesterEpochs, []string{"10", "11"}) || !reflect.DeepEqual(proposerEpochs, []string{"10", "11"}) {
+		t.Errorf("unexpected queried epochs %v and %v", attesterEpochs, proposerEpochs)
+	}
+	if len(resp.CurrentEpochDuties) != 2 || len(resp.NextEpochDuties) != 2 {
+		t.Fatalf("unexpected number of duties %d and %d", len(resp.CurrentEpochDuties), len(resp.NextEpochDuties))
+	}
+	known := resp.CurrentEpochDuties[0]
+	if known.ValidatorIndex != 5 || known.Status != ethpb.ValidatorStatus_ACTIVE || known.CommitteeIndex != 1 {
+		t.Errorf("unexpected duty %v", known)
+	}
+	if known.AttesterSlot != types.Slot(firstSlot+3) || !reflect.DeepEqual(known.ProposerSlots, []types.Slot{types.Slot(firstSlot + 7)}) {
+		t.Errorf("unexpected duty slots %v", known)
+	}
+	if !reflect.DeepEqual(known.Committee, []types.ValidatorIndex{4, 5}) {
+		t.Errorf("unexpected committee %v", known.Committee)
+	}
+	if unknown := resp.CurrentEpochDuties[1]; unknown.Status != ethpb.ValidatorStatus_UNKNOWN_STATUS || unknown.Committee != nil {
+		t.Errorf("unexpected duty for an unknown validator %v", unknown)
+	}
+
+	// The duties of the current epoch were already sent, so the next call waits for the next epoch.
+	cancel()
+	if _, err := stream.Recv(); err == nil {
+		t.Fatal("expected an error once the context is canceled")
+	}
+	if len(attesterEpochs) != 2 {
+		t.Errorf("expected no further duty queries, got %v", attesterEpochs)
+	}
+}
+
+func TestStreamDuties_GenesisError(t *testing.T) {
+	client := newTestClient(t, map[string]http.HandlerFunc{
+		"/zond/v1/beacon/genesis": func(w http.ResponseWriter, _ *http.Request) {
+			writeJson(t, w, http.StatusInternalServerError, map[string]interface{}{"code": 500, "message": "internal error"})
+		},
+	})
+
+	if _, err := client.StreamDuties(context.Background(), &ethpb.DutiesRequest{}); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestGetFeeRecipientByPubKey(t *testing.T) {
+	// The beacon API doesn't expose fee recipients, so no request may reach the beacon node.
+	client := newTestClient(t, map[string]http.HandlerFunc{
+		"/": func(_ http.ResponseWriter, r *http.Request) {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		},
+	})
+
+	pubkey := make([]byte, 48)
+	resp, err := client.GetFeeRecipientByPubKey(context.Background(), &ethpb.FeeRecipientByPubKeyRequest{PublicKey: pubkey})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.FeeRecipient) != 0 {
+		t.Errorf("expected an empty fee recipient, got %#x", resp.FeeRecipient)
+	}
+}
+
+func TestProposeAttestation(t *testing.T) {
+	attestation := &ethpb.Attestation{
+		AggregationBits: []byte{0b11},
+		Data: &ethpb.AttestationData{
+			Slot:            3,
+			CommitteeIndex:  1,
+			BeaconBlockRoot: make([]byte, 32),
+			Source:          &ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+		},
+		Signature: make([]byte, 96),
+	}
+
+	var postedAttestations []map[string]interface{}
+	client := newTestClient(t, map[string]http.HandlerFunc{
+		"/zond/v1/beacon/pool/attestations": func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("unexpected method %s", r.Method)
+			}
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal(body, &postedAttestations); err != nil {
+				t.Fatal(err)
+			}
+			w.WriteHeader(http.StatusOK)
+		},
+	})
+
+	resp, err := client.ProposeAttestation(context.Background(), attestation)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(postedAttestations) != 1 {
+		t.Fatalf("expected 1 posted attestation, got %d", len(postedAttestations))
+	}
+	if postedAttestations[0]["aggregation_bits"] != "0x03" {
+		t.Errorf("unexpected aggregation bits %v", postedAttestations[0]["aggregation_bits"])
+	}
+	data, ok := postedAttestations[0]["data"].(map[string]interface{})
+	if !ok || data["slot"] != "3" || data["index"] != "1" {
+		t.Errorf("unexpected attestation data %v", postedAttestations[0]["data"])
+	}
+
+	expectedRoot, err := attestation.Data.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(resp.AttestationDataRoot, expectedRoot[:]) {
+		t.Errorf("unexpected attestation data root %#x", resp.AttestationDataRoot)
+	}
+}
+
+func TestGetBeaconBlock_Phase0(t *testing.T) {
+	root := hexutil.Encode(make([]byte, 32))
+	randaoReveal := hexutil.Encode(make([]byte, 96))
+
+	client := newTestClient(t, map[string]http.HandlerFunc{
+		"/zond/v2/validator/blocks/9": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("randao_reveal") != randaoReveal {
+				t.Errorf("unexpected randao reveal %s", r.URL.Query().Get("randao_reveal"))
+			}
+			writeJson(t, w, http.StatusOK, map[string]interface{}{
+				"version": "phase0",
+				"data": map[string]interface{}{
+					"slot":           "9",
+					"proposer_index": "4",
+					"parent_root":    root,
+					"state_root":     root,
+					"body": map[string]interface{}{
+						"randao_reveal": randaoReveal,
+						"eth1_data": map[string]string{
+							"deposit_root":  root,
+							"deposit_count": "12",
+							"block_hash":    root,
+						},
+						"graffiti":           root,
+						"proposer_slashings": []interface{}{},
+						"attester_slashings": []interface{}{},
+						"attestations":       []interface{}{},
+						"deposits":           []interface{}{},
+						"voluntary_exits":    []interface{}{},
+					},
+				},
+			})
+		},
+	})
+
+	resp, err := client.GetBeaconBlock(context.Background(), &ethpb.BlockRequest{Slot: 9, RandaoReveal: make([]byte, 96)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	block, ok := resp.Block.(*ethpb.GenericBeaconBlock_Phase0)
+	if !ok {
+		t.Fatalf("unexpected block type %T", resp.Block)
+	}
+	if block.Phase0.Slot != 9 || block.Phase0.ProposerIndex != 4 || block.Phase0.Body.Eth1Data.DepositCount != 12 {
+		t.Errorf("unexpected block %v", block.Phase0)
+	}
+}
+
+func TestGetBeaconBlock_UnsupportedVersion(t *testing.T) {
+	client := newTestClient(t, map[string]http.HandlerFunc{
+		"/zond/v2/validator/blocks/1": func(w http.ResponseWriter, _ *http.Request) {
+			writeJson(t, w, http.StatusOK, map[string]interface{}{"version": "unknown", "data": map[string]interface{}{}})
+		},
+	})
+
+	if _, err := client.GetBeaconBlock(context.Background(), &ethpb.BlockRequest{Slot: 1}); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestJsonDecoder_Uint256(t *testing.T) {
+	d := &jsonDecoder{}
+	value := d.uint256("base fee", "4660")
+	if d.err != nil {
+		t.Fatal(d.err)
+	}
+	if len(value) != 32 || value[0] != 0x34 || value[1] != 0x12 {
+		t.Errorf("unexpected little-endian encoding %#x", value)
+	}
+	if uint256ToString(value) != "4660" {
+		t.Errorf("unexpected round trip %s", uint256ToString(value))
+	}
+
+	d.uint256("base fee", "-1")
+	if d.err == nil {
+		t.Error("expected an error for a negative value")
+	}
+}
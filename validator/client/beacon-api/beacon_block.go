@@ -0,0 +1,127 @@
+//go:build use_beacon_api
+// +build use_beacon_api
+
+package beacon_api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	neturl "net/url"
+
+	"github.com/pkg/errors"
+	rpcmiddleware "github.com/theQRL/zond/beacon-chain/rpc/apimiddleware"
+	"github.com/theQRL/zond/common/hexutil"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+)
+
+// versionedBlockJson is the response of the endpoints returning a block of any fork.
+// The block is decoded once we know which fork it belongs to.
+type versionedBlockJson struct {
+	Version string          `json:"version"`
+	Data    json.RawMessage `json:"data"`
+}
+
+func (c *beaconApiValidatorClient) getBeaconBlock(ctx context.Context, in *ethpb.BlockRequest) (*ethpb.GenericBeaconBlock, error) {
+	params := neturl.Values{}
+	params.Add("randao_reveal", hexutil.Encode(in.RandaoReveal))
+	if len(in.Graffiti) > 0 {
+		params.Add("graffiti", hexutil.Encode(in.Graffiti))
+	}
+
+	produceBlockJson := &versionedBlockJson{}
+	url := buildURL(fmt.Sprintf("/zond/v2/validator/blocks/%d", in.Slot), params)
+	if _, err := c.jsonRestHandler.GetRestJsonResponse(ctx, url, produceBlockJson); err != nil {
+		return nil, errors.Wrap(err, "failed to query GET REST endpoint")
+	}
+
+	d := &jsonDecoder{}
+	var response *ethpb.GenericBeaconBlock
+	switch produceBlockJson.Version {
+	case "phase0":
+		blockJson := &rpcmiddleware.BeaconBlockJson{}
+		if err := json.Unmarshal(produceBlockJson.Data, blockJson); err != nil {
+			return nil, errors.Wrap(err, "failed to decode phase0 block response json")
+		}
+		response = &ethpb.GenericBeaconBlock{
+			Block: &ethpb.GenericBeaconBlock_Phase0{Phase0: d.phase0BeaconBlock(blockJson)},
+		}
+	case "altair":
+		blockJson := &rpcmiddleware.BeaconBlockAltairJson{}
+		if err := json.Unmarshal(produceBlockJson.Data, blockJson); err != nil {
+			return nil, errors.Wrap(err, "failed to decode altair block response json")
+		}
+		response = &ethpb.GenericBeaconBlock{
+			Block: &ethpb.GenericBeaconBlock_Altair{Altair: d.altairBeaconBlock(blockJson)},
+		}
+	case "bellatrix":
+		blockJson := &rpcmiddleware.BeaconBlockBellatrixJson{}
+		if err := json.Unmarshal(produceBlockJson.Data, blockJson); err != nil {
+			return nil, errors.Wrap(err, "failed to decode bellatrix block response json")
+		}
+		response = &ethpb.GenericBeaconBlock{
+			Block: &ethpb.GenericBeaconBlock_Bellatrix{Bellatrix: d.bellatrixBeaconBlock(blockJson)},
+		}
+	default:
+		return nil, errors.Errorf("unsupported block version `%s`", produceBlockJson.Version)
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	return response, nil
+}
+
+func (c *beaconApiValidatorClient) proposeBeaconBlock(ctx context.Context, in *ethpb.GenericSignedBeaconBlock) (*ethpb.ProposeResponse, error) {
+	var endpoint string
+	var blockJson interface{}
+	var blockRoot [32]byte
+	var err error
+
+	switch blockType := in.Block.(type) {
+	case *ethpb.GenericSignedBeaconBlock_Phase0:
+		endpoint = "/zond/v1/beacon/blocks"
+		blockJson = &rpcmiddleware.SignedBeaconBlockContainerJson{
+			Message:   jsonifyPhase0BeaconBlock(blockType.Phase0.Block),
+			Signature: hexutil.Encode(blockType.Phase0.Signature),
+		}
+		blockRoot, err = blockType.Phase0.Block.HashTreeRoot()
+	case *ethpb.GenericSignedBeaconBlock_Altair:
+		endpoint = "/zond/v1/beacon/blocks"
+		blockJson = &rpcmiddleware.SignedBeaconBlockAltairContainerJson{
+			Message:   jsonifyAltairBeaconBlock(blockType.Altair.Block),
+			Signature: hexutil.Encode(blockType.Altair.Signature),
+		}
+		blockRoot, err = blockType.Altair.Block.HashTreeRoot()
+	case *ethpb.GenericSignedBeaconBlock_Bellatrix:
+		endpoint = "/zond/v1/beacon/blocks"
+		blockJson = &rpcmiddleware.SignedBeaconBlockBellatrixContainerJson{
+			Message:   jsonifyBellatrixBeaconBlock(blockType.Bellatrix.Block),
+			Signature: hexutil.Encode(blockType.Bellatrix.Signature),
+		}
+		blockRoot, err = blockType.Bellatrix.Block.HashTreeRoot()
+	case *ethpb.GenericSignedBeaconBlock_BlindedBellatrix:
+		endpoint = "/zond/v1/beacon/blinded_blocks"
+		blockJson = &rpcmiddleware.SignedBlindedBeaconBlockBellatrixContainerJson{
+			Message:   jsonifyBlindedBellatrixBeaconBlock(blockType.BlindedBellatrix.Block),
+			Signature: hexutil.Encode(blockType.BlindedBellatrix.Signature),
+		}
+		blockRoot, err = blockType.BlindedBellatrix.Block.HashTreeRoot()
+	default:
+		return nil, errors.Errorf("unsupported block type %T", in.Block)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute block root")
+	}
+
+	body, err := marshalJsonBody(blockJson)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.jsonRestHandler.PostRestJson(ctx, endpoint, nil, body, nil); err != nil {
+		return nil, errors.Wrap(err, "failed to send POST data to REST endpoint")
+	}
+
+	return &ethpb.ProposeResponse{BlockRoot: blockRoot[:]}, nil
+}
@@ -0,0 +1,273 @@
+//go:build use_beacon_api
+// +build use_beacon_api
+
+package beacon_api
+
+import (
+	rpcmiddleware "github.com/theQRL/zond/beacon-chain/rpc/apimiddleware"
+	"github.com/theQRL/zond/common/hexutil"
+	enginev1 "github.com/theQRL/zond/protos/engine/v1"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+)
+
+// This file converts protobuf objects into the JSON objects expected by the beacon node REST API.
+
+func jsonifyHexArray(values [][]byte) []string {
+	jsonValues := make([]string, len(values))
+	for i, value := range values {
+		jsonValues[i] = hexutil.Encode(value)
+	}
+	return jsonValues
+}
+
+func jsonifyUint64Array(values []uint64) []string {
+	jsonValues := make([]string, len(values))
+	for i, value := range values {
+		jsonValues[i] = uint64ToString(value)
+	}
+	return jsonValues
+}
+
+func jsonifyCheckpoint(checkpoint *ethpb.Checkpoint) *rpcmiddleware.CheckpointJson {
+	return &rpcmiddleware.CheckpointJson{
+		Epoch: uint64ToString(checkpoint.Epoch),
+		Root:  hexutil.Encode(checkpoint.Root),
+	}
+}
+
+func jsonifyAttestationData(data *ethpb.AttestationData) *rpcmiddleware.AttestationDataJson {
+	return &rpcmiddleware.AttestationDataJson{
+		Slot:            uint64ToString(data.Slot),
+		CommitteeIndex:  uint64ToString(data.CommitteeIndex),
+		BeaconBlockRoot: hexutil.Encode(data.BeaconBlockRoot),
+		Source:          jsonifyCheckpoint(data.Source),
+		Target:          jsonifyCheckpoint(data.Target),
+	}
+}
+
+func jsonifyAttestation(attestation *ethpb.Attestation) *rpcmiddleware.AttestationJson {
+	return &rpcmiddleware.AttestationJson{
+		AggregationBits: hexutil.Encode(attestation.AggregationBits),
+		Data:            jsonifyAttestationData(attestation.Data),
+		Signature:       hexutil.Encode(attestation.Signature),
+	}
+}
+
+func jsonifyAttestations(attestations []*ethpb.Attestation) []*rpcmiddleware.AttestationJson {
+	jsonAttestations := make([]*rpcmiddleware.AttestationJson, len(attestations))
+	for i, attestation := range attestations {
+		jsonAttestations[i] = jsonifyAttestation(attestation)
+	}
+	return jsonAttestations
+}
+
+func jsonifyIndexedAttestation(indexedAttestation *ethpb.IndexedAttestation) *rpcmiddleware.IndexedAttestationJson {
+	return &rpcmiddleware.IndexedAttestationJson{
+		AttestingIndices: jsonifyUint64Array(indexedAttestation.AttestingIndices),
+		Data:             jsonifyAttestationData(indexedAttestation.Data),
+		Signature:        hexutil.Encode(indexedAttestation.Signature),
+	}
+}
+
+func jsonifyAttesterSlashings(attesterSlashings []*ethpb.AttesterSlashing) []*rpcmiddleware.AttesterSlashingJson {
+	jsonAttesterSlashings := make([]*rpcmiddleware.AttesterSlashingJson, len(attesterSlashings))
+	for i, attesterSlashing := range attesterSlashings {
+		jsonAttesterSlashings[i] = &rpcmiddleware.AttesterSlashingJson{
+			Attestation_1: jsonifyIndexedAttestation(attesterSlashing.Attestation_1),
+			Attestation_2: jsonifyIndexedAttestation(attesterSlashing.Attestation_2),
+		}
+	}
+	return jsonAttesterSlashings
+}
+
+func jsonifySignedBeaconBlockHeader(signedHeader *ethpb.SignedBeaconBlockHeader) *rpcmiddleware.SignedBeaconBlockHeaderJson {
+	return &rpcmiddleware.SignedBeaconBlockHeaderJson{
+		Header: &rpcmiddleware.BeaconBlockHeaderJson{
+			Slot:          uint64ToString(signedHeader.Header.Slot),
+			ProposerIndex: uint64ToString(signedHeader.Header.ProposerIndex),
+			ParentRoot:    hexutil.Encode(signedHeader.Header.ParentRoot),
+			StateRoot:     hexutil.Encode(signedHeader.Header.StateRoot),
+			BodyRoot:      hexutil.Encode(signedHeader.Header.BodyRoot),
+		},
+		Signature: hexutil.Encode(signedHeader.Signature),
+	}
+}
+
+func jsonifyProposerSlashings(proposerSlashings []*ethpb.ProposerSlashing) []*rpcmiddleware.ProposerSlashingJson {
+	jsonProposerSlashings := make([]*rpcmiddleware.ProposerSlashingJson, len(proposerSlashings))
+	for i, proposerSlashing := range proposerSlashings {
+		jsonProposerSlashings[i] = &rpcmiddleware.ProposerSlashingJson{
+			Header_1: jsonifySignedBeaconBlockHeader(proposerSlashing.Header_1),
+			Header_2: jsonifySignedBeaconBlockHeader(proposerSlashing.Header_2),
+		}
+	}
+	return jsonProposerSlashings
+}
+
+func jsonifyDeposits(deposits []*ethpb.Deposit) []*rpcmiddleware.DepositJson {
+	jsonDeposits := make([]*rpcmiddleware.DepositJson, len(deposits))
+	for i, deposit := range deposits {
+		jsonDeposits[i] = &rpcmiddleware.DepositJson{
+			Proof: jsonifyHexArray(deposit.Proof),
+			Data: &rpcmiddleware.Deposit_DataJson{
+				PublicKey:             hexutil.Encode(deposit.Data.PublicKey),
+				WithdrawalCredentials: hexutil.Encode(deposit.Data.WithdrawalCredentials),
+				Amount:                uint64ToString(deposit.Data.Amount),
+				Signature:             hexutil.Encode(deposit.Data.Signature),
+			},
+		}
+	}
+	return jsonDeposits
+}
+
+func jsonifySignedVoluntaryExit(signedVoluntaryExit *ethpb.SignedVoluntaryExit) *rpcmiddleware.SignedVoluntaryExitJson {
+	return &rpcmiddleware.SignedVoluntaryExitJson{
+		Exit: &rpcmiddleware.VoluntaryExitJson{
+			Epoch:          uint64ToString(signedVoluntaryExit.Exit.Epoch),
+			ValidatorIndex: uint64ToString(signedVoluntaryExit.Exit.ValidatorIndex),
+		},
+		Signature: hexutil.Encode(signedVoluntaryExit.Signature),
+	}
+}
+
+func jsonifySignedVoluntaryExits(voluntaryExits []*ethpb.SignedVoluntaryExit) []*rpcmiddleware.SignedVoluntaryExitJson {
+	jsonSignedVoluntaryExits := make([]*rpcmiddleware.SignedVoluntaryExitJson, len(voluntaryExits))
+	for i, signedVoluntaryExit := range voluntaryExits {
+		jsonSignedVoluntaryExits[i] = jsonifySignedVoluntaryExit(signedVoluntaryExit)
+	}
+	return jsonSignedVoluntaryExits
+}
+
+func jsonifyEth1Data(eth1Data *ethpb.Eth1Data) *rpcmiddleware.Eth1DataJson {
+	return &rpcmiddleware.Eth1DataJson{
+		BlockHash:    hexutil.Encode(eth1Data.BlockHash),
+		DepositCount: uint64ToString(eth1Data.DepositCount),
+		DepositRoot:  hexutil.Encode(eth1Data.DepositRoot),
+	}
+}
+
+func jsonifySyncAggregate(syncAggregate *ethpb.SyncAggregate) *rpcmiddleware.SyncAggregateJson {
+	return &rpcmiddleware.SyncAggregateJson{
+		SyncCommitteeBits:      hexutil.Encode(syncAggregate.SyncCommitteeBits),
+		SyncCommitteeSignature: hexutil.Encode(syncAggregate.SyncCommitteeSignature),
+	}
+}
+
+func jsonifyExecutionPayload(payload *enginev1.ExecutionPayload) *rpcmiddleware.ExecutionPayloadJson {
+	return &rpcmiddleware.ExecutionPayloadJson{
+		ParentHash:    hexutil.Encode(payload.ParentHash),
+		FeeRecipient:  hexutil.Encode(payload.FeeRecipient),
+		StateRoot:     hexutil.Encode(payload.StateRoot),
+		ReceiptsRoot:  hexutil.Encode(payload.ReceiptsRoot),
+		LogsBloom:     hexutil.Encode(payload.LogsBloom),
+		PrevRandao:    hexutil.Encode(payload.PrevRandao),
+		BlockNumber:   uint64ToString(payload.BlockNumber),
+		GasLimit:      uint64ToString(payload.GasLimit),
+		GasUsed:       uint64ToString(payload.GasUsed),
+		TimeStamp:     uint64ToString(payload.Timestamp),
+		ExtraData:     hexutil.Encode(payload.ExtraData),
+		BaseFeePerGas: uint256ToString(payload.BaseFeePerGas),
+		BlockHash:     hexutil.Encode(payload.BlockHash),
+		Transactions:  jsonifyHexArray(payload.Transactions),
+	}
+}
+
+func jsonifyExecutionPayloadHeader(header *enginev1.ExecutionPayloadHeader) *rpcmiddleware.ExecutionPayloadHeaderJson {
+	return &rpcmiddleware.ExecutionPayloadHeaderJson{
+		ParentHash:       hexutil.Encode(header.ParentHash),
+		FeeRecipient:     hexutil.Encode(header.FeeRecipient),
+		StateRoot:        hexutil.Encode(header.StateRoot),
+		ReceiptsRoot:     hexutil.Encode(header.ReceiptsRoot),
+		LogsBloom:        hexutil.Encode(header.LogsBloom),
+		PrevRandao:       hexutil.Encode(header.PrevRandao),
+		BlockNumber:      uint64ToString(header.BlockNumber),
+		GasLimit:         uint64ToString(header.GasLimit),
+		GasUsed:          uint64ToString(header.GasUsed),
+		TimeStamp:        uint64ToString(header.Timestamp),
+		ExtraData:        hexutil.Encode(header.ExtraData),
+		BaseFeePerGas:    uint256ToString(header.BaseFeePerGas),
+		BlockHash:        hexutil.Encode(header.BlockHash),
+		TransactionsRoot: hexutil.Encode(header.TransactionsRoot),
+	}
+}
+
+func jsonifyPhase0BeaconBlock(block *ethpb.BeaconBlock) *rpcmiddleware.BeaconBlockJson {
+	return &rpcmiddleware.BeaconBlockJson{
+		Slot:          uint64ToString(block.Slot),
+		ProposerIndex: uint64ToString(block.ProposerIndex),
+		ParentRoot:    hexutil.Encode(block.ParentRoot),
+		StateRoot:     hexutil.Encode(block.StateRoot),
+		Body: &rpcmiddleware.BeaconBlockBodyJson{
+			RandaoReveal:      hexutil.Encode(block.Body.RandaoReveal),
+			Eth1Data:          jsonifyEth1Data(block.Body.Eth1Data),
+			Graffiti:          hexutil.Encode(block.Body.Graffiti),
+			ProposerSlashings: jsonifyProposerSlashings(block.Body.ProposerSlashings),
+			AttesterSlashings: jsonifyAttesterSlashings(block.Body.AttesterSlashings),
+			Attestations:      jsonifyAttestations(block.Body.Attestations),
+			Deposits:          jsonifyDeposits(block.Body.Deposits),
+			VoluntaryExits:    jsonifySignedVoluntaryExits(block.Body.VoluntaryExits),
+		},
+	}
+}
+
+func jsonifyAltairBeaconBlock(block *ethpb.BeaconBlockAltair) *rpcmiddleware.BeaconBlockAltairJson {
+	return &rpcmiddleware.BeaconBlockAltairJson{
+		Slot:          uint64ToString(block.Slot),
+		ProposerIndex: uint64ToString(block.ProposerIndex),
+		ParentRoot:    hexutil.Encode(block.ParentRoot),
+		StateRoot:     hexutil.Encode(block.StateRoot),
+		Body: &rpcmiddleware.BeaconBlockBodyAltairJson{
+			RandaoReveal:      hexutil.Encode(block.Body.RandaoReveal),
+			Eth1Data:          jsonifyEth1Data(block.Body.Eth1Data),
+			Graffiti:          hexutil.Encode(block.Body.Graffiti),
+			ProposerSlashings: jsonifyProposerSlashings(block.Body.ProposerSlashings),
+			AttesterSlashings: jsonifyAttesterSlashings(block.Body.AttesterSlashings),
+			Attestations:      jsonifyAttestations(block.Body.Attestations),
+			Deposits:          jsonifyDeposits(block.Body.Deposits),
+			VoluntaryExits:    jsonifySignedVoluntaryExits(block.Body.VoluntaryExits),
+			SyncAggregate:     jsonifySyncAggregate(block.Body.SyncAggregate),
+		},
+	}
+}
+
+func jsonifyBellatrixBeaconBlock(block *ethpb.BeaconBlockBellatrix) *rpcmiddleware.BeaconBlockBellatrixJson {
+	return &rpcmiddleware.BeaconBlockBellatrixJson{
+		Slot:          uint64ToString(block.Slot),
+		ProposerIndex: uint64ToString(block.ProposerIndex),
+		ParentRoot:    hexutil.Encode(block.ParentRoot),
+		StateRoot:     hexutil.Encode(block.StateRoot),
+		Body: &rpcmiddleware.BeaconBlockBodyBellatrixJson{
+			RandaoReveal:      hexutil.Encode(block.Body.RandaoReveal),
+			Eth1Data:          jsonifyEth1Data(block.Body.Eth1Data),
+			Graffiti:          hexutil.Encode(block.Body.Graffiti),
+			ProposerSlashings: jsonifyProposerSlashings(block.Body.ProposerSlashings),
+			AttesterSlashings: jsonifyAttesterSlashings(block.Body.AttesterSlashings),
+			Attestations:      jsonifyAttestations(block.Body.Attestations),
+			Deposits:          jsonifyDeposits(block.Body.Deposits),
+			VoluntaryExits:    jsonifySignedVoluntaryExits(block.Body.VoluntaryExits),
+			SyncAggregate:     jsonifySyncAggregate(block.Body.SyncAggregate),
+			ExecutionPayload:  jsonifyExecutionPayload(block.Body.ExecutionPayload),
+		},
+	}
+}
+
+func jsonifyBlindedBellatrixBeaconBlock(block *ethpb.BlindedBeaconBlockBellatrix) *rpcmiddleware.BlindedBeaconBlockBellatrixJson {
+	return &rpcmiddleware.BlindedBeaconBlockBellatrixJson{
+		Slot:          uint64ToString(block.Slot),
+		ProposerIndex: uint64ToString(block.ProposerIndex),
+		ParentRoot:    hexutil.Encode(block.ParentRoot),
+		StateRoot:     hexutil.Encode(block.StateRoot),
+		Body: &rpcmiddleware.BlindedBeaconBlockBodyBellatrixJson{
+			RandaoReveal:           hexutil.Encode(block.Body.RandaoReveal),
+			Eth1Data:               jsonifyEth1Data(block.Body.Eth1Data),
+			Graffiti:               hexutil.Encode(block.Body.Graffiti),
+			ProposerSlashings:      jsonifyProposerSlashings(block.Body.ProposerSlashings),
+			AttesterSlashings:      jsonifyAttesterSlashings(block.Body.AttesterSlashings),
+			Attestations:           jsonifyAttestations(block.Body.Attestations),
+			Deposits:               jsonifyDeposits(block.Body.Deposits),
+			VoluntaryExits:         jsonifySignedVoluntaryExits(block.Body.VoluntaryExits),
+			SyncAggregate:          jsonifySyncAggregate(block.Body.SyncAggregate),
+			ExecutionPayloadHeader: jsonifyExecutionPayloadHeader(block.Body.ExecutionPayloadHeader),
+		},
+	}
+}
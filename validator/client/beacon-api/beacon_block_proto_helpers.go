@@ -0,0 +1,320 @@
+//go:build use_beacon_api
+// +build use_beacon_api
+
+package beacon_api
+
+import (
+	"github.com/pkg/errors"
+	rpcmiddleware "github.com/theQRL/zond/beacon-chain/rpc/apimiddleware"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	enginev1 "github.com/theQRL/zond/protos/engine/v1"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+)
+
+// This file converts the JSON objects returned by the beacon node REST API into their protobuf counterparts.
+// Every conversion reports the first field that could not be decoded.
+
+func (d *jsonDecoder) checkpoint(name string, jsonCheckpoint *rpcmiddleware.CheckpointJson) *ethpb.Checkpoint {
+	if d.err != nil {
+		return nil
+	}
+	if jsonCheckpoint == nil {
+		d.err = errors.Errorf("%s is nil", name)
+		return nil
+	}
+	return &ethpb.Checkpoint{
+		Epoch: types.Epoch(d.uint64(name+" epoch", jsonCheckpoint.Epoch)),
+		Root:  d.hex(name+" root", jsonCheckpoint.Root),
+	}
+}
+
+func (d *jsonDecoder) attestationData(jsonData *rpcmiddleware.AttestationDataJson) *ethpb.AttestationData {
+	if d.err != nil {
+		return nil
+	}
+	if jsonData == nil {
+		d.err = errors.New("attestation data is nil")
+		return nil
+	}
+	return &ethpb.AttestationData{
+		Slot:            types.Slot(d.uint64("attestation slot", jsonData.Slot)),
+		CommitteeIndex:  types.CommitteeIndex(d.uint64("attestation committee index", jsonData.CommitteeIndex)),
+		BeaconBlockRoot: d.hex("attestation beacon block root", jsonData.BeaconBlockRoot),
+		Source:          d.checkpoint("attestation source", jsonData.Source),
+		Target:          d.checkpoint("attestation target", jsonData.Target),
+	}
+}
+
+func (d *jsonDecoder) attestation(jsonAttestation *rpcmiddleware.AttestationJson) *ethpb.Attestation {
+	if d.err != nil {
+		return nil
+	}
+	if jsonAttestation == nil {
+		d.err = errors.New("attestation is nil")
+		return nil
+	}
+	return &ethpb.Attestation{
+		AggregationBits: d.hex("attestation aggregation bits", jsonAttestation.AggregationBits),
+		Data:            d.attestationData(jsonAttestation.Data),
+		Signature:       d.hex("attestation signature", jsonAttestation.Signature),
+	}
+}
+
+func (d *jsonDecoder) attestations(jsonAttestations []*rpcmiddleware.AttestationJson) []*ethpb.Attestation {
+	attestations := make([]*ethpb.Attestation, len(jsonAttestations))
+	for i, jsonAttestation := range jsonAttestations {
+		attestations[i] = d.attestation(jsonAttestation)
+	}
+	return attestations
+}
+
+func (d *jsonDecoder) indexedAttestation(jsonAttestation *rpcmiddleware.IndexedAttestationJson) *ethpb.IndexedAttestation {
+	if d.err != nil {
+		return nil
+	}
+	if jsonAttestation == nil {
+		d.err = errors.New("indexed attestation is nil")
+		return nil
+	}
+	return &ethpb.IndexedAttestation{
+		AttestingIndices: d.uint64Array("attesting index", jsonAttestation.AttestingIndices),
+		Data:             d.attestationData(jsonAttestation.Data),
+		Signature:        d.hex("indexed attestation signature", jsonAttestation.Signature),
+	}
+}
+
+func (d *jsonDecoder) attesterSlashings(jsonSlashings []*rpcmiddleware.AttesterSlashingJson) []*ethpb.AttesterSlashing {
+	slashings := make([]*ethpb.AttesterSlashing, len(jsonSlashings))
+	for i, jsonSlashing := range jsonSlashings {
+		if d.err != nil {
+			return nil
+		}
+		if jsonSlashing == nil {
+			d.err = errors.Errorf("attester slashing at index %d is nil", i)
+			return nil
+		}
+		slashings[i] = &ethpb.AttesterSlashing{
+			Attestation_1: d.indexedAttestation(jsonSlashing.Attestation_1),
+			Attestation_2: d.indexedAttestation(jsonSlashing.Attestation_2),
+		}
+	}
+	return slashings
+}
+
+func (d *jsonDecoder) signedBeaconBlockHeader(jsonHeader *rpcmiddleware.SignedBeaconBlockHeaderJson) *ethpb.SignedBeaconBlockHeader {
+	if d.err != nil {
+		return nil
+	}
+	if jsonHeader == nil || jsonHeader.Header == nil {
+		d.err = errors.New("signed beacon block header is nil")
+		return nil
+	}
+	return &ethpb.SignedBeaconBlockHeader{
+		Header: &ethpb.BeaconBlockHeader{
+			Slot:          types.Slot(d.uint64("header slot", jsonHeader.Header.Slot)),
+			ProposerIndex: types.ValidatorIndex(d.uint64("header proposer index", jsonHeader.Header.ProposerIndex)),
+			ParentRoot:    d.hex("header parent root", jsonHeader.Header.ParentRoot),
+			StateRoot:     d.hex("header state root", jsonHeader.Header.StateRoot),
+			BodyRoot:      d.hex("header body root", jsonHeader.Header.BodyRoot),
+		},
+		Signature: d.hex("header signature", jsonHeader.Signature),
+	}
+}
+
+func (d *jsonDecoder) proposerSlashings(jsonSlashings []*rpcmiddleware.ProposerSlashingJson) []*ethpb.ProposerSlashing {
+	slashings := make([]*ethpb.ProposerSlashing, len(jsonSlashings))
+	for i, jsonSlashing := range jsonSlashings {
+		if d.err != nil {
+			return nil
+		}
+		if jsonSlashing == nil {
+			d.err = errors.Errorf("proposer slashing at index %d is nil", i)
+			return nil
+		}
+		slashings[i] = &ethpb.ProposerSlashing{
+			Header_1: d.signedBeaconBlockHeader(jsonSlashing.Header_1),
+			Header_2: d.signedBeaconBlockHeader(jsonSlashing.Header_2),
+		}
+	}
+	return slashings
+}
+
+func (d *jsonDecoder) deposits(jsonDeposits []*rpcmiddleware.DepositJson) []*ethpb.Deposit {
+	deposits := make([]*ethpb.Deposit, len(jsonDeposits))
+	for i, jsonDeposit := range jsonDeposits {
+		if d.err != nil {
+			return nil
+		}
+		if jsonDeposit == nil || jsonDeposit.Data == nil {
+			d.err = errors.Errorf("deposit at index %d is nil", i)
+			return nil
+		}
+		deposits[i] = &ethpb.Deposit{
+			Proof: d.hexArray("deposit proof", jsonDeposit.Proof),
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             d.hex("deposit public key", jsonDeposit.Data.PublicKey),
+				WithdrawalCredentials: d.hex("deposit withdrawal credentials", jsonDeposit.Data.WithdrawalCredentials),
+				Amount:                d.uint64("deposit amount", jsonDeposit.Data.Amount),
+				Signature:             d.hex("deposit signature", jsonDeposit.Data.Signature),
+			},
+		}
+	}
+	return deposits
+}
+
+func (d *jsonDecoder) signedVoluntaryExits(jsonExits []*rpcmiddleware.SignedVoluntaryExitJson) []*ethpb.SignedVoluntaryExit {
+	exits := make([]*ethpb.SignedVoluntaryExit, len(jsonExits))
+	for i, jsonExit := range jsonExits {
+		if d.err != nil {
+			return nil
+		}
+		if jsonExit == nil || jsonExit.Exit == nil {
+			d.err = errors.Errorf("signed voluntary exit at index %d is nil", i)
+			return nil
+		}
+		exits[i] = &ethpb.SignedVoluntaryExit{
+			Exit: &ethpb.VoluntaryExit{
+				Epoch:          types.Epoch(d.uint64("exit epoch", jsonExit.Exit.Epoch)),
+				ValidatorIndex: types.ValidatorIndex(d.uint64("exit validator index", jsonExit.Exit.ValidatorIndex)),
+			},
+			Signature: d.hex("exit signature", jsonExit.Signature),
+		}
+	}
+	return exits
+}
+
+func (d *jsonDecoder) eth1Data(jsonEth1Data *rpcmiddleware.Eth1DataJson) *ethpb.Eth1Data {
+	if d.err != nil {
+		return nil
+	}
+	if jsonEth1Data == nil {
+		d.err = errors.New("eth1 data is nil")
+		return nil
+	}
+	return &ethpb.Eth1Data{
+		DepositRoot:  d.hex("eth1 data deposit root", jsonEth1Data.DepositRoot),
+		DepositCount: d.uint64("eth1 data deposit count", jsonEth1Data.DepositCount),
+		BlockHash:    d.hex("eth1 data block hash", jsonEth1Data.BlockHash),
+	}
+}
+
+func (d *jsonDecoder) syncAggregate(jsonSyncAggregate *rpcmiddleware.SyncAggregateJson) *ethpb.SyncAggregate {
+	if d.err != nil {
+		return nil
+	}
+	if jsonSyncAggregate == nil {
+		d.err = errors.New("sync aggregate is nil")
+		return nil
+	}
+	return &ethpb.SyncAggregate{
+		SyncCommitteeBits:      d.hex("sync committee bits", jsonSyncAggregate.SyncCommitteeBits),
+		SyncCommitteeSignature: d.hex("sync committee signature", jsonSyncAggregate.SyncCommitteeSignature),
+	}
+}
+
+func (d *jsonDecoder) executionPayload(jsonPayload *rpcmiddleware.ExecutionPayloadJson) *enginev1.ExecutionPayload {
+	if d.err != nil {
+		return nil
+	}
+	if jsonPayload == nil {
+		d.err = errors.New("execution payload is nil")
+		return nil
+	}
+	return &enginev1.ExecutionPayload{
+		ParentHash:    d.hex("payload parent hash", jsonPayload.ParentHash),
+		FeeRecipient:  d.hex("payload fee recipient", jsonPayload.FeeRecipient),
+		StateRoot:     d.hex("payload state root", jsonPayload.StateRoot),
+		ReceiptsRoot:  d.hex("payload receipts root", jsonPayload.ReceiptsRoot),
+		LogsBloom:     d.hex("payload logs bloom", jsonPayload.LogsBloom),
+		PrevRandao:    d.hex("payload prev randao", jsonPayload.PrevRandao),
+		BlockNumber:   d.uint64("payload block number", jsonPayload.BlockNumber),
+		GasLimit:      d.uint64("payload gas limit", jsonPayload.GasLimit),
+		GasUsed:       d.uint64("payload gas used", jsonPayload.GasUsed),
+		Timestamp:     d.uint64("payload timestamp", jsonPayload.TimeStamp),
+		ExtraData:     d.hex("payload extra data", jsonPayload.ExtraData),
+		BaseFeePerGas: d.uint256("payload base fee per gas", jsonPayload.BaseFeePerGas),
+		BlockHash:     d.hex("payload block hash", jsonPayload.BlockHash),
+		Transactions:  d.hexArray("payload transaction", jsonPayload.Transactions),
+	}
+}
+
+func (d *jsonDecoder) phase0BeaconBlock(jsonBlock *rpcmiddleware.BeaconBlockJson) *ethpb.BeaconBlock {
+	if d.err != nil {
+		return nil
+	}
+	if jsonBlock == nil || jsonBlock.Body == nil {
+		d.err = errors.New("phase0 block is nil")
+		return nil
+	}
+	return &ethpb.BeaconBlock{
+		Slot:          types.Slot(d.uint64("block slot", jsonBlock.Slot)),
+		ProposerIndex: types.ValidatorIndex(d.uint64("block proposer index", jsonBlock.ProposerIndex)),
+		ParentRoot:    d.hex("block parent root", jsonBlock.ParentRoot),
+		StateRoot:     d.hex("block state root", jsonBlock.StateRoot),
+		Body: &ethpb.BeaconBlockBody{
+			RandaoReveal:      d.hex("randao reveal", jsonBlock.Body.RandaoReveal),
+			Eth1Data:          d.eth1Data(jsonBlock.Body.Eth1Data),
+			Graffiti:          d.hex("graffiti", jsonBlock.Body.Graffiti),
+			ProposerSlashings: d.proposerSlashings(jsonBlock.Body.ProposerSlashings),
+			AttesterSlashings: d.attesterSlashings(jsonBlock.Body.AttesterSlashings),
+			Attestations:      d.attestations(jsonBlock.Body.Attestations),
+			Deposits:          d.deposits(jsonBlock.Body.Deposits),
+			VoluntaryExits:    d.signedVoluntaryExits(jsonBlock.Body.VoluntaryExits),
+		},
+	}
+}
+
+func (d *jsonDecoder) altairBeaconBlock(jsonBlock *rpcmiddleware.BeaconBlockAltairJson) *ethpb.BeaconBlockAltair {
+	if d.err != nil {
+		return nil
+	}
+	if jsonBlock == nil || jsonBlock.Body == nil {
+		d.err = errors.New("altair block is nil")
+		return nil
+	}
+	return &ethpb.BeaconBlockAltair{
+		Slot:          types.Slot(d.uint64("block slot", jsonBlock.Slot)),
+		ProposerIndex: types.ValidatorIndex(d.uint64("block proposer index", jsonBlock.ProposerIndex)),
+		ParentRoot:    d.hex("block parent root", jsonBlock.ParentRoot),
+		StateRoot:     d.hex("block state root", jsonBlock.StateRoot),
+		Body: &ethpb.BeaconBlockBodyAltair{
+			RandaoReveal:      d.hex("randao reveal", jsonBlock.Body.RandaoReveal),
+			Eth1Data:          d.eth1Data(jsonBlock.Body.Eth1Data),
+			Graffiti:          d.hex("graffiti", jsonBlock.Body.Graffiti),
+			ProposerSlashings: d.proposerSlashings(jsonBlock.Body.ProposerSlashings),
+			AttesterSlashings: d.attesterSlashings(jsonBlock.Body.AttesterSlashings),
+			Attestations:      d.attestations(jsonBlock.Body.Attestations),
+			Deposits:          d.deposits(jsonBlock.Body.Deposits),
+			VoluntaryExits:    d.signedVoluntaryExits(jsonBlock.Body.VoluntaryExits),
+			SyncAggregate:     d.syncAggregate(jsonBlock.Body.SyncAggregate),
+		},
+	}
+}
+
+func (d *jsonDecoder) bellatrixBeaconBlock(jsonBlock *rpcmiddleware.BeaconBlockBellatrixJson) *ethpb.BeaconBlockBellatrix {
+	if d.err != nil {
+		return nil
+	}
+	if jsonBlock == nil || jsonBlock.Body == nil {
+		d.err = errors.New("bellatrix block is nil")
+		return nil
+	}
+	return &ethpb.BeaconBlockBellatrix{
+		Slot:          types.Slot(d.uint64("block slot", jsonBlock.Slot)),
+		ProposerIndex: types.ValidatorIndex(d.uint64("block proposer index", jsonBlock.ProposerIndex)),
+		ParentRoot:    d.hex("block parent root", jsonBlock.ParentRoot),
+		StateRoot:     d.hex("block state root", jsonBlock.StateRoot),
+		Body: &ethpb.BeaconBlockBodyBellatrix{
+			RandaoReveal:      d.hex("randao reveal", jsonBlock.Body.RandaoReveal),
+			Eth1Data:          d.eth1Data(jsonBlock.Body.Eth1Data),
+			Graffiti:          d.hex("graffiti", jsonBlock.Body.Graffiti),
+			ProposerSlashings: d.proposerSlashings(jsonBlock.Body.ProposerSlashings),
+			AttesterSlashings: d.attesterSlashings(jsonBlock.Body.AttesterSlashings),
+			Attestations:      d.attestations(jsonBlock.Body.Attestations),
+			Deposits:          d.deposits(jsonBlock.Body.Deposits),
+			VoluntaryExits:    d.signedVoluntaryExits(jsonBlock.Body.VoluntaryExits),
+			SyncAggregate:     d.syncAggregate(jsonBlock.Body.SyncAggregate),
+			ExecutionPayload:  d.executionPayload(jsonBlock.Body.ExecutionPayload),
+		},
+	}
+}
@@ -0,0 +1,44 @@
+//go:build use_beacon_api
+// +build use_beacon_api
+
+package beacon_api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/zond/beacon-chain/core/signing"
+	"github.com/theQRL/zond/common/hexutil"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	"github.com/theQRL/zond/network/forks"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+)
+
+func (c *beaconApiValidatorClient) getDomainData(ctx context.Context, epoch types.Epoch, domainType [signing.DomainByteLength]byte) (*ethpb.DomainResponse, error) {
+	// Get the fork version from the given epoch
+	fork, err := forks.Fork(epoch)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get fork version for epoch %d", epoch)
+	}
+
+	genesis, _, err := c.getGenesis(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get genesis info")
+	}
+
+	if !validRoot(genesis.GenesisValidatorsRoot) {
+		return nil, errors.Errorf("invalid genesis validators root: %s", genesis.GenesisValidatorsRoot)
+	}
+
+	genesisValidatorRoot, err := hexutil.Decode(genesis.GenesisValidatorsRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode genesis validators root")
+	}
+
+	signatureDomain, err := signing.ComputeDomain(domainType, fork.CurrentVersion, genesisValidatorRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute signature domain")
+	}
+
+	return &ethpb.DomainResponse{SignatureDomain: signatureDomain}, nil
+}
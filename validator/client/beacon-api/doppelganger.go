@@ -0,0 +1,147 @@
+//go:build use_beacon_api
+// +build use_beacon_api
+
+package beacon_api
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/zond/common/hexutil"
+	"github.com/theQRL/zond/config/params"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+	"github.com/theQRL/zond/time/slots"
+)
+
+type livenessResponseJson struct {
+	Data []*struct {
+		Index  string `json:"index"`
+		IsLive bool   `json:"is_live"`
+	} `json:"data"`
+}
+
+// checkDoppelGanger mirrors the gRPC doppelganger check with the liveness endpoint. A validator whose
+// last signed epoch is old enough is reported as a duplicate if it was seen live in any of the last
+// three epochs, since those attestations can't come from this validator client.
+func (c *beaconApiValidatorClient) checkDoppelGanger(ctx context.Context, in *ethpb.DoppelGangerRequest) (*ethpb.DoppelGangerResponse, error) {
+	resp := &ethpb.DoppelGangerResponse{
+		Responses: make([]*ethpb.DoppelGangerResponse_ValidatorResponse, len(in.ValidatorRequests)),
+	}
+	for i, v := range in.ValidatorRequests {
+		resp.Responses[i] = &ethpb.DoppelGangerResponse_ValidatorResponse{
+			PublicKey:       v.PublicKey,
+			DuplicateExists: false,
+		}
+	}
+	if len(in.ValidatorRequests) == 0 {
+		return resp, nil
+	}
+
+	currentEpoch, err := c.currentEpoch(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get current epoch")
+	}
+	// Liveness is not tracked before Altair.
+	if currentEpoch < params.BeaconConfig().AltairForkEpoch {
+		log.Info("Skipping doppelganger check for Phase 0")
+		return resp, nil
+	}
+
+	// If the validator's last recorded epoch was less than 2 epochs ago, we can't tell
+	// its own attestations apart from those of a doppelganger.
+	pubkeys := make([][]byte, 0, len(in.ValidatorRequests))
+	for _, v := range in.ValidatorRequests {
+		if v.Epoch+2 < currentEpoch {
+			pubkeys = append(pubkeys, v.PublicKey)
+		}
+	}
+	if len(pubkeys) == 0 {
+		return resp, nil
+	}
+
+	stateValidators, err := c.getStateValidators(ctx, pubkeys, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get state validators")
+	}
+	indexByPubkey := make(map[string]types.ValidatorIndex, len(stateValidators))
+	indices := make([]types.ValidatorIndex, 0, len(stateValidators))
+	for _, stateValidator := range stateValidators {
+		index, err := strconv.ParseUint(stateValidator.Index, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse validator index `%s`", stateValidator.Index)
+		}
+		indexByPubkey[stateValidator.Validator.PublicKey] = types.ValidatorIndex(index)
+		indices = append(indices, types.ValidatorIndex(index))
+	}
+	if len(indices) == 0 {
+		return resp, nil
+	}
+
+	// Any validator left at this point has a last recorded epoch more than 2 epochs ago, so
+	// the current epoch is at least 3.
+	live := make(map[types.ValidatorIndex]bool)
+	for epoch := currentEpoch.Sub(2); epoch <= currentEpoch; epoch++ {
+		if epoch < params.BeaconConfig().AltairForkEpoch {
+			continue
+		}
+		if err := c.getLiveness(ctx, epoch, indices, live); err != nil {
+			return nil, errors.Wrapf(err, "failed to get liveness for epoch `%d`", epoch)
+		}
+	}
+
+	for i, v := range in.ValidatorRequests {
+		if v.Epoch+2 >= currentEpoch {
+			continue
+		}
+		index, ok := indexByPubkey[hexutil.Encode(v.PublicKey)]
+		if !ok {
+			continue
+		}
+		if live[index] {
+			log.WithField("validatorIndex", index).Info("Validator was seen live on the network")
+			resp.Responses[i].DuplicateExists = true
+		}
+	}
+	return resp, nil
+}
+
+// getLiveness marks in live the given validators which were live in the given epoch.
+func (c *beaconApiValidatorClient) getLiveness(ctx context.Context, epoch types.Epoch, indices []types.ValidatorIndex, live map[types.ValidatorIndex]bool) error {
+	body, err := marshalJsonBody(validatorIndicesToStrings(indices))
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal validator indices")
+	}
+	livenessJson := &livenessResponseJson{}
+	if _, err := c.jsonRestHandler.PostRestJson(ctx, fmt.Sprintf("/eth/v1/validator/liveness/%d", epoch), nil, body, livenessJson); err != nil {
+		return errors.Wrap(err, "failed to send POST data to REST endpoint")
+	}
+	for _, liveness := range livenessJson.Data {
+		if liveness == nil {
+			return errors.New("liveness data is nil")
+		}
+		index, err := strconv.ParseUint(liveness.Index, 10, 64)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse validator index `%s`", liveness.Index)
+		}
+		if liveness.IsLive {
+			live[types.ValidatorIndex(index)] = true
+		}
+	}
+	return nil
+}
+
+// currentEpoch returns the current epoch according to the genesis time of the beacon node.
+func (c *beaconApiValidatorClient) currentEpoch(ctx context.Context) (types.Epoch, error) {
+	genesis, _, err := c.getGenesis(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get genesis")
+	}
+	genesisTime, err := strconv.ParseUint(genesis.GenesisTime, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to parse genesis time `%s`", genesis.GenesisTime)
+	}
+	return slots.ToEpoch(slots.CurrentSlot(genesisTime)), nil
+}
@@ -0,0 +1,293 @@
+//go:build use_beacon_api
+// +build use_beacon_api
+
+package beacon_api
+
+import (
+	"context"
+	"fmt"
+	neturl "net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+	rpcmiddleware "github.com/theQRL/zond/beacon-chain/rpc/apimiddleware"
+	"github.com/theQRL/zond/common/hexutil"
+	"github.com/theQRL/zond/config/params"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+)
+
+type validatorForDuty struct {
+	pubkey []byte
+	index  types.ValidatorIndex
+	status ethpb.ValidatorStatus
+	known  bool
+}
+
+func (c *beaconApiValidatorClient) getDuties(ctx context.Context, in *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
+	validators, err := c.getValidatorsForDuties(ctx, in.PublicKeys)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get validators for duties")
+	}
+
+	currentEpochDuties, err := c.getDutiesForEpoch(ctx, in.Epoch, validators)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get duties for current epoch `%d`", in.Epoch)
+	}
+
+	nextEpochDuties, err := c.getDutiesForEpoch(ctx, in.Epoch+1, validators)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get duties for next epoch `%d`", in.Epoch+1)
+	}
+
+	return &ethpb.DutiesResponse{
+		CurrentEpochDuties: currentEpochDuties,
+		NextEpochDuties:    nextEpochDuties,
+	}, nil
+}
+
+func (c *beaconApiValidatorClient) getValidatorsForDuties(ctx context.Context, pubkeys [][]byte) ([]validatorForDuty, error) {
+	validators := make([]validatorForDuty, len(pubkeys))
+	if len(pubkeys) == 0 {
+		return validators, nil
+	}
+
+	stateValidators, err := c.getStateValidators(ctx, pubkeys, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get state validators")
+	}
+
+	validatorsByPubkey := make(map[string]*rpcmiddleware.ValidatorContainerJson, len(stateValidators))
+	for _, stateValidator := range stateValidators {
+		validatorsByPubkey[stateValidator.Validator.PublicKey] = stateValidator
+	}
+
+	for i, pubkey := range pubkeys {
+		validators[i] = validatorForDuty{
+			pubkey: pubkey,
+			status: ethpb.ValidatorStatus_UNKNOWN_STATUS,
+		}
+
+		stateValidator, ok := validatorsByPubkey[hexutil.Encode(pubkey)]
+		if !ok {
+			continue
+		}
+
+		index, err := strconv.ParseUint(stateValidator.Index, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse validator index `%s`", stateValidator.Index)
+		}
+
+		status, ok := beaconAPITogRPCValidatorStatus[stateValidator.Status]
+		if !ok {
+			return nil, errors.Errorf("invalid validator status `%s`", stateValidator.Status)
+		}
+
+		validators[i].index = types.ValidatorIndex(index)
+		validators[i].status = status
+		validators[i].known = true
+	}
+
+	return validators, nil
+}
+
+func (c *beaconApiValidatorClient) getDutiesForEpoch(ctx context.Context, epoch types.Epoch, validators []validatorForDuty) ([]*ethpb.DutiesResponse_Duty, error) {
+	indices := make([]types.ValidatorIndex, 0, len(validators))
+	for _, validator := range validators {
+		if validator.known {
+			indices = append(indices, validator.index)
+		}
+	}
+
+	attesterDuties := make(map[types.ValidatorIndex]*rpcmiddleware.AttesterDutyJson)
+	proposerSlots := make(map[types.ValidatorIndex][]types.Slot)
+	syncCommitteeMembers := make(map[types.ValidatorIndex]bool)
+	committees := make(map[string][]types.ValidatorIndex)
+
+	if len(indices) > 0 {
+		attesterDutiesJson, err := c.getAttesterDuties(ctx, epoch, indices)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get attester duties")
+		}
+		for _, attesterDuty := range attesterDutiesJson {
+			index, err := strconv.ParseUint(attesterDuty.ValidatorIndex, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse attester validator index `%s`", attesterDuty.ValidatorIndex)
+			}
+			attesterDuties[types.ValidatorIndex(index)] = attesterDuty
+		}
+
+		proposerDutiesJson, err := c.getProposerDuties(ctx, epoch)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get proposer duties")
+		}
+		for _, proposerDuty := range proposerDutiesJson {
+			d := &jsonDecoder{}
+			index := types.ValidatorIndex(d.uint64("proposer validator index", proposerDuty.ValidatorIndex))
+			slot := types.Slot(d.uint64("proposer slot", proposerDuty.Slot))
+			if d.err != nil {
+				return nil, d.err
+			}
+			proposerSlots[index] = append(proposerSlots[index], slot)
+		}
+
+		if epoch >= params.BeaconConfig().AltairForkEpoch {
+			syncDutiesJson, err := c.getSyncCommitteeDuties(ctx, epoch, indices)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get sync committee duties")
+			}
+			for _, syncDuty := range syncDutiesJson {
+				index, err := strconv.ParseUint(syncDuty.ValidatorIndex, 10, 64)
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to parse sync committee validator index `%s`", syncDuty.ValidatorIndex)
+				}
+				syncCommitteeMembers[types.ValidatorIndex(index)] = true
+			}
+		}
+
+		if len(attesterDuties) > 0 {
+			committeesJson, err := c.getStateCommittees(ctx, epoch)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to get committees")
+			}
+			for _, committee := range committeesJson {
+				d := &jsonDecoder{}
+				members := d.uint64Array("committee validator index", committee.Validators)
+				if d.err != nil {
+					return nil, d.err
+				}
+				committeeValidators := make([]types.ValidatorIndex, len(members))
+				for i, member := range members {
+					committeeValidators[i] = types.ValidatorIndex(member)
+				}
+				committees[committeeKey(committee.Slot, committee.Index)] = committeeValidators
+			}
+		}
+	}
+
+	duties := make([]*ethpb.DutiesResponse_Duty, len(validators))
+	for i, validator := range validators {
+		duty := &ethpb.DutiesResponse_Duty{
+			PublicKey: validator.pubkey,
+			Status:    validator.status,
+		}
+		duties[i] = duty
+
+		if !validator.known {
+			continue
+		}
+
+		duty.ValidatorIndex = validator.index
+		duty.ProposerSlots = proposerSlots[validator.index]
+		duty.IsSyncCommittee = syncCommitteeMembers[validator.index]
+
+		attesterDuty, ok := attesterDuties[validator.index]
+		if !ok {
+			continue
+		}
+
+		d := &jsonDecoder{}
+		duty.AttesterSlot = types.Slot(d.uint64("attester slot", attesterDuty.Slot))
+		duty.CommitteeIndex = types.CommitteeIndex(d.uint64("committee index", attesterDuty.CommitteeIndex))
+		if d.err != nil {
+			return nil, d.err
+		}
+
+		committee, ok := committees[committeeKey(attesterDuty.Slot, attesterDuty.CommitteeIndex)]
+		if !ok {
+			return nil, errors.Errorf("failed to find committee %s for slot %s", attesterDuty.CommitteeIndex, attesterDuty.Slot)
+		}
+		duty.Committee = committee
+	}
+
+	return duties, nil
+}
+
+// getAttesterDuties returns the attester duties of the given validators for the given epoch.
+func (c *beaconApiValidatorClient) getAttesterDuties(ctx context.Context, epoch types.Epoch, indices []types.ValidatorIndex) ([]*rpcmiddleware.AttesterDutyJson, error) {
+	body, err := marshalJsonBody(validatorIndicesToStrings(indices))
+	if err != nil {
+		return nil, err
+	}
+
+	attesterDutiesJson := &rpcmiddleware.AttesterDutiesResponseJson{}
+	if _, err := c.jsonRestHandler.PostRestJson(ctx, fmt.Sprintf("/zond/v1/validator/duties/attester/%d", epoch), nil, body, attesterDutiesJson); err != nil {
+		return nil, errors.Wrap(err, "failed to send POST data to REST endpoint")
+	}
+
+	for index, attesterDuty := range attesterDutiesJson.Data {
+		if attesterDuty == nil {
+			return nil, errors.Errorf("attester duty at index `%d` is nil", index)
+		}
+	}
+
+	return attesterDutiesJson.Data, nil
+}
+
+// getProposerDuties returns the proposer duties of all the validators for the given epoch.
+func (c *beaconApiValidatorClient) getProposerDuties(ctx context.Context, epoch types.Epoch) ([]*rpcmiddleware.ProposerDutyJson, error) {
+	proposerDutiesJson := &rpcmiddleware.ProposerDutiesResponseJson{}
+	if _, err := c.jsonRestHandler.GetRestJsonResponse(ctx, fmt.Sprintf("/zond/v1/validator/duties/proposer/%d", epoch), proposerDutiesJson); err != nil {
+		return nil, errors.Wrap(err, "failed to get json response")
+	}
+
+	for index, proposerDuty := range proposerDutiesJson.Data {
+		if proposerDuty == nil {
+			return nil, errors.Errorf("proposer duty at index `%d` is nil", index)
+		}
+	}
+
+	return proposerDutiesJson.Data, nil
+}
+
+// getSyncCommitteeDuties returns the sync committee duties of the given validators for the given epoch.
+func (c *beaconApiValidatorClient) getSyncCommitteeDuties(ctx context.Context, epoch types.Epoch, indices []types.ValidatorIndex) ([]*rpcmiddleware.SyncCommitteeDuty, error) {
+	body, err := marshalJsonBody(validatorIndicesToStrings(indices))
+	if err != nil {
+		return nil, err
+	}
+
+	syncDutiesJson := &rpcmiddleware.SyncCommitteeDutiesResponseJson{}
+	if _, err := c.jsonRestHandler.PostRestJson(ctx, fmt.Sprintf("/zond/v1/validator/duties/sync/%d", epoch), nil, body, syncDutiesJson); err != nil {
+		return nil, errors.Wrap(err, "failed to send POST data to REST endpoint")
+	}
+
+	for index, syncDuty := range syncDutiesJson.Data {
+		if syncDuty == nil {
+			return nil, errors.Errorf("sync committee duty at index `%d` is nil", index)
+		}
+	}
+
+	return syncDutiesJson.Data, nil
+}
+
+// getStateCommittees returns all the committees of the head state for the given epoch.
+func (c *beaconApiValidatorClient) getStateCommittees(ctx context.Context, epoch types.Epoch) ([]*rpcmiddleware.CommitteeJson, error) {
+	params := neturl.Values{}
+	params.Add("epoch", uint64ToString(epoch))
+
+	committeesJson := &rpcmiddleware.StateCommitteesResponseJson{}
+	if _, err := c.jsonRestHandler.GetRestJsonResponse(ctx, buildURL("/zond/v1/beacon/states/head/committees", params), committeesJson); err != nil {
+		return nil, errors.Wrap(err, "failed to get json response")
+	}
+
+	for index, committee := range committeesJson.Data {
+		if committee == nil {
+			return nil, errors.Errorf("committee at index `%d` is nil", index)
+		}
+	}
+
+	return committeesJson.Data, nil
+}
+
+func committeeKey(slot string, committeeIndex string) string {
+	return slot + "/" + committeeIndex
+}
+
+func validatorIndicesToStrings(indices []types.ValidatorIndex) []string {
+	stringIndices := make([]string, len(indices))
+	for i, index := range indices {
+		stringIndices[i] = uint64ToString(index)
+	}
+	return stringIndices
+}
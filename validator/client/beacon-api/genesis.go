@@ -0,0 +1,91 @@
+//go:build use_beacon_api
+// +build use_beacon_api
+
+package beacon_api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/zond/api/gateway/apimiddleware"
+	rpcmiddleware "github.com/theQRL/zond/beacon-chain/rpc/apimiddleware"
+	"github.com/theQRL/zond/common/hexutil"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+	"google.golang.org/grpc"
+)
+
+// chainStartPollingInterval is how long we wait between two genesis queries while the chain hasn't started yet.
+var chainStartPollingInterval = time.Second
+
+func (c *beaconApiValidatorClient) getGenesis(ctx context.Context) (*rpcmiddleware.GenesisResponse_GenesisJson, *apimiddleware.DefaultErrorJson, error) {
+	genesisJson := &rpcmiddleware.GenesisResponseJson{}
+	errorJson, err := c.jsonRestHandler.GetRestJsonResponse(ctx, "/zond/v1/beacon/genesis", genesisJson)
+	if err != nil {
+		return nil, errorJson, errors.Wrap(err, "failed to get json response")
+	}
+
+	if genesisJson.Data == nil {
+		return nil, nil, errors.New("genesis data is nil")
+	}
+
+	return genesisJson.Data, nil, nil
+}
+
+// waitForChainStartStream mimics the gRPC WaitForChainStart stream by polling the genesis endpoint
+// until the beacon node knows about the chain start.
+type waitForChainStartStream struct {
+	grpc.ClientStream
+	ctx             context.Context
+	validatorClient *beaconApiValidatorClient
+}
+
+func (c *beaconApiValidatorClient) waitForChainStart(ctx context.Context) (ethpb.BeaconNodeValidator_WaitForChainStartClient, error) {
+	return &waitForChainStartStream{
+		ctx:             ctx,
+		validatorClient: c,
+	}, nil
+}
+
+func (s *waitForChainStartStream) Recv() (*ethpb.ChainStartResponse, error) {
+	for {
+		genesis, errorJson, err := s.validatorClient.getGenesis(s.ctx)
+		if err == nil {
+			return genesisToChainStartResponse(genesis)
+		}
+		// A 404 means that the chain hasn't started yet, so we keep polling.
+		if errorJson == nil || errorJson.Code != http.StatusNotFound {
+			return nil, errors.Wrap(err, "failed to get genesis data")
+		}
+
+		select {
+		case <-s.ctx.Done():
+			return nil, errors.Wrap(s.ctx.Err(), "context canceled while waiting for chain start")
+		case <-time.After(chainStartPollingInterval):
+		}
+	}
+}
+
+func genesisToChainStartResponse(genesis *rpcmiddleware.GenesisResponse_GenesisJson) (*ethpb.ChainStartResponse, error) {
+	genesisTime, err := strconv.ParseUint(genesis.GenesisTime, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse genesis time `%s`", genesis.GenesisTime)
+	}
+
+	if !validRoot(genesis.GenesisValidatorsRoot) {
+		return nil, errors.Errorf("invalid genesis validators root `%s`", genesis.GenesisValidatorsRoot)
+	}
+
+	genesisValidatorsRoot, err := hexutil.Decode(genesis.GenesisValidatorsRoot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode genesis validators root `%s`", genesis.GenesisValidatorsRoot)
+	}
+
+	return &ethpb.ChainStartResponse{
+		Started:               true,
+		GenesisTime:           genesisTime,
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	}, nil
+}
@@ -0,0 +1,105 @@
+//go:build use_beacon_api
+// +build use_beacon_api
+
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/zond/api/gateway/apimiddleware"
+)
+
+type jsonRestHandler interface {
+	GetRestJsonResponse(ctx context.Context, query string, responseJson interface{}) (*apimiddleware.DefaultErrorJson, error)
+	PostRestJson(ctx context.Context, apiEndpoint string, headers map[string]string, data *bytes.Buffer, responseJson interface{}) (*apimiddleware.DefaultErrorJson, error)
+}
+
+type beaconApiJsonRestHandler struct {
+	httpClient http.Client
+	host       string
+}
+
+// GetRestJsonResponse sends a GET requests to apiEndpoint and decodes the response body as a JSON object into responseJson.
+// If an HTTP error is returned, the body is decoded as a DefaultErrorJson JSON object instead and returned as the first return value.
+func (c beaconApiJsonRestHandler) GetRestJsonResponse(ctx context.Context, apiEndpoint string, responseJson interface{}) (*apimiddleware.DefaultErrorJson, error) {
+	if responseJson == nil {
+		return nil, errors.New("responseJson is nil")
+	}
+
+	url := c.host + apiEndpoint
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create request for endpoint %s", url)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query REST API %s", url)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+
+	return decodeJsonResp(resp, responseJson)
+}
+
+// PostRestJson sends a POST requests to apiEndpoint with the provided headers and data.
+// If responseJson is not nil, the response body is decoded as a JSON object into it.
+// If an HTTP error is returned, the body is decoded as a DefaultErrorJson JSON object instead and returned as the first return value.
+func (c beaconApiJsonRestHandler) PostRestJson(ctx context.Context, apiEndpoint string, headers map[string]string, data *bytes.Buffer, responseJson interface{}) (*apimiddleware.DefaultErrorJson, error) {
+	if data == nil {
+		return nil, errors.New("POST data is nil")
+	}
+
+	url := c.host + apiEndpoint
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create request for endpoint %s", url)
+	}
+
+	for headerKey, headerValue := range headers {
+		req.Header.Set(headerKey, headerValue)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to send POST data to REST endpoint %s", url)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+
+	return decodeJsonResp(resp, responseJson)
+}
+
+func decodeJsonResp(resp *http.Response, responseJson interface{}) (*apimiddleware.DefaultErrorJson, error) {
+	decoder := json.NewDecoder(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		errorJson := &apimiddleware.DefaultErrorJson{}
+		if err := decoder.Decode(errorJson); err != nil {
+			return &apimiddleware.DefaultErrorJson{Code: resp.StatusCode}, errors.Wrapf(err, "failed to decode error json for %s (status code %d)", resp.Request.URL, resp.StatusCode)
+		}
+		if errorJson.Code == 0 {
+			errorJson.Code = resp.StatusCode
+		}
+		return errorJson, errors.Errorf("error %d: %s", errorJson.Code, errorJson.Message)
+	}
+
+	if responseJson != nil {
+		if err := decoder.Decode(responseJson); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode response json for %s", resp.Request.URL)
+		}
+	}
+
+	return nil, nil
+}
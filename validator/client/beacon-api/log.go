@@ -0,0 +1,8 @@
+//go:build use_beacon_api
+// +build use_beacon_api
+
+package beacon_api
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "beacon-api")
@@ -0,0 +1,33 @@
+//go:build use_beacon_api
+// +build use_beacon_api
+
+package beacon_api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+)
+
+func (c *beaconApiValidatorClient) proposeExit(ctx context.Context, signedVoluntaryExit *ethpb.SignedVoluntaryExit) (*ethpb.ProposeExitResponse, error) {
+	if signedVoluntaryExit == nil || signedVoluntaryExit.Exit == nil {
+		return nil, errors.New("signed voluntary exit is nil")
+	}
+
+	body, err := marshalJsonBody(jsonifySignedVoluntaryExit(signedVoluntaryExit))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.jsonRestHandler.PostRestJson(ctx, "/zond/v1/beacon/pool/voluntary_exits", nil, body, nil); err != nil {
+		return nil, errors.Wrap(err, "failed to send POST data to REST endpoint")
+	}
+
+	exitRoot, err := signedVoluntaryExit.Exit.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute exit root")
+	}
+
+	return &ethpb.ProposeExitResponse{ExitRoot: exitRoot[:]}, nil
+}
@@ -0,0 +1,63 @@
+//go:build use_beacon_api
+// +build use_beacon_api
+
+package beacon_api
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	rpcmiddleware "github.com/theQRL/zond/beacon-chain/rpc/apimiddleware"
+	"github.com/theQRL/zond/common/hexutil"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+)
+
+func (c *beaconApiValidatorClient) prepareBeaconProposer(ctx context.Context, recipients []*ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer) error {
+	jsonRecipients := make([]*rpcmiddleware.FeeRecipientJson, len(recipients))
+	for i, recipient := range recipients {
+		jsonRecipients[i] = &rpcmiddleware.FeeRecipientJson{
+			ValidatorIndex: uint64ToString(recipient.ValidatorIndex),
+			FeeRecipient:   hexutil.Encode(recipient.FeeRecipient),
+		}
+	}
+
+	body, err := marshalJsonBody(jsonRecipients)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.jsonRestHandler.PostRestJson(ctx, "/zond/v1/validator/prepare_beacon_proposer", nil, body, nil); err != nil {
+		return errors.Wrap(err, "failed to send POST data to REST endpoint")
+	}
+
+	return nil
+}
+
+func (c *beaconApiValidatorClient) submitValidatorRegistrations(ctx context.Context, registrations []*ethpb.SignedValidatorRegistrationV1) error {
+	jsonRegistrations := make([]*rpcmiddleware.SignedValidatorRegistrationJson, len(registrations))
+	for i, registration := range registrations {
+		if registration == nil || registration.Message == nil {
+			return errors.Errorf("validator registration at index `%d` is nil", i)
+		}
+		jsonRegistrations[i] = &rpcmiddleware.SignedValidatorRegistrationJson{
+			Message: &rpcmiddleware.ValidatorRegistrationJson{
+				FeeRecipient: hexutil.Encode(registration.Message.FeeRecipient),
+				GasLimit:     uint64ToString(registration.Message.GasLimit),
+				Timestamp:    uint64ToString(registration.Message.Timestamp),
+				Pubkey:       hexutil.Encode(registration.Message.Pubkey),
+			},
+			Signature: hexutil.Encode(registration.Signature),
+		}
+	}
+
+	body, err := marshalJsonBody(jsonRegistrations)
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.jsonRestHandler.PostRestJson(ctx, "/zond/v1/validator/register_validator", nil, body, nil); err != nil {
+		return errors.Wrap(err, "failed to send POST data to REST endpoint")
+	}
+
+	return nil
+}
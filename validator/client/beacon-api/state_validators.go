@@ -0,0 +1,63 @@
+//go:build use_beacon_api
+// +build use_beacon_api
+
+package beacon_api
+
+import (
+	"context"
+	neturl "net/url"
+
+	"github.com/pkg/errors"
+	rpcmiddleware "github.com/theQRL/zond/beacon-chain/rpc/apimiddleware"
+	"github.com/theQRL/zond/common/hexutil"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+)
+
+// getStateValidators returns the head state validators matching either one of the given public keys or indices,
+// optionally filtered by status.
+func (c *beaconApiValidatorClient) getStateValidators(
+	ctx context.Context,
+	pubkeys [][]byte,
+	indices []types.ValidatorIndex,
+	statuses []string,
+) ([]*rpcmiddleware.ValidatorContainerJson, error) {
+	params := neturl.Values{}
+
+	// Deduplicate the ids since the same validator may be requested by both its public key and its index
+	seenIds := make(map[string]bool)
+	for _, pubkey := range pubkeys {
+		id := hexutil.Encode(pubkey)
+		if !seenIds[id] {
+			seenIds[id] = true
+			params.Add("id", id)
+		}
+	}
+	for _, index := range indices {
+		id := uint64ToString(index)
+		if !seenIds[id] {
+			seenIds[id] = true
+			params.Add("id", id)
+		}
+	}
+	for _, status := range statuses {
+		params.Add("status", status)
+	}
+
+	url := buildURL("/zond/v1/beacon/states/head/validators", params)
+	stateValidatorsJson := &rpcmiddleware.StateValidatorsResponseJson{}
+	if _, err := c.jsonRestHandler.GetRestJsonResponse(ctx, url, stateValidatorsJson); err != nil {
+		return nil, errors.Wrap(err, "failed to get json response")
+	}
+
+	if stateValidatorsJson.Data == nil {
+		return nil, errors.New("stateValidatorsJson.Data is nil")
+	}
+
+	for i, validatorContainer := range stateValidatorsJson.Data {
+		if validatorContainer == nil || validatorContainer.Validator == nil {
+			return nil, errors.Errorf("validator container at index %d is nil", i)
+		}
+	}
+
+	return stateValidatorsJson.Data, nil
+}
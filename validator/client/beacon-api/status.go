@@ -0,0 +1,226 @@
+//go:build use_beacon_api
+// +build use_beacon_api
+
+package beacon_api
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	rpcmiddleware "github.com/theQRL/zond/beacon-chain/rpc/apimiddleware"
+	"github.com/theQRL/zond/common/hexutil"
+	"github.com/theQRL/zond/config/params"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// nonexistentIndex is the index reported for the validators that are not in the beacon state yet.
+const nonexistentIndex = types.ValidatorIndex(^uint64(0))
+
+var beaconAPITogRPCValidatorStatus = map[string]ethpb.ValidatorStatus{
+	"pending_initialized": ethpb.ValidatorStatus_DEPOSITED,
+	"pending_queued":      ethpb.ValidatorStatus_PENDING,
+	"active_ongoing":      ethpb.ValidatorStatus_ACTIVE,
+	"active_exiting":      ethpb.ValidatorStatus_EXITING,
+	"active_slashed":      ethpb.ValidatorStatus_SLASHING,
+	"exited_unslashed":    ethpb.ValidatorStatus_EXITED,
+	"exited_slashed":      ethpb.ValidatorStatus_EXITED,
+	"withdrawal_possible": ethpb.ValidatorStatus_EXITED,
+	"withdrawal_done":     ethpb.ValidatorStatus_EXITED,
+}
+
+func (c *beaconApiValidatorClient) validatorStatus(ctx context.Context, in *ethpb.ValidatorStatusRequest) (*ethpb.ValidatorStatusResponse, error) {
+	_, _, validatorsStatusResponse, err := c.getValidatorsStatusResponse(ctx, [][]byte{in.PublicKey}, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get validator status response")
+	}
+
+	if len(validatorsStatusResponse) != 1 {
+		return nil, errors.New("number of validator status responses not expected")
+	}
+
+	return validatorsStatusResponse[0], nil
+}
+
+func (c *beaconApiValidatorClient) multipleValidatorStatus(ctx context.Context, in *ethpb.MultipleValidatorStatusRequest) (*ethpb.MultipleValidatorStatusResponse, error) {
+	publicKeys, indices, statuses, err := c.getValidatorsStatusResponse(ctx, in.PublicKeys, in.Indices)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get validators status response")
+	}
+
+	return &ethpb.MultipleValidatorStatusResponse{
+		PublicKeys: publicKeys,
+		Statuses:   statuses,
+		Indices:    indices,
+	}, nil
+}
+
+func (c *beaconApiValidatorClient) validatorIndex(ctx context.Context, in *ethpb.ValidatorIndexRequest) (*ethpb.ValidatorIndexResponse, error) {
+	stateValidators, err := c.getStateValidators(ctx, [][]byte{in.PublicKey}, nil, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get state validator")
+	}
+
+	// Report a missing validator as the gRPC validator server does, so that callers can tell it apart.
+	if len(stateValidators) == 0 {
+		return nil, status.Errorf(codes.NotFound, "could not find validator index for public key `%#x`", in.PublicKey)
+	}
+
+	d := &jsonDecoder{}
+	index := d.uint64("validator index", stateValidators[0].Index)
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	return &ethpb.ValidatorIndexResponse{Index: types.ValidatorIndex(index)}, nil
+}
+
+// getValidatorsStatusResponse returns the public keys, indices and statuses of the validators matching either one of
+// inPubKeys or inIndices. The requested public keys come first and in the same order, followed by the validators
+// that were only requested by index. Public keys that are not in the beacon state are reported with an unknown status.
+func (c *beaconApiValidatorClient) getValidatorsStatusResponse(ctx context.Context, inPubKeys [][]byte, inIndices []int64) (
+	[][]byte,
+	[]types.ValidatorIndex,
+	[]*ethpb.ValidatorStatusResponse,
+	error,
+) {
+	indices := make([]types.ValidatorIndex, len(inIndices))
+	for i, index := range inIndices {
+		if index < 0 {
+			return nil, nil, nil, errors.Errorf("invalid validator index %d", index)
+		}
+		indices[i] = types.ValidatorIndex(index)
+	}
+
+	outPubKeys := make([][]byte, 0, len(inPubKeys)+len(inIndices))
+	outIndices := make([]types.ValidatorIndex, 0, len(inPubKeys)+len(inIndices))
+	outStatuses := make([]*ethpb.ValidatorStatusResponse, 0, len(inPubKeys)+len(inIndices))
+
+	// Without any filter, the beacon node would return the whole validator registry
+	if len(inPubKeys) == 0 && len(indices) == 0 {
+		return outPubKeys, outIndices, outStatuses, nil
+	}
+
+	stateValidators, err := c.getStateValidators(ctx, inPubKeys, indices, nil)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to get state validators")
+	}
+
+	var activationQueue []*rpcmiddleware.ValidatorContainerJson
+	validatorsByPubkey := make(map[string]*rpcmiddleware.ValidatorContainerJson, len(stateValidators))
+	for _, stateValidator := range stateValidators {
+		validatorsByPubkey[stateValidator.Validator.PublicKey] = stateValidator
+		if beaconAPITogRPCValidatorStatus[stateValidator.Status] == ethpb.ValidatorStatus_PENDING && activationQueue == nil {
+			activationQueue, err = c.getActivationQueue(ctx)
+			if err != nil {
+				return nil, nil, nil, errors.Wrap(err, "failed to get activation queue")
+			}
+		}
+	}
+
+	seenPubkeys := make(map[string]bool, len(stateValidators))
+	for _, pubkey := range inPubKeys {
+		hexPubkey := hexutil.Encode(pubkey)
+		seenPubkeys[hexPubkey] = true
+
+		stateValidator, ok := validatorsByPubkey[hexPubkey]
+		if !ok {
+			outPubKeys = append(outPubKeys, pubkey)
+			outIndices = append(outIndices, nonexistentIndex)
+			outStatuses = append(outStatuses, &ethpb.ValidatorStatusResponse{
+				Status:          ethpb.ValidatorStatus_UNKNOWN_STATUS,
+				ActivationEpoch: params.BeaconConfig().FarFutureEpoch,
+			})
+			continue
+		}
+
+		index, status, err := validatorContainerToStatusResponse(stateValidator, activationQueue)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		outPubKeys = append(outPubKeys, pubkey)
+		outIndices = append(outIndices, index)
+		outStatuses = append(outStatuses, status)
+	}
+
+	for _, stateValidator := range stateValidators {
+		if seenPubkeys[stateValidator.Validator.PublicKey] {
+			continue
+		}
+		seenPubkeys[stateValidator.Validator.PublicKey] = true
+
+		pubkey, err := hexutil.Decode(stateValidator.Validator.PublicKey)
+		if err != nil {
+			return nil, nil, nil, errors.Wrapf(err, "failed to decode validator public key `%s`", stateValidator.Validator.PublicKey)
+		}
+
+		index, status, err := validatorContainerToStatusResponse(stateValidator, activationQueue)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		outPubKeys = append(outPubKeys, pubkey)
+		outIndices = append(outIndices, index)
+		outStatuses = append(outStatuses, status)
+	}
+
+	return outPubKeys, outIndices, outStatuses, nil
+}
+
+// getActivationQueue returns the validators waiting for their activation, in the order in which they will be activated.
+func (c *beaconApiValidatorClient) getActivationQueue(ctx context.Context) ([]*rpcmiddleware.ValidatorContainerJson, error) {
+	queue, err := c.getStateValidators(ctx, nil, nil, []string{"pending_queued"})
+	if err != nil {
+		return nil, err
+	}
+
+	d := &jsonDecoder{}
+	sort.SliceStable(queue, func(i, j int) bool {
+		eligibilityI := d.uint64("activation eligibility epoch", queue[i].Validator.ActivationEligibilityEpoch)
+		eligibilityJ := d.uint64("activation eligibility epoch", queue[j].Validator.ActivationEligibilityEpoch)
+		if eligibilityI != eligibilityJ {
+			return eligibilityI < eligibilityJ
+		}
+		return d.uint64("validator index", queue[i].Index) < d.uint64("validator index", queue[j].Index)
+	})
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	return queue, nil
+}
+
+func validatorContainerToStatusResponse(
+	stateValidator *rpcmiddleware.ValidatorContainerJson,
+	activationQueue []*rpcmiddleware.ValidatorContainerJson,
+) (types.ValidatorIndex, *ethpb.ValidatorStatusResponse, error) {
+	status, ok := beaconAPITogRPCValidatorStatus[stateValidator.Status]
+	if !ok {
+		return 0, nil, errors.Errorf("invalid validator status `%s`", stateValidator.Status)
+	}
+
+	d := &jsonDecoder{}
+	index := d.uint64("validator index", stateValidator.Index)
+	activationEpoch := d.uint64("activation epoch", stateValidator.Validator.ActivationEpoch)
+	if d.err != nil {
+		return 0, nil, d.err
+	}
+
+	statusResponse := &ethpb.ValidatorStatusResponse{
+		Status:          status,
+		ActivationEpoch: types.Epoch(activationEpoch),
+	}
+
+	if status == ethpb.ValidatorStatus_PENDING {
+		for position, queued := range activationQueue {
+			if queued.Index == stateValidator.Index {
+				statusResponse.PositionInActivationQueue = uint64(position)
+				break
+			}
+		}
+	}
+
+	return types.ValidatorIndex(index), statusResponse, nil
+}
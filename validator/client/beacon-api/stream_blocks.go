@@ -0,0 +1,138 @@
+//go:build use_beacon_api
+// +build use_beacon_api
+
+package beacon_api
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	rpcmiddleware "github.com/theQRL/zond/beacon-chain/rpc/apimiddleware"
+	"github.com/theQRL/zond/config/params"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+	"google.golang.org/grpc"
+)
+
+// streamBlocksAltairClient mimics the gRPC StreamBlocksAltair stream by polling the head block
+// and only returning it once a newer block than the previous one is available.
+type streamBlocksAltairClient struct {
+	grpc.ClientStream
+	ctx             context.Context
+	validatorClient *beaconApiValidatorClient
+	prevBlockSlot   types.Slot
+	sentBlock       bool
+	pollingTime     time.Duration
+}
+
+// signedBlockJson is the signed block of any fork, decoded once we know which fork it belongs to.
+type signedBlockJson struct {
+	Message   json.RawMessage `json:"message"`
+	Signature string          `json:"signature"`
+}
+
+func (c *beaconApiValidatorClient) streamBlocks(ctx context.Context, pollingTime time.Duration) ethpb.BeaconNodeValidator_StreamBlocksAltairClient {
+	return &streamBlocksAltairClient{
+		ctx:             ctx,
+		validatorClient: c,
+		pollingTime:     pollingTime,
+	}
+}
+
+func (c *streamBlocksAltairClient) Recv() (*ethpb.StreamBlocksResponse, error) {
+	for {
+		response, slot, err := c.validatorClient.getHeadSignedBeaconBlock(c.ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get latest signed block")
+		}
+
+		// Only send the block if it's newer than the previous one
+		if !c.sentBlock || slot > c.prevBlockSlot {
+			c.prevBlockSlot = slot
+			c.sentBlock = true
+			return response, nil
+		}
+
+		select {
+		case <-c.ctx.Done():
+			return nil, errors.Wrap(c.ctx.Err(), "context canceled while waiting for a new block")
+		case <-time.After(c.pollingTime):
+		}
+	}
+}
+
+func (c *beaconApiValidatorClient) getHeadSignedBeaconBlock(ctx context.Context) (*ethpb.StreamBlocksResponse, types.Slot, error) {
+	blockJson := &versionedBlockJson{}
+	if _, err := c.jsonRestHandler.GetRestJsonResponse(ctx, "/zond/v2/beacon/blocks/head", blockJson); err != nil {
+		return nil, 0, errors.Wrap(err, "failed to query GET REST endpoint")
+	}
+
+	signedBlock := &signedBlockJson{}
+	if err := json.Unmarshal(blockJson.Data, signedBlock); err != nil {
+		return nil, 0, errors.Wrap(err, "failed to decode signed block json")
+	}
+
+	d := &jsonDecoder{}
+	signature := d.hex("block signature", signedBlock.Signature)
+
+	var response *ethpb.StreamBlocksResponse
+	var slot types.Slot
+	switch blockJson.Version {
+	case "phase0":
+		phase0BlockJson := &rpcmiddleware.BeaconBlockJson{}
+		if err := json.Unmarshal(signedBlock.Message, phase0BlockJson); err != nil {
+			return nil, 0, errors.Wrap(err, "failed to decode phase0 block json")
+		}
+		block := d.phase0BeaconBlock(phase0BlockJson)
+		if d.err != nil {
+			return nil, 0, d.err
+		}
+		slot = block.Slot
+		response = &ethpb.StreamBlocksResponse{
+			Block: &ethpb.StreamBlocksResponse_Phase0Block{
+				Phase0Block: &ethpb.SignedBeaconBlock{Block: block, Signature: signature},
+			},
+		}
+	case "altair":
+		altairBlockJson := &rpcmiddleware.BeaconBlockAltairJson{}
+		if err := json.Unmarshal(signedBlock.Message, altairBlockJson); err != nil {
+			return nil, 0, errors.Wrap(err, "failed to decode altair block json")
+		}
+		block := d.altairBeaconBlock(altairBlockJson)
+		if d.err != nil {
+			return nil, 0, d.err
+		}
+		slot = block.Slot
+		response = &ethpb.StreamBlocksResponse{
+			Block: &ethpb.StreamBlocksResponse_AltairBlock{
+				AltairBlock: &ethpb.SignedBeaconBlockAltair{Block: block, Signature: signature},
+			},
+		}
+	case "bellatrix":
+		bellatrixBlockJson := &rpcmiddleware.BeaconBlockBellatrixJson{}
+		if err := json.Unmarshal(signedBlock.Message, bellatrixBlockJson); err != nil {
+			return nil, 0, errors.Wrap(err, "failed to decode bellatrix block json")
+		}
+		block := d.bellatrixBeaconBlock(bellatrixBlockJson)
+		if d.err != nil {
+			return nil, 0, d.err
+		}
+		slot = block.Slot
+		response = &ethpb.StreamBlocksResponse{
+			Block: &ethpb.StreamBlocksResponse_BellatrixBlock{
+				BellatrixBlock: &ethpb.SignedBeaconBlockBellatrix{Block: block, Signature: signature},
+			},
+		}
+	default:
+		return nil, 0, errors.Errorf("unsupported block version `%s`", blockJson.Version)
+	}
+
+	return response, slot, nil
+}
+
+// blockPollingInterval is how often the head block is polled while streaming blocks.
+func blockPollingInterval() time.Duration {
+	return time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+}
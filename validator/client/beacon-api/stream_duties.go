@@ -0,0 +1,73 @@
+//go:build use_beacon_api
+// +build use_beacon_api
+
+package beacon_api
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+	"github.com/theQRL/zond/time/slots"
+	"google.golang.org/grpc"
+)
+
+// streamDutiesClient mimics the gRPC StreamDuties stream by querying the duties of the requested
+// validators once at the start of every epoch.
+type streamDutiesClient struct {
+	grpc.ClientStream
+	ctx             context.Context
+	validatorClient *beaconApiValidatorClient
+	request         *ethpb.DutiesRequest
+	genesisTime     uint64
+	prevEpoch       types.Epoch
+	sentDuties      bool
+}
+
+func (c *beaconApiValidatorClient) streamDuties(ctx context.Context, in *ethpb.DutiesRequest) (ethpb.BeaconNodeValidator_StreamDutiesClient, error) {
+	genesis, _, err := c.getGenesis(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get genesis")
+	}
+	genesisTime, err := strconv.ParseUint(genesis.GenesisTime, 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse genesis time `%s`", genesis.GenesisTime)
+	}
+	return &streamDutiesClient{
+		ctx:             ctx,
+		validatorClient: c,
+		request:         in,
+		genesisTime:     genesisTime,
+	}, nil
+}
+
+func (c *streamDutiesClient) Recv() (*ethpb.DutiesResponse, error) {
+	epoch := slots.ToEpoch(slots.CurrentSlot(c.genesisTime))
+	if c.sentDuties && epoch <= c.prevEpoch {
+		// Wait for the start of the next epoch.
+		nextEpochStart, err := slots.EpochStart(c.prevEpoch + 1)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to compute start of next epoch")
+		}
+		select {
+		case <-c.ctx.Done():
+			return nil, errors.Wrap(c.ctx.Err(), "context canceled while waiting for the next epoch")
+		case <-time.After(time.Until(slots.StartTime(c.genesisTime, nextEpochStart))):
+		}
+		epoch = slots.ToEpoch(slots.CurrentSlot(c.genesisTime))
+	}
+
+	duties, err := c.validatorClient.getDuties(c.ctx, &ethpb.DutiesRequest{
+		Epoch:      epoch,
+		PublicKeys: c.request.PublicKeys,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get duties for epoch `%d`", epoch)
+	}
+	c.prevEpoch = epoch
+	c.sentDuties = true
+	return duties, nil
+}
@@ -0,0 +1,160 @@
+//go:build use_beacon_api
+// +build use_beacon_api
+
+package beacon_api
+
+import (
+	"context"
+	neturl "net/url"
+
+	"github.com/pkg/errors"
+	rpcmiddleware "github.com/theQRL/zond/beacon-chain/rpc/apimiddleware"
+	"github.com/theQRL/zond/common/hexutil"
+	"github.com/theQRL/zond/config/params"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+	"github.com/theQRL/zond/time/slots"
+)
+
+func (c *beaconApiValidatorClient) getSyncMessageBlockRoot(ctx context.Context) (*ethpb.SyncMessageBlockRootResponse, error) {
+	blockRootJson := &rpcmiddleware.BlockRootResponseJson{}
+	if _, err := c.jsonRestHandler.GetRestJsonResponse(ctx, "/zond/v1/beacon/blocks/head/root", blockRootJson); err != nil {
+		return nil, errors.Wrap(err, "failed to query GET REST endpoint")
+	}
+
+	if blockRootJson.ExecutionOptimistic {
+		return nil, errors.New("the head block is optimistic")
+	}
+
+	if blockRootJson.Data == nil {
+		return nil, errors.New("block root data is nil")
+	}
+
+	if !validRoot(blockRootJson.Data.Root) {
+		return nil, errors.Errorf("invalid block root `%s`", blockRootJson.Data.Root)
+	}
+
+	blockRoot, err := hexutil.Decode(blockRootJson.Data.Root)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode block root `%s`", blockRootJson.Data.Root)
+	}
+
+	return &ethpb.SyncMessageBlockRootResponse{Root: blockRoot}, nil
+}
+
+func (c *beaconApiValidatorClient) submitSyncMessage(ctx context.Context, syncMessage *ethpb.SyncCommitteeMessage) error {
+	body, err := marshalJsonBody([]*rpcmiddleware.SyncCommitteeMessageJson{
+		{
+			Slot:            uint64ToString(syncMessage.Slot),
+			BeaconBlockRoot: hexutil.Encode(syncMessage.BlockRoot),
+			ValidatorIndex:  uint64ToString(syncMessage.ValidatorIndex),
+			Signature:       hexutil.Encode(syncMessage.Signature),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.jsonRestHandler.PostRestJson(ctx, "/zond/v1/beacon/pool/sync_committees", nil, body, nil); err != nil {
+		return errors.Wrap(err, "failed to send POST data to REST endpoint")
+	}
+
+	return nil
+}
+
+// getSyncSubcommitteeIndex derives the subcommittees of the validator from its positions in the sync committee,
+// as reported by its sync committee duties.
+func (c *beaconApiValidatorClient) getSyncSubcommitteeIndex(ctx context.Context, in *ethpb.SyncSubcommitteeIndexRequest) (*ethpb.SyncSubcommitteeIndexResponse, error) {
+	validatorIndexResponse, err := c.validatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: in.PublicKey})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get validator index")
+	}
+
+	syncDuties, err := c.getSyncCommitteeDuties(ctx, slots.ToEpoch(in.Slot), []types.ValidatorIndex{validatorIndexResponse.Index})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get sync committee duties")
+	}
+
+	subcommitteeSize := params.BeaconConfig().SyncCommitteeSize / params.BeaconConfig().SyncCommitteeSubnetCount
+	var indices []types.CommitteeIndex
+	for _, syncDuty := range syncDuties {
+		d := &jsonDecoder{}
+		positions := d.uint64Array("validator sync committee index", syncDuty.ValidatorSyncCommitteeIndices)
+		if d.err != nil {
+			return nil, d.err
+		}
+		for _, position := range positions {
+			indices = append(indices, types.CommitteeIndex(position/subcommitteeSize))
+		}
+	}
+
+	return &ethpb.SyncSubcommitteeIndexResponse{Indices: indices}, nil
+}
+
+func (c *beaconApiValidatorClient) getSyncCommitteeContribution(ctx context.Context, in *ethpb.SyncCommitteeContributionRequest) (*ethpb.SyncCommitteeContribution, error) {
+	blockRootResponse, err := c.getSyncMessageBlockRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get sync message block root")
+	}
+
+	params := neturl.Values{}
+	params.Add("slot", uint64ToString(in.Slot))
+	params.Add("subcommittee_index", uint64ToString(in.SubnetId))
+	params.Add("beacon_block_root", hexutil.Encode(blockRootResponse.Root))
+
+	contributionJson := &rpcmiddleware.ProduceSyncCommitteeContributionResponseJson{}
+	if _, err := c.jsonRestHandler.GetRestJsonResponse(ctx, buildURL("/zond/v1/validator/sync_committee_contribution", params), contributionJson); err != nil {
+		return nil, errors.Wrap(err, "failed to query GET REST endpoint")
+	}
+
+	if contributionJson.Data == nil {
+		return nil, errors.New("sync committee contribution data is nil")
+	}
+
+	d := &jsonDecoder{}
+	contribution := &ethpb.SyncCommitteeContribution{
+		Slot:              types.Slot(d.uint64("contribution slot", contributionJson.Data.Slot)),
+		BlockRoot:         d.hex("contribution beacon block root", contributionJson.Data.BeaconBlockRoot),
+		SubcommitteeIndex: d.uint64("contribution subcommittee index", contributionJson.Data.SubcommitteeIndex),
+		AggregationBits:   d.hex("contribution aggregation bits", contributionJson.Data.AggregationBits),
+		Signature:         d.hex("contribution signature", contributionJson.Data.Signature),
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	return contribution, nil
+}
+
+func (c *beaconApiValidatorClient) submitSignedContributionAndProof(ctx context.Context, in *ethpb.SignedContributionAndProof) error {
+	if in == nil || in.Message == nil || in.Message.Contribution == nil {
+		return errors.New("signed contribution and proof is nil")
+	}
+
+	contribution := in.Message.Contribution
+	body, err := marshalJsonBody([]*rpcmiddleware.SignedContributionAndProofJson{
+		{
+			Message: &rpcmiddleware.ContributionAndProofJson{
+				AggregatorIndex: uint64ToString(in.Message.AggregatorIndex),
+				Contribution: &rpcmiddleware.SyncCommitteeContributionJson{
+					Slot:              uint64ToString(contribution.Slot),
+					BeaconBlockRoot:   hexutil.Encode(contribution.BlockRoot),
+					SubcommitteeIndex: uint64ToString(contribution.SubcommitteeIndex),
+					AggregationBits:   hexutil.Encode(contribution.AggregationBits),
+					Signature:         hexutil.Encode(contribution.Signature),
+				},
+				SelectionProof: hexutil.Encode(in.Message.SelectionProof),
+			},
+			Signature: hexutil.Encode(in.Signature),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.jsonRestHandler.PostRestJson(ctx, "/zond/v1/validator/contribution_and_proofs", nil, body, nil); err != nil {
+		return errors.Wrap(err, "failed to send POST data to REST endpoint")
+	}
+
+	return nil
+}
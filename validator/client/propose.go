@@ -205,24 +205,17 @@ func ProposeExit(
 	ctx, span := trace.StartSpan(ctx, "validator.ProposeExit")
 	defer span.End()
 
-	indexResponse, err := validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey})
-	if err != nil {
-		return errors.Wrap(err, "gRPC call to get validator index failed")
-	}
 	genesisResponse, err := nodeClient.GetGenesis(ctx, &emptypb.Empty{})
 	if err != nil {
 		return errors.Wrap(err, "gRPC call to get genesis time failed")
 	}
 	totalSecondsPassed := prysmTime.Now().Unix() - genesisResponse.GenesisTime.Seconds
 	currentEpoch := types.Epoch(uint64(totalSecondsPassed) / uint64(params.BeaconConfig().SlotsPerEpoch.Mul(params.BeaconConfig().SecondsPerSlot)))
-	currentSlot := slots.CurrentSlot(uint64(genesisResponse.GenesisTime.AsTime().Unix()))
-	exit := &ethpb.VoluntaryExit{Epoch: currentEpoch, ValidatorIndex: indexResponse.Index}
-	sig, err := signVoluntaryExit(ctx, validatorClient, signer, pubKey, exit, currentSlot)
+	signedExit, err := CreateSignedVoluntaryExit(ctx, validatorClient, signer, pubKey, currentEpoch)
 	if err != nil {
-		return errors.Wrap(err, "failed to sign voluntary exit")
+		return err
 	}
 
-	signedExit := &ethpb.SignedVoluntaryExit{Exit: exit, Signature: sig}
 	exitResp, err := validatorClient.ProposeExit(ctx, signedExit)
 	if err != nil {
 		return errors.Wrap(err, "failed to propose voluntary exit")
@@ -235,6 +228,34 @@ func ProposeExit(
 	return nil
 }
 
+// CreateSignedVoluntaryExit signs a voluntary exit of the validator at the given epoch.
+// The signed exit is returned without being broadcast.
+func CreateSignedVoluntaryExit(
+	ctx context.Context,
+	validatorClient iface.ValidatorClient,
+	signer iface.SigningFunc,
+	pubKey []byte,
+	epoch types.Epoch,
+) (*ethpb.SignedVoluntaryExit, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.CreateSignedVoluntaryExit")
+	defer span.End()
+
+	indexResponse, err := validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey})
+	if err != nil {
+		return nil, errors.Wrap(err, "gRPC call to get validator index failed")
+	}
+	slot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute the start slot of the exit epoch")
+	}
+	exit := &ethpb.VoluntaryExit{Epoch: epoch, ValidatorIndex: indexResponse.Index}
+	sig, err := signVoluntaryExit(ctx, validatorClient, signer, pubKey, exit, slot)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign voluntary exit")
+	}
+	return &ethpb.SignedVoluntaryExit{Exit: exit, Signature: sig}, nil
+}
+
 // Sign randao reveal with randao domain and private key.
 func (v *validator) signRandaoReveal(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, epoch types.Epoch, slot types.Slot) ([]byte, error) {
 	domain, err := v.domainData(ctx, epoch, params.BeaconConfig().DomainRandao[:])
@@ -336,9 +357,19 @@ func signVoluntaryExit(
 	return sig.Marshal(), nil
 }
 
-// Gets the graffiti from cli or file for the validator public key.
+// Gets the graffiti from the database, cli or file for the validator public key.
 func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, error) {
-	// When specified, default graffiti from the command line takes the first priority.
+	// When set through the keymanager API, the graffiti of the validator takes the first priority.
+	// It is read on every proposal, so that updates apply without a restart.
+	// A failure to read it must not prevent the proposal, so the next sources are used instead.
+	g, ok, err := v.db.GraffitiForPubKey(ctx, pubKey)
+	if err != nil {
+		log.WithError(err).WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).Warn("Could not read graffiti from the database")
+	} else if ok {
+		return g, nil
+	}
+
+	// When specified, default graffiti from the command line takes the second priority.
 	if len(v.graffiti) != 0 {
 		return v.graffiti, nil
 	}
@@ -347,17 +378,16 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubk
 		return nil, errors.New("graffitiStruct can't be nil")
 	}
 
-	// When specified, individual validator specified graffiti takes the second priority.
-	idx, err := v.validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
+	// When specified, individual validator specified graffiti takes the third priority.
+	specific, ok, err := v.specificGraffiti(ctx, pubKey)
 	if err != nil {
 		return []byte{}, err
 	}
-	g, ok := v.graffitiStruct.Specific[idx.Index]
 	if ok {
-		return []byte(g), nil
+		return specific, nil
 	}
 
-	// When specified, a graffiti from the ordered list in the file take third priority.
+	// When specified, a graffiti from the ordered list in the file take fourth priority.
 	if v.graffitiOrderedIndex < uint64(len(v.graffitiStruct.Ordered)) {
 		graffiti := v.graffitiStruct.Ordered[v.graffitiOrderedIndex]
 		v.graffitiOrderedIndex = v.graffitiOrderedIndex + 1
@@ -368,7 +398,7 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubk
 		return []byte(graffiti), nil
 	}
 
-	// When specified, a graffiti from the random list in the file take fourth priority.
+	// When specified, a graffiti from the random list in the file take fifth priority.
 	if len(v.graffitiStruct.Random) != 0 {
 		r := rand.NewGenerator()
 		r.Seed(time.Now().Unix())
@@ -383,3 +413,41 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubk
 
 	return []byte{}, nil
 }
+
+// DefaultGraffiti returns the graffiti used for the validator public key when none is set
+// through the keymanager API, from the cli or the specific or default graffiti of the file.
+// The ordered and random graffiti of the file change with every proposal, so they are not
+// reported.
+func (v *validator) DefaultGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, error) {
+	if len(v.graffiti) != 0 {
+		return v.graffiti, nil
+	}
+	if v.graffitiStruct == nil {
+		return []byte{}, nil
+	}
+	specific, ok, err := v.specificGraffiti(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return specific, nil
+	}
+	return []byte(v.graffitiStruct.Default), nil
+}
+
+// specificGraffiti returns the graffiti specified in the file for the index of the validator
+// public key, if any.
+func (v *validator) specificGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, bool, error) {
+	if len(v.graffitiStruct.Specific) == 0 {
+		return nil, false, nil
+	}
+	idx, err := v.validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
+	if err != nil {
+		return nil, false, err
+	}
+	specific, ok := v.graffitiStruct.Specific[idx.Index]
+	if !ok {
+		return nil, false, nil
+	}
+	return []byte(specific), true, nil
+}
@@ -0,0 +1,146 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	fieldparams "github.com/theQRL/zond/config/fieldparams"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+	"github.com/theQRL/zond/validator/client/iface"
+	vdb "github.com/theQRL/zond/validator/db"
+	dbtest "github.com/theQRL/zond/validator/db/testing"
+	"github.com/theQRL/zond/validator/graffiti"
+)
+
+// indexValidatorClient is a validator client that only looks up validator indices.
+type indexValidatorClient struct {
+	iface.ValidatorClient
+	index types.ValidatorIndex
+}
+
+func (c *indexValidatorClient) ValidatorIndex(_ context.Context, _ *ethpb.ValidatorIndexRequest) (*ethpb.ValidatorIndexResponse, error) {
+	return &ethpb.ValidatorIndexResponse{Index: c.index}, nil
+}
+
+// failingGraffitiDB is a validator database whose graffiti can't be read.
+type failingGraffitiDB struct {
+	vdb.Database
+}
+
+func (failingGraffitiDB) GraffitiForPubKey(_ context.Context, _ [fieldparams.BLSPubkeyLength]byte) ([]byte, bool, error) {
+	return nil, false, errors.New("database closed")
+}
+
+func TestGetGraffiti(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	file := &graffiti.Graffiti{
+		Default:  "file default",
+		Specific: map[types.ValidatorIndex]string{7: "file specific"},
+	}
+	tests := []struct {
+		name      string
+		saved     string // Graffiti set in the database, if any
+		failingDB bool
+		flag      string
+		file      *graffiti.Graffiti
+		want      string
+	}{
+		{name: "database over flag", saved: "database", flag: "flag", file: file, want: "database"},
+		{name: "database over file", saved: "database", file: file, want: "database"},
+		{name: "empty database graffiti", saved: "", flag: "flag", file: file, want: "flag"},
+		{name: "flag without database entry", flag: "flag", file: file, want: "flag"},
+		{name: "file without database entry", file: file, want: "file specific"},
+		{name: "file default", file: &graffiti.Graffiti{Default: "file default"}, want: "file default"},
+		{name: "flag when the database fails", failingDB: true, flag: "flag", file: file, want: "flag"},
+		{name: "file when the database fails", failingDB: true, file: file, want: "file specific"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+			if tt.saved != "" {
+				if err := db.SaveGraffitiForPubKey(ctx, pubKey, []byte(tt.saved)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			v := &validator{
+				db:              db,
+				graffiti:        []byte(tt.flag),
+				graffitiStruct:  tt.file,
+				validatorClient: &indexValidatorClient{index: 7},
+			}
+			if tt.failingDB {
+				v.db = failingGraffitiDB{Database: db}
+			}
+			got, err := v.getGraffiti(ctx, pubKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("unexpected graffiti %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetGraffiti_UpdatedWithoutRestart(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	db := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+	v := &validator{db: db, graffiti: []byte("flag")}
+
+	for _, want := range []string{"first", "second"} {
+		if err := db.SaveGraffitiForPubKey(ctx, pubKey, []byte(want)); err != nil {
+			t.Fatal(err)
+		}
+		if got, err := v.getGraffiti(ctx, pubKey); err != nil || string(got) != want {
+			t.Errorf("unexpected graffiti %q, want %q: %v", got, want, err)
+		}
+	}
+	if err := db.DeleteGraffitiForPubKey(ctx, pubKey); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := v.getGraffiti(ctx, pubKey); err != nil || string(got) != "flag" {
+		t.Errorf("unexpected graffiti %q after deleting it, want %q: %v", got, "flag", err)
+	}
+}
+
+func TestDefaultGraffiti(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	db := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+	if err := db.SaveGraffitiForPubKey(ctx, pubKey, []byte("database")); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		flag string
+		file *graffiti.Graffiti
+		want string
+	}{
+		{name: "flag", flag: "flag", file: &graffiti.Graffiti{Default: "file default"}, want: "flag"},
+		{name: "file specific", file: &graffiti.Graffiti{Default: "file default", Specific: map[types.ValidatorIndex]string{7: "file specific"}}, want: "file specific"},
+		{name: "file default", file: &graffiti.Graffiti{Default: "file default", Specific: map[types.ValidatorIndex]string{8: "other"}}, want: "file default"},
+		{name: "ordered graffiti not reported", file: &graffiti.Graffiti{Ordered: []string{"ordered"}}, want: ""},
+		{name: "no graffiti", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &validator{
+				db:              db,
+				graffiti:        []byte(tt.flag),
+				graffitiStruct:  tt.file,
+				validatorClient: &indexValidatorClient{index: 7},
+			}
+			got, err := v.DefaultGraffiti(ctx, pubKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("unexpected default graffiti %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -256,6 +256,12 @@ func (v *ValidatorService) Keymanager() (keymanager.IKeymanager, error) {
 	return v.validator.Keymanager()
 }
 
+// DefaultGraffiti returns the graffiti used for the validator public key when none is set
+// through the keymanager API.
+func (v *ValidatorService) DefaultGraffiti(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, error) {
+	return v.validator.DefaultGraffiti(ctx, pubKey)
+}
+
 func (v *ValidatorService) ProposerSettings() *validatorserviceconfig.ProposerSettings {
 	return v.validator.ProposerSettings()
 }
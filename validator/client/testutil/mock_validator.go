@@ -46,6 +46,7 @@ type FakeValidator struct {
 	NextSlotRet                       <-chan types.Slot
 	PublicKey                         string
 	UpdateDutiesRet                   error
+	DefaultGraffitiRet                []byte
 	ProposerSettingsErr               error
 	RolesAtRet                        []iface.ValidatorRole
 	Balances                          map[[fieldparams.BLSPubkeyLength]byte]uint64
@@ -282,3 +283,8 @@ func (f *FakeValidator) ProposerSettings() *validatorserviceconfig.ProposerSetti
 func (f *FakeValidator) SetProposerSettings(settings *validatorserviceconfig.ProposerSettings) {
 	f.proposerSettings = settings
 }
+
+// DefaultGraffiti for mocking
+func (fv *FakeValidator) DefaultGraffiti(_ context.Context, _ [fieldparams.BLSPubkeyLength]byte) ([]byte, error) {
+	return fv.DefaultGraffitiRet, nil
+}
@@ -62,4 +62,9 @@ type ValidatorDB interface {
 	// Graffiti ordered index related methods
 	SaveGraffitiOrderedIndex(ctx context.Context, index uint64) error
 	GraffitiOrderedIndex(ctx context.Context, fileHash [32]byte) (uint64, error)
+
+	// Graffiti set for individual public keys related methods
+	GraffitiForPubKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, bool, error)
+	SaveGraffitiForPubKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, graffiti []byte) error
+	DeleteGraffitiForPubKey(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error
 }
@@ -0,0 +1,127 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/zond/config/params"
+	"github.com/theQRL/zond/io/file"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+const compactFileSuffix = ".compact"
+
+// Compact rewrites the validator database in dirPath into a new file and replaces the
+// original with it. Bolt never returns freed pages to the filesystem, so this is the
+// only way to shrink the file after pruning. The database must not be opened by any
+// other process, including a Store in this one, while it is being compacted.
+// The sizes of the file before and after compaction are returned.
+func Compact(ctx context.Context, dirPath string) (int64, int64, error) {
+	_, span := trace.StartSpan(ctx, "ValidatorDB.Compact")
+	defer span.End()
+
+	datafile := filepath.Join(dirPath, ProtectionDbFileName)
+	if !file.FileExists(datafile) {
+		return 0, 0, fmt.Errorf("no validator database found at %s", datafile)
+	}
+	before, err := fileSize(datafile)
+	if err != nil {
+		return 0, 0, err
+	}
+	srcDB, err := bolt.Open(datafile, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{
+		Timeout:  params.BeaconIoConfig().BoltTimeout,
+		ReadOnly: true,
+	})
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return 0, 0, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return 0, 0, err
+	}
+
+	compactPath := datafile + compactFileSuffix
+	log.WithField("path", compactPath).Info("Writing compacted database")
+	err = copyDatabase(srcDB, compactPath)
+	// The source database has to be closed before it is replaced.
+	if closeErr := srcDB.Close(); closeErr != nil && err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		if rmErr := os.RemoveAll(compactPath); rmErr != nil {
+			log.WithError(rmErr).Error("Failed to remove partially compacted database")
+		}
+		return 0, 0, err
+	}
+	if err := os.Rename(compactPath, datafile); err != nil {
+		return 0, 0, errors.Wrap(err, "could not replace database with compacted copy")
+	}
+	after, err := fileSize(datafile)
+	if err != nil {
+		return 0, 0, err
+	}
+	return before, after, nil
+}
+
+func copyDatabase(srcDB *bolt.DB, dstPath string) error {
+	if err := os.RemoveAll(dstPath); err != nil {
+		return errors.Wrapf(err, "could not remove stale file %s", dstPath)
+	}
+	dstDB, err := bolt.Open(dstPath, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{
+		Timeout: params.BeaconIoConfig().BoltTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	err = srcDB.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			log.Debugf("Compacting bucket %s with %d keys", name, b.Stats().KeyN)
+			return dstDB.Update(func(tx2 *bolt.Tx) error {
+				b2, err := tx2.CreateBucket(name)
+				if err != nil {
+					return err
+				}
+				return compactBucket(b, b2)
+			})
+		})
+	})
+	if err != nil {
+		if closeErr := dstDB.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Failed to close compacted database")
+		}
+		return err
+	}
+	return dstDB.Close()
+}
+
+// Copies every key and nested bucket of srcBucket into dstBucket. Keys are inserted in
+// order, so pages are filled completely rather than split in half as they grow.
+func compactBucket(srcBucket, dstBucket *bolt.Bucket) error {
+	dstBucket.FillPercent = 1.0
+	if err := dstBucket.SetSequence(srcBucket.Sequence()); err != nil {
+		return err
+	}
+	return srcBucket.ForEach(func(k, v []byte) error {
+		if v == nil {
+			if bkt := srcBucket.Bucket(k); bkt != nil {
+				b2, err := dstBucket.CreateBucket(k)
+				if err != nil {
+					return err
+				}
+				return compactBucket(bkt, b2)
+			}
+		}
+		return dstBucket.Put(k, v)
+	})
+}
+
+func fileSize(path string) (int64, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
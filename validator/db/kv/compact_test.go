@@ -0,0 +1,107 @@
+package kv
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	fieldparams "github.com/theQRL/zond/config/fieldparams"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+)
+
+func TestCompact(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	db, err := NewKVStore(ctx, dir, &Config{PubKeys: [][fieldparams.BLSPubkeyLength]byte{pubKey}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Fill the history with proposals which are pruned afterwards, leaving free pages behind.
+	highest := slotAfterWeakSubjectivityPeriod(10)
+	if err := db.SaveProposalHistoryForSlot(ctx, pubKey, highest, []byte{1}); err != nil {
+		t.Fatal(err)
+	}
+	for slot := types.Slot(0); slot < 2000; slot++ {
+		if err := db.SaveProposalHistoryForSlot(ctx, pubKey, slot, make([]byte, 32)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.PruneProposalHistory(ctx); err != nil {
+		t.Fatal(err)
+	}
+	atts := []*ethpb.IndexedAttestation{
+		{Data: &ethpb.AttestationData{Source: &ethpb.Checkpoint{Epoch: 2}, Target: &ethpb.Checkpoint{Epoch: 3}}},
+		{Data: &ethpb.AttestationData{Source: &ethpb.Checkpoint{Epoch: 3}, Target: &ethpb.Checkpoint{Epoch: 4}}},
+	}
+	if err := db.SaveAttestationsForPubKey(ctx, pubKey, [][32]byte{{2}, {3}}, atts); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveGraffitiForPubKey(ctx, pubKey, []byte("graffiti")); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	before, after, err := Compact(ctx, dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after >= before {
+		t.Errorf("compacted size %d is not smaller than %d", after, before)
+	}
+	if _, err := os.Stat(filepath.Join(dir, ProtectionDbFileName+compactFileSuffix)); !os.IsNotExist(err) {
+		t.Errorf("compacted copy left behind: %v", err)
+	}
+
+	db, err = NewKVStore(ctx, dir, &Config{PubKeys: [][fieldparams.BLSPubkeyLength]byte{pubKey}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+	if slot, exists, err := db.HighestSignedProposal(ctx, pubKey); err != nil || !exists || slot != highest {
+		t.Errorf("unexpected highest signed proposal %d (exists %v), want %d: %v", slot, exists, highest, err)
+	}
+	if slot, exists, err := db.LowestSignedProposal(ctx, pubKey); err != nil || !exists || slot != 0 {
+		t.Errorf("unexpected lowest signed proposal %d (exists %v): %v", slot, exists, err)
+	}
+	if root, exists, err := db.ProposalHistoryForSlot(ctx, pubKey, highest); err != nil || !exists || root[0] != 1 {
+		t.Errorf("unexpected proposal %#x (exists %v) at the highest slot: %v", root, exists, err)
+	}
+	if source, exists, err := db.LowestSignedSourceEpoch(ctx, pubKey); err != nil || !exists || source != 2 {
+		t.Errorf("unexpected lowest signed source epoch %d (exists %v): %v", source, exists, err)
+	}
+	if target, exists, err := db.LowestSignedTargetEpoch(ctx, pubKey); err != nil || !exists || target != 3 {
+		t.Errorf("unexpected lowest signed target epoch %d (exists %v): %v", target, exists, err)
+	}
+	if root, err := db.SigningRootAtTargetEpoch(ctx, pubKey, 4); err != nil || root != [32]byte{3} {
+		t.Errorf("unexpected signing root %#x at target epoch 4: %v", root, err)
+	}
+	if graffiti, ok, err := db.GraffitiForPubKey(ctx, pubKey); err != nil || !ok || string(graffiti) != "graffiti" {
+		t.Errorf("unexpected graffiti %q (set %v): %v", graffiti, ok, err)
+	}
+}
+
+func TestCompact_NoDatabase(t *testing.T) {
+	_, _, err := Compact(context.Background(), t.TempDir())
+	if err == nil || !strings.Contains(err.Error(), "no validator database found") {
+		t.Fatalf("expected a missing database error, got %v", err)
+	}
+}
+
+func TestCompact_DatabaseInUse(t *testing.T) {
+	db := setupDB(t, nil)
+	_, _, err := Compact(context.Background(), db.DatabasePath())
+	if err == nil || !strings.Contains(err.Error(), "database may be in use") {
+		t.Fatalf("expected a lock error, got %v", err)
+	}
+}
@@ -152,6 +152,7 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			pubKeysBucket,
 			migrationsBucket,
 			graffitiBucket,
+			pubKeyGraffitiBucket,
 		)
 	}); err != nil {
 		return nil, err
@@ -169,6 +170,10 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 		if err := kv.PruneAttestations(ctx); err != nil {
 			return nil, errors.Wrap(err, "could not prune old attestations from DB")
 		}
+		// Prune proposals older than the weak subjectivity period of each key's latest proposal.
+		if err := kv.PruneProposalHistory(ctx); err != nil {
+			return nil, errors.Wrap(err, "could not prune old proposals from DB")
+		}
 	}
 
 	// Batch save attestation records for slashing protection at timed
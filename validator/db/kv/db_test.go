@@ -0,0 +1,27 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/theQRL/zond/config/fieldparams"
+)
+
+// setupDB instantiates and returns a Store instance.
+func setupDB(t testing.TB, pubkeys [][fieldparams.BLSPubkeyLength]byte) *Store {
+	db, err := NewKVStore(context.Background(), t.TempDir(), &Config{
+		PubKeys: pubkeys,
+	})
+	if err != nil {
+		t.Fatalf("Failed to instantiate DB: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := db.Close(); err != nil {
+			t.Fatalf("Failed to close database: %v", err)
+		}
+		if err := db.ClearDB(); err != nil {
+			t.Fatalf("Failed to clear database: %v", err)
+		}
+	})
+	return db
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 
+	fieldparams "github.com/theQRL/zond/config/fieldparams"
 	"github.com/theQRL/zond/encoding/bytesutil"
 	bolt "go.etcd.io/bbolt"
 )
@@ -37,3 +38,38 @@ func (s *Store) GraffitiOrderedIndex(_ context.Context, fileHash [32]byte) (uint
 	})
 	return orderedIndex, err
 }
+
+// GraffitiForPubKey fetches the graffiti set for the given public key, and whether one is set.
+func (s *Store) GraffitiForPubKey(_ context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) ([]byte, bool, error) {
+	var graffiti []byte
+	exists := false
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(pubKeyGraffitiBucket).Cursor()
+		k, v := c.Seek(pubKey[:])
+		if !bytes.Equal(k, pubKey[:]) {
+			return nil
+		}
+		graffiti = make([]byte, len(v))
+		copy(graffiti, v)
+		exists = true
+		return nil
+	})
+	return graffiti, exists, err
+}
+
+// SaveGraffitiForPubKey sets the graffiti of the given public key, overriding the graffiti
+// flag and file.
+func (s *Store) SaveGraffitiForPubKey(_ context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, graffiti []byte) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(pubKeyGraffitiBucket)
+		return bkt.Put(pubKey[:], graffiti)
+	})
+}
+
+// DeleteGraffitiForPubKey deletes the graffiti set for the given public key.
+func (s *Store) DeleteGraffitiForPubKey(_ context.Context, pubKey [fieldparams.BLSPubkeyLength]byte) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(pubKeyGraffitiBucket)
+		return bkt.Delete(pubKey[:])
+	})
+}
@@ -0,0 +1,55 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/theQRL/zond/config/fieldparams"
+)
+
+func TestStore_GraffitiForPubKey(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	other := [fieldparams.BLSPubkeyLength]byte{2}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey, other})
+
+	if _, ok, err := db.GraffitiForPubKey(ctx, pubKey); err != nil || ok {
+		t.Fatalf("unexpected graffiti before saving one: %v, %v", ok, err)
+	}
+
+	if err := db.SaveGraffitiForPubKey(ctx, pubKey, []byte("first")); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveGraffitiForPubKey(ctx, pubKey, []byte("second")); err != nil {
+		t.Fatal(err)
+	}
+	graffiti, ok, err := db.GraffitiForPubKey(ctx, pubKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok || string(graffiti) != "second" {
+		t.Errorf("unexpected graffiti %q (set %v), want %q", graffiti, ok, "second")
+	}
+	if _, ok, err := db.GraffitiForPubKey(ctx, other); err != nil || ok {
+		t.Errorf("unexpected graffiti of another public key: %v, %v", ok, err)
+	}
+
+	// An empty graffiti is set, rather than missing
+	if err := db.SaveGraffitiForPubKey(ctx, other, []byte{}); err != nil {
+		t.Fatal(err)
+	}
+	if graffiti, ok, err := db.GraffitiForPubKey(ctx, other); err != nil || !ok || len(graffiti) != 0 {
+		t.Errorf("unexpected empty graffiti %q (set %v): %v", graffiti, ok, err)
+	}
+
+	if err := db.DeleteGraffitiForPubKey(ctx, pubKey); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok, err := db.GraffitiForPubKey(ctx, pubKey); err != nil || ok {
+		t.Errorf("unexpected graffiti after deleting it: %v, %v", ok, err)
+	}
+	// Deleting a missing graffiti is a no-op
+	if err := db.DeleteGraffitiForPubKey(ctx, pubKey); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,49 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/theQRL/zond/encoding/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// PruneProposalHistory loops through every public key in the proposal history bucket
+// and prunes all proposals older than the weak subjectivity period with respect to the
+// highest slot signed by that key. Proposals are otherwise only pruned when a new block
+// is signed, so keys which stopped proposing keep their full history.
+func (s *Store) PruneProposalHistory(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.PruneProposalHistory")
+	defer span.End()
+	var pubkeys [][]byte
+	err := s.view(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(historicProposalsBucket)
+		return bucket.ForEach(func(pubKey []byte, _ []byte) error {
+			key := make([]byte, len(pubKey))
+			copy(key, pubKey)
+			pubkeys = append(pubkeys, key)
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+	for _, k := range pubkeys {
+		err = s.update(func(tx *bolt.Tx) error {
+			bucket := tx.Bucket(historicProposalsBucket)
+			valBucket := bucket.Bucket(k)
+			if valBucket == nil {
+				return nil
+			}
+			highestSlotBytes, _ := valBucket.Cursor().Last()
+			if highestSlotBytes == nil {
+				return nil
+			}
+			return pruneProposalHistoryBySlot(valBucket, bytesutil.BytesToSlotBigEndian(highestSlotBytes))
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
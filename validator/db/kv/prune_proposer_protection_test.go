@@ -0,0 +1,93 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	fieldparams "github.com/theQRL/zond/config/fieldparams"
+	"github.com/theQRL/zond/config/params"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+)
+
+// Returns the first slot of the given number of epochs after the weak subjectivity period.
+func slotAfterWeakSubjectivityPeriod(epochs types.Epoch) types.Slot {
+	cfg := params.BeaconConfig()
+	return types.Slot(uint64(cfg.WeakSubjectivityPeriod+epochs) * uint64(cfg.SlotsPerEpoch))
+}
+
+func TestStore_PruneProposalHistory(t *testing.T) {
+	ctx := context.Background()
+	spe := params.BeaconConfig().SlotsPerEpoch
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	recent := [fieldparams.BLSPubkeyLength]byte{2}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey, recent})
+
+	highest := slotAfterWeakSubjectivityPeriod(10)
+	// Proposals are pruned relative to the slot being saved, so saving the highest
+	// slot first leaves the older proposals behind until the history is pruned.
+	slots := []types.Slot{highest, 0, 10*spe - 1, 10 * spe, 11 * spe}
+	for _, slot := range slots {
+		if err := db.SaveProposalHistoryForSlot(ctx, pubKey, slot, []byte{byte(slot)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, slot := range []types.Slot{1, 2} {
+		if err := db.SaveProposalHistoryForSlot(ctx, recent, slot, []byte{byte(slot)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if proposals, err := db.ProposalHistoryForPubKey(ctx, pubKey); err != nil || len(proposals) != len(slots) {
+		t.Fatalf("unexpected proposals %v before pruning: %v", proposals, err)
+	}
+
+	if err := db.PruneProposalHistory(ctx); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		pubKey [fieldparams.BLSPubkeyLength]byte
+		slot   types.Slot
+		kept   bool
+	}{
+		{name: "genesis", pubKey: pubKey, slot: 0, kept: false},
+		{name: "last slot of the pruned epoch", pubKey: pubKey, slot: 10*spe - 1, kept: false},
+		{name: "weak subjectivity period before the highest", pubKey: pubKey, slot: 10 * spe, kept: false},
+		{name: "within the weak subjectivity period", pubKey: pubKey, slot: 11 * spe, kept: true},
+		{name: "highest", pubKey: pubKey, slot: highest, kept: true},
+		{name: "recent key", pubKey: recent, slot: 1, kept: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signingRoot, exists, err := db.ProposalHistoryForSlot(ctx, tt.pubKey, tt.slot)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if exists != tt.kept {
+				t.Errorf("proposal at slot %d kept: %v, want %v", tt.slot, exists, tt.kept)
+			}
+			if exists && signingRoot[0] != byte(tt.slot) {
+				t.Errorf("unexpected signing root %#x at slot %d", signingRoot, tt.slot)
+			}
+		})
+	}
+
+	// The lowest and highest signed proposals still guard against slashable blocks.
+	if lowest, exists, err := db.LowestSignedProposal(ctx, pubKey); err != nil || !exists || lowest != 0 {
+		t.Errorf("unexpected lowest signed proposal %d (exists %v): %v", lowest, exists, err)
+	}
+	if slot, exists, err := db.HighestSignedProposal(ctx, pubKey); err != nil || !exists || slot != highest {
+		t.Errorf("unexpected highest signed proposal %d (exists %v), want %d: %v", slot, exists, highest, err)
+	}
+}
+
+func TestStore_PruneProposalHistory_Empty(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})
+	if err := db.PruneProposalHistory(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if proposals, err := db.ProposalHistoryForPubKey(ctx, pubKey); err != nil || len(proposals) != 0 {
+		t.Errorf("unexpected proposals %v: %v", proposals, err)
+	}
+}
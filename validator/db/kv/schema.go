@@ -37,4 +37,7 @@ var (
 	// Graffiti ordered index and hash keys
 	graffitiOrderedIndexKey = []byte("graffiti-ordered-index")
 	graffitiFileHashKey     = []byte("graffiti-file-hash")
+
+	// Graffiti set for individual validators through the keymanager API.
+	pubKeyGraffitiBucket = []byte("pubkey-graffiti")
 )
@@ -9,6 +9,7 @@ import (
 	"github.com/theQRL/zond/async/event"
 	fieldparams "github.com/theQRL/zond/config/fieldparams"
 	"github.com/theQRL/zond/crypto/bls"
+	"github.com/theQRL/zond/crypto/hd"
 	ethpbservice "github.com/theQRL/zond/protos/eth/service"
 	validatorpb "github.com/theQRL/zond/protos/zond/v1alpha1/validator-client"
 	"github.com/theQRL/zond/validator/accounts/iface"
@@ -24,6 +25,10 @@ const (
 	// keys for Prysm Ethereum validators. According to EIP-2334, the format is as follows:
 	// m / purpose / coin_type / account_index / withdrawal_key / validating_key
 	ValidatingKeyDerivationPathTemplate = "m/12381/3600/%d/0/0"
+	// DilithiumValidatingKeyDerivationPathTemplate defines the hierarchical path for
+	// Dilithium validating keys, which are derived with the scheme of the hd package
+	// instead of EIP-2333.
+	DilithiumValidatingKeyDerivationPathTemplate = hd.ValidatingKeyPathTemplate
 )
 
 // SetupConfig includes configuration values for initializing
@@ -31,11 +36,13 @@ const (
 type SetupConfig struct {
 	Wallet           iface.Wallet
 	ListenForChanges bool
+	KeyScheme        keymanager.KeyScheme
 }
 
 // Keymanager implementation for derived, HD keymanager using EIP-2333 and EIP-2334.
 type Keymanager struct {
-	localKM *local.Keymanager
+	localKM   *local.Keymanager
+	keyScheme keymanager.KeyScheme
 }
 
 // NewKeymanager instantiates a new derived keymanager from configuration options.
@@ -51,13 +58,15 @@ func NewKeymanager(
 		return nil, err
 	}
 	return &Keymanager{
-		localKM: localKM,
+		localKM:   localKM,
+		keyScheme: cfg.KeyScheme,
 	}, nil
 }
 
 // RecoverAccountsFromMnemonic given a mnemonic phrase, is able to regenerate N accounts
 // from a derived seed, encrypt them according to the EIP-2334 JSON standard, and write them
-// to disk. Then, the mnemonic is never stored nor used by the validator.
+// to disk. Then, the mnemonic is never stored nor used by the validator. The keys are
+// derived for the key scheme of the keymanager.
 func (km *Keymanager) RecoverAccountsFromMnemonic(
 	ctx context.Context, mnemonic, mnemonicLanguage, mnemonicPassphrase string, numAccounts int,
 ) error {
@@ -68,18 +77,56 @@ func (km *Keymanager) RecoverAccountsFromMnemonic(
 	privKeys := make([][]byte, numAccounts)
 	pubKeys := make([][]byte, numAccounts)
 	for i := 0; i < numAccounts; i++ {
-		privKey, err := util.PrivateKeyFromSeedAndPath(
-			seed, fmt.Sprintf(ValidatingKeyDerivationPathTemplate, i),
-		)
+		privKeys[i], pubKeys[i], err = deriveValidatingKey(seed, i, km.keyScheme)
 		if err != nil {
 			return err
 		}
-		privKeys[i] = privKey.Marshal()
-		pubKeys[i] = privKey.PublicKey().Marshal()
 	}
 	return km.localKM.ImportKeypairs(ctx, privKeys, pubKeys)
 }
 
+// ValidatingKeyDerivationPath returns the derivation path of the validating key of
+// the given account for the given key scheme.
+func ValidatingKeyDerivationPath(account int, scheme keymanager.KeyScheme) string {
+	if scheme == keymanager.Dilithium {
+		return fmt.Sprintf(DilithiumValidatingKeyDerivationPathTemplate, account)
+	}
+	return fmt.Sprintf(ValidatingKeyDerivationPathTemplate, account)
+}
+
+// ValidatingKeyDerivationPaths returns the derivation path of every validating key, in the
+// order of FetchValidatingPublicKeys.
+func (km *Keymanager) ValidatingKeyDerivationPaths() []string {
+	keySchemes := km.localKM.ValidatingKeySchemes()
+	paths := make([]string, len(keySchemes))
+	for i, scheme := range keySchemes {
+		paths[i] = ValidatingKeyDerivationPath(i, scheme)
+	}
+	return paths
+}
+
+// deriveValidatingKey derives the private and public validating keys of the given
+// account from a mnemonic seed, for the given key scheme.
+func deriveValidatingKey(seed []byte, account int, scheme keymanager.KeyScheme) ([]byte, []byte, error) {
+	path := ValidatingKeyDerivationPath(account, scheme)
+	if scheme == keymanager.Dilithium {
+		dilithiumSeed, err := hd.DilithiumSeedFromSeedAndPath(seed, path)
+		if err != nil {
+			return nil, nil, err
+		}
+		privKey, err := bls.SecretKeyFromBytes(dilithiumSeed[:])
+		if err != nil {
+			return nil, nil, err
+		}
+		return privKey.Marshal(), privKey.PublicKey().Marshal(), nil
+	}
+	privKey, err := util.PrivateKeyFromSeedAndPath(seed, path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return privKey.Marshal(), privKey.PublicKey().Marshal(), nil
+}
+
 // ExtractKeystores retrieves the secret keys for specified public keys
 // in the function input, encrypts them using the specified password,
 // and returns their respective EIP-2335 keystores.
@@ -149,6 +196,7 @@ func (km *Keymanager) ListKeymanagerAccounts(ctx context.Context, cfg keymanager
 	if err != nil {
 		return err
 	}
+	derivationPaths := km.ValidatingKeyDerivationPaths()
 	if len(accountNames) == 1 {
 		fmt.Print("Showing 1 validator account\n")
 	} else if len(accountNames) == 0 {
@@ -159,7 +207,7 @@ func (km *Keymanager) ListKeymanagerAccounts(ctx context.Context, cfg keymanager
 	}
 	for i := 0; i < len(accountNames); i++ {
 		fmt.Println("")
-		validatingKeyPath := fmt.Sprintf(ValidatingKeyDerivationPathTemplate, i)
+		validatingKeyPath := derivationPaths[i]
 
 		// Retrieve the withdrawal key account metadata.
 		fmt.Printf("%s | %s\n", au.BrightBlue(fmt.Sprintf("Account %d", i)).Bold(), au.BrightGreen(accountNames[i]).Bold())
@@ -0,0 +1,57 @@
+package derived
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/theQRL/zond/crypto/bls/dilithium"
+	"github.com/theQRL/zond/validator/keymanager"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestDeriveValidatingKey(t *testing.T) {
+	seed, err := seedFromMnemonic(testMnemonic, "english", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	blsPriv, blsPub, err := deriveValidatingKey(seed, 0, keymanager.BLS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(blsPriv) != 32 || len(blsPub) != 48 {
+		t.Fatalf("unexpected BLS key lengths %d and %d", len(blsPriv), len(blsPub))
+	}
+
+	dilithiumPriv, dilithiumPub, err := deriveValidatingKey(seed, 0, keymanager.Dilithium)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dilithiumPub) != dilithium.PublicKeyLength {
+		t.Fatalf("unexpected Dilithium public key length %d", len(dilithiumPub))
+	}
+	// The secret key is the Dilithium seed of the validating key path of the hd package.
+	want := "98390824f59e82ba6507e086733422c1627c25c65ab4bf23387ef166b6fa16df5e3e3f915b0240a7fa22c2b508188ce6"
+	if have := hex.EncodeToString(dilithiumPriv); have != want {
+		t.Errorf("unexpected Dilithium secret key: have %s, want %s", have, want)
+	}
+
+	otherPriv, _, err := deriveValidatingKey(seed, 1, keymanager.Dilithium)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(otherPriv, dilithiumPriv) {
+		t.Error("accounts 0 and 1 derived the same key")
+	}
+}
+
+func TestValidatingKeyDerivationPath(t *testing.T) {
+	if path := ValidatingKeyDerivationPath(3, keymanager.BLS); path != "m/12381/3600/3/0/0" {
+		t.Errorf("unexpected BLS path %s", path)
+	}
+	if path := ValidatingKeyDerivationPath(3, keymanager.Dilithium); path != "m/12381/238/3/0/0" {
+		t.Errorf("unexpected Dilithium path %s", path)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/theQRL/zond/async/event"
 	fieldparams "github.com/theQRL/zond/config/fieldparams"
 	"github.com/theQRL/zond/crypto/bls"
+	"github.com/theQRL/zond/crypto/bls/dilithium"
 	"github.com/theQRL/zond/encoding/bytesutil"
 	validatorpb "github.com/theQRL/zond/protos/zond/v1alpha1/validator-client"
 	"github.com/theQRL/zond/runtime/interop"
@@ -198,6 +199,20 @@ func (km *Keymanager) FetchValidatingPrivateKeys(ctx context.Context) ([][32]byt
 	return privKeys, nil
 }
 
+// ValidatingKeySchemes returns the signature scheme of every validating key, in the order of
+// FetchValidatingPublicKeys.
+func (_ *Keymanager) ValidatingKeySchemes() []keymanager.KeyScheme {
+	lock.RLock()
+	defer lock.RUnlock()
+	schemes := make([]keymanager.KeyScheme, len(orderedPublicKeys))
+	for i, pk := range orderedPublicKeys {
+		if secretKey, ok := secretKeysCache[pk]; ok && len(secretKey.Marshal()) == dilithium.SecretKeyLength {
+			schemes[i] = keymanager.Dilithium
+		}
+	}
+	return schemes
+}
+
 // Sign signs a message using a validator key.
 func (_ *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
 	ctx, span := trace.StartSpan(ctx, "keymanager.Sign")
@@ -106,6 +106,17 @@ const (
 	Web3Signer
 )
 
+// KeyScheme defines an enum for the signature scheme of the validating keys
+// derived by a keymanager.
+type KeyScheme int
+
+const (
+	// BLS validating keys, derived according to EIP-2333.
+	BLS KeyScheme = iota
+	// Dilithium validating keys, derived with the scheme of the crypto/hd package.
+	Dilithium
+)
+
 // IncorrectPasswordErrMsg defines a common error string representing an EIP-2335
 // keystore password was incorrect.
 const IncorrectPasswordErrMsg = "invalid checksum"
@@ -145,3 +156,27 @@ func ParseKind(k string) (Kind, error) {
 		return 0, fmt.Errorf("%s is not an allowed keymanager", k)
 	}
 }
+
+// String marshals a key scheme to a string value.
+func (s KeyScheme) String() string {
+	switch s {
+	case BLS:
+		return "bls"
+	case Dilithium:
+		return "dilithium"
+	default:
+		return fmt.Sprintf("%d", int(s))
+	}
+}
+
+// ParseKeyScheme from a raw string, returning a key scheme.
+func ParseKeyScheme(s string) (KeyScheme, error) {
+	switch strings.ToLower(s) {
+	case "bls":
+		return BLS, nil
+	case "dilithium":
+		return Dilithium, nil
+	default:
+		return 0, fmt.Errorf("%s is not an allowed key scheme", s)
+	}
+}
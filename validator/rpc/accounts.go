@@ -41,14 +41,18 @@ func (s *Server) ListAccounts(ctx context.Context, req *pb.ListAccountsRequest)
 	if err != nil {
 		return nil, err
 	}
+	var derivationPaths []string
+	if derivedKM, ok := km.(*derived.Keymanager); ok && s.wallet.KeymanagerKind() == keymanager.Derived {
+		derivationPaths = derivedKM.ValidatingKeyDerivationPaths()
+	}
 	accs := make([]*pb.Account, len(keys))
 	for i := 0; i < len(keys); i++ {
 		accs[i] = &pb.Account{
 			ValidatingPublicKey: keys[i][:],
 			AccountName:         petnames.DeterministicName(keys[i][:], "-"),
 		}
-		if s.wallet.KeymanagerKind() == keymanager.Derived {
-			accs[i].DerivationPath = fmt.Sprintf(derived.ValidatingKeyDerivationPathTemplate, i)
+		if i < len(derivationPaths) {
+			accs[i].DerivationPath = derivationPaths[i]
 		}
 	}
 	if req.All {
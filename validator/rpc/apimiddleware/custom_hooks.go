@@ -0,0 +1,43 @@
+package apimiddleware
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/theQRL/zond/api/gateway/apimiddleware"
+	"github.com/theQRL/zond/config/params"
+)
+
+// https://ethereum.github.io/keymanager-APIs/#/Voluntary%20Exit/signVoluntaryExit
+// The epoch of the exit is an optional query parameter, while the gRPC gateway expects it in the body.
+// A missing epoch is passed on as the far future epoch, so that it is not mistaken for epoch 0.
+func setVoluntaryExitEpoch(
+	endpoint *apimiddleware.Endpoint,
+	_ http.ResponseWriter,
+	req *http.Request,
+) (apimiddleware.RunDefault, apimiddleware.ErrorJson) {
+	if _, ok := endpoint.PostRequest.(*SetVoluntaryExitRequestJson); ok {
+		farFutureEpoch := uint64(params.BeaconConfig().FarFutureEpoch)
+		epoch := strconv.FormatUint(farFutureEpoch, 10)
+		if e := req.URL.Query().Get("epoch"); e != "" {
+			v, err := strconv.ParseUint(e, 10, 64)
+			if err != nil {
+				return false, &apimiddleware.DefaultErrorJson{
+					Message: errors.Wrapf(err, "invalid epoch %q", e).Error(),
+					Code:    http.StatusBadRequest,
+				}
+			}
+			if v == farFutureEpoch {
+				return false, &apimiddleware.DefaultErrorJson{
+					Message: fmt.Sprintf("invalid epoch %q: the far future epoch cannot be requested", e),
+					Code:    http.StatusBadRequest,
+				}
+			}
+			epoch = e
+		}
+		endpoint.PostRequest = &SetVoluntaryExitRequestJson{Epoch: epoch}
+	}
+	return false, nil
+}
@@ -0,0 +1,56 @@
+package apimiddleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/theQRL/zond/api/gateway/apimiddleware"
+	"github.com/theQRL/zond/config/params"
+)
+
+func TestSetVoluntaryExitEpoch(t *testing.T) {
+	farFutureEpoch := strconv.FormatUint(uint64(params.BeaconConfig().FarFutureEpoch), 10)
+	tests := []struct {
+		name    string
+		query   string
+		want    string
+		code    int
+		message string
+	}{
+		{name: "missing epoch", query: "", want: farFutureEpoch},
+		{name: "empty epoch", query: "?epoch=", want: farFutureEpoch},
+		{name: "epoch", query: "?epoch=12", want: "12"},
+		{name: "epoch 0", query: "?epoch=0", want: "0"},
+		{name: "malformed epoch", query: "?epoch=foo", code: http.StatusBadRequest, message: "invalid epoch \"foo\""},
+		{name: "negative epoch", query: "?epoch=-1", code: http.StatusBadRequest, message: "invalid epoch \"-1\""},
+		{name: "far future epoch", query: "?epoch=" + farFutureEpoch, code: http.StatusBadRequest, message: "the far future epoch cannot be requested"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint := &apimiddleware.Endpoint{PostRequest: &SetVoluntaryExitRequestJson{}}
+			req := httptest.NewRequest(http.MethodPost, "http://example.com/eth/v1/validator/0x01/voluntary_exit"+tt.query, nil)
+			runDefault, errJson := setVoluntaryExitEpoch(endpoint, httptest.NewRecorder(), req)
+			if runDefault {
+				t.Error("unexpected default processing of the request body")
+			}
+			if tt.code != 0 {
+				if errJson == nil {
+					t.Fatal("expected an error")
+				}
+				if errJson.StatusCode() != tt.code || !strings.Contains(errJson.Msg(), tt.message) {
+					t.Errorf("unexpected error %d %q, want %d containing %q", errJson.StatusCode(), errJson.Msg(), tt.code, tt.message)
+				}
+				return
+			}
+			if errJson != nil {
+				t.Fatalf("unexpected error %q", errJson.Msg())
+			}
+			if epoch := endpoint.PostRequest.(*SetVoluntaryExitRequestJson).Epoch; epoch != tt.want {
+				t.Errorf("unexpected epoch %q, want %q", epoch, tt.want)
+			}
+		})
+	}
+}
@@ -20,6 +20,8 @@ func (*ValidatorEndpointFactory) Paths() []string {
 		"/eth/v1/remotekeys",
 		"/eth/v1/validator/{pubkey}/feerecipient",
 		"/eth/v1/validator/{pubkey}/gas_limit",
+		"/eth/v1/validator/{pubkey}/graffiti",
+		"/eth/v1/validator/{pubkey}/voluntary_exit",
 	}
 }
 
@@ -46,6 +48,15 @@ func (*ValidatorEndpointFactory) Create(path string) (*apimiddleware.Endpoint, e
 		endpoint.GetResponse = &GetGasLimitResponseJson{}
 		endpoint.PostRequest = &SetGasLimitRequestJson{}
 		endpoint.DeleteRequest = &DeleteGasLimitRequestJson{}
+	case "/eth/v1/validator/{pubkey}/graffiti":
+		endpoint.GetResponse = &GetGraffitiResponseJson{}
+		endpoint.PostRequest = &SetGraffitiRequestJson{}
+	case "/eth/v1/validator/{pubkey}/voluntary_exit":
+		endpoint.PostRequest = &SetVoluntaryExitRequestJson{}
+		endpoint.PostResponse = &SetVoluntaryExitResponseJson{}
+		endpoint.Hooks = apimiddleware.HookCollection{
+			OnPreDeserializeRequestBodyIntoContainer: setVoluntaryExitEpoch,
+		}
 	default:
 		return nil, errors.New("invalid path")
 	}
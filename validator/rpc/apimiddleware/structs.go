@@ -77,6 +77,11 @@ type GasLimitJson struct {
 	GasLimit string `json:"gas_limit"`
 }
 
+type GraffitiJson struct {
+	Pubkey   string `json:"pubkey" hex:"true"`
+	Graffiti string `json:"graffiti"`
+}
+
 type GetFeeRecipientByPubkeyResponseJson struct {
 	Data *FeeRecipientJson `json:"data"`
 }
@@ -96,3 +101,29 @@ type SetGasLimitRequestJson struct {
 type DeleteGasLimitRequestJson struct {
 	Pubkey string `json:"pubkey" hex:"true"`
 }
+
+type GetGraffitiResponseJson struct {
+	Data *GraffitiJson `json:"data"`
+}
+
+type SetGraffitiRequestJson struct {
+	Graffiti string `json:"graffiti"`
+}
+
+type SetVoluntaryExitRequestJson struct {
+	Epoch string `json:"epoch"`
+}
+
+type SetVoluntaryExitResponseJson struct {
+	Data *SignedVoluntaryExitJson `json:"data"`
+}
+
+type SignedVoluntaryExitJson struct {
+	Exit      *VoluntaryExitJson `json:"message"`
+	Signature string             `json:"signature" hex:"true"`
+}
+
+type VoluntaryExitJson struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
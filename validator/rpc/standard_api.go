@@ -7,14 +7,18 @@ import (
 	"fmt"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
 	"github.com/theQRL/zond/common"
 	"github.com/theQRL/zond/common/hexutil"
 	fieldparams "github.com/theQRL/zond/config/fieldparams"
 	"github.com/theQRL/zond/config/params"
 	validatorServiceConfig "github.com/theQRL/zond/config/validator/service"
+	types "github.com/theQRL/zond/consensus-types/primitives"
 	"github.com/theQRL/zond/encoding/bytesutil"
 	ethpbservice "github.com/theQRL/zond/protos/eth/service"
 	eth "github.com/theQRL/zond/protos/zond/v1alpha1"
+	"github.com/theQRL/zond/time/slots"
+	"github.com/theQRL/zond/validator/client"
 	"github.com/theQRL/zond/validator/keymanager"
 	"github.com/theQRL/zond/validator/keymanager/derived"
 	slashingprotection "github.com/theQRL/zond/validator/slashing-protection-history"
@@ -25,7 +29,10 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-const nonExistantPublicKey = "0x0"
+const (
+	nonExistantPublicKey = "0x0"
+	graffitiLength       = 32
+)
 
 // ListKeystores implements the standard validator key management API.
 func (s *Server) ListKeystores(
@@ -48,13 +55,17 @@ func (s *Server) ListKeystores(
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not retrieve keystores: %v", err)
 	}
+	var derivationPaths []string
+	if derivedKM, ok := km.(*derived.Keymanager); ok && s.wallet.KeymanagerKind() == keymanager.Derived {
+		derivationPaths = derivedKM.ValidatingKeyDerivationPaths()
+	}
 	keystoreResponse := make([]*ethpbservice.ListKeystoresResponse_Keystore, len(pubKeys))
 	for i := 0; i < len(pubKeys); i++ {
 		keystoreResponse[i] = &ethpbservice.ListKeystoresResponse_Keystore{
 			ValidatingPubkey: pubKeys[i][:],
 		}
-		if s.wallet.KeymanagerKind() == keymanager.Derived {
-			keystoreResponse[i].DerivationPath = fmt.Sprintf(derived.ValidatingKeyDerivationPathTemplate, i)
+		if i < len(derivationPaths) {
+			keystoreResponse[i].DerivationPath = derivationPaths[i]
 		}
 	}
 	return &ethpbservice.ListKeystoresResponse{
@@ -657,6 +668,138 @@ func (s *Server) DeleteFeeRecipientByPubkey(ctx context.Context, req *ethpbservi
 	return &empty.Empty{}, nil
 }
 
+// GetGraffiti returns the graffiti set for the public key through the keymanager API, or
+// else the graffiti from the flag or file used by the validator.
+func (s *Server) GetGraffiti(ctx context.Context, req *ethpbservice.PubkeyRequest) (*ethpbservice.GetGraffitiResponse, error) {
+	if s.valDB == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator database not ready")
+	}
+	if s.validatorService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator service not ready")
+	}
+	validatorKey := req.Pubkey
+	if err := validatePublicKey(validatorKey); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	graffiti, found, err := s.valDB.GraffitiForPubKey(ctx, bytesutil.ToBytes48(validatorKey))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get graffiti: %v", err)
+	}
+	if !found {
+		graffiti, err = s.validatorService.DefaultGraffiti(ctx, bytesutil.ToBytes48(validatorKey))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get graffiti: %v", err)
+		}
+	}
+	return &ethpbservice.GetGraffitiResponse{
+		Data: &ethpbservice.GetGraffitiResponse_Graffiti{
+			Pubkey:   validatorKey,
+			Graffiti: string(graffiti),
+		},
+	}, nil
+}
+
+// SetGraffiti sets the graffiti of the public key, which takes precedence over the graffiti
+// flag and file from the next proposal on.
+func (s *Server) SetGraffiti(ctx context.Context, req *ethpbservice.SetGraffitiRequest) (*empty.Empty, error) {
+	if s.valDB == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator database not ready")
+	}
+	validatorKey := req.Pubkey
+	if err := validatePublicKey(validatorKey); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if len(req.Graffiti) > graffitiLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Graffiti is longer than %d bytes", graffitiLength)
+	}
+	if err := s.valDB.SaveGraffitiForPubKey(ctx, bytesutil.ToBytes48(validatorKey), []byte(req.Graffiti)); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save graffiti: %v", err)
+	}
+	// override the 200 success with 202 according to the specs
+	if err := grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "202")); err != nil {
+		return &empty.Empty{}, status.Errorf(codes.Internal, "Could not set custom success code header: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// DeleteGraffiti deletes the graffiti set for the public key, so that the graffiti flag or
+// file is used again.
+func (s *Server) DeleteGraffiti(ctx context.Context, req *ethpbservice.PubkeyRequest) (*empty.Empty, error) {
+	if s.valDB == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator database not ready")
+	}
+	validatorKey := req.Pubkey
+	if err := validatePublicKey(validatorKey); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err := s.valDB.DeleteGraffitiForPubKey(ctx, bytesutil.ToBytes48(validatorKey)); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not delete graffiti: %v", err)
+	}
+	// override the 200 success with 204 according to the specs
+	if err := grpc.SetHeader(ctx, metadata.Pairs("x-http-code", "204")); err != nil {
+		return &empty.Empty{}, status.Errorf(codes.Internal, "Could not set custom success code header: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// SetVoluntaryExit signs a voluntary exit for the public key with the keymanager holding it,
+// at the requested epoch or at the current epoch if the far future epoch is requested, which
+// the HTTP gateway passes on when no epoch is given. The signed exit is returned to the caller,
+// who is responsible for broadcasting it.
+func (s *Server) SetVoluntaryExit(ctx context.Context, req *ethpbservice.SetVoluntaryExitRequest) (*ethpbservice.SetVoluntaryExitResponse, error) {
+	if s.validatorService == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator service not ready")
+	}
+	validatorKey := req.Pubkey
+	if err := validatePublicKey(validatorKey); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	km, err := s.validatorService.Keymanager()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get keymanager: %v", err)
+	}
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve public keys: %v", err)
+	}
+	found := false
+	for _, pubKey := range pubKeys {
+		if bytes.Equal(pubKey[:], validatorKey) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("no keystore found for pubkey: %q", hexutil.Encode(validatorKey)))
+	}
+
+	epoch := types.Epoch(req.Epoch)
+	if epoch == params.BeaconConfig().FarFutureEpoch {
+		genesisResponse, err := s.beaconNodeClient.GetGenesis(ctx, &empty.Empty{})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get genesis time: %v", err)
+		}
+		epoch = slots.ToEpoch(slots.CurrentSlot(uint64(genesisResponse.GenesisTime.AsTime().Unix())))
+	}
+	signedExit, err := client.CreateSignedVoluntaryExit(ctx, s.beaconNodeValidatorClient, km.Sign, validatorKey, epoch)
+	if err != nil {
+		// The beacon node does not know validators that are not deposited yet.
+		if status.Code(errors.Cause(err)) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "Could not find validator index for pubkey %q: %v", hexutil.Encode(validatorKey), err)
+		}
+		return nil, status.Errorf(codes.Internal, "Could not create voluntary exit: %v", err)
+	}
+	return &ethpbservice.SetVoluntaryExitResponse{
+		Data: &ethpbservice.SetVoluntaryExitResponse_SignedVoluntaryExit{
+			Message: &ethpbservice.SetVoluntaryExitResponse_SignedVoluntaryExit_VoluntaryExit{
+				Epoch:          uint64(signedExit.Exit.Epoch),
+				ValidatorIndex: uint64(signedExit.Exit.ValidatorIndex),
+			},
+			Signature: signedExit.Signature,
+		},
+	}, nil
+}
+
 func validatePublicKey(pubkey []byte) error {
 	if len(pubkey) != fieldparams.BLSPubkeyLength {
 		return status.Errorf(
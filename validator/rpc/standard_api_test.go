@@ -0,0 +1,356 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/theQRL/zond/beacon-chain/core/signing"
+	"github.com/theQRL/zond/common/hexutil"
+	fieldparams "github.com/theQRL/zond/config/fieldparams"
+	"github.com/theQRL/zond/config/params"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	"github.com/theQRL/zond/crypto/bls"
+	"github.com/theQRL/zond/encoding/bytesutil"
+	ethpbservice "github.com/theQRL/zond/protos/eth/service"
+	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
+	validatorpb "github.com/theQRL/zond/protos/zond/v1alpha1/validator-client"
+	"github.com/theQRL/zond/validator/client"
+	"github.com/theQRL/zond/validator/client/iface"
+	"github.com/theQRL/zond/validator/client/testutil"
+	dbtest "github.com/theQRL/zond/validator/db/testing"
+	"github.com/theQRL/zond/validator/keymanager"
+	remoteweb3signer "github.com/theQRL/zond/validator/keymanager/remote-web3signer"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// newTestValidatorService returns a validator service of the given validator, without a
+// connection to a beacon node.
+func newTestValidatorService(t *testing.T, v iface.Validator) *client.ValidatorService {
+	vs, err := client.NewValidatorService(context.Background(), &client.Config{Validator: v})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return vs
+}
+
+// checkCode checks that the error has the given gRPC status code.
+func checkCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+	if status.Code(err) != code {
+		t.Fatalf("unexpected error %v, want code %v", err, code)
+	}
+}
+
+// checkHTTPCode checks that the stream overrides the success code with the given one.
+func checkHTTPCode(t *testing.T, stream *runtime.ServerTransportStream, code string) {
+	t.Helper()
+	if got := stream.Header().Get("x-http-code"); len(got) != 1 || got[0] != code {
+		t.Errorf("unexpected x-http-code %v, want %s", got, code)
+	}
+}
+
+func TestServer_Graffiti(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	s := &Server{
+		valDB:            dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey}),
+		validatorService: newTestValidatorService(t, &testutil.FakeValidator{DefaultGraffitiRet: []byte("flag")}),
+	}
+	get := func() string {
+		t.Helper()
+		resp, err := s.GetGraffiti(context.Background(), &ethpbservice.PubkeyRequest{Pubkey: pubKey[:]})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.Data.Graffiti
+	}
+
+	// Without a graffiti in the database, the one of the flag or file is reported
+	if got := get(); got != "flag" {
+		t.Errorf("unexpected graffiti %q, want %q", got, "flag")
+	}
+
+	stream := &runtime.ServerTransportStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	if _, err := s.SetGraffiti(ctx, &ethpbservice.SetGraffitiRequest{Pubkey: pubKey[:], Graffiti: "database"}); err != nil {
+		t.Fatal(err)
+	}
+	checkHTTPCode(t, stream, "202")
+	if got := get(); got != "database" {
+		t.Errorf("unexpected graffiti %q, want %q", got, "database")
+	}
+
+	stream = &runtime.ServerTransportStream{}
+	ctx = grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	if _, err := s.DeleteGraffiti(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubKey[:]}); err != nil {
+		t.Fatal(err)
+	}
+	checkHTTPCode(t, stream, "204")
+	if got := get(); got != "flag" {
+		t.Errorf("unexpected graffiti %q after deleting it, want %q", got, "flag")
+	}
+}
+
+func TestServer_SetGraffiti_Length(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	s := &Server{valDB: dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey})}
+
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
+	if _, err := s.SetGraffiti(ctx, &ethpbservice.SetGraffitiRequest{Pubkey: pubKey[:], Graffiti: strings.Repeat("a", graffitiLength)}); err != nil {
+		t.Fatalf("unexpected error setting a graffiti of %d bytes: %v", graffitiLength, err)
+	}
+	_, err := s.SetGraffiti(ctx, &ethpbservice.SetGraffitiRequest{Pubkey: pubKey[:], Graffiti: strings.Repeat("a", graffitiLength+1)})
+	checkCode(t, err, codes.InvalidArgument)
+
+	// The length is counted in bytes rather than characters
+	_, err = s.SetGraffiti(ctx, &ethpbservice.SetGraffitiRequest{Pubkey: pubKey[:], Graffiti: strings.Repeat("é", 17)})
+	checkCode(t, err, codes.InvalidArgument)
+
+	graffiti, _, err := s.valDB.GraffitiForPubKey(context.Background(), pubKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(graffiti) != graffitiLength {
+		t.Errorf("rejected graffiti saved: %q", graffiti)
+	}
+}
+
+func TestServer_Graffiti_Errors(t *testing.T) {
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &runtime.ServerTransportStream{})
+
+	notReady := &Server{}
+	_, err := notReady.GetGraffiti(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubKey[:]})
+	checkCode(t, err, codes.FailedPrecondition)
+	_, err = notReady.SetGraffiti(ctx, &ethpbservice.SetGraffitiRequest{Pubkey: pubKey[:]})
+	checkCode(t, err, codes.FailedPrecondition)
+	_, err = notReady.DeleteGraffiti(ctx, &ethpbservice.PubkeyRequest{Pubkey: pubKey[:]})
+	checkCode(t, err, codes.FailedPrecondition)
+
+	s := &Server{
+		valDB:            dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubKey}),
+		validatorService: newTestValidatorService(t, &testutil.FakeValidator{}),
+	}
+	malformed := []byte{0x01, 0x02}
+	_, err = s.GetGraffiti(ctx, &ethpbservice.PubkeyRequest{Pubkey: malformed})
+	checkCode(t, err, codes.FailedPrecondition)
+	_, err = s.SetGraffiti(ctx, &ethpbservice.SetGraffitiRequest{Pubkey: malformed, Graffiti: "graffiti"})
+	checkCode(t, err, codes.FailedPrecondition)
+	_, err = s.DeleteGraffiti(ctx, &ethpbservice.PubkeyRequest{Pubkey: malformed})
+	checkCode(t, err, codes.FailedPrecondition)
+}
+
+var exitTestDomain = bytesutil.PadTo([]byte("exit domain"), 32)
+
+// exitNodeClient is a beacon node client that only serves the genesis time.
+type exitNodeClient struct {
+	ethpb.NodeClient
+	genesis time.Time
+}
+
+func (c *exitNodeClient) GetGenesis(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.Genesis, error) {
+	return &ethpb.Genesis{GenesisTime: timestamppb.New(c.genesis)}, nil
+}
+
+// exitValidatorClient is a validator client that knows the indices of the validators
+// on chain and serves the domain of voluntary exits.
+type exitValidatorClient struct {
+	iface.ValidatorClient
+	indices map[[fieldparams.BLSPubkeyLength]byte]types.ValidatorIndex
+}
+
+func (c *exitValidatorClient) ValidatorIndex(_ context.Context, in *ethpb.ValidatorIndexRequest) (*ethpb.ValidatorIndexResponse, error) {
+	index, ok := c.indices[bytesutil.ToBytes48(in.PublicKey)]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "could not find validator index for public key %#x", in.PublicKey)
+	}
+	return &ethpb.ValidatorIndexResponse{Index: index}, nil
+}
+
+func (c *exitValidatorClient) DomainData(_ context.Context, _ *ethpb.DomainRequest) (*ethpb.DomainResponse, error) {
+	return &ethpb.DomainResponse{SignatureDomain: exitTestDomain}, nil
+}
+
+// localKeymanager is a keymanager signing with the secret keys it holds.
+type localKeymanager struct {
+	keymanager.IKeymanager
+	keys map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey
+}
+
+func newLocalKeymanager(t *testing.T, n int) *localKeymanager {
+	km := &localKeymanager{keys: make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey)}
+	for i := 0; i < n; i++ {
+		key, err := bls.RandKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		km.keys[bytesutil.ToBytes48(key.PublicKey().Marshal())] = key
+	}
+	return km
+}
+
+func (km *localKeymanager) FetchValidatingPublicKeys(_ context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(km.keys))
+	for pubKey := range km.keys {
+		pubKeys = append(pubKeys, pubKey)
+	}
+	return pubKeys, nil
+}
+
+func (km *localKeymanager) Sign(_ context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	return km.keys[bytesutil.ToBytes48(req.PublicKey)].Sign(req.SigningRoot), nil
+}
+
+// newExitServer returns a server signing exits with the keymanager, whose chain started
+// ten and a half epochs ago and holds the validators of the given indices.
+func newExitServer(t *testing.T, km keymanager.IKeymanager, indices map[[fieldparams.BLSPubkeyLength]byte]types.ValidatorIndex) *Server {
+	epochDuration := time.Duration(uint64(params.BeaconConfig().SlotsPerEpoch)*params.BeaconConfig().SecondsPerSlot) * time.Second
+	return &Server{
+		validatorService:          newTestValidatorService(t, &testutil.FakeValidator{Km: km}),
+		beaconNodeClient:          &exitNodeClient{genesis: time.Now().Add(-10*epochDuration - epochDuration/2)},
+		beaconNodeValidatorClient: &exitValidatorClient{indices: indices},
+	}
+}
+
+// checkSignedExit checks that the exit of the validator at the epoch is signed by its key.
+func checkSignedExit(t *testing.T, resp *ethpbservice.SetVoluntaryExitResponse, pubKey [fieldparams.BLSPubkeyLength]byte, index types.ValidatorIndex, epoch types.Epoch) {
+	t.Helper()
+	exit := resp.Data.Message
+	if types.Epoch(exit.Epoch) != epoch || types.ValidatorIndex(exit.ValidatorIndex) != index {
+		t.Fatalf("unexpected exit of validator %d at epoch %d, want %d at %d", exit.ValidatorIndex, exit.Epoch, index, epoch)
+	}
+	root, err := signing.ComputeSigningRoot(&ethpb.VoluntaryExit{Epoch: epoch, ValidatorIndex: index}, exitTestDomain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, err := bls.PublicKeyFromBytes(pubKey[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := bls.SignatureFromBytes(resp.Data.Signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sig.Verify(pub, root[:]) {
+		t.Error("invalid signature of the voluntary exit")
+	}
+}
+
+func TestServer_SetVoluntaryExit(t *testing.T) {
+	ctx := context.Background()
+	km := newLocalKeymanager(t, 2)
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	onChain, pending := pubKeys[0], pubKeys[1]
+	s := newExitServer(t, km, map[[fieldparams.BLSPubkeyLength]byte]types.ValidatorIndex{onChain: 5})
+
+	// A missing epoch is passed as the far future epoch, and replaced by the current one
+	resp, err := s.SetVoluntaryExit(ctx, &ethpbservice.SetVoluntaryExitRequest{Pubkey: onChain[:], Epoch: uint64(params.BeaconConfig().FarFutureEpoch)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSignedExit(t, resp, onChain, 5, 10)
+
+	resp, err = s.SetVoluntaryExit(ctx, &ethpbservice.SetVoluntaryExitRequest{Pubkey: onChain[:], Epoch: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSignedExit(t, resp, onChain, 5, 3)
+
+	// Epoch 0 is a requested epoch rather than a missing one
+	resp, err = s.SetVoluntaryExit(ctx, &ethpbservice.SetVoluntaryExitRequest{Pubkey: onChain[:], Epoch: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSignedExit(t, resp, onChain, 5, 0)
+
+	unknown := [fieldparams.BLSPubkeyLength]byte{1}
+	_, err = s.SetVoluntaryExit(ctx, &ethpbservice.SetVoluntaryExitRequest{Pubkey: unknown[:], Epoch: 3})
+	checkCode(t, err, codes.NotFound)
+	if !strings.Contains(err.Error(), "no keystore found") {
+		t.Errorf("unexpected error for an unknown key: %v", err)
+	}
+
+	_, err = s.SetVoluntaryExit(ctx, &ethpbservice.SetVoluntaryExitRequest{Pubkey: pending[:], Epoch: 3})
+	checkCode(t, err, codes.NotFound)
+	if !strings.Contains(err.Error(), "Could not find validator index") {
+		t.Errorf("unexpected error for a validator not on chain: %v", err)
+	}
+
+	_, err = s.SetVoluntaryExit(ctx, &ethpbservice.SetVoluntaryExitRequest{Pubkey: []byte{0x01}, Epoch: 3})
+	checkCode(t, err, codes.FailedPrecondition)
+	_, err = (&Server{}).SetVoluntaryExit(ctx, &ethpbservice.SetVoluntaryExitRequest{Pubkey: onChain[:], Epoch: 3})
+	checkCode(t, err, codes.FailedPrecondition)
+}
+
+func TestServer_SetVoluntaryExit_RemoteSigner(t *testing.T) {
+	ctx := context.Background()
+	key, err := bls.RandKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey := bytesutil.ToBytes48(key.PublicKey().Marshal())
+
+	// The web3signer signs the signing root of the voluntary exit it is sent
+	var signed struct {
+		Type          string        `json:"type"`
+		SigningRoot   hexutil.Bytes `json:"signingRoot"`
+		VoluntaryExit struct {
+			Epoch          string `json:"epoch"`
+			ValidatorIndex string `json:"validator_index"`
+		} `json:"voluntary_exit"`
+	}
+	signer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/eth2/sign/"+hexutil.Encode(pubKey[:]) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&signed); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		if _, err := w.Write([]byte(hexutil.Encode(key.Sign(signed.SigningRoot).Marshal()))); err != nil {
+			t.Error(err)
+		}
+	}))
+	t.Cleanup(signer.Close)
+
+	km, err := remoteweb3signer.NewKeymanager(ctx, &remoteweb3signer.SetupConfig{
+		BaseEndpoint:          signer.URL,
+		GenesisValidatorsRoot: bytesutil.PadTo([]byte("genesis validators root"), 32),
+		ProvidedPublicKeys:    [][fieldparams.BLSPubkeyLength]byte{pubKey},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := newExitServer(t, km, map[[fieldparams.BLSPubkeyLength]byte]types.ValidatorIndex{pubKey: 9})
+
+	resp, err := s.SetVoluntaryExit(ctx, &ethpbservice.SetVoluntaryExitRequest{Pubkey: pubKey[:], Epoch: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkSignedExit(t, resp, pubKey, 9, 4)
+	if signed.Type != "VOLUNTARY_EXIT" || signed.VoluntaryExit.Epoch != "4" || signed.VoluntaryExit.ValidatorIndex != "9" {
+		t.Errorf("unexpected sign request %+v", signed)
+	}
+	root, err := signing.ComputeSigningRoot(&ethpb.VoluntaryExit{Epoch: 4, ValidatorIndex: 9}, exitTestDomain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(signed.SigningRoot, root[:]) {
+		t.Errorf("unexpected signing root %#x sent to the signer, want %#x", signed.SigningRoot, root)
+	}
+}
@@ -8,11 +8,11 @@ import (
 	"io"
 
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 	fieldparams "github.com/theQRL/zond/config/fieldparams"
 	types "github.com/theQRL/zond/consensus-types/primitives"
 	"github.com/theQRL/zond/encoding/bytesutil"
 	ethpb "github.com/theQRL/zond/protos/zond/v1alpha1"
-	"github.com/theQRL/zond/protos/zond/v1alpha1/slashings"
 	"github.com/theQRL/zond/validator/db"
 	"github.com/theQRL/zond/validator/db/kv"
 	"github.com/theQRL/zond/validator/slashing-protection-history/format"
@@ -138,22 +138,13 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 }
 
 func validateMetadata(ctx context.Context, validatorDB db.Database, interchangeJSON *format.EIPSlashingProtectionFormat) error {
-	// We need to ensure the version in the metadata field matches the one we support.
-	version := interchangeJSON.Metadata.InterchangeFormatVersion
-	if version != format.InterchangeFormatVersion {
-		return fmt.Errorf(
-			"slashing protection JSON version '%s' is not supported, wanted '%s'",
-			version,
-			format.InterchangeFormatVersion,
-		)
+	gvr, err := validateMetadataFormat(interchangeJSON)
+	if err != nil {
+		return err
 	}
 
 	// We need to verify the genesis validators root matches that of our chain data, otherwise
 	// the imported slashing protection JSON was created on a different chain.
-	gvr, err := RootFromHex(interchangeJSON.Metadata.GenesisValidatorsRoot)
-	if err != nil {
-		return fmt.Errorf("%#x is not a valid root: %w", interchangeJSON.Metadata.GenesisValidatorsRoot, err)
-	}
 	dbGvr, err := validatorDB.GenesisValidatorsRoot(ctx)
 	if err != nil {
 		return errors.Wrap(err, "could not retrieve genesis validators root to db")
@@ -171,6 +162,23 @@ func validateMetadata(ctx context.Context, validatorDB db.Database, interchangeJ
 	return nil
 }
 
+func validateMetadataFormat(interchangeJSON *format.EIPSlashingProtectionFormat) ([32]byte, error) {
+	// We need to ensure the version in the metadata field matches the one we support.
+	version := interchangeJSON.Metadata.InterchangeFormatVersion
+	if version != format.InterchangeFormatVersion {
+		return [32]byte{}, fmt.Errorf(
+			"slashing protection JSON version '%s' is not supported, wanted '%s'",
+			version,
+			format.InterchangeFormatVersion,
+		)
+	}
+	gvr, err := RootFromHex(interchangeJSON.Metadata.GenesisValidatorsRoot)
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("%#x is not a valid root: %w", interchangeJSON.Metadata.GenesisValidatorsRoot, err)
+	}
+	return gvr, nil
+}
+
 // We create a map of pubKey -> []*SignedBlock. Then, for each public key we observe,
 // we append to this map. This allows us to handle valid input JSON data such as:
 //
@@ -236,23 +244,11 @@ func parseAttestationsForUniquePublicKeys(data []*format.ProtectionData) (map[[f
 }
 
 func filterSlashablePubKeysFromBlocks(_ context.Context, historyByPubKey map[[fieldparams.BLSPubkeyLength]byte]kv.ProposalHistoryForPubkey) [][fieldparams.BLSPubkeyLength]byte {
-	// Given signing roots are optional in the EIP standard, we behave as follows:
-	// For a given block:
-	//   If we have a previous block with the same slot in our history:
-	//     If signing root is nil, we consider that proposer public key as slashable
-	//     If signing root is not nil , then we compare signing roots. If they are different,
-	//     then we consider that proposer public key as slashable.
 	slashablePubKeys := make([][fieldparams.BLSPubkeyLength]byte, 0)
 	for pubKey, proposals := range historyByPubKey {
-		seenSigningRootsBySlot := make(map[types.Slot][]byte)
-		for _, blk := range proposals.Proposals {
-			if signingRoot, ok := seenSigningRootsBySlot[blk.Slot]; ok {
-				if signingRoot == nil || !bytes.Equal(signingRoot, blk.SigningRoot) {
-					slashablePubKeys = append(slashablePubKeys, pubKey)
-					break
-				}
-			}
-			seenSigningRootsBySlot[blk.Slot] = blk.SigningRoot
+		if v := findSlashableProposal(pubKey, proposals.Proposals); v != nil {
+			logViolation(v)
+			slashablePubKeys = append(slashablePubKeys, pubKey)
 		}
 	}
 	return slashablePubKeys
@@ -267,30 +263,9 @@ func filterSlashablePubKeysFromAttestations(
 	// First we need to find attestations that are slashable with respect to other
 	// attestations within the same JSON import.
 	for pubKey, signedAtts := range signedAttsByPubKey {
-		signingRootsByTarget := make(map[types.Epoch][32]byte)
-		targetEpochsBySource := make(map[types.Epoch][]types.Epoch)
-	Loop:
-		for _, att := range signedAtts {
-			// Check for double votes.
-			if sr, ok := signingRootsByTarget[att.Target]; ok {
-				if slashings.SigningRootsDiffer(sr, att.SigningRoot) {
-					slashablePubKeys = append(slashablePubKeys, pubKey)
-					break Loop
-				}
-			}
-			// Check for surround voting.
-			for source, targets := range targetEpochsBySource {
-				for _, target := range targets {
-					a := createAttestation(source, target)
-					b := createAttestation(att.Source, att.Target)
-					if slashings.IsSurround(a, b) || slashings.IsSurround(b, a) {
-						slashablePubKeys = append(slashablePubKeys, pubKey)
-						break Loop
-					}
-				}
-			}
-			signingRootsByTarget[att.Target] = att.SigningRoot
-			targetEpochsBySource[att.Source] = append(targetEpochsBySource[att.Source], att.Target)
+		if v := findSlashableAttestation(pubKey, signedAtts); v != nil {
+			logViolation(v)
+			slashablePubKeys = append(slashablePubKeys, pubKey)
 		}
 	}
 	// Then, we need to find attestations that are slashable with respect to our database.
@@ -377,3 +352,10 @@ func createAttestation(source, target types.Epoch) *ethpb.IndexedAttestation {
 		},
 	}
 }
+
+func logViolation(v *Violation) {
+	log.WithFields(logrus.Fields{
+		"pubKey": fmt.Sprintf("%#x", bytesutil.Trunc(v.PubKey[:])),
+		"kind":   v.Kind,
+	}).Warnf("Slashable record found in slashing protection JSON, public key will not be imported: %s", v.Detail)
+}
@@ -0,0 +1,164 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/theQRL/zond/config/fieldparams"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	"github.com/theQRL/zond/validator/slashing-protection-history/format"
+)
+
+// Minimal slashing protection history of a single public key.
+type minimalHistory struct {
+	hasBlocks    bool
+	highestSlot  types.Slot
+	hasAtts      bool
+	highestSrc   types.Epoch
+	highestTgt   types.Epoch
+	pubKeyString string
+}
+
+// MergeStandardProtectionJSON combines several EIP-3076 JSON files for the same chain into a
+// single file using the minimal strategy described by the EIP. For every public key, only one
+// signed block at the highest slot and one signed attestation at the highest source and target
+// epochs seen across all files are kept, without signing roots. A validator importing the result
+// refuses anything at or below those bounds, so the merged history is never less safe than any
+// of its inputs.
+func MergeStandardProtectionJSON(
+	ctx context.Context,
+	interchangeJSONs ...*format.EIPSlashingProtectionFormat,
+) (*format.EIPSlashingProtectionFormat, error) {
+	if len(interchangeJSONs) == 0 {
+		return nil, errors.New("no slashing protection JSON files to merge")
+	}
+	var genesisValidatorsRoot [32]byte
+	historyByPubKey := make(map[[fieldparams.BLSPubkeyLength]byte]*minimalHistory)
+	for i, interchangeJSON := range interchangeJSONs {
+		gvr, err := validateMetadataFormat(interchangeJSON)
+		if err != nil {
+			return nil, errors.Wrapf(err, "slashing protection JSON metadata of file %d was incorrect", i)
+		}
+		if i == 0 {
+			genesisValidatorsRoot = gvr
+		} else if gvr != genesisValidatorsRoot {
+			return nil, fmt.Errorf(
+				"genesis validators root %#x of file %d does not match %#x, files are from different chains",
+				gvr, i, genesisValidatorsRoot,
+			)
+		}
+		if err := mergeProtectionData(ctx, historyByPubKey, interchangeJSON.Data); err != nil {
+			return nil, errors.Wrapf(err, "could not merge slashing protection JSON file %d", i)
+		}
+	}
+
+	merged := &format.EIPSlashingProtectionFormat{}
+	merged.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	merged.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", genesisValidatorsRoot)
+	merged.Data = make([]*format.ProtectionData, 0, len(historyByPubKey))
+	for _, history := range historyByPubKey {
+		item := &format.ProtectionData{
+			Pubkey:             history.pubKeyString,
+			SignedBlocks:       make([]*format.SignedBlock, 0),
+			SignedAttestations: make([]*format.SignedAttestation, 0),
+		}
+		if history.hasBlocks {
+			item.SignedBlocks = append(item.SignedBlocks, &format.SignedBlock{
+				Slot: fmt.Sprintf("%d", history.highestSlot),
+			})
+		}
+		if history.hasAtts {
+			item.SignedAttestations = append(item.SignedAttestations, &format.SignedAttestation{
+				SourceEpoch: fmt.Sprintf("%d", history.highestSrc),
+				TargetEpoch: fmt.Sprintf("%d", history.highestTgt),
+			})
+		}
+		merged.Data = append(merged.Data, item)
+	}
+	sort.Slice(merged.Data, func(i, j int) bool {
+		return strings.Compare(merged.Data[i].Pubkey, merged.Data[j].Pubkey) < 0
+	})
+	return merged, nil
+}
+
+func mergeProtectionData(
+	ctx context.Context,
+	historyByPubKey map[[fieldparams.BLSPubkeyLength]byte]*minimalHistory,
+	data []*format.ProtectionData,
+) error {
+	signedBlocksByPubKey, err := parseBlocksForUniquePublicKeys(data)
+	if err != nil {
+		return errors.Wrap(err, "could not parse unique entries for blocks by public key")
+	}
+	signedAttsByPubKey, err := parseAttestationsForUniquePublicKeys(data)
+	if err != nil {
+		return errors.Wrap(err, "could not parse unique entries for attestations by public key")
+	}
+	historyFor := func(pubKey [fieldparams.BLSPubkeyLength]byte) (*minimalHistory, error) {
+		if history, ok := historyByPubKey[pubKey]; ok {
+			return history, nil
+		}
+		pubKeyHex, err := pubKeyToHexString(pubKey[:])
+		if err != nil {
+			return nil, errors.Wrap(err, "could not convert public key to hex string")
+		}
+		history := &minimalHistory{pubKeyString: pubKeyHex}
+		historyByPubKey[pubKey] = history
+		return history, nil
+	}
+	// Public keys without any records are kept, so they still show up in the merged file.
+	for _, validatorData := range data {
+		pubKey, err := PubKeyFromHex(validatorData.Pubkey)
+		if err != nil {
+			return fmt.Errorf("%s is not a valid public key: %w", validatorData.Pubkey, err)
+		}
+		if _, err := historyFor(pubKey); err != nil {
+			return err
+		}
+	}
+
+	for pubKey, signedBlocks := range signedBlocksByPubKey {
+		proposalHistory, err := transformSignedBlocks(ctx, signedBlocks)
+		if err != nil {
+			return errors.Wrapf(err, "could not parse signed blocks in JSON file for key %#x", pubKey)
+		}
+		history, err := historyFor(pubKey)
+		if err != nil {
+			return err
+		}
+		for _, proposal := range proposalHistory.Proposals {
+			if !history.hasBlocks || proposal.Slot > history.highestSlot {
+				history.highestSlot = proposal.Slot
+			}
+			history.hasBlocks = true
+		}
+	}
+	for pubKey, signedAtts := range signedAttsByPubKey {
+		historicalAtts, err := transformSignedAttestations(pubKey, signedAtts)
+		if err != nil {
+			return errors.Wrapf(err, "could not parse signed attestations in JSON file for key %#x", pubKey)
+		}
+		history, err := historyFor(pubKey)
+		if err != nil {
+			return err
+		}
+		for _, att := range historicalAtts {
+			if !history.hasAtts || att.Source > history.highestSrc {
+				history.highestSrc = att.Source
+			}
+			if !history.hasAtts || att.Target > history.highestTgt {
+				history.highestTgt = att.Target
+			}
+			history.hasAtts = true
+		}
+		// A malformed attestation with its source after its target must not produce
+		// an invalid minimal attestation, so we raise the target to match.
+		if history.highestSrc > history.highestTgt {
+			history.highestTgt = history.highestSrc
+		}
+	}
+	return nil
+}
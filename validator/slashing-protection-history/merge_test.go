@@ -0,0 +1,192 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	fieldparams "github.com/theQRL/zond/config/fieldparams"
+	"github.com/theQRL/zond/validator/slashing-protection-history/format"
+)
+
+func TestMergeStandardProtectionJSON(t *testing.T) {
+	type minimal struct {
+		slot   string // Empty if no block is expected
+		source string // Empty if no attestation is expected
+		target string
+	}
+	tests := []struct {
+		name  string
+		files [][]*format.ProtectionData
+		want  map[[fieldparams.BLSPubkeyLength]byte]minimal
+	}{
+		{
+			name: "single file",
+			files: [][]*format.ProtectionData{{
+				protectionData(testPubKey(1),
+					[]*format.SignedBlock{block("3", testRoot(1)), block("7", testRoot(2))},
+					[]*format.SignedAttestation{att("1", "2", testRoot(1)), att("4", "5", testRoot(2))},
+				),
+			}},
+			want: map[[fieldparams.BLSPubkeyLength]byte]minimal{
+				testPubKey(1): {slot: "7", source: "4", target: "5"},
+			},
+		},
+		{
+			name: "maxima across files",
+			files: [][]*format.ProtectionData{
+				{protectionData(testPubKey(1),
+					[]*format.SignedBlock{block("9", testRoot(1))},
+					[]*format.SignedAttestation{att("6", "7", testRoot(1))},
+				)},
+				{protectionData(testPubKey(1),
+					[]*format.SignedBlock{block("4", testRoot(2))},
+					[]*format.SignedAttestation{att("2", "10", testRoot(2))},
+				)},
+			},
+			// The highest source and target come from different attestations.
+			want: map[[fieldparams.BLSPubkeyLength]byte]minimal{
+				testPubKey(1): {slot: "9", source: "6", target: "10"},
+			},
+		},
+		{
+			name: "records of one key spread across items",
+			files: [][]*format.ProtectionData{{
+				protectionData(testPubKey(1), []*format.SignedBlock{block("2", "")}, nil),
+				protectionData(testPubKey(1), []*format.SignedBlock{block("8", "")}, []*format.SignedAttestation{att("3", "4", "")}),
+			}},
+			want: map[[fieldparams.BLSPubkeyLength]byte]minimal{
+				testPubKey(1): {slot: "8", source: "3", target: "4"},
+			},
+		},
+		{
+			name: "source after target raises the target",
+			files: [][]*format.ProtectionData{
+				{protectionData(testPubKey(1), nil, []*format.SignedAttestation{att("8", "5", "")})},
+				{protectionData(testPubKey(1), nil, []*format.SignedAttestation{att("1", "6", "")})},
+			},
+			want: map[[fieldparams.BLSPubkeyLength]byte]minimal{
+				testPubKey(1): {source: "8", target: "8"},
+			},
+		},
+		{
+			name: "keys without records are kept",
+			files: [][]*format.ProtectionData{
+				{protectionData(testPubKey(1), nil, nil)},
+				{protectionData(testPubKey(2), []*format.SignedBlock{block("1", "")}, nil)},
+			},
+			want: map[[fieldparams.BLSPubkeyLength]byte]minimal{
+				testPubKey(1): {},
+				testPubKey(2): {slot: "1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := make([]*format.EIPSlashingProtectionFormat, len(tt.files))
+			for i, data := range tt.files {
+				files[i] = protectionJSON(testGenesisValidatorsRoot, data...)
+			}
+			merged, err := MergeStandardProtectionJSON(context.Background(), files...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if merged.Metadata.InterchangeFormatVersion != format.InterchangeFormatVersion {
+				t.Errorf("unexpected version %s", merged.Metadata.InterchangeFormatVersion)
+			}
+			if merged.Metadata.GenesisValidatorsRoot != testGenesisValidatorsRoot {
+				t.Errorf("unexpected genesis validators root %s", merged.Metadata.GenesisValidatorsRoot)
+			}
+			if len(merged.Data) != len(tt.want) {
+				t.Fatalf("unexpected number of keys %d, want %d", len(merged.Data), len(tt.want))
+			}
+			for _, item := range merged.Data {
+				pubKey, err := PubKeyFromHex(item.Pubkey)
+				if err != nil {
+					t.Fatal(err)
+				}
+				want, ok := tt.want[pubKey]
+				if !ok {
+					t.Fatalf("unexpected key %s", item.Pubkey)
+				}
+				if want.slot == "" {
+					if len(item.SignedBlocks) != 0 {
+						t.Errorf("unexpected blocks %v for key %s", item.SignedBlocks, item.Pubkey)
+					}
+				} else if len(item.SignedBlocks) != 1 || item.SignedBlocks[0].Slot != want.slot || item.SignedBlocks[0].SigningRoot != "" {
+					t.Errorf("unexpected blocks %v for key %s, want slot %s", item.SignedBlocks, item.Pubkey, want.slot)
+				}
+				if want.source == "" {
+					if len(item.SignedAttestations) != 0 {
+						t.Errorf("unexpected attestations %v for key %s", item.SignedAttestations, item.Pubkey)
+					}
+					continue
+				}
+				if len(item.SignedAttestations) != 1 {
+					t.Fatalf("unexpected attestations %v for key %s", item.SignedAttestations, item.Pubkey)
+				}
+				if a := item.SignedAttestations[0]; a.SourceEpoch != want.source || a.TargetEpoch != want.target || a.SigningRoot != "" {
+					t.Errorf("unexpected attestation source %s target %s for key %s, want source %s target %s",
+						a.SourceEpoch, a.TargetEpoch, item.Pubkey, want.source, want.target)
+				}
+			}
+		})
+	}
+}
+
+func TestMergeStandardProtectionJSON_Sorted(t *testing.T) {
+	merged, err := MergeStandardProtectionJSON(context.Background(),
+		protectionJSON(testGenesisValidatorsRoot, protectionData(testPubKey(3), nil, nil), protectionData(testPubKey(1), nil, nil)),
+		protectionJSON(testGenesisValidatorsRoot, protectionData(testPubKey(2), nil, nil)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, item := range merged.Data {
+		if want := fmt.Sprintf("%#x", testPubKey(byte(i+1))); item.Pubkey != want {
+			t.Errorf("unexpected key %s at %d, want %s", item.Pubkey, i, want)
+		}
+	}
+}
+
+func TestMergeStandardProtectionJSON_Errors(t *testing.T) {
+	otherChain := "0x0202020202020202020202020202020202020202020202020202020202020202"
+	badVersion := protectionJSON(testGenesisValidatorsRoot)
+	badVersion.Metadata.InterchangeFormatVersion = "4"
+	tests := []struct {
+		name  string
+		files []*format.EIPSlashingProtectionFormat
+		err   string
+	}{
+		{
+			name: "no files",
+			err:  "no slashing protection JSON files to merge",
+		},
+		{
+			name:  "different genesis validators roots",
+			files: []*format.EIPSlashingProtectionFormat{protectionJSON(testGenesisValidatorsRoot), protectionJSON(otherChain)},
+			err:   "files are from different chains",
+		},
+		{
+			name:  "unsupported version",
+			files: []*format.EIPSlashingProtectionFormat{protectionJSON(testGenesisValidatorsRoot), badVersion},
+			err:   "metadata of file 1 was incorrect",
+		},
+		{
+			name: "invalid public key",
+			files: []*format.EIPSlashingProtectionFormat{
+				protectionJSON(testGenesisValidatorsRoot, &format.ProtectionData{Pubkey: "0x01"}),
+			},
+			err: "could not merge slashing protection JSON file 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := MergeStandardProtectionJSON(context.Background(), tt.files...)
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("expected error %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package history
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/theQRL/zond/config/fieldparams"
+	types "github.com/theQRL/zond/consensus-types/primitives"
+	"github.com/theQRL/zond/protos/zond/v1alpha1/slashings"
+	"github.com/theQRL/zond/validator/db/kv"
+	"github.com/theQRL/zond/validator/slashing-protection-history/format"
+)
+
+// ViolationKind describes why a record in a slashing protection history is slashable.
+type ViolationKind string
+
+const (
+	// DoubleProposal is a block signed at a slot which already has a block with a different signing root.
+	DoubleProposal ViolationKind = "double proposal"
+	// DoubleVote is an attestation signed for a target epoch which already has an attestation
+	// with a different signing root.
+	DoubleVote ViolationKind = "double vote"
+	// SurroundVote is an attestation which surrounds, or is surrounded by, another attestation.
+	SurroundVote ViolationKind = "surround vote"
+	// InvalidAttestation is an attestation with a source epoch greater than its target epoch.
+	InvalidAttestation ViolationKind = "invalid attestation"
+)
+
+// Violation records the first slashable record found for a public key within
+// a slashing protection history.
+type Violation struct {
+	PubKey [fieldparams.BLSPubkeyLength]byte
+	Kind   ViolationKind
+	Detail string
+}
+
+// ReadStandardProtectionJSON decodes an EIP-3076 JSON file and checks its metadata
+// is well formed, without comparing it against any validator database.
+func ReadStandardProtectionJSON(r io.Reader) (*format.EIPSlashingProtectionFormat, error) {
+	encodedJSON, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read slashing protection JSON file")
+	}
+	interchangeJSON := &format.EIPSlashingProtectionFormat{}
+	if err := json.Unmarshal(encodedJSON, interchangeJSON); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal slashing protection JSON file")
+	}
+	if _, err := validateMetadataFormat(interchangeJSON); err != nil {
+		return nil, errors.Wrap(err, "slashing protection JSON metadata was incorrect")
+	}
+	return interchangeJSON, nil
+}
+
+// VerifyStandardProtectionJSON checks the signed blocks and attestations of every public key
+// in an EIP-3076 JSON file against the other records for the same key in that file. It returns
+// the first slashable block and the first slashable attestation found for each key, sorted by
+// public key. Entries for the same public key spread across several items of the file are
+// checked together.
+func VerifyStandardProtectionJSON(ctx context.Context, interchangeJSON *format.EIPSlashingProtectionFormat) ([]*Violation, error) {
+	signedBlocksByPubKey, err := parseBlocksForUniquePublicKeys(interchangeJSON.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse unique entries for blocks by public key")
+	}
+	signedAttsByPubKey, err := parseAttestationsForUniquePublicKeys(interchangeJSON.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse unique entries for attestations by public key")
+	}
+
+	violations := make([]*Violation, 0)
+	for pubKey, signedBlocks := range signedBlocksByPubKey {
+		proposalHistory, err := transformSignedBlocks(ctx, signedBlocks)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse signed blocks in JSON file for key %#x", pubKey)
+		}
+		if v := findSlashableProposal(pubKey, proposalHistory.Proposals); v != nil {
+			violations = append(violations, v)
+		}
+	}
+	for pubKey, signedAtts := range signedAttsByPubKey {
+		historicalAtts, err := transformSignedAttestations(pubKey, signedAtts)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse signed attestations in JSON file for key %#x", pubKey)
+		}
+		if v := findSlashableAttestation(pubKey, historicalAtts); v != nil {
+			violations = append(violations, v)
+		}
+	}
+	sort.Slice(violations, func(i, j int) bool {
+		if c := bytes.Compare(violations[i].PubKey[:], violations[j].PubKey[:]); c != 0 {
+			return c < 0
+		}
+		return violations[i].Kind < violations[j].Kind
+	})
+	return violations, nil
+}
+
+func findSlashableProposal(pubKey [fieldparams.BLSPubkeyLength]byte, proposals []kv.Proposal) *Violation {
+	// Given signing roots are optional in the EIP standard, we behave as follows:
+	// For a given block:
+	//   If we have a previous block with the same slot in our history:
+	//     If either signing root is empty, we consider that proposer public key as slashable
+	//     If both signing roots are set, then we compare them. If they are different,
+	//     then we consider that proposer public key as slashable.
+	seenSigningRootsBySlot := make(map[types.Slot][32]byte)
+	for _, blk := range proposals {
+		var signingRoot [32]byte
+		copy(signingRoot[:], blk.SigningRoot)
+		if existing, ok := seenSigningRootsBySlot[blk.Slot]; ok {
+			if slashings.SigningRootsDiffer(existing, signingRoot) {
+				return &Violation{
+					PubKey: pubKey,
+					Kind:   DoubleProposal,
+					Detail: fmt.Sprintf("slot %d signed more than once", blk.Slot),
+				}
+			}
+		}
+		seenSigningRootsBySlot[blk.Slot] = signingRoot
+	}
+	return nil
+}
+
+func findSlashableAttestation(pubKey [fieldparams.BLSPubkeyLength]byte, signedAtts []*kv.AttestationRecord) *Violation {
+	signingRootsByTarget := make(map[types.Epoch][32]byte)
+	targetEpochsBySource := make(map[types.Epoch][]types.Epoch)
+	for _, att := range signedAtts {
+		if att.Source > att.Target {
+			return &Violation{
+				PubKey: pubKey,
+				Kind:   InvalidAttestation,
+				Detail: fmt.Sprintf("source epoch %d is greater than target epoch %d", att.Source, att.Target),
+			}
+		}
+		// Check for double votes.
+		if sr, ok := signingRootsByTarget[att.Target]; ok {
+			if slashings.SigningRootsDiffer(sr, att.SigningRoot) {
+				return &Violation{
+					PubKey: pubKey,
+					Kind:   DoubleVote,
+					Detail: fmt.Sprintf("target epoch %d signed more than once", att.Target),
+				}
+			}
+		}
+		// Check for surround voting.
+		b := createAttestation(att.Source, att.Target)
+		for source, targets := range targetEpochsBySource {
+			for _, target := range targets {
+				a := createAttestation(source, target)
+				if slashings.IsSurround(a, b) || slashings.IsSurround(b, a) {
+					return &Violation{
+						PubKey: pubKey,
+						Kind:   SurroundVote,
+						Detail: fmt.Sprintf(
+							"source %d target %d conflicts with source %d target %d",
+							att.Source, att.Target, source, target,
+						),
+					}
+				}
+			}
+		}
+		signingRootsByTarget[att.Target] = att.SigningRoot
+		targetEpochsBySource[att.Source] = append(targetEpochsBySource[att.Source], att.Target)
+	}
+	return nil
+}
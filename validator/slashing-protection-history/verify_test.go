@@ -0,0 +1,250 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	fieldparams "github.com/theQRL/zond/config/fieldparams"
+	"github.com/theQRL/zond/validator/slashing-protection-history/format"
+)
+
+const testGenesisValidatorsRoot = "0x0101010101010101010101010101010101010101010101010101010101010101"
+
+func testPubKey(b byte) [fieldparams.BLSPubkeyLength]byte {
+	return [fieldparams.BLSPubkeyLength]byte{b}
+}
+
+func testRoot(b byte) string {
+	return fmt.Sprintf("%#x", [32]byte{b})
+}
+
+// protectionJSON builds an interchange file with the given genesis validators root and records.
+func protectionJSON(gvr string, data ...*format.ProtectionData) *format.EIPSlashingProtectionFormat {
+	f := &format.EIPSlashingProtectionFormat{Data: data}
+	f.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	f.Metadata.GenesisValidatorsRoot = gvr
+	return f
+}
+
+func protectionData(pubKey [fieldparams.BLSPubkeyLength]byte, blocks []*format.SignedBlock, atts []*format.SignedAttestation) *format.ProtectionData {
+	return &format.ProtectionData{
+		Pubkey:             fmt.Sprintf("%#x", pubKey),
+		SignedBlocks:       blocks,
+		SignedAttestations: atts,
+	}
+}
+
+func block(slot string, root string) *format.SignedBlock {
+	return &format.SignedBlock{Slot: slot, SigningRoot: root}
+}
+
+func att(source, target string, root string) *format.SignedAttestation {
+	return &format.SignedAttestation{SourceEpoch: source, TargetEpoch: target, SigningRoot: root}
+}
+
+func TestReadStandardProtectionJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		err  string
+	}{
+		{
+			name: "valid",
+			json: `{"metadata":{"interchange_format_version":"5","genesis_validators_root":"` + testGenesisValidatorsRoot + `"},"data":[]}`,
+		},
+		{
+			name: "malformed JSON",
+			json: `{"metadata":`,
+			err:  "could not unmarshal",
+		},
+		{
+			name: "unsupported version",
+			json: `{"metadata":{"interchange_format_version":"4","genesis_validators_root":"` + testGenesisValidatorsRoot + `"},"data":[]}`,
+			err:  "metadata was incorrect",
+		},
+		{
+			name: "invalid genesis validators root",
+			json: `{"metadata":{"interchange_format_version":"5","genesis_validators_root":"0x01"},"data":[]}`,
+			err:  "metadata was incorrect",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadStandardProtectionJSON(strings.NewReader(tt.json))
+			if tt.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("expected error %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestVerifyStandardProtectionJSON(t *testing.T) {
+	pubKey := testPubKey(1)
+	tests := []struct {
+		name   string
+		data   []*format.ProtectionData
+		want   []ViolationKind
+		detail string
+	}{
+		{
+			name: "clean history",
+			data: []*format.ProtectionData{protectionData(pubKey,
+				[]*format.SignedBlock{block("1", testRoot(1)), block("2", testRoot(2)), block("2", testRoot(2))},
+				[]*format.SignedAttestation{att("1", "2", testRoot(1)), att("2", "3", testRoot(2)), att("2", "3", testRoot(2))},
+			)},
+		},
+		{
+			name: "double proposal",
+			data: []*format.ProtectionData{protectionData(pubKey,
+				[]*format.SignedBlock{block("5", testRoot(1)), block("5", testRoot(2))}, nil,
+			)},
+			want:   []ViolationKind{DoubleProposal},
+			detail: "slot 5 signed more than once",
+		},
+		{
+			name: "double proposal without signing roots",
+			data: []*format.ProtectionData{protectionData(pubKey,
+				[]*format.SignedBlock{block("5", ""), block("5", "")}, nil,
+			)},
+			want: []ViolationKind{DoubleProposal},
+		},
+		{
+			name: "double vote",
+			data: []*format.ProtectionData{protectionData(pubKey, nil,
+				[]*format.SignedAttestation{att("1", "4", testRoot(1)), att("2", "4", testRoot(2))},
+			)},
+			want:   []ViolationKind{DoubleVote},
+			detail: "target epoch 4 signed more than once",
+		},
+		{
+			name: "double vote without signing roots",
+			data: []*format.ProtectionData{protectionData(pubKey, nil,
+				[]*format.SignedAttestation{att("1", "4", ""), att("1", "4", "")},
+			)},
+			want: []ViolationKind{DoubleVote},
+		},
+		{
+			name: "surrounding vote",
+			data: []*format.ProtectionData{protectionData(pubKey, nil,
+				[]*format.SignedAttestation{att("3", "4", testRoot(1)), att("2", "5", testRoot(2))},
+			)},
+			want:   []ViolationKind{SurroundVote},
+			detail: "source 2 target 5 conflicts with source 3 target 4",
+		},
+		{
+			name: "surrounded vote",
+			data: []*format.ProtectionData{protectionData(pubKey, nil,
+				[]*format.SignedAttestation{att("2", "5", testRoot(1)), att("3", "4", testRoot(2))},
+			)},
+			want:   []ViolationKind{SurroundVote},
+			detail: "source 3 target 4 conflicts with source 2 target 5",
+		},
+		{
+			name: "source after target",
+			data: []*format.ProtectionData{protectionData(pubKey, nil,
+				[]*format.SignedAttestation{att("5", "4", testRoot(1))},
+			)},
+			want:   []ViolationKind{InvalidAttestation},
+			detail: "source epoch 5 is greater than target epoch 4",
+		},
+		{
+			name: "records of one key spread across items",
+			data: []*format.ProtectionData{
+				protectionData(pubKey,
+					[]*format.SignedBlock{block("5", testRoot(1))},
+					[]*format.SignedAttestation{att("1", "4", testRoot(1))},
+				),
+				protectionData(pubKey,
+					[]*format.SignedBlock{block("5", testRoot(2))},
+					[]*format.SignedAttestation{att("2", "4", testRoot(2))},
+				),
+			},
+			want: []ViolationKind{DoubleProposal, DoubleVote},
+		},
+		{
+			name: "first violation per key only",
+			data: []*format.ProtectionData{protectionData(pubKey, nil,
+				[]*format.SignedAttestation{att("1", "4", testRoot(1)), att("2", "4", testRoot(2)), att("0", "9", testRoot(3))},
+			)},
+			want: []ViolationKind{DoubleVote},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations, err := VerifyStandardProtectionJSON(context.Background(), protectionJSON(testGenesisValidatorsRoot, tt.data...))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(violations) != len(tt.want) {
+				t.Fatalf("unexpected number of violations %d, want %d", len(violations), len(tt.want))
+			}
+			for i, v := range violations {
+				if v.PubKey != pubKey {
+					t.Errorf("unexpected public key %#x", v.PubKey)
+				}
+				if v.Kind != tt.want[i] {
+					t.Errorf("unexpected violation %q, want %q", v.Kind, tt.want[i])
+				}
+			}
+			if tt.detail != "" && violations[0].Detail != tt.detail {
+				t.Errorf("unexpected detail %q, want %q", violations[0].Detail, tt.detail)
+			}
+		})
+	}
+}
+
+func TestVerifyStandardProtectionJSON_SortedByPubKey(t *testing.T) {
+	doubleVote := []*format.SignedAttestation{att("1", "4", testRoot(1)), att("2", "4", testRoot(2))}
+	doubleProposal := []*format.SignedBlock{block("5", testRoot(1)), block("5", testRoot(2))}
+	violations, err := VerifyStandardProtectionJSON(context.Background(), protectionJSON(testGenesisValidatorsRoot,
+		protectionData(testPubKey(3), doubleProposal, doubleVote),
+		protectionData(testPubKey(2), nil, []*format.SignedAttestation{att("1", "2", testRoot(1))}),
+		protectionData(testPubKey(1), nil, doubleVote),
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		pubKey [fieldparams.BLSPubkeyLength]byte
+		kind   ViolationKind
+	}{
+		{testPubKey(1), DoubleVote},
+		{testPubKey(3), DoubleProposal},
+		{testPubKey(3), DoubleVote},
+	}
+	if len(violations) != len(want) {
+		t.Fatalf("unexpected number of violations %d, want %d", len(violations), len(want))
+	}
+	for i, v := range violations {
+		if v.PubKey != want[i].pubKey || v.Kind != want[i].kind {
+			t.Errorf("violation %d: have %#x %q, want %#x %q", i, v.PubKey, v.Kind, want[i].pubKey, want[i].kind)
+		}
+	}
+}
+
+func TestVerifyStandardProtectionJSON_InvalidRecords(t *testing.T) {
+	tests := []struct {
+		name string
+		data *format.ProtectionData
+	}{
+		{name: "invalid public key", data: &format.ProtectionData{Pubkey: "0x01"}},
+		{name: "invalid slot", data: protectionData(testPubKey(1), []*format.SignedBlock{block("a", "")}, nil)},
+		{name: "invalid epoch", data: protectionData(testPubKey(1), nil, []*format.SignedAttestation{att("1", "a", "")})},
+		{name: "invalid signing root", data: protectionData(testPubKey(1), nil, []*format.SignedAttestation{att("1", "2", "0x01")})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := VerifyStandardProtectionJSON(context.Background(), protectionJSON(testGenesisValidatorsRoot, tt.data)); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
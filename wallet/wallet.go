@@ -9,6 +9,8 @@ import (
 	"github.com/theQRL/go-qrllib/xmss"
 	"github.com/theQRL/zond/api"
 	"github.com/theQRL/zond/config"
+	"github.com/theQRL/zond/crypto/hd"
+	"github.com/theQRL/zond/crypto/keystore"
 	"github.com/theQRL/zond/misc"
 	"github.com/theQRL/zond/protos"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -18,10 +20,19 @@ import (
 	"time"
 )
 
+// Scrypt parameters used to encrypt new wallets, which are lowered by tests.
+var (
+	scryptN = keystore.StandardScryptN
+	scryptP = keystore.StandardScryptP
+)
+
 type Wallet struct {
 	outFileName string
 
 	pbData *protos.Wallet
+
+	// key encrypts the wallet file. It is nil for legacy plaintext wallets.
+	key *keystore.Key
 }
 
 func (w *Wallet) AddXMSS(height uint8, hashFunction xmss.HashFunction) {
@@ -88,6 +99,23 @@ func (w *Wallet) RecoverDilithiumFromSeed(seed [common2.SeedSize]uint8) {
 	w.Save()
 }
 
+// RecoverDilithiumFromMnemonic adds the Dilithium addresses derived from a BIP-39
+// mnemonic at the given indices of the spending key path.
+func (w *Wallet) RecoverDilithiumFromMnemonic(mnemonic, passphrase string, indices []uint32) error {
+	seed, err := hd.SeedFromMnemonic(mnemonic, passphrase)
+	if err != nil {
+		return err
+	}
+	for _, index := range indices {
+		dilithiumSeed, err := hd.DilithiumSeedFromSeedAndPath(seed, fmt.Sprintf(hd.SpendingKeyPathTemplate, index))
+		if err != nil {
+			return err
+		}
+		w.RecoverDilithiumFromSeed(dilithiumSeed)
+	}
+	return nil
+}
+
 func (w *Wallet) reqBalance(address string) (uint64, error) {
 	userConfig := config.GetUserConfig()
 	apiHostPort := fmt.Sprintf("http://%s:%d",
@@ -149,17 +177,33 @@ func (w *Wallet) Remove() {
 }
 
 func (w *Wallet) Save() {
-	jsonData, err := protojson.Marshal(w.pbData)
-	if err != nil {
+	if err := w.save(); err != nil {
 		fmt.Println("Error: ", err)
-		return
 	}
-	err = ioutil.WriteFile(w.outFileName, jsonData, 0644)
+}
+
+// save writes the wallet file, encrypting each account if the wallet has a key.
+func (w *Wallet) save() error {
+	if w.key == nil {
+		jsonData, err := protojson.Marshal(w.pbData)
+		if err != nil {
+			return err
+		}
+		return keystore.WriteFileAtomic(w.outFileName, jsonData)
+	}
+	items := make([]*keystore.Item, 0, len(w.pbData.Info))
+	for _, info := range w.pbData.Info {
+		data, err := protojson.Marshal(info)
+		if err != nil {
+			return err
+		}
+		items = append(items, &keystore.Item{ID: info.Address, Data: data})
+	}
+	f, err := w.key.Encrypt(items)
 	if err != nil {
-		fmt.Println("Error: ", err)
-		return
+		return err
 	}
-	return
+	return keystore.WriteFile(w.outFileName, f)
 }
 
 func (w *Wallet) Load() {
@@ -173,6 +217,10 @@ func (w *Wallet) Load() {
 		fmt.Println("Error reading file: ", err)
 		return
 	}
+	if keystore.IsEncrypted(data) {
+		fmt.Println("Error: wallet file is encrypted, a password is required to open it")
+		return
+	}
 	err = protojson.Unmarshal(data, w.pbData)
 	if err != nil {
 		fmt.Println("Error while decoding file: ", err)
@@ -180,6 +228,57 @@ func (w *Wallet) Load() {
 	}
 }
 
+// loadEncrypted decrypts the encrypted wallet file with the given password. If the
+// file does not exist, a new key is derived from the password to create it.
+func (w *Wallet) loadEncrypted(password string) error {
+	w.pbData = &protos.Wallet{}
+
+	if !misc.FileExists(w.outFileName) {
+		key, err := keystore.NewKey(password, scryptN, scryptP)
+		if err != nil {
+			return err
+		}
+		w.key = key
+		return nil
+	}
+	f, err := keystore.ReadFile(w.outFileName)
+	if err != nil {
+		return err
+	}
+	key, items, err := keystore.Decrypt(f, password)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		info := &protos.Info{}
+		if err := protojson.Unmarshal(item.Data, info); err != nil {
+			return fmt.Errorf("error while decoding account %s: %w", item.ID, err)
+		}
+		if info.Address != item.ID {
+			return fmt.Errorf("account %s holds address %s", item.ID, info.Address)
+		}
+		w.pbData.Info = append(w.pbData.Info, info)
+	}
+	w.key = key
+	return nil
+}
+
+// IsEncrypted reports whether the wallet file is encrypted.
+func (w *Wallet) IsEncrypted() bool {
+	return w.key != nil
+}
+
+// Encrypt encrypts the wallet file with the given password, converting a legacy
+// plaintext wallet into the encrypted format.
+func (w *Wallet) Encrypt(password string) error {
+	key, err := keystore.NewKey(password, scryptN, scryptP)
+	if err != nil {
+		return err
+	}
+	w.key = key
+	return w.save()
+}
+
 func (w *Wallet) GetXMSSAccountByIndex(index uint) (*xmss.XMSS, error) {
 	if int(index) > len(w.pbData.Info) {
 		return nil, errors.New(fmt.Sprintf("Invalid Wallet Index"))
@@ -220,6 +319,8 @@ func (w *Wallet) GetDilithiumAccountByIndex(index uint) (*dilithium.Dilithium, e
 	return nil, fmt.Errorf("not a dilithium account")
 }
 
+// NewWallet opens a legacy plaintext wallet file. Use OpenWallet to open an encrypted
+// wallet file.
 func NewWallet(walletFileName string) *Wallet {
 	w := &Wallet{
 		outFileName: walletFileName,
@@ -228,3 +329,15 @@ func NewWallet(walletFileName string) *Wallet {
 
 	return w
 }
+
+// OpenWallet opens the encrypted wallet file with the given password. If the file does
+// not exist, the wallet is created encrypted with the password when an account is added.
+func OpenWallet(walletFileName, password string) (*Wallet, error) {
+	w := &Wallet{
+		outFileName: walletFileName,
+	}
+	if err := w.loadEncrypted(password); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
@@ -1,14 +1,20 @@
 package wallet
 
 import (
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/theQRL/zond/crypto/hd"
+	"github.com/theQRL/zond/crypto/keystore"
 	"github.com/theQRL/zond/misc"
 	"github.com/theQRL/zond/protos"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestWalletXmssCreation(t *testing.T) {
@@ -51,6 +57,106 @@ func TestWalletDilithiumCreation(t *testing.T) {
 	}
 }
 
+func TestWalletDilithiumRecoveryFromMnemonic(t *testing.T) {
+	outFileName := "wallet_new.txt"
+	defer os.Remove(outFileName)
+	mnemonic := "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+	wallet := NewWallet(outFileName)
+	if err := wallet.RecoverDilithiumFromMnemonic(mnemonic, "", []uint32{0, 1}); err != nil {
+		t.Fatal(err)
+	}
+	if err := wallet.RecoverDilithiumFromMnemonic("abandon abandon abandon", "", []uint32{0}); err == nil {
+		t.Error("expected an error for an invalid mnemonic")
+	}
+
+	wallet = NewWallet(outFileName)
+	if len(wallet.pbData.Info) != 2 {
+		t.Fatalf("expected 2 recovered addresses, got %d", len(wallet.pbData.Info))
+	}
+	for i, info := range wallet.pbData.Info {
+		seed, err := hd.DilithiumSeedFromMnemonic(mnemonic, "", fmt.Sprintf(hd.SpendingKeyPathTemplate, i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.HexSeed != misc.BytesToHexStr(seed[:]) {
+			t.Errorf("address %d: have seed %s, want %x", i, info.HexSeed, seed)
+		}
+	}
+	if wallet.pbData.Info[0].Address == wallet.pbData.Info[1].Address {
+		t.Error("recovered addresses are not distinct")
+	}
+}
+
+func TestWalletEncryption(t *testing.T) {
+	scryptN, scryptP = keystore.LightScryptN, keystore.LightScryptP
+	outFileName := "wallet_new.txt"
+	defer os.Remove(outFileName)
+
+	wallet, err := OpenWallet(outFileName, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wallet.AddDilithium()
+	wallet.AddDilithium()
+	address := wallet.pbData.Info[1].Address
+
+	data, err := ioutil.ReadFile(outFileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !keystore.IsEncrypted(data) {
+		t.Fatal("wallet file is not encrypted")
+	}
+	if strings.Contains(string(data), wallet.pbData.Info[1].HexSeed) {
+		t.Error("wallet file holds a plaintext seed")
+	}
+
+	if _, err := OpenWallet(outFileName, "wrong password"); !errors.Is(err, keystore.ErrDecrypt) {
+		t.Errorf("expected error (%v), got error (%v)", keystore.ErrDecrypt, err)
+	}
+	wallet, err = OpenWallet(outFileName, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(wallet.pbData.Info) != 2 || wallet.pbData.Info[1].Address != address {
+		t.Fatalf("unexpected accounts after reopening the wallet: %v", wallet.pbData.Info)
+	}
+	if _, err := wallet.GetDilithiumAccountByIndex(2); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWalletMigration(t *testing.T) {
+	scryptN, scryptP = keystore.LightScryptN, keystore.LightScryptP
+	outFileName := "wallet_new.txt"
+	defer os.Remove(outFileName)
+
+	wallet := NewWallet(outFileName)
+	wallet.AddDilithium()
+	wallet.AddXMSS(4, 0)
+	if wallet.IsEncrypted() {
+		t.Fatal("legacy wallet is encrypted")
+	}
+	want := wallet.pbData.Info
+
+	if err := wallet.Encrypt("password"); err != nil {
+		t.Fatal(err)
+	}
+	wallet, err := OpenWallet(outFileName, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(wallet.pbData.Info) != len(want) {
+		t.Fatalf("expected %d accounts, got %d", len(want), len(wallet.pbData.Info))
+	}
+	for i, info := range wallet.pbData.Info {
+		if !proto.Equal(info, want[i]) {
+			t.Errorf("account %d: expected %v, got %v", i, want[i], info)
+		}
+	}
+}
+
 // func TestWalletBalance(t *testing.T) {
 // 	subtests := []struct {
 // 		name          string
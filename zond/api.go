@@ -0,0 +1,262 @@
+// Copyright 2015 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package zond
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	ethereum "github.com/theQRL/zond"
+	"github.com/theQRL/zond/block"
+	"github.com/theQRL/zond/common/hexutil"
+	"github.com/theQRL/zond/config"
+	"github.com/theQRL/zond/core/state"
+	"github.com/theQRL/zond/p2p"
+	"github.com/theQRL/zond/rpc"
+	"github.com/theQRL/zond/zond/downloader"
+)
+
+// syncPollInterval is how often the sync status is polled for syncing subscriptions.
+const syncPollInterval = 3 * time.Second
+
+// ZondAPI provides an API to access Zond full node-related information.
+type ZondAPI struct {
+	z *Zond
+}
+
+// NewZondAPI creates a new Zond protocol API for full nodes.
+func NewZondAPI(z *Zond) *ZondAPI {
+	return &ZondAPI{z}
+}
+
+// Syncing returns false in case the node is currently not syncing with the network. It can be up-to-date or has not
+// yet received the latest block headers from its peers. In case it is synchronizing:
+// - startingBlock: block number this node started to synchronize from
+// - currentBlock:  block number this node is currently importing
+// - highestBlock:  block number of the highest block header this node has received from peers
+func (api *ZondAPI) Syncing() (interface{}, error) {
+	syncing, progress := api.z.syncProgress()
+	if !syncing {
+		return false, nil
+	}
+	return map[string]interface{}{
+		"startingBlock": hexutil.Uint64(progress.StartingBlock),
+		"currentBlock":  hexutil.Uint64(progress.CurrentBlock),
+		"highestBlock":  hexutil.Uint64(progress.HighestBlock),
+	}, nil
+}
+
+// SyncingAPI provides the syncing subscription of the zond namespace.
+type SyncingAPI struct {
+	z *Zond
+}
+
+// NewSyncingAPI creates a new SyncingAPI.
+func NewSyncingAPI(z *Zond) *SyncingAPI {
+	return &SyncingAPI{z}
+}
+
+// Syncing provides information when this node starts synchronising with the Zond network and when it's finished.
+func (api *SyncingAPI) Syncing(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+
+	go func() {
+		ticker := time.NewTicker(syncPollInterval)
+		defer ticker.Stop()
+
+		wasSyncing := false
+		for {
+			select {
+			case <-ticker.C:
+				syncing, progress := api.z.syncProgress()
+				switch {
+				case syncing && !wasSyncing:
+					notifier.Notify(rpcSub.ID, &downloader.SyncingResult{
+						Syncing: true,
+						Status:  progress,
+					})
+				case !syncing && wasSyncing:
+					notifier.Notify(rpcSub.ID, false)
+				}
+				wasSyncing = syncing
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
+
+// AdminAPI is the collection of administrative API methods exposed over a
+// private endpoint.
+type AdminAPI struct {
+	srv *p2p.ServerV1
+}
+
+// NewAdminAPI creates a new API definition for the admin methods of the node.
+func NewAdminAPI(srv *p2p.ServerV1) *AdminAPI {
+	return &AdminAPI{srv}
+}
+
+// AddPeer requests connecting to a remote node, given as a libp2p multiaddr
+// including the peer ID.
+func (api *AdminAPI) AddPeer(url string) (bool, error) {
+	if err := api.srv.ConnectPeer(url); err != nil {
+		return false, fmt.Errorf("invalid peer: %v", err)
+	}
+	return true, nil
+}
+
+// Peers retrieves all the information we know about each individual peer at the
+// protocol granularity.
+func (api *AdminAPI) Peers() ([]*p2p.PeerInfoV1, error) {
+	return api.srv.PeersInfo(), nil
+}
+
+// NodeInfo retrieves all the information we know about the host node at the
+// protocol granularity.
+func (api *AdminAPI) NodeInfo() (*p2p.NodeInfoV1, error) {
+	return api.srv.NodeInfo(), nil
+}
+
+// NetAPI offers network related RPC methods
+type NetAPI struct {
+	srv *p2p.ServerV1
+}
+
+// NewNetAPI creates a new net API instance.
+func NewNetAPI(srv *p2p.ServerV1) *NetAPI {
+	return &NetAPI{srv}
+}
+
+// Listening returns an indication if the node is listening for network connections.
+func (api *NetAPI) Listening() bool {
+	return api.srv.Listening()
+}
+
+// PeerCount returns the number of connected peers
+func (api *NetAPI) PeerCount() hexutil.Uint {
+	return hexutil.Uint(api.srv.PeerCount())
+}
+
+// Version returns the network identifier of the chain.
+func (api *NetAPI) Version() string {
+	return config.GetDevConfig().ChainID.String()
+}
+
+// AccountRangeMaxResults is the maximum number of results to be returned per call
+const AccountRangeMaxResults = 256
+
+// DebugAPI is the collection of Zond full node APIs for debugging the
+// protocol.
+type DebugAPI struct {
+	z *Zond
+}
+
+// NewDebugAPI creates a new DebugAPI instance.
+func NewDebugAPI(z *Zond) *DebugAPI {
+	return &DebugAPI{z: z}
+}
+
+// DumpBlock retrieves the entire state of the database at a given block.
+func (api *DebugAPI) DumpBlock(blockNr rpc.BlockNumber) (state.Dump, error) {
+	opts := &state.DumpConfig{
+		OnlyWithAddresses: true,
+		Max:               AccountRangeMaxResults, // Sanity limit over RPC
+	}
+	b, err := api.blockByNumber(blockNr)
+	if err != nil {
+		return state.Dump{}, err
+	}
+	stateDb, err := api.z.stateAtBlockV1(b)
+	if err != nil {
+		return state.Dump{}, err
+	}
+	return stateDb.RawDump(opts), nil
+}
+
+// AccountRange enumerates all accounts in the given block and start point in paging request
+func (api *DebugAPI) AccountRange(blockNrOrHash rpc.BlockNumberOrHash, start hexutil.Bytes, maxResults int, nocode, nostorage, incompletes bool) (state.IteratorDump, error) {
+	var (
+		b   *block.Block
+		err error
+	)
+	if number, ok := blockNrOrHash.Number(); ok {
+		b, err = api.blockByNumber(number)
+	} else if hash, ok := blockNrOrHash.Hash(); ok {
+		b, err = api.z.blockchainV1.GetBlock(hash)
+	} else {
+		return state.IteratorDump{}, errors.New("either block number or block hash must be specified")
+	}
+	if err != nil {
+		return state.IteratorDump{}, err
+	}
+	stateDb, err := api.z.stateAtBlockV1(b)
+	if err != nil {
+		return state.IteratorDump{}, err
+	}
+
+	opts := &state.DumpConfig{
+		SkipCode:          nocode,
+		SkipStorage:       nostorage,
+		OnlyWithAddresses: !incompletes,
+		Start:             start,
+		Max:               uint64(maxResults),
+	}
+	if maxResults > AccountRangeMaxResults || maxResults <= 0 {
+		opts.Max = AccountRangeMaxResults
+	}
+	return stateDb.IteratorDump(opts), nil
+}
+
+// blockByNumber resolves the given number, treating the pending and latest
+// tags as the current head of the chain.
+func (api *DebugAPI) blockByNumber(number rpc.BlockNumber) (*block.Block, error) {
+	var b *block.Block
+	if number == rpc.PendingBlockNumber || number == rpc.LatestBlockNumber {
+		b = api.z.blockchainV1.CurrentBlock()
+	} else if number == rpc.FinalizedBlockNumber || number == rpc.SafeBlockNumber {
+		b = api.z.blockchainV1.CurrentFinalizedBlock()
+	} else {
+		b = api.z.blockchainV1.GetBlockByNumber(uint64(number))
+	}
+	if b == nil {
+		return nil, fmt.Errorf("block #%d not found", number)
+	}
+	return b, nil
+}
+
+// syncProgress returns whether the node is syncing along with its progress.
+func (s *Zond) syncProgress() (bool, ethereum.SyncProgress) {
+	syncing, startingSlot, highestSlot := s.srv.SyncProgress()
+	progress := ethereum.SyncProgress{
+		StartingBlock: startingSlot,
+		CurrentBlock:  s.blockchainV1.Height(),
+		HighestBlock:  highestSlot,
+	}
+	return syncing, progress
+}
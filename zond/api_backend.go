@@ -19,6 +19,7 @@ package zond
 import (
 	"context"
 	"errors"
+	"math/big"
 	"time"
 
 	"github.com/theQRL/zond/block"
@@ -30,25 +31,33 @@ import (
 	"github.com/theQRL/zond/core/state"
 	"github.com/theQRL/zond/core/types"
 	"github.com/theQRL/zond/core/vm"
+	"github.com/theQRL/zond/event"
 	"github.com/theQRL/zond/metadata"
 	"github.com/theQRL/zond/ntp"
 	"github.com/theQRL/zond/params"
 	"github.com/theQRL/zond/protos"
 	"github.com/theQRL/zond/rpc"
 	"github.com/theQRL/zond/transactions"
+	"github.com/theQRL/zond/zond/gasprice"
 )
 
+// errNoChainV1 is returned by the V1 chain accessors of a backend created without a V1 chain.
+var errNoChainV1 = errors.New("V1 chain is not available")
+
 // ZondAPIBackend implements ethapi.Backend for full nodes
 type ZondAPIBackend struct {
 	extRPCEnabled       bool
 	allowUnprotectedTxs bool
 	zond                *Zond
 	ntp                 ntp.NTPInterface
-	//gpo                 *gasprice.Oracle
+	gpo                 *gasprice.Oracle
 }
 
 // ChainConfig returns the active chain configuration.
 func (b *ZondAPIBackend) ChainConfig() *params.ChainConfig {
+	if b.zond.blockchainV1 != nil {
+		return b.zond.blockchainV1.ExecutionConfig()
+	}
 	return b.zond.blockchain.Config()
 }
 
@@ -66,6 +75,9 @@ func (b *ZondAPIBackend) GetValidators(ctx context.Context) (*metadata.EpochMeta
 }
 
 func (b *ZondAPIBackend) HeaderByNumberV1(ctx context.Context, number rpc.BlockNumber) (*protos.BlockHeader, error) {
+	if b.zond.blockchainV1 == nil {
+		return nil, errNoChainV1
+	}
 	// Pending block is only known by the miner
 	// if number == rpc.PendingBlockNumber {
 	// 	block := b.zond.miner.PendingBlock()
@@ -140,6 +152,9 @@ func (b *ZondAPIBackend) HeaderByHash(ctx context.Context, hash common.Hash) (*t
 }
 
 func (b *ZondAPIBackend) BlockByNumberV1(ctx context.Context, number rpc.BlockNumber) (*protos.Block, error) {
+	if b.zond.blockchainV1 == nil {
+		return nil, errNoChainV1
+	}
 	// Pending block is only known by the miner
 	if number == rpc.PendingBlockNumber {
 		block := b.zond.pos.PendingBlock()
@@ -264,7 +279,7 @@ func (b *ZondAPIBackend) StateAndHeaderByNumberV1(ctx context.Context, number rp
 	}
 
 	//stateDb, err := b.zond.BlockChain().StateAt(header.Root)
-	stateDb, err := b.zond.BlockChain().StateAt(bm.TrieRoot())
+	stateDb, err := b.zond.BlockChainV1().StateAt(bm.TrieRoot())
 
 	return stateDb, block.Header().PBData(), err
 }
@@ -336,7 +351,25 @@ func (b *ZondAPIBackend) StateAndHeaderByNumberOrHash(ctx context.Context, block
 	return nil, nil, errors.New("invalid arguments; neither block nor hash specified")
 }
 
+func (b *ZondAPIBackend) BadBlockByHashV1(ctx context.Context, hash common.Hash) (*protos.Block, error) {
+	if badBlock, ok := b.zond.blockchainV1.GetBadBlock(hash); ok {
+		return badBlock.PBData(), nil
+	}
+	return nil, nil
+}
+
+func (b *ZondAPIBackend) StateAtBlockV1(ctx context.Context, block *block.Block) (*state.StateDB, error) {
+	return b.zond.stateAtBlockV1(block)
+}
+
+func (b *ZondAPIBackend) StateAtTransactionV1(ctx context.Context, block *block.Block, txIndex int) (core.Message, vm.BlockContext, *state.StateDB, error) {
+	return b.zond.stateAtTransactionV1(block, txIndex)
+}
+
 func (b *ZondAPIBackend) GetReceiptsV1(ctx context.Context, hash common.Hash, isProtocolTransaction bool) (types.Receipts, error) {
+	if b.zond.blockchainV1 == nil {
+		return nil, errNoChainV1
+	}
 	//return b.zond.blockchain.GetReceiptsByHash(hash), nil
 	return b.zond.blockchainV1.GetReceiptsByHash(hash, isProtocolTransaction), nil
 }
@@ -448,6 +481,13 @@ func (b *ZondAPIBackend) GetTransaction(ctx context.Context, txHash common.Hash)
 	return tx, blockHash, blockNumber, index, nil
 }
 
+func (b *ZondAPIBackend) GetTransactionsByAddress(ctx context.Context, address common.Address, cursor []byte, limit int) ([]*rawdb.AddressTxIndexEntry, []byte, error) {
+	if b.zond.addressIndex == nil {
+		return nil, nil, errors.New("address transaction index is not enabled")
+	}
+	return b.zond.addressIndex.Transactions(b.zond.blockchain.CurrentHeader(), address, cursor, limit)
+}
+
 func (b *ZondAPIBackend) GetPoolNonceV1(ctx context.Context, addr common.Address) (uint64, error) {
 	//return b.zond.txPool.Nonce(addr), nil
 	return b.zond.blockchainV1.GetNonce(addr)
@@ -457,6 +497,26 @@ func (b *ZondAPIBackend) GetPoolNonce(ctx context.Context, addr common.Address)
 	return b.zond.txPool.Nonce(addr), nil
 }
 
+func (b *ZondAPIBackend) TxPoolContentV1() (map[common.Address][]*protos.Transaction, map[common.Address][]*protos.Transaction, error) {
+	return b.zond.blockchainV1.TxPoolContent()
+}
+
+func (b *ZondAPIBackend) TxPoolContentFromV1(addr common.Address) ([]*protos.Transaction, []*protos.Transaction, error) {
+	return b.zond.blockchainV1.TxPoolContentFrom(addr)
+}
+
+func (b *ZondAPIBackend) SubscribeNewTxsEventV1(ch chan<- core.NewTxsEventV1) event.Subscription {
+	if b.zond.blockchainV1 == nil {
+		// There is no V1 transaction pool to listen to, hand out a
+		// subscription that only ends when unsubscribed.
+		return event.NewSubscription(func(quit <-chan struct{}) error {
+			<-quit
+			return nil
+		})
+	}
+	return b.zond.blockchainV1.GetTransactionPool().SubscribeNewTxsEvent(ch)
+}
+
 //func (b *ZondAPIBackend) Stats() (pending int, queued int) {
 //	return b.zond.txPool.Stats()
 //}
@@ -481,14 +541,6 @@ func (b *ZondAPIBackend) GetPoolNonce(ctx context.Context, addr common.Address)
 //	return b.zond.Downloader().Progress()
 //}
 //
-//func (b *ZondAPIBackend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
-//	return b.gpo.SuggestTipCap(ctx)
-//}
-//
-//func (b *ZondAPIBackend) FeeHistory(ctx context.Context, blockCount int, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (firstBlock *big.Int, reward [][]*big.Int, baseFee []*big.Int, gasUsedRatio []float64, err error) {
-//	return b.gpo.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
-//}
-//
 //func (b *ZondAPIBackend) ChainDb() ethdb.Database {
 //	return b.zond.ChainDb()
 //}
@@ -509,6 +561,14 @@ func (b *ZondAPIBackend) GetPoolNonce(ctx context.Context, addr common.Address)
 //	return b.allowUnprotectedTxs
 //}
 
+func (b *ZondAPIBackend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return b.gpo.SuggestTipCap(ctx)
+}
+
+func (b *ZondAPIBackend) FeeHistory(ctx context.Context, blockCount int, lastBlock rpc.BlockNumber, rewardPercentiles []float64) (firstBlock *big.Int, reward [][]*big.Int, baseFee []*big.Int, gasUsedRatio []float64, err error) {
+	return b.gpo.FeeHistory(ctx, blockCount, lastBlock, rewardPercentiles)
+}
+
 func (b *ZondAPIBackend) RPCGasCap() uint64 {
 	// TODO (cyyber): Add a separate config for RPCGasCap
 	return config.GetDevConfig().BlockGasLimit
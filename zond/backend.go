@@ -17,6 +17,7 @@
 package zond
 
 import (
+	"errors"
 	"sync/atomic"
 	"time"
 
@@ -24,6 +25,7 @@ import (
 	"github.com/theQRL/zond/consensus"
 	"github.com/theQRL/zond/consensus_old"
 	"github.com/theQRL/zond/core"
+	"github.com/theQRL/zond/core/state/pruner"
 	"github.com/theQRL/zond/core/txpool"
 	"github.com/theQRL/zond/core/vm"
 	"github.com/theQRL/zond/ethdb"
@@ -31,20 +33,24 @@ import (
 	"github.com/theQRL/zond/miner"
 	"github.com/theQRL/zond/node"
 	"github.com/theQRL/zond/ntp"
+	"github.com/theQRL/zond/p2p"
 	"github.com/theQRL/zond/rpc"
 	"github.com/theQRL/zond/zond/downloader"
 	"github.com/theQRL/zond/zond/filters"
+	"github.com/theQRL/zond/zond/gasprice"
 	"github.com/theQRL/zond/zond/zondconfig"
 )
 
 type Zond struct {
 	pos          *consensus_old.POS
+	srv          *p2p.ServerV1
 	blockchainV1 *chain.Chain
 	blockchain   *core.BlockChain
 	APIBackend   *ZondAPIBackend
 	handler      *handler
 	miner        *miner.Miner
 	txPool       *txpool.TxPool
+	addressIndex *core.AddressIndex // Address transaction index, nil unless enabled
 
 	// DB interfaces
 	chainDb ethdb.Database // Block chain database
@@ -58,49 +64,55 @@ func (s *Zond) APIs() []rpc.API {
 	//apis = append(apis, s.engine.APIs(s.BlockChain())...)
 
 	// Append all the local APIs and return
-	return append(apis, []rpc.API{
-		//{
-		//	Namespace: "zond",
-		//	Version:   "1.0",
-		//	Service:   NewEthereumAPI(s),
-		//}, {
-		//	Namespace: "zond",
-		//	Version:   "1.0",
-		//	Service:   downloader.NewDownloaderAPI(s.handler.downloader, s.eventMux),
-		//},
-		{
-			Namespace: "zond",
+	apis = append(apis, rpc.API{
+		Namespace: "zond",
+		Version:   "1.0",
+		Service:   filters.NewFilterAPI(s.APIBackend, false, 5*time.Minute),
+	})
+	if s.blockchainV1 != nil {
+		apis = append(apis, rpc.API{
+			Namespace: "debug",
 			Version:   "1.0",
-			Service:   filters.NewFilterAPI(s.APIBackend, false, 5*time.Minute),
-		}, // {
-		//	Namespace: "admin",
-		//	Version:   "1.0",
-		//	Service:   NewAdminAPI(s),
-		//}, {
-		//	Namespace: "debug",
-		//	Version:   "1.0",
-		//	Service:   NewDebugAPI(s),
-		//}, {
-		//	Namespace: "net",
-		//	Version:   "1.0",
-		//	Service:   s.netRPCService,
-		//}
-	}...)
+			Service:   NewDebugAPI(s),
+		})
+	}
+	// The network and sync status are only known if the p2p server is running
+	if s.srv != nil {
+		apis = append(apis, []rpc.API{
+			{
+				Namespace: "zond",
+				Version:   "1.0",
+				Service:   NewZondAPI(s),
+			}, {
+				Namespace: "zond",
+				Version:   "1.0",
+				Service:   NewSyncingAPI(s),
+			}, {
+				Namespace: "admin",
+				Version:   "1.0",
+				Service:   NewAdminAPI(s.srv),
+			}, {
+				Namespace: "net",
+				Version:   "1.0",
+				Service:   NewNetAPI(s.srv),
+			},
+		}...)
+	}
+	return apis
 }
 
 func (s *Zond) BlockChainV1() *chain.Chain {
 	return s.blockchainV1
 }
 
-func NewV1(stack *node.Node, pos *consensus_old.POS) (*Zond, error) {
+func NewV1(stack *node.Node, config *zondconfig.Config, pos *consensus_old.POS, srv *p2p.ServerV1) (*Zond, error) {
 	z := &Zond{
 		pos:          pos,
+		srv:          srv,
 		blockchainV1: stack.Blockchain(),
 	}
-	z.APIBackend = &ZondAPIBackend{stack.Config().ExtRPCEnabled(),
-		stack.Config().AllowUnprotectedTxs, z, ntp.GetNTP()}
+	z.APIBackend = newAPIBackend(stack, config, z)
 	stack.RegisterAPIs(z.APIs())
-	config := zondconfig.Defaults
 
 	// Override the chain config with provided settings.
 	var overrides core.ChainOverrides
@@ -111,24 +123,32 @@ func NewV1(stack *node.Node, pos *consensus_old.POS) (*Zond, error) {
 		overrides.OverrideTerminalTotalDifficultyPassed = config.OverrideTerminalTotalDifficultyPassed
 	}
 
+	// The address index is built from the execution chain database, which is not
+	// maintained by the legacy chain.
+	if config.AddressIndex {
+		return nil, errors.New("the address transaction index is only supported on the execution chain")
+	}
+
 	//z.shutdownTracker.MarkStartup()
 	return z, nil
 }
 
-func New(stack *node.Node) (*Zond, error) {
+func New(stack *node.Node, config *zondconfig.Config) (*Zond, error) {
 	z := &Zond{
 		// pos:          pos,
 		// blockchainV1: stack.Blockchain(),
 	}
-	z.APIBackend = &ZondAPIBackend{stack.Config().ExtRPCEnabled(),
-		stack.Config().AllowUnprotectedTxs, z, ntp.GetNTP()}
+	z.APIBackend = newAPIBackend(stack, config, z)
 	stack.RegisterAPIs(z.APIs())
-	config := zondconfig.Defaults
 	// Assemble the Ethereum object
 	chainDb, err := stack.OpenDatabaseWithFreezer("chaindata", config.DatabaseCache, config.DatabaseHandles, config.DatabaseFreezer, "zond/db/chaindata/", false)
 	if err != nil {
 		return nil, err
 	}
+	// Finish an interrupted offline state pruning before the state is used
+	if err := pruner.RecoverPruning(stack.ResolvePath(""), chainDb, stack.ResolvePath(config.TrieCleanCacheJournal)); err != nil {
+		return nil, err
+	}
 
 	var (
 		vmConfig = vm.Config{
@@ -154,15 +174,46 @@ func New(stack *node.Node) (*Zond, error) {
 	if config.OverrideTerminalTotalDifficultyPassed != nil {
 		overrides.OverrideTerminalTotalDifficultyPassed = config.OverrideTerminalTotalDifficultyPassed
 	}
+	z.chainDb = chainDb
 	z.blockchain, err = core.NewBlockChain(chainDb, cacheConfig, config.Genesis, &overrides, z.engine, vmConfig, &config.TxLookupLimit)
 	if err != nil {
 		return nil, err
 	}
+	if config.AddressIndex {
+		z.addressIndex = core.NewAddressIndex(chainDb, z.blockchain.Config())
+		z.addressIndex.Start(z.blockchain)
+	}
 	z.txPool = txpool.NewTxPool(config.TxPool, z.blockchain.Config(), z.blockchain)
 	//z.shutdownTracker.MarkStartup()
 	return z, nil
 }
 
+// newAPIBackend creates the RPC backend of z, with a gas price oracle configured from config.
+func newAPIBackend(stack *node.Node, config *zondconfig.Config, z *Zond) *ZondAPIBackend {
+	backend := &ZondAPIBackend{stack.Config().ExtRPCEnabled(),
+		stack.Config().AllowUnprotectedTxs, z, ntp.GetNTP(), nil}
+	gpoParams := config.GPO
+	if gpoParams.Default == nil {
+		gpoParams.Default = config.Miner.GasPrice
+	}
+	if z.blockchainV1 != nil {
+		backend.gpo = gasprice.NewOracle(backend, gpoParams)
+	} else {
+		backend.gpo = gasprice.NewChainOracle(backend, gpoParams)
+	}
+	return backend
+}
+
+// Stop terminates the services of the backend that run in the background.
+func (s *Zond) Stop() error {
+	if s.addressIndex != nil {
+		if err := s.addressIndex.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *Zond) BlockChain() *core.BlockChain       { return s.blockchain }
 func (s *Zond) Downloader() *downloader.Downloader { return s.handler.downloader }
 func (s *Zond) SyncMode() downloader.SyncMode {
@@ -22,8 +22,11 @@ import (
 	"math/big"
 
 	"github.com/theQRL/zond/common"
+	"github.com/theQRL/zond/core"
 	"github.com/theQRL/zond/core/bloombits"
 	"github.com/theQRL/zond/core/types"
+	"github.com/theQRL/zond/event"
+	"github.com/theQRL/zond/params"
 	"github.com/theQRL/zond/protos"
 	"github.com/theQRL/zond/rpc"
 )
@@ -41,7 +44,9 @@ type Backend interface {
 	GetLogs(ctx context.Context, hash common.Hash, number uint64) ([][]*types.Log, error)
 	PendingBlockAndReceiptsV1() (*protos.Block, types.Receipts)
 	PendingBlockAndReceipts() (*types.Block, types.Receipts)
+	ChainConfig() *params.ChainConfig
 
+	SubscribeNewTxsEventV1(chan<- core.NewTxsEventV1) event.Subscription
 	//SubscribeNewTxsEvent(chan<- core.NewTxsEvent) event.Subscription
 	//SubscribeChainEvent(ch chan<- core.ChainEvent) event.Subscription
 	//SubscribeRemovedLogsEvent(ch chan<- core.RemovedLogsEvent) event.Subscription
@@ -28,6 +28,8 @@ import (
 	"github.com/theQRL/zond/core"
 	"github.com/theQRL/zond/core/types"
 	"github.com/theQRL/zond/event"
+	"github.com/theQRL/zond/log"
+	"github.com/theQRL/zond/protos"
 	"github.com/theQRL/zond/rpc"
 )
 
@@ -44,8 +46,8 @@ const (
 	PendingLogsSubscription
 	// MinedAndPendingLogsSubscription queries for logs in mined and pending blocks.
 	MinedAndPendingLogsSubscription
-	// PendingTransactionsSubscription queries tx hashes for pending
-	// transactions entering the pending state
+	// PendingTransactionsSubscription queries for pending transactions
+	// entering the transaction pool
 	PendingTransactionsSubscription
 	// BlocksSubscription queries hashes for blocks that are imported
 	BlocksSubscription
@@ -71,7 +73,7 @@ type subscription struct {
 	created   time.Time
 	logsCrit  zond.FilterQuery
 	logs      chan []*types.Log
-	hashes    chan []common.Hash
+	txs       chan []*protos.Transaction
 	headers   chan *types.Header
 	installed chan struct{} // closed when the filter is installed
 	err       chan error    // closed when the filter is uninstalled
@@ -94,7 +96,7 @@ type EventSystem struct {
 	// Channels
 	install       chan *subscription         // install filter for event notification
 	uninstall     chan *subscription         // remove filter for event notification
-	txsCh         chan core.NewTxsEvent      // Channel to receive new transactions event
+	txsCh         chan core.NewTxsEventV1    // Channel to receive new transactions event
 	logsCh        chan []*types.Log          // Channel to receive new log event
 	pendingLogsCh chan []*types.Log          // Channel to receive new log event
 	rmLogsCh      chan core.RemovedLogsEvent // Channel to receive removed log event
@@ -113,7 +115,7 @@ func NewEventSystem(backend Backend, lightMode bool) *EventSystem {
 		lightMode:     lightMode,
 		install:       make(chan *subscription),
 		uninstall:     make(chan *subscription),
-		txsCh:         make(chan core.NewTxsEvent, txChanSize),
+		txsCh:         make(chan core.NewTxsEventV1, txChanSize),
 		logsCh:        make(chan []*types.Log, logsChanSize),
 		rmLogsCh:      make(chan core.RemovedLogsEvent, rmLogsChanSize),
 		pendingLogsCh: make(chan []*types.Log, logsChanSize),
@@ -121,7 +123,7 @@ func NewEventSystem(backend Backend, lightMode bool) *EventSystem {
 	}
 
 	// Subscribe events
-	//m.txsSub = m.backend.SubscribeNewTxsEvent(m.txsCh)
+	m.txsSub = m.backend.SubscribeNewTxsEventV1(m.txsCh)
 	//m.logsSub = m.backend.SubscribeLogsEvent(m.logsCh)
 	//m.rmLogsSub = m.backend.SubscribeRemovedLogsEvent(m.rmLogsCh)
 	//m.chainSub = m.backend.SubscribeChainEvent(m.chainCh)
@@ -131,8 +133,11 @@ func NewEventSystem(backend Backend, lightMode bool) *EventSystem {
 	//if m.txsSub == nil || m.logsSub == nil || m.rmLogsSub == nil || m.chainSub == nil || m.pendingLogsSub == nil {
 	//	log.Crit("Subscribe for event system failed")
 	//}
-	//
-	//go m.eventLoop()
+	if m.txsSub == nil {
+		log.Crit("Subscribe for event system failed")
+	}
+
+	go m.eventLoop()
 	return m
 }
 
@@ -162,7 +167,7 @@ func (sub *Subscription) Unsubscribe() {
 			case sub.es.uninstall <- sub.f:
 				break uninstallLoop
 			case <-sub.f.logs:
-			case <-sub.f.hashes:
+			case <-sub.f.txs:
 			case <-sub.f.headers:
 			}
 		}
@@ -229,7 +234,7 @@ func (es *EventSystem) subscribeMinedPendingLogs(crit zond.FilterQuery, logs cha
 		logsCrit:  crit,
 		created:   time.Now(),
 		logs:      logs,
-		hashes:    make(chan []common.Hash),
+		txs:       make(chan []*protos.Transaction),
 		headers:   make(chan *types.Header),
 		installed: make(chan struct{}),
 		err:       make(chan error),
@@ -246,7 +251,7 @@ func (es *EventSystem) subscribeLogs(crit zond.FilterQuery, logs chan []*types.L
 		logsCrit:  crit,
 		created:   time.Now(),
 		logs:      logs,
-		hashes:    make(chan []common.Hash),
+		txs:       make(chan []*protos.Transaction),
 		headers:   make(chan *types.Header),
 		installed: make(chan struct{}),
 		err:       make(chan error),
@@ -263,7 +268,7 @@ func (es *EventSystem) subscribePendingLogs(crit zond.FilterQuery, logs chan []*
 		logsCrit:  crit,
 		created:   time.Now(),
 		logs:      logs,
-		hashes:    make(chan []common.Hash),
+		txs:       make(chan []*protos.Transaction),
 		headers:   make(chan *types.Header),
 		installed: make(chan struct{}),
 		err:       make(chan error),
@@ -279,7 +284,7 @@ func (es *EventSystem) SubscribeNewHeads(headers chan *types.Header) *Subscripti
 		typ:       BlocksSubscription,
 		created:   time.Now(),
 		logs:      make(chan []*types.Log),
-		hashes:    make(chan []common.Hash),
+		txs:       make(chan []*protos.Transaction),
 		headers:   headers,
 		installed: make(chan struct{}),
 		err:       make(chan error),
@@ -287,15 +292,15 @@ func (es *EventSystem) SubscribeNewHeads(headers chan *types.Header) *Subscripti
 	return es.subscribe(sub)
 }
 
-// SubscribePendingTxs creates a subscription that writes transaction hashes for
+// SubscribePendingTxs creates a subscription that writes transactions for
 // transactions that enter the transaction pool.
-func (es *EventSystem) SubscribePendingTxs(hashes chan []common.Hash) *Subscription {
+func (es *EventSystem) SubscribePendingTxs(txs chan []*protos.Transaction) *Subscription {
 	sub := &subscription{
 		id:        rpc.NewID(),
 		typ:       PendingTransactionsSubscription,
 		created:   time.Now(),
 		logs:      make(chan []*types.Log),
-		hashes:    hashes,
+		txs:       txs,
 		headers:   make(chan *types.Header),
 		installed: make(chan struct{}),
 		err:       make(chan error),
@@ -338,13 +343,9 @@ func (es *EventSystem) handleRemovedLogs(filters filterIndex, ev core.RemovedLog
 	}
 }
 
-func (es *EventSystem) handleTxsEvent(filters filterIndex, ev core.NewTxsEvent) {
-	hashes := make([]common.Hash, 0, len(ev.Txs))
-	for _, tx := range ev.Txs {
-		hashes = append(hashes, tx.Hash())
-	}
+func (es *EventSystem) handleTxsEvent(filters filterIndex, ev core.NewTxsEventV1) {
 	for _, f := range filters[PendingTransactionsSubscription] {
-		f.hashes <- hashes
+		f.txs <- ev.Txs
 	}
 }
 
@@ -441,10 +442,10 @@ func (es *EventSystem) eventLoop() {
 	// Ensure all subscriptions get cleaned up
 	defer func() {
 		es.txsSub.Unsubscribe()
-		es.logsSub.Unsubscribe()
-		es.rmLogsSub.Unsubscribe()
-		es.pendingLogsSub.Unsubscribe()
-		es.chainSub.Unsubscribe()
+		//es.logsSub.Unsubscribe()
+		//es.rmLogsSub.Unsubscribe()
+		//es.pendingLogsSub.Unsubscribe()
+		//es.chainSub.Unsubscribe()
 	}()
 
 	index := make(filterIndex)
@@ -488,12 +489,12 @@ func (es *EventSystem) eventLoop() {
 		// System stopped
 		case <-es.txsSub.Err():
 			return
-		case <-es.logsSub.Err():
-			return
-		case <-es.rmLogsSub.Err():
-			return
-		case <-es.chainSub.Err():
-			return
+			//case <-es.logsSub.Err():
+			//	return
+			//case <-es.rmLogsSub.Err():
+			//	return
+			//case <-es.chainSub.Err():
+			//	return
 		}
 	}
 }
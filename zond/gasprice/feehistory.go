@@ -0,0 +1,327 @@
+// Copyright 2021 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package gasprice
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"sync/atomic"
+
+	"github.com/theQRL/zond/common"
+	"github.com/theQRL/zond/core/types"
+	"github.com/theQRL/zond/log"
+	"github.com/theQRL/zond/rpc"
+)
+
+var (
+	errInvalidPercentile = errors.New("invalid reward percentile")
+	errRequestBeyondHead = errors.New("request beyond head block")
+)
+
+const (
+	// maxBlockFetchers is the max number of goroutines to spin up to pull blocks
+	// for the fee history calculation.
+	maxBlockFetchers = 4
+)
+
+// blockFees represents a single slot for feeHistory. Empty slots have a nil
+// block and are reported as blocks without any gas usage.
+type blockFees struct {
+	// set by the caller
+	blockNumber uint64
+	block       *feeBlock
+	receipts    types.Receipts
+	// filled by processBlock
+	results processedFees
+	err     error
+}
+
+type cacheKey struct {
+	hash        common.Hash
+	percentiles string
+}
+
+// processedFees contains the results of a processed block.
+type processedFees struct {
+	reward               []*big.Int
+	baseFee, nextBaseFee *big.Int
+	gasUsedRatio         float64
+}
+
+// txGasAndReward is sorted in ascending order based on reward
+type (
+	txGasAndReward struct {
+		gasUsed uint64
+		reward  *big.Int
+	}
+	sortGasAndReward []txGasAndReward
+)
+
+func (s sortGasAndReward) Len() int { return len(s) }
+func (s sortGasAndReward) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+func (s sortGasAndReward) Less(i, j int) bool {
+	return s[i].reward.Cmp(s[j].reward) < 0
+}
+
+// processBlock takes a blockFees structure with the block and receipts fields
+// filled, and fills in the rest of the fields. If the requested percentiles
+// are not available for the block, a slice of zero rewards is returned.
+//
+// Blocks do not adjust their base fee, so the next base fee is the base fee
+// of the processed block and the gas used is taken from the receipts.
+func (oracle *Oracle) processBlock(bf *blockFees, percentiles []float64) {
+	bf.results.baseFee = bf.block.baseFee
+	bf.results.nextBaseFee = new(big.Int).Set(bf.results.baseFee)
+
+	txs := bf.block.txs
+	if len(bf.receipts) != len(txs) {
+		bf.err = fmt.Errorf("receipt count mismatch for block %d: have %d, want %d", bf.blockNumber, len(bf.receipts), len(txs))
+		return
+	}
+	var gasUsed uint64
+	if len(bf.receipts) > 0 {
+		gasUsed = bf.receipts[len(bf.receipts)-1].CumulativeGasUsed
+	}
+	if gasLimit := bf.block.gasLimit; gasLimit > 0 {
+		bf.results.gasUsedRatio = float64(gasUsed) / float64(gasLimit)
+	}
+	if len(percentiles) == 0 {
+		// rewards were not requested, return null
+		return
+	}
+	bf.results.reward = make([]*big.Int, len(percentiles))
+	if len(txs) == 0 {
+		// return an all zero row if there are no transactions to gather data from
+		for i := range bf.results.reward {
+			bf.results.reward[i] = new(big.Int)
+		}
+		return
+	}
+
+	sorter := make(sortGasAndReward, len(txs))
+	for i, tx := range txs {
+		reward, err := effectiveGasTip(tx, bf.results.baseFee)
+		if err != nil {
+			// Included transactions always cover the base fee, so this can
+			// only happen on a corrupted block.
+			reward = new(big.Int)
+		}
+		sorter[i] = txGasAndReward{gasUsed: bf.receipts[i].GasUsed, reward: reward}
+	}
+	sort.Stable(sorter)
+
+	var txIndex int
+	sumGasUsed := sorter[0].gasUsed
+
+	for i, p := range percentiles {
+		thresholdGasUsed := uint64(float64(gasUsed) * p / 100)
+		for sumGasUsed < thresholdGasUsed && txIndex < len(txs)-1 {
+			txIndex++
+			sumGasUsed += sorter[txIndex].gasUsed
+		}
+		bf.results.reward[i] = sorter[txIndex].reward
+	}
+}
+
+// resolveBlockRange resolves the specified block range to absolute slot
+// numbers while also enforcing backend specific limitations. Empty slots
+// within the range are counted as blocks.
+// Note: an error is only returned if retrieving the head header has failed.
+// If there are no retrievable blocks in the specified range then zero block
+// count is returned with no error.
+func (oracle *Oracle) resolveBlockRange(ctx context.Context, reqEnd rpc.BlockNumber, blocks int) (uint64, int, error) {
+	headNumber, _, err := oracle.chain.header(ctx, rpc.LatestBlockNumber)
+	if err != nil {
+		return 0, 0, err
+	}
+	headBlock := rpc.BlockNumber(headNumber)
+
+	switch reqEnd {
+	case rpc.PendingBlockNumber, rpc.LatestBlockNumber:
+		// Pending blocks are produced by the proposer and never exposed to
+		// the fee history, so both resolve to the current head.
+		reqEnd = headBlock
+	case rpc.SafeBlockNumber, rpc.FinalizedBlockNumber:
+		finalized, _, err := oracle.chain.header(ctx, rpc.FinalizedBlockNumber)
+		if err != nil {
+			return 0, 0, err
+		}
+		reqEnd = rpc.BlockNumber(finalized)
+	case rpc.EarliestBlockNumber:
+		reqEnd = 0
+	default:
+		if reqEnd > headBlock {
+			return 0, 0, fmt.Errorf("%w: requested %d, head %d", errRequestBeyondHead, reqEnd, headBlock)
+		}
+	}
+	// Ensure not trying to retrieve before genesis.
+	if uint64(reqEnd+1) < uint64(blocks) {
+		blocks = int(reqEnd + 1)
+	}
+	return uint64(reqEnd), blocks, nil
+}
+
+// FeeHistory returns data relevant for fee estimation based on the specified range of blocks.
+// The range can be specified either with absolute block numbers or ending with the latest
+// or pending block. Backends may or may not support gathering data from the pending block
+// or blocks older than a certain age (specified in maxHistory). The first block of the
+// actually processed range is returned to avoid ambiguity when parts of the requested range
+// are not available or when the head has changed during processing this request.
+// Three arrays are returned based on the processed blocks:
+//   - reward: the requested percentiles of effective priority fees per gas of transactions in each
+//     block, sorted in ascending order and weighted by gas used.
+//   - baseFee: base fee per gas in the given block
+//   - gasUsedRatio: gasUsed/gasLimit in the given block
+//
+// Note: baseFee includes the next block after the newest of the returned range, because this
+// value can be derived from the newest block. Empty slots carry the base fee of the nearest
+// produced block and no rewards.
+func (oracle *Oracle) FeeHistory(ctx context.Context, blocks int, unresolvedLastBlock rpc.BlockNumber, rewardPercentiles []float64) (*big.Int, [][]*big.Int, []*big.Int, []float64, error) {
+	if blocks < 1 {
+		return common.Big0, nil, nil, nil, nil // returning with no data and no error means there are no retrievable blocks
+	}
+	maxFeeHistory := oracle.maxHeaderHistory
+	if len(rewardPercentiles) != 0 {
+		maxFeeHistory = oracle.maxBlockHistory
+	}
+	if blocks > maxFeeHistory {
+		log.Warn("Sanitizing fee history length", "requested", blocks, "truncated", maxFeeHistory)
+		blocks = maxFeeHistory
+	}
+	for i, p := range rewardPercentiles {
+		if p < 0 || p > 100 {
+			return common.Big0, nil, nil, nil, fmt.Errorf("%w: %f", errInvalidPercentile, p)
+		}
+		if i > 0 && p < rewardPercentiles[i-1] {
+			return common.Big0, nil, nil, nil, fmt.Errorf("%w: #%d:%f > #%d:%f", errInvalidPercentile, i-1, rewardPercentiles[i-1], i, p)
+		}
+	}
+	lastBlock, blocks, err := oracle.resolveBlockRange(ctx, unresolvedLastBlock, blocks)
+	if err != nil || blocks == 0 {
+		return common.Big0, nil, nil, nil, err
+	}
+	oldestBlock := lastBlock + 1 - uint64(blocks)
+
+	var (
+		next    = oldestBlock
+		results = make(chan *blockFees, blocks)
+	)
+	percentileKey := make([]byte, 8*len(rewardPercentiles))
+	for i, p := range rewardPercentiles {
+		binary.LittleEndian.PutUint64(percentileKey[i*8:(i+1)*8], math.Float64bits(p))
+	}
+	for i := 0; i < maxBlockFetchers && i < blocks; i++ {
+		go func() {
+			for {
+				// Retrieve the next block number to fetch with this goroutine
+				blockNumber := atomic.AddUint64(&next, 1) - 1
+				if blockNumber > lastBlock {
+					return
+				}
+
+				fees := &blockFees{blockNumber: blockNumber}
+				b, err := oracle.chain.block(ctx, blockNumber)
+				if err != nil {
+					fees.err = err
+				} else if b != nil {
+					fees.block = b
+					cacheKey := cacheKey{hash: b.hash, percentiles: string(percentileKey)}
+					if p, ok := oracle.historyCache.Get(cacheKey); ok {
+						fees.results = p
+					} else {
+						if len(b.txs) > 0 {
+							fees.receipts, fees.err = oracle.chain.receipts(ctx, b.hash)
+						}
+						if fees.err == nil {
+							oracle.processBlock(fees, rewardPercentiles)
+						}
+						if fees.err == nil {
+							oracle.historyCache.Add(cacheKey, fees.results)
+						}
+					}
+				}
+				// send to results even if empty to guarantee that blocks items are sent in total
+				results <- fees
+			}
+		}()
+	}
+	var (
+		reward       = make([][]*big.Int, blocks)
+		baseFee      = make([]*big.Int, blocks+1)
+		gasUsedRatio = make([]float64, blocks)
+	)
+	for ; blocks > 0; blocks-- {
+		fees := <-results
+		if fees.err != nil {
+			return common.Big0, nil, nil, nil, fees.err
+		}
+		i := int(fees.blockNumber - oldestBlock)
+		if fees.block == nil {
+			// The slot is empty, it carries no rewards and its base fee is
+			// filled in from the surrounding blocks below.
+			if len(rewardPercentiles) != 0 {
+				reward[i] = make([]*big.Int, len(rewardPercentiles))
+				for j := range reward[i] {
+					reward[i][j] = new(big.Int)
+				}
+			}
+			continue
+		}
+		reward[i], baseFee[i], gasUsedRatio[i] = fees.results.reward, fees.results.baseFee, fees.results.gasUsedRatio
+		if i == len(gasUsedRatio)-1 {
+			baseFee[i+1] = fees.results.nextBaseFee
+		}
+	}
+	fillBaseFees(baseFee)
+
+	if len(rewardPercentiles) == 0 {
+		reward = nil
+	}
+	return new(big.Int).SetUint64(oldestBlock), reward, baseFee, gasUsedRatio, nil
+}
+
+// fillBaseFees replaces the base fees of empty slots with the base fee of the
+// closest preceding block, or of the closest following block if there is no
+// preceding one in the range.
+func fillBaseFees(baseFee []*big.Int) {
+	var last *big.Int
+	for i := range baseFee {
+		if baseFee[i] == nil {
+			baseFee[i] = last
+		} else {
+			last = baseFee[i]
+		}
+	}
+	last = new(big.Int)
+	for i := len(baseFee) - 1; i >= 0; i-- {
+		if baseFee[i] == nil {
+			baseFee[i] = last
+		} else {
+			last = baseFee[i]
+		}
+	}
+	for i := range baseFee {
+		baseFee[i] = new(big.Int).Set(baseFee[i])
+	}
+}
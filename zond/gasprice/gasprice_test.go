@@ -0,0 +1,188 @@
+// Copyright 2020 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package gasprice
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/theQRL/go-qrllib/dilithium"
+	"github.com/theQRL/zond/common"
+	"github.com/theQRL/zond/core/types"
+	"github.com/theQRL/zond/misc"
+	"github.com/theQRL/zond/params"
+	"github.com/theQRL/zond/rpc"
+)
+
+// testChainBackend serves a chain of blocks built on core types to the oracle.
+type testChainBackend struct {
+	blocks   []*types.Block
+	receipts map[common.Hash]types.Receipts
+}
+
+// testPK returns a distinct Dilithium public key for each seed.
+func testPK(seed byte) []byte {
+	pk := make([]byte, dilithium.PKSizePacked)
+	pk[0] = seed
+	return pk
+}
+
+// newTestChainBackend builds a chain where block n carries a transaction with a
+// tip of n gwei from a user, and one with a tip of 100 gwei from the proposer.
+func newTestChainBackend(n int) *testChainBackend {
+	config := params.TestChainConfig
+	proposer := misc.GetAddressFromUnSizedPK(testPK(0xff))
+	b := &testChainBackend{receipts: make(map[common.Hash]types.Receipts)}
+	for i := 0; i < n; i++ {
+		header := &types.Header{
+			Number:   big.NewInt(int64(i)),
+			GasLimit: 1000000,
+			BaseFee:  big.NewInt(params.GWei),
+			Coinbase: proposer,
+			Time:     uint64(i),
+		}
+		var (
+			txs      []*types.Transaction
+			receipts types.Receipts
+		)
+		if i > 0 {
+			user := types.NewTx(&types.DynamicFeeTx{
+				ChainID:   config.ChainID,
+				GasTipCap: big.NewInt(int64(i) * params.GWei),
+				GasFeeCap: big.NewInt(1000 * params.GWei),
+				Gas:       21000,
+				To:        &common.Address{},
+				Value:     new(big.Int),
+				PK:        testPK(byte(i)),
+			})
+			own := types.NewTx(&types.DynamicFeeTx{
+				ChainID:   config.ChainID,
+				GasTipCap: big.NewInt(100 * params.GWei),
+				GasFeeCap: big.NewInt(1000 * params.GWei),
+				Gas:       21000,
+				To:        &common.Address{},
+				Value:     new(big.Int),
+				PK:        testPK(0xff),
+			})
+			txs = []*types.Transaction{user, own}
+			receipts = types.Receipts{
+				{GasUsed: 21000, CumulativeGasUsed: 21000},
+				{GasUsed: 21000, CumulativeGasUsed: 42000},
+			}
+		}
+		blk := types.NewBlockWithHeader(header).WithBody(txs, nil)
+		b.blocks = append(b.blocks, blk)
+		b.receipts[blk.Hash()] = receipts
+	}
+	return b
+}
+
+func (b *testChainBackend) HeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Header, error) {
+	blk, err := b.BlockByNumber(ctx, number)
+	if blk == nil {
+		return nil, err
+	}
+	return blk.Header(), nil
+}
+
+func (b *testChainBackend) BlockByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Block, error) {
+	switch number {
+	case rpc.LatestBlockNumber, rpc.PendingBlockNumber:
+		return b.blocks[len(b.blocks)-1], nil
+	case rpc.FinalizedBlockNumber, rpc.SafeBlockNumber:
+		return b.blocks[len(b.blocks)/2], nil
+	}
+	if number < 0 || int(number) >= len(b.blocks) {
+		return nil, nil
+	}
+	return b.blocks[number], nil
+}
+
+func (b *testChainBackend) GetReceipts(ctx context.Context, hash common.Hash) (types.Receipts, error) {
+	return b.receipts[hash], nil
+}
+
+func (b *testChainBackend) ChainConfig() *params.ChainConfig {
+	return params.TestChainConfig
+}
+
+func TestChainOracleSuggestTipCap(t *testing.T) {
+	config := Config{
+		Blocks:           3,
+		Percentile:       60,
+		MaxHeaderHistory: 32,
+		MaxBlockHistory:  32,
+		Default:          big.NewInt(params.GWei),
+	}
+	oracle := NewChainOracle(newTestChainBackend(33), config)
+
+	// The transactions of the proposer are skipped, leaving a single price in
+	// each block, so twice the blocks are sampled: user tips of 27 to 32 gwei.
+	got, err := oracle.SuggestTipCap(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := big.NewInt(30 * params.GWei); got.Cmp(want) != 0 {
+		t.Errorf("unexpected tip cap: have %v, want %v", got, want)
+	}
+}
+
+func TestChainOracleFeeHistory(t *testing.T) {
+	config := Config{
+		Blocks:           3,
+		Percentile:       60,
+		MaxHeaderHistory: 32,
+		MaxBlockHistory:  32,
+	}
+	oracle := NewChainOracle(newTestChainBackend(33), config)
+
+	first, reward, baseFee, gasUsedRatio, err := oracle.FeeHistory(context.Background(), 4, rpc.LatestBlockNumber, []float64{0, 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Uint64() != 29 {
+		t.Errorf("unexpected first block %v", first)
+	}
+	if len(reward) != 4 || len(baseFee) != 5 || len(gasUsedRatio) != 4 {
+		t.Fatalf("unexpected result lengths %d, %d and %d", len(reward), len(baseFee), len(gasUsedRatio))
+	}
+	for i := range reward {
+		low := big.NewInt(int64(29+i) * params.GWei)
+		if reward[i][0].Cmp(low) != 0 || reward[i][1].Cmp(big.NewInt(100*params.GWei)) != 0 {
+			t.Errorf("block %d: unexpected rewards %v", 29+i, reward[i])
+		}
+		if baseFee[i].Cmp(big.NewInt(params.GWei)) != 0 {
+			t.Errorf("block %d: unexpected base fee %v", 29+i, baseFee[i])
+		}
+		if gasUsedRatio[i] != 0.042 {
+			t.Errorf("block %d: unexpected gas used ratio %v", 29+i, gasUsedRatio[i])
+		}
+	}
+
+	// The finalized block ends the range when requested.
+	first, _, _, _, err = oracle.FeeHistory(context.Background(), 2, rpc.FinalizedBlockNumber, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Uint64() != 15 {
+		t.Errorf("unexpected first block %v for the finalized range", first)
+	}
+	if _, _, _, _, err := oracle.FeeHistory(context.Background(), 2, 40, nil); err == nil {
+		t.Error("expected a range beyond the head to be rejected")
+	}
+}
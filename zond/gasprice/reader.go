@@ -0,0 +1,137 @@
+// Copyright 2023 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package gasprice
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/theQRL/zond/block"
+	"github.com/theQRL/zond/common"
+	"github.com/theQRL/zond/core/types"
+	"github.com/theQRL/zond/rpc"
+	"github.com/theQRL/zond/transactions"
+)
+
+// feeTx holds the fields of a transaction the oracle samples fees from.
+type feeTx struct {
+	from           *common.Address // nil if the sender could not be derived
+	tipCap, feeCap *big.Int
+}
+
+// feeBlock holds the fields of a block the oracle samples fees from.
+type feeBlock struct {
+	hash     common.Hash
+	baseFee  *big.Int
+	gasLimit uint64
+	proposer common.Address
+	txs      []feeTx
+}
+
+// chainReader gives the oracle access to the blocks of the chain it serves.
+type chainReader interface {
+	// header returns the number and hash of the head or finalized block.
+	header(ctx context.Context, number rpc.BlockNumber) (uint64, common.Hash, error)
+	// block returns the block with the given number, or nil for an empty slot.
+	block(ctx context.Context, number uint64) (*feeBlock, error)
+	// receipts returns the receipts of the transactions of the given block.
+	receipts(ctx context.Context, hash common.Hash) (types.Receipts, error)
+}
+
+// v1Reader reads the blocks of the V1 chain.
+type v1Reader struct {
+	backend OracleBackend
+}
+
+func (r *v1Reader) header(ctx context.Context, number rpc.BlockNumber) (uint64, common.Hash, error) {
+	head, err := r.backend.HeaderByNumberV1(ctx, number)
+	if err != nil {
+		return 0, common.Hash{}, err
+	}
+	return head.SlotNumber, common.BytesToHash(head.Hash), nil
+}
+
+func (r *v1Reader) block(ctx context.Context, number uint64) (*feeBlock, error) {
+	pb, err := r.backend.BlockByNumberV1(ctx, rpc.BlockNumber(number))
+	if pb == nil {
+		return nil, err
+	}
+	b := block.BlockFromPBData(pb)
+	fb := &feeBlock{
+		hash:     b.Hash(),
+		baseFee:  b.Header().BaseFee(),
+		gasLimit: b.GasLimit(),
+		proposer: *b.Minter(),
+	}
+	for _, protoTx := range b.Transactions() {
+		tx := transactions.ProtoToTransaction(protoTx)
+		from := tx.AddrFrom()
+		fb.txs = append(fb.txs, feeTx{from: &from, tipCap: tx.GasTipCap(), feeCap: tx.GasFeeCap()})
+	}
+	return fb, nil
+}
+
+func (r *v1Reader) receipts(ctx context.Context, hash common.Hash) (types.Receipts, error) {
+	return r.backend.GetReceiptsV1(ctx, hash, false)
+}
+
+// blockChainReader reads the blocks of a core.BlockChain.
+type blockChainReader struct {
+	backend ChainOracleBackend
+}
+
+func (r *blockChainReader) header(ctx context.Context, number rpc.BlockNumber) (uint64, common.Hash, error) {
+	head, err := r.backend.HeaderByNumber(ctx, number)
+	if err != nil {
+		return 0, common.Hash{}, err
+	}
+	if head == nil {
+		return 0, common.Hash{}, fmt.Errorf("header #%d not found", number)
+	}
+	return head.Number.Uint64(), head.Hash(), nil
+}
+
+func (r *blockChainReader) block(ctx context.Context, number uint64) (*feeBlock, error) {
+	b, err := r.backend.BlockByNumber(ctx, rpc.BlockNumber(number))
+	if b == nil {
+		return nil, err
+	}
+	baseFee := b.BaseFee()
+	if baseFee == nil {
+		baseFee = new(big.Int)
+	}
+	fb := &feeBlock{
+		hash:     b.Hash(),
+		baseFee:  baseFee,
+		gasLimit: b.GasLimit(),
+		proposer: b.Coinbase(),
+	}
+	signer := types.MakeSigner(r.backend.ChainConfig(), b.Number())
+	for _, tx := range b.Transactions() {
+		ftx := feeTx{tipCap: tx.GasTipCap(), feeCap: tx.GasFeeCap()}
+		if from, err := types.Sender(signer, tx); err == nil {
+			ftx.from = &from
+		}
+		fb.txs = append(fb.txs, ftx)
+	}
+	return fb, nil
+}
+
+func (r *blockChainReader) receipts(ctx context.Context, hash common.Hash) (types.Receipts, error) {
+	return r.backend.GetReceipts(ctx, hash)
+}
@@ -0,0 +1,77 @@
+// Copyright 2021 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package zond
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/theQRL/zond/block"
+	"github.com/theQRL/zond/core"
+	"github.com/theQRL/zond/core/state"
+	"github.com/theQRL/zond/core/vm"
+)
+
+// stateAtBlockV1 returns the state after all transactions of the given block were applied.
+// Every imported block commits its state to disk, so no re-execution is required.
+func (s *Zond) stateAtBlockV1(b *block.Block) (*state.StateDB, error) {
+	bm, err := s.blockchainV1.GetBlockMetaData(b.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("state of block #%d %#x not found: %w", b.Number(), b.Hash(), err)
+	}
+	return s.blockchainV1.StateAt(bm.TrieRoot())
+}
+
+// stateAtTransactionV1 returns the execution environment of a certain transaction.
+func (s *Zond) stateAtTransactionV1(b *block.Block, txIndex int) (core.Message, vm.BlockContext, *state.StateDB, error) {
+	// Short circuit if it's genesis block.
+	if b.Number() == 0 {
+		return nil, vm.BlockContext{}, nil, errors.New("no transaction in genesis")
+	}
+	if txIndex < 0 || txIndex >= len(b.Transactions()) {
+		return nil, vm.BlockContext{}, nil, fmt.Errorf("transaction index %d out of range for block %#x", txIndex, b.Hash())
+	}
+	// Create the parent state database
+	parent, err := s.blockchainV1.GetBlock(b.ParentHash())
+	if err != nil {
+		return nil, vm.BlockContext{}, nil, err
+	}
+	statedb, err := s.stateAtBlockV1(parent)
+	if err != nil {
+		return nil, vm.BlockContext{}, nil, err
+	}
+	// Recompute transactions up to the target index.
+	core.ReplayProtocolTransactionsV1(b, statedb)
+	var (
+		blockCtx = core.NewEVMBlockContextV1(b.Header(), s.blockchainV1.GetBlockHashBySlotNumber, b.Minter())
+		vmenv    = vm.NewEVM(blockCtx, vm.TxContext{}, statedb, s.blockchainV1.ExecutionConfig(), vm.Config{})
+		gp       = new(core.GasPool).AddGas(b.GasLimit())
+		usedGas  = new(uint64)
+	)
+	for idx := 0; idx < txIndex; idx++ {
+		if _, err := core.ApplyTransactionV1(vmenv, gp, statedb, b, idx, usedGas); err != nil {
+			return nil, vm.BlockContext{}, nil, fmt.Errorf("transaction %d failed: %w", idx, err)
+		}
+		// Ensure any modifications are committed to the state
+		statedb.Finalise(true)
+	}
+	msg, err := core.TransactionAsMessageV1(b.Transactions()[txIndex], b.Header().BaseFee())
+	if err != nil {
+		return nil, vm.BlockContext{}, nil, err
+	}
+	return msg, blockCtx, statedb, nil
+}
@@ -17,10 +17,14 @@
 package tracers
 
 import (
-	"bytes"
+	"bufio"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"runtime"
+	"sync"
 	"time"
 
 	"github.com/theQRL/zond/block"
@@ -30,10 +34,13 @@ import (
 	"github.com/theQRL/zond/core"
 	"github.com/theQRL/zond/core/state"
 	"github.com/theQRL/zond/core/types"
+	"github.com/theQRL/zond/core/vm"
 	"github.com/theQRL/zond/internal/zondapi"
+	"github.com/theQRL/zond/log"
+	"github.com/theQRL/zond/params"
 	"github.com/theQRL/zond/protos"
-	"github.com/theQRL/zond/rlp"
 	"github.com/theQRL/zond/rpc"
+	"github.com/theQRL/zond/transactions"
 	"github.com/theQRL/zond/zond/tracers/logger"
 )
 
@@ -46,13 +53,6 @@ const (
 	// and reexecute to produce missing historical state necessary to run a specific
 	// trace.
 	defaultTraceReexec = uint64(128)
-
-	// defaultTracechainMemLimit is the size of the triedb, at which traceChain
-	// switches over and tries to use a disk-backed database instead of building
-	// on top of memory.
-	// For non-archive nodes, this limit _will_ be overblown, as disk-backed tries
-	// will only be found every ~15K blocks or so.
-	defaultTracechainMemLimit = common.StorageSize(500 * 1024 * 1024)
 )
 
 // BackendV1 interface provides the common API services (that are provided by
@@ -62,16 +62,16 @@ type BackendV1 interface {
 	HeaderByNumberV1(ctx context.Context, number rpc.BlockNumber) (*protos.BlockHeader, error)
 	BlockByHashV1(ctx context.Context, hash common.Hash) (*protos.Block, error)
 	BlockByNumberV1(ctx context.Context, number rpc.BlockNumber) (*protos.Block, error)
+	BadBlockByHashV1(ctx context.Context, hash common.Hash) (*protos.Block, error)
 	GetTransactionV1(ctx context.Context, txHash common.Hash) (*protos.Transaction, common.Hash, uint64, uint64, error)
 	RPCGasCap() uint64
-	//ChainConfig() *params.ChainConfig
+	ChainConfig() *params.ChainConfig
 	Engine() consensus.Engine
-	//ChainDb() ethdb.Database
-	// StateAtBlock returns the state corresponding to the stateroot of the block.
-	// N.B: For executing transactions on block N, the required stateRoot is block N-1,
-	// so this method should be called with the parent.
-	//StateAtBlock(ctx context.Context, block *types.Block, reexec uint64, base *state.StateDB, checkLive, preferDisk bool) (*state.StateDB, error)
-	//StateAtTransaction(ctx context.Context, block *types.Block, txIndex int, reexec uint64) (core.Message, vm.BlockContext, *state.StateDB, error)
+	// StateAtBlockV1 returns the state after all transactions of the block were applied.
+	// N.B: For executing transactions on block N, the required state is the one of
+	// block N-1, so this method should be called with the parent.
+	StateAtBlockV1(ctx context.Context, block *block.Block) (*state.StateDB, error)
+	StateAtTransactionV1(ctx context.Context, block *block.Block, txIndex int) (core.Message, vm.BlockContext, *state.StateDB, error)
 }
 
 type Backend interface {
@@ -126,7 +126,17 @@ func (context *chainContext) GetHeader(hash common.Hash, number uint64) *protos.
 }
 
 func (context *chainContext) GetBlockBySlotNumber(n uint64) (*block.Block, error) {
-	return nil, nil
+	return context.api.blockByNumber(context.ctx, rpc.BlockNumber(n))
+}
+
+// getHash returns the hash of the block proposed at the given slot, or the
+// zero hash if no block is known for it.
+func (context *chainContext) getHash(n uint64) common.Hash {
+	b, err := context.GetBlockBySlotNumber(n)
+	if err != nil {
+		return common.Hash{}
+	}
+	return b.Hash()
 }
 
 // chainContext construts the context reader which is used by the evm for reading
@@ -135,9 +145,16 @@ func (api *API) chainContext(ctx context.Context) core.ChainContextV1 {
 	return &chainContext{api: api, ctx: ctx}
 }
 
+// blockContext creates the evm block context the transactions of the given
+// block are executed in.
+func (api *API) blockContext(ctx context.Context, b *block.Block) vm.BlockContext {
+	chainCtx := &chainContext{api: api, ctx: ctx}
+	return core.NewEVMBlockContextV1(b.Header(), chainCtx.getHash, b.Minter())
+}
+
 // blockByNumber is the wrapper of the chain access function offered by the backend.
 // It will return an error if the block is not found.
-func (api *API) blockByNumber(ctx context.Context, number rpc.BlockNumber) (*protos.Block, error) {
+func (api *API) blockByNumber(ctx context.Context, number rpc.BlockNumber) (*block.Block, error) {
 	b, err := api.backend.BlockByNumberV1(ctx, number)
 	if err != nil {
 		return nil, err
@@ -145,12 +162,12 @@ func (api *API) blockByNumber(ctx context.Context, number rpc.BlockNumber) (*pro
 	if b == nil {
 		return nil, fmt.Errorf("block #%d not found", number)
 	}
-	return b, nil
+	return block.BlockFromPBData(b), nil
 }
 
 // blockByHash is the wrapper of the chain access function offered by the backend.
 // It will return an error if the block is not found.
-func (api *API) blockByHash(ctx context.Context, hash common.Hash) (*protos.Block, error) {
+func (api *API) blockByHash(ctx context.Context, hash common.Hash) (*block.Block, error) {
 	b, err := api.backend.BlockByHashV1(ctx, hash)
 	if err != nil {
 		return nil, err
@@ -158,7 +175,7 @@ func (api *API) blockByHash(ctx context.Context, hash common.Hash) (*protos.Bloc
 	if b == nil {
 		return nil, fmt.Errorf("block %s not found", hash.Hex())
 	}
-	return b, nil
+	return block.BlockFromPBData(b), nil
 }
 
 // blockByNumberAndHash is the wrapper of the chain access function offered by
@@ -166,23 +183,57 @@ func (api *API) blockByHash(ctx context.Context, hash common.Hash) (*protos.Bloc
 //
 // Note this function is friendly for the light client which can only retrieve the
 // historical(before the CHT) header/block by number.
-func (api *API) blockByNumberAndHash(ctx context.Context, number rpc.BlockNumber, hash common.Hash) (*protos.Block, error) {
+func (api *API) blockByNumberAndHash(ctx context.Context, number rpc.BlockNumber, hash common.Hash) (*block.Block, error) {
 	b, err := api.blockByNumber(ctx, number)
 	if err != nil {
 		return nil, err
 	}
-	if common.BytesToHash(b.Header.Hash) == hash {
+	if b.Hash() == hash {
 		return b, nil
 	}
 	return api.blockByHash(ctx, hash)
 }
 
+// badBlockByHash retrieves a block from the pool of bad ones kept by the backend.
+// It will return an error if the block is not found.
+func (api *API) badBlockByHash(ctx context.Context, hash common.Hash) (*block.Block, error) {
+	b, err := api.backend.BadBlockByHashV1(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if b == nil {
+		return nil, fmt.Errorf("bad block %#x not found", hash)
+	}
+	return block.BlockFromPBData(b), nil
+}
+
+// parentState returns the state the transactions of the given block are
+// executed on, with the protocol transactions of the block already applied.
+func (api *API) parentState(ctx context.Context, b *block.Block) (*state.StateDB, error) {
+	if b.Number() == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+	parent, err := api.blockByHash(ctx, b.ParentHash())
+	if err != nil {
+		return nil, err
+	}
+	statedb, err := api.backend.StateAtBlockV1(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	core.ReplayProtocolTransactionsV1(b, statedb)
+	return statedb, nil
+}
+
 // TraceConfig holds extra parameters to trace functions.
 type TraceConfig struct {
 	*logger.Config
 	Tracer  *string
 	Timeout *string
 	Reexec  *uint64
+	// Config specific to given tracer. Note struct logger
+	// config are historically embedded in main object.
+	TracerConfig json.RawMessage
 }
 
 // TraceCallConfig is the config for traceCall API. It holds one more
@@ -192,6 +243,7 @@ type TraceCallConfig struct {
 	Tracer         *string
 	Timeout        *string
 	Reexec         *uint64
+	TracerConfig   json.RawMessage
 	StateOverrides *zondapi.StateOverride
 	BlockOverrides *zondapi.BlockOverrides
 }
@@ -212,9 +264,8 @@ type txTraceResult struct {
 // blockTraceTask represents a single block trace task when an entire chain is
 // being traced.
 type blockTraceTask struct {
-	statedb *state.StateDB   // Intermediate state prepped for tracing
-	block   *types.Block     // Block to trace the transactions from
-	rootref common.Hash      // Trie root reference held for this task
+	block   *block.Block     // Block to trace the transactions from
+	index   int              // Position of the block within the traced segment
 	results []*txTraceResult // Trace results procudes by the task
 }
 
@@ -235,244 +286,183 @@ type txTraceTask struct {
 
 // TraceChain returns the structured logs created during the execution of EVM
 // between two blocks (excluding start) and returns them as a JSON object.
-//func (api *API) TraceChain(ctx context.Context, start, end rpc.BlockNumber, config *TraceConfig) (*rpc.Subscription, error) { // Fetch the block interval that we want to trace
-//	from, err := api.blockByNumber(ctx, start)
-//	if err != nil {
-//		return nil, err
-//	}
-//	to, err := api.blockByNumber(ctx, end)
-//	if err != nil {
-//		return nil, err
-//	}
-//	if from.Number().Cmp(to.Number()) >= 0 {
-//		return nil, fmt.Errorf("end block (#%d) needs to come after start block (#%d)", end, start)
-//	}
-//	return api.traceChain(ctx, from, to, config)
-//}
+func (api *API) TraceChain(ctx context.Context, start, end rpc.BlockNumber, config *TraceConfig) (*rpc.Subscription, error) { // Fetch the block interval that we want to trace
+	from, err := api.blockByNumber(ctx, start)
+	if err != nil {
+		return nil, err
+	}
+	to, err := api.blockByNumber(ctx, end)
+	if err != nil {
+		return nil, err
+	}
+	if from.Number() >= to.Number() {
+		return nil, fmt.Errorf("end block (#%d) needs to come after start block (#%d)", end, start)
+	}
+	return api.traceChain(ctx, from, to, config)
+}
 
 // traceChain configures a new tracer according to the provided configuration, and
 // executes all the transactions contained within. The return value will be one item
 // per transaction, dependent on the requested tracer.
-func (api *API) traceChain(ctx context.Context, start, end *types.Block, config *TraceConfig) (*rpc.Subscription, error) {
-	return nil, nil
+func (api *API) traceChain(ctx context.Context, start, end *block.Block, config *TraceConfig) (*rpc.Subscription, error) {
 	// Tracing a chain is a **long** operation, only do with subscriptions
-	//notifier, supported := rpc.NotifierFromContext(ctx)
-	//if !supported {
-	//	return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
-	//}
-	//sub := notifier.CreateSubscription()
-	//
-	//// Prepare all the states for tracing. Note this procedure can take very
-	//// long time. Timeout mechanism is necessary.
-	//reexec := defaultTraceReexec
-	//if config != nil && config.Reexec != nil {
-	//	reexec = *config.Reexec
-	//}
-	//blocks := int(end.NumberU64() - start.NumberU64())
-	//threads := runtime.NumCPU()
-	//if threads > blocks {
-	//	threads = blocks
-	//}
-	//var (
-	//	pend     = new(sync.WaitGroup)
-	//	tasks    = make(chan *blockTraceTask, threads)
-	//	results  = make(chan *blockTraceTask, threads)
-	//	localctx = context.Background()
-	//)
-	//for th := 0; th < threads; th++ {
-	//	pend.Add(1)
-	//	go func() {
-	//		defer pend.Done()
-	//
-	//		// Fetch and execute the next block trace tasks
-	//		for task := range tasks {
-	//			signer := types.MakeSigner(api.backend.ChainConfig(), task.block.Number())
-	//			blockCtx := core.NewEVMBlockContext(task.block.Header(), api.chainContext(localctx), nil)
-	//			// Trace all the transactions contained within
-	//			for i, tx := range task.block.Transactions() {
-	//				msg, _ := tx.AsMessage(signer, task.block.BaseFee())
-	//				txctx := &Context{
-	//					BlockHash: task.block.Hash(),
-	//					TxIndex:   i,
-	//					TxHash:    tx.Hash(),
-	//				}
-	//				res, err := api.traceTx(localctx, msg, txctx, blockCtx, task.statedb, config)
-	//				if err != nil {
-	//					task.results[i] = &txTraceResult{Error: err.Error()}
-	//					log.Warn("Tracing failed", "hash", tx.Hash(), "block", task.block.NumberU64(), "err", err)
-	//					break
-	//				}
-	//				// Only delete empty objects if EIP158/161 (a.k.a Spurious Dragon) is in effect
-	//				task.statedb.Finalise(api.backend.ChainConfig().IsEIP158(task.block.Number()))
-	//				task.results[i] = &txTraceResult{Result: res}
-	//			}
-	//			// Stream the result back to the user or abort on teardown
-	//			select {
-	//			case results <- task:
-	//			case <-notifier.Closed():
-	//				return
-	//			}
-	//		}
-	//	}()
-	//}
-	//// Start a goroutine to feed all the blocks into the tracers
-	//var (
-	//	begin     = time.Now()
-	//	derefTodo []common.Hash // list of hashes to dereference from the db
-	//	derefsMu  sync.Mutex    // mutex for the derefs
-	//)
-	//
-	//go func() {
-	//	var (
-	//		logged  time.Time
-	//		number  uint64
-	//		traced  uint64
-	//		failed  error
-	//		parent  common.Hash
-	//		statedb *state.StateDB
-	//	)
-	//	// Ensure everything is properly cleaned up on any exit path
-	//	defer func() {
-	//		close(tasks)
-	//		pend.Wait()
-	//
-	//		switch {
-	//		case failed != nil:
-	//			log.Warn("Chain tracing failed", "start", start.NumberU64(), "end", end.NumberU64(), "transactions", traced, "elapsed", time.Since(begin), "err", failed)
-	//		case number < end.NumberU64():
-	//			log.Warn("Chain tracing aborted", "start", start.NumberU64(), "end", end.NumberU64(), "abort", number, "transactions", traced, "elapsed", time.Since(begin))
-	//		default:
-	//			log.Info("Chain tracing finished", "start", start.NumberU64(), "end", end.NumberU64(), "transactions", traced, "elapsed", time.Since(begin))
-	//		}
-	//		close(results)
-	//	}()
-	//	var preferDisk bool
-	//	// Feed all the blocks both into the tracer, as well as fast process concurrently
-	//	for number = start.NumberU64(); number < end.NumberU64(); number++ {
-	//		// Stop tracing if interruption was requested
-	//		select {
-	//		case <-notifier.Closed():
-	//			return
-	//		default:
-	//		}
-	//		// clean out any derefs
-	//		derefsMu.Lock()
-	//		for _, h := range derefTodo {
-	//			statedb.Database().TrieDB().Dereference(h)
-	//		}
-	//		derefTodo = derefTodo[:0]
-	//		derefsMu.Unlock()
-	//
-	//		// Print progress logs if long enough time elapsed
-	//		if time.Since(logged) > 8*time.Second {
-	//			logged = time.Now()
-	//			log.Info("Tracing chain segment", "start", start.NumberU64(), "end", end.NumberU64(), "current", number, "transactions", traced, "elapsed", time.Since(begin))
-	//		}
-	//		// Retrieve the parent state to trace on top
-	//		block, err := api.blockByNumber(localctx, rpc.BlockNumber(number))
-	//		if err != nil {
-	//			failed = err
-	//			break
-	//		}
-	//		// Prepare the statedb for tracing. Don't use the live database for
-	//		// tracing to avoid persisting state junks into the database.
-	//		statedb, err = api.backend.StateAtBlock(localctx, block, reexec, statedb, false, preferDisk)
-	//		if err != nil {
-	//			failed = err
-	//			break
-	//		}
-	//		if trieDb := statedb.Database().TrieDB(); trieDb != nil {
-	//			// Hold the reference for tracer, will be released at the final stage
-	//			trieDb.Reference(block.Root(), common.Hash{})
-	//
-	//			// Release the parent state because it's already held by the tracer
-	//			if parent != (common.Hash{}) {
-	//				trieDb.Dereference(parent)
-	//			}
-	//			// Prefer disk if the trie db memory grows too much
-	//			s1, s2 := trieDb.Size()
-	//			if !preferDisk && (s1+s2) > defaultTracechainMemLimit {
-	//				log.Info("Switching to prefer-disk mode for tracing", "size", s1+s2)
-	//				preferDisk = true
-	//			}
-	//		}
-	//		parent = block.Root()
-	//
-	//		next, err := api.blockByNumber(localctx, rpc.BlockNumber(number+1))
-	//		if err != nil {
-	//			failed = err
-	//			break
-	//		}
-	//		// Send the block over to the concurrent tracers (if not in the fast-forward phase)
-	//		txs := next.Transactions()
-	//		select {
-	//		case tasks <- &blockTraceTask{statedb: statedb.Copy(), block: next, rootref: block.Root(), results: make([]*txTraceResult, len(txs))}:
-	//		case <-notifier.Closed():
-	//			return
-	//		}
-	//		traced += uint64(len(txs))
-	//	}
-	//}()
-	//
-	//// Keep reading the trace results and stream the to the user
-	//go func() {
-	//	var (
-	//		done = make(map[uint64]*blockTraceResult)
-	//		next = start.NumberU64() + 1
-	//	)
-	//	for res := range results {
-	//		// Queue up next received result
-	//		result := &blockTraceResult{
-	//			Block:  hexutil.Uint64(res.block.NumberU64()),
-	//			Hash:   res.block.Hash(),
-	//			Traces: res.results,
-	//		}
-	//		// Schedule any parent tries held in memory by this task for dereferencing
-	//		done[uint64(result.Block)] = result
-	//		derefsMu.Lock()
-	//		derefTodo = append(derefTodo, res.rootref)
-	//		derefsMu.Unlock()
-	//		// Stream completed traces to the user, aborting on the first error
-	//		for result, ok := done[next]; ok; result, ok = done[next] {
-	//			if len(result.Traces) > 0 || next == end.NumberU64() {
-	//				notifier.Notify(sub.ID, result)
-	//			}
-	//			delete(done, next)
-	//			next++
-	//		}
-	//	}
-	//}()
-	//return sub, nil
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	sub := notifier.CreateSubscription()
+
+	blocks := int(end.Number() - start.Number())
+	threads := runtime.NumCPU()
+	if threads > blocks {
+		threads = blocks
+	}
+	var (
+		pend     = new(sync.WaitGroup)
+		tasks    = make(chan *blockTraceTask, threads)
+		results  = make(chan *blockTraceTask, threads)
+		localctx = context.Background()
+	)
+	for th := 0; th < threads; th++ {
+		pend.Add(1)
+		go func() {
+			defer pend.Done()
+
+			// Fetch and execute the next block trace tasks. The state of every
+			// imported block is kept on disk, so blocks are traced independently.
+			for task := range tasks {
+				res, err := api.traceBlock(localctx, task.block, config)
+				if err != nil {
+					log.Warn("Tracing failed", "hash", task.block.Hash(), "block", task.block.Number(), "err", err)
+					res = []*txTraceResult{{Error: err.Error()}}
+				}
+				task.results = res
+
+				// Stream the result back to the user or abort on teardown
+				select {
+				case results <- task:
+				case <-notifier.Closed():
+					return
+				}
+			}
+		}()
+	}
+	// Start a goroutine to feed all the blocks into the tracers
+	begin := time.Now()
+
+	go func() {
+		var (
+			logged time.Time
+			number uint64
+			index  int
+			traced uint64
+			failed error
+		)
+		// Ensure everything is properly cleaned up on any exit path
+		defer func() {
+			close(tasks)
+			pend.Wait()
+
+			switch {
+			case failed != nil:
+				log.Warn("Chain tracing failed", "start", start.Number(), "end", end.Number(), "transactions", traced, "elapsed", time.Since(begin), "err", failed)
+			case number <= end.Number():
+				log.Warn("Chain tracing aborted", "start", start.Number(), "end", end.Number(), "abort", number, "transactions", traced, "elapsed", time.Since(begin))
+			default:
+				log.Info("Chain tracing finished", "start", start.Number(), "end", end.Number(), "transactions", traced, "elapsed", time.Since(begin))
+			}
+			close(results)
+		}()
+		// Feed all the blocks into the tracers
+		for number = start.Number() + 1; number <= end.Number(); number++ {
+			// Stop tracing if interruption was requested
+			select {
+			case <-notifier.Closed():
+				return
+			default:
+			}
+			// Print progress logs if long enough time elapsed
+			if time.Since(logged) > 8*time.Second {
+				logged = time.Now()
+				log.Info("Tracing chain segment", "start", start.Number(), "end", end.Number(), "current", number, "transactions", traced, "elapsed", time.Since(begin))
+			}
+			pb, err := api.backend.BlockByNumberV1(localctx, rpc.BlockNumber(number))
+			if err != nil {
+				failed = err
+				break
+			}
+			// Slots without a proposed block have nothing to trace
+			if pb == nil {
+				continue
+			}
+			next := block.BlockFromPBData(pb)
+
+			// Send the block over to the concurrent tracers
+			select {
+			case tasks <- &blockTraceTask{block: next, index: index}:
+			case <-notifier.Closed():
+				return
+			}
+			index++
+			traced += uint64(len(next.Transactions()))
+		}
+	}()
+
+	// Keep reading the trace results and stream the to the user
+	go func() {
+		var (
+			done = make(map[int]*blockTraceTask)
+			next = 0
+		)
+		for res := range results {
+			// Queue up next received result
+			done[res.index] = res
+
+			// Stream completed traces to the user in order
+			for task, ok := done[next]; ok; task, ok = done[next] {
+				if len(task.results) > 0 || task.block.Number() == end.Number() {
+					notifier.Notify(sub.ID, &blockTraceResult{
+						Block:  hexutil.Uint64(task.block.Number()),
+						Hash:   task.block.Hash(),
+						Traces: task.results,
+					})
+				}
+				delete(done, next)
+				next++
+			}
+		}
+	}()
+	return sub, nil
 }
 
 // TraceBlockByNumber returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
-//func (api *API) TraceBlockByNumber(ctx context.Context, number rpc.BlockNumber, config *TraceConfig) ([]*txTraceResult, error) {
-//	block, err := api.blockByNumber(ctx, number)
-//	if err != nil {
-//		return nil, err
-//	}
-//	return api.traceBlock(ctx, block, config)
-//}
+func (api *API) TraceBlockByNumber(ctx context.Context, number rpc.BlockNumber, config *TraceConfig) ([]*txTraceResult, error) {
+	b, err := api.blockByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	return api.traceBlock(ctx, b, config)
+}
 
 // TraceBlockByHash returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
-//func (api *API) TraceBlockByHash(ctx context.Context, hash common.Hash, config *TraceConfig) ([]*txTraceResult, error) {
-//	block, err := api.blockByHash(ctx, hash)
-//	if err != nil {
-//		return nil, err
-//	}
-//	return api.traceBlock(ctx, block, config)
-//}
+func (api *API) TraceBlockByHash(ctx context.Context, hash common.Hash, config *TraceConfig) ([]*txTraceResult, error) {
+	b, err := api.blockByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	return api.traceBlock(ctx, b, config)
+}
 
 // TraceBlock returns the structured logs created during the execution of EVM
-// and returns them as a JSON object.
+// and returns them as a JSON object. The blob is the protobuf encoded block.
 func (api *API) TraceBlock(ctx context.Context, blob hexutil.Bytes, config *TraceConfig) ([]*txTraceResult, error) {
-	block := new(types.Block)
-	if err := rlp.Decode(bytes.NewReader(blob), block); err != nil {
+	b := new(block.Block)
+	if err := b.DeSerialize(blob); err != nil {
 		return nil, fmt.Errorf("could not decode block: %v", err)
 	}
-	return api.traceBlock(ctx, block, config)
+	return api.traceBlock(ctx, b, config)
 }
 
 // TraceBlockFromFile returns the structured logs created during the execution of
@@ -488,303 +478,262 @@ func (api *API) TraceBlockFromFile(ctx context.Context, file string, config *Tra
 // TraceBadBlock returns the structured logs created during the execution of
 // EVM against a block pulled from the pool of bad ones and returns them as a JSON
 // object.
-//func (api *API) TraceBadBlock(ctx context.Context, hash common.Hash, config *TraceConfig) ([]*txTraceResult, error) {
-//	block := rawdb.ReadBadBlock(api.backend.ChainDb(), hash)
-//	if block == nil {
-//		return nil, fmt.Errorf("bad block %#x not found", hash)
-//	}
-//	return api.traceBlock(ctx, block, config)
-//}
+func (api *API) TraceBadBlock(ctx context.Context, hash common.Hash, config *TraceConfig) ([]*txTraceResult, error) {
+	b, err := api.badBlockByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	return api.traceBlock(ctx, b, config)
+}
 
 // StandardTraceBlockToFile dumps the structured logs created during the
 // execution of EVM to the local file system and returns a list of files
 // to the caller.
-//func (api *API) StandardTraceBlockToFile(ctx context.Context, hash common.Hash, config *StdTraceConfig) ([]string, error) {
-//	block, err := api.blockByHash(ctx, hash)
-//	if err != nil {
-//		return nil, err
-//	}
-//	return api.standardTraceBlockToFile(ctx, block, config)
-//}
+func (api *API) StandardTraceBlockToFile(ctx context.Context, hash common.Hash, config *StdTraceConfig) ([]string, error) {
+	b, err := api.blockByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	return api.standardTraceBlockToFile(ctx, b, config)
+}
 
 // IntermediateRoots executes a block (bad- or canon- or side-), and returns a list
 // of intermediate roots: the stateroot after each transaction.
 func (api *API) IntermediateRoots(ctx context.Context, hash common.Hash, config *TraceConfig) ([]common.Hash, error) {
-	return nil, nil
-	//block, _ := api.blockByHash(ctx, hash)
-	//if block == nil {
-	//	// Check in the bad blocks
-	//	block = rawdb.ReadBadBlock(api.backend.ChainDb(), hash)
-	//}
-	//if block == nil {
-	//	return nil, fmt.Errorf("block %#x not found", hash)
-	//}
-	//if block.NumberU64() == 0 {
-	//	return nil, errors.New("genesis is not traceable")
-	//}
-	//parent, err := api.blockByNumberAndHash(ctx, rpc.BlockNumber(block.NumberU64()-1), block.ParentHash())
-	//if err != nil {
-	//	return nil, err
-	//}
-	//reexec := defaultTraceReexec
-	//if config != nil && config.Reexec != nil {
-	//	reexec = *config.Reexec
-	//}
-	//statedb, err := api.backend.StateAtBlock(ctx, parent, reexec, nil, true, false)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//var (
-	//	roots              []common.Hash
-	//	signer             = types.MakeSigner(api.backend.ChainConfig(), block.Number())
-	//	chainConfig        = api.backend.ChainConfig()
-	//	vmctx              = core.NewEVMBlockContext(block.Header(), api.chainContext(ctx), nil)
-	//	deleteEmptyObjects = chainConfig.IsEIP158(block.Number())
-	//)
-	//for i, tx := range block.Transactions() {
-	//	var (
-	//		msg, _    = tx.AsMessage(signer, block.BaseFee())
-	//		txContext = core.NewEVMTxContext(msg)
-	//		vmenv     = vm.NewEVM(vmctx, txContext, statedb, chainConfig, vm.Config{})
-	//	)
-	//	statedb.Prepare(tx.Hash(), i)
-	//	if _, err := core.ApplyMessage(vmenv, msg, new(core.GasPool).AddGas(msg.Gas())); err != nil {
-	//		log.Warn("Tracing intermediate roots did not complete", "txindex", i, "txhash", tx.Hash(), "err", err)
-	//		// We intentionally don't return the error here: if we do, then the RPC server will not
-	//		// return the roots. Most likely, the caller already knows that a certain transaction fails to
-	//		// be included, but still want the intermediate roots that led to that point.
-	//		// It may happen the tx_N causes an erroneous state, which in turn causes tx_N+M to not be
-	//		// executable.
-	//		// N.B: This should never happen while tracing canon blocks, only when tracing bad blocks.
-	//		return roots, nil
-	//	}
-	//	// calling IntermediateRoot will internally call Finalize on the state
-	//	// so any modifications are written to the trie
-	//	roots = append(roots, statedb.IntermediateRoot(deleteEmptyObjects))
-	//}
-	//return roots, nil
+	b, _ := api.blockByHash(ctx, hash)
+	if b == nil {
+		// Check in the bad blocks
+		b, _ = api.badBlockByHash(ctx, hash)
+	}
+	if b == nil {
+		return nil, fmt.Errorf("block %#x not found", hash)
+	}
+	statedb, err := api.parentState(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		roots   []common.Hash
+		vmenv   = vm.NewEVM(api.blockContext(ctx, b), vm.TxContext{}, statedb, api.backend.ChainConfig(), vm.Config{})
+		gp      = new(core.GasPool).AddGas(b.GasLimit())
+		usedGas = new(uint64)
+	)
+	for i, tx := range b.Transactions() {
+		if _, err := core.ApplyTransactionV1(vmenv, gp, statedb, b, i, usedGas); err != nil {
+			log.Warn("Tracing intermediate roots did not complete", "txindex", i, "txhash", transactions.ProtoToTransaction(tx).Hash(), "err", err)
+			// We intentionally don't return the error here: if we do, then the RPC server will not
+			// return the roots. Most likely, the caller already knows that a certain transaction fails to
+			// be included, but still want the intermediate roots that led to that point.
+			// It may happen the tx_N causes an erroneous state, which in turn causes tx_N+M to not be
+			// executable.
+			// N.B: This should never happen while tracing canon blocks, only when tracing bad blocks.
+			return roots, nil
+		}
+		// calling IntermediateRoot will internally call Finalize on the state
+		// so any modifications are written to the trie
+		roots = append(roots, statedb.IntermediateRoot(true))
+	}
+	return roots, nil
 }
 
 // StandardTraceBadBlockToFile dumps the structured logs created during the
 // execution of EVM against a block pulled from the pool of bad ones to the
 // local file system and returns a list of files to the caller.
-//func (api *API) StandardTraceBadBlockToFile(ctx context.Context, hash common.Hash, config *StdTraceConfig) ([]string, error) {
-//	block := rawdb.ReadBadBlock(api.backend.ChainDb(), hash)
-//	if block == nil {
-//		return nil, fmt.Errorf("bad block %#x not found", hash)
-//	}
-//	return api.standardTraceBlockToFile(ctx, block, config)
-//}
+func (api *API) StandardTraceBadBlockToFile(ctx context.Context, hash common.Hash, config *StdTraceConfig) ([]string, error) {
+	b, err := api.badBlockByHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	return api.standardTraceBlockToFile(ctx, b, config)
+}
 
 // traceBlock configures a new tracer according to the provided configuration, and
 // executes all the transactions contained within. The return value will be one item
-// per transaction, dependent on the requestd tracer.
-func (api *API) traceBlock(ctx context.Context, block *types.Block, config *TraceConfig) ([]*txTraceResult, error) {
-	return nil, nil
-	//if block.NumberU64() == 0 {
-	//	return nil, errors.New("genesis is not traceable")
-	//}
-	//parent, err := api.blockByNumberAndHash(ctx, rpc.BlockNumber(block.NumberU64()-1), block.ParentHash())
-	//if err != nil {
-	//	return nil, err
-	//}
-	//reexec := defaultTraceReexec
-	//if config != nil && config.Reexec != nil {
-	//	reexec = *config.Reexec
-	//}
-	//statedb, err := api.backend.StateAtBlock(ctx, parent, reexec, nil, true, false)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//// Execute all the transaction contained within the block concurrently
-	//var (
-	//	signer  = types.MakeSigner(api.backend.ChainConfig(), block.Number())
-	//	txs     = block.Transactions()
-	//	results = make([]*txTraceResult, len(txs))
-	//
-	//	pend = new(sync.WaitGroup)
-	//	jobs = make(chan *txTraceTask, len(txs))
-	//)
-	//threads := runtime.NumCPU()
-	//if threads > len(txs) {
-	//	threads = len(txs)
-	//}
-	//blockHash := block.Hash()
-	//for th := 0; th < threads; th++ {
-	//	pend.Add(1)
-	//	go func() {
-	//		blockCtx := core.NewEVMBlockContext(block.Header(), api.chainContext(ctx), nil)
-	//		defer pend.Done()
-	//		// Fetch and execute the next transaction trace tasks
-	//		for task := range jobs {
-	//			msg, _ := txs[task.index].AsMessage(signer, block.BaseFee())
-	//			txctx := &Context{
-	//				BlockHash: blockHash,
-	//				TxIndex:   task.index,
-	//				TxHash:    txs[task.index].Hash(),
-	//			}
-	//			res, err := api.traceTx(ctx, msg, txctx, blockCtx, task.statedb, config)
-	//			if err != nil {
-	//				results[task.index] = &txTraceResult{Error: err.Error()}
-	//				continue
-	//			}
-	//			results[task.index] = &txTraceResult{Result: res}
-	//		}
-	//	}()
-	//}
-	//// Feed the transactions into the tracers and return
-	//var failed error
-	//blockCtx := core.NewEVMBlockContext(block.Header(), api.chainContext(ctx), nil)
-	//for i, tx := range txs {
-	//	// Send the trace task over for execution
-	//	jobs <- &txTraceTask{statedb: statedb.Copy(), index: i}
-	//
-	//	// Generate the next state snapshot fast without tracing
-	//	msg, _ := tx.AsMessage(signer, block.BaseFee())
-	//	statedb.Prepare(tx.Hash(), i)
-	//	vmenv := vm.NewEVM(blockCtx, core.NewEVMTxContext(msg), statedb, api.backend.ChainConfig(), vm.Config{})
-	//	if _, err := core.ApplyMessage(vmenv, msg, new(core.GasPool).AddGas(msg.Gas())); err != nil {
-	//		failed = err
-	//		break
-	//	}
-	//	// Finalize the state so any modifications are written to the trie
-	//	// Only delete empty objects if EIP158/161 (a.k.a Spurious Dragon) is in effect
-	//	statedb.Finalise(vmenv.ChainConfig().IsEIP158(block.Number()))
-	//}
-	//close(jobs)
-	//pend.Wait()
-	//
-	//// If execution failed in between, abort
-	//if failed != nil {
-	//	return nil, failed
-	//}
-	//return results, nil
+// per transaction, dependent on the requestd tracer. Transactions which are not
+// executed by the EVM, such as stake transactions, are reported as errors.
+func (api *API) traceBlock(ctx context.Context, b *block.Block, config *TraceConfig) ([]*txTraceResult, error) {
+	statedb, err := api.parentState(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+	// Execute all the transaction contained within the block concurrently
+	var (
+		txs      = b.Transactions()
+		baseFee  = b.Header().BaseFee()
+		blockCtx = api.blockContext(ctx, b)
+		results  = make([]*txTraceResult, len(txs))
+
+		pend = new(sync.WaitGroup)
+		jobs = make(chan *txTraceTask, len(txs))
+	)
+	threads := runtime.NumCPU()
+	if threads > len(txs) {
+		threads = len(txs)
+	}
+	blockHash := b.Hash()
+	for th := 0; th < threads; th++ {
+		pend.Add(1)
+		go func() {
+			defer pend.Done()
+			// Fetch and execute the next transaction trace tasks
+			for task := range jobs {
+				msg, err := core.TransactionAsMessageV1(txs[task.index], baseFee)
+				if err != nil {
+					results[task.index] = &txTraceResult{Error: err.Error()}
+					continue
+				}
+				txctx := &Context{
+					BlockHash: blockHash,
+					TxIndex:   task.index,
+					TxHash:    transactions.ProtoToTransaction(txs[task.index]).Hash(),
+				}
+				res, err := api.traceTx(ctx, msg, txctx, blockCtx, task.statedb, config)
+				if err != nil {
+					results[task.index] = &txTraceResult{Error: err.Error()}
+					continue
+				}
+				results[task.index] = &txTraceResult{Result: res}
+			}
+		}()
+	}
+	// Feed the transactions into the tracers and return
+	var (
+		failed  error
+		vmenv   = vm.NewEVM(blockCtx, vm.TxContext{}, statedb, api.backend.ChainConfig(), vm.Config{})
+		gp      = new(core.GasPool).AddGas(b.GasLimit())
+		usedGas = new(uint64)
+	)
+	for i := range txs {
+		// Send the trace task over for execution
+		jobs <- &txTraceTask{statedb: statedb.Copy(), index: i}
+
+		// Generate the next state snapshot fast without tracing
+		if _, err := core.ApplyTransactionV1(vmenv, gp, statedb, b, i, usedGas); err != nil {
+			failed = err
+			break
+		}
+		// Finalize the state so any modifications are written to the trie
+		statedb.Finalise(true)
+	}
+	close(jobs)
+	pend.Wait()
+
+	// If execution failed in between, abort
+	if failed != nil {
+		return nil, failed
+	}
+	return results, nil
 }
 
 // standardTraceBlockToFile configures a new tracer which uses standard JSON output,
 // and traces either a full block or an individual transaction. The return value will
 // be one filename per transaction traced.
-func (api *API) standardTraceBlockToFile(ctx context.Context, block *types.Block, config *StdTraceConfig) ([]string, error) {
-	return nil, nil
+func (api *API) standardTraceBlockToFile(ctx context.Context, b *block.Block, config *StdTraceConfig) ([]string, error) {
 	// If we're tracing a single transaction, make sure it's present
-	//if config != nil && config.TxHash != (common.Hash{}) {
-	//	if !containsTx(block, config.TxHash) {
-	//		return nil, fmt.Errorf("transaction %#x not found in block", config.TxHash)
-	//	}
-	//}
-	//if block.NumberU64() == 0 {
-	//	return nil, errors.New("genesis is not traceable")
-	//}
-	//parent, err := api.blockByNumberAndHash(ctx, rpc.BlockNumber(block.NumberU64()-1), block.ParentHash())
-	//if err != nil {
-	//	return nil, err
-	//}
-	//reexec := defaultTraceReexec
-	//if config != nil && config.Reexec != nil {
-	//	reexec = *config.Reexec
-	//}
-	//statedb, err := api.backend.StateAtBlock(ctx, parent, reexec, nil, true, false)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//// Retrieve the tracing configurations, or use default values
-	//var (
-	//	logConfig logger.Config
-	//	txHash    common.Hash
-	//)
-	//if config != nil {
-	//	logConfig = config.Config
-	//	txHash = config.TxHash
-	//}
-	//logConfig.Debug = true
-	//
-	//// Execute transaction, either tracing all or just the requested one
-	//var (
-	//	dumps       []string
-	//	signer      = types.MakeSigner(api.backend.ChainConfig(), block.Number())
-	//	chainConfig = api.backend.ChainConfig()
-	//	vmctx       = core.NewEVMBlockContext(block.Header(), api.chainContext(ctx), nil)
-	//	canon       = true
-	//)
-	//// Check if there are any overrides: the caller may wish to enable a future
-	//// fork when executing this block. Note, such overrides are only applicable to the
-	//// actual specified block, not any preceding blocks that we have to go through
-	//// in order to obtain the state.
-	//// Therefore, it's perfectly valid to specify `"futureForkBlock": 0`, to enable `futureFork`
-	//
-	//if config != nil && config.Overrides != nil {
-	//	// Copy the config, to not screw up the main config
-	//	// Note: the Clique-part is _not_ deep copied
-	//	chainConfigCopy := new(params.ChainConfig)
-	//	*chainConfigCopy = *chainConfig
-	//	chainConfig = chainConfigCopy
-	//	if berlin := config.Config.Overrides.BerlinBlock; berlin != nil {
-	//		chainConfig.BerlinBlock = berlin
-	//		canon = false
-	//	}
-	//}
-	//for i, tx := range block.Transactions() {
-	//	// Prepare the trasaction for un-traced execution
-	//	var (
-	//		msg, _    = tx.AsMessage(signer, block.BaseFee())
-	//		txContext = core.NewEVMTxContext(msg)
-	//		vmConf    vm.Config
-	//		dump      *os.File
-	//		writer    *bufio.Writer
-	//		err       error
-	//	)
-	//	// If the transaction needs tracing, swap out the configs
-	//	if tx.Hash() == txHash || txHash == (common.Hash{}) {
-	//		// Generate a unique temporary file to dump it into
-	//		prefix := fmt.Sprintf("block_%#x-%d-%#x-", block.Hash().Bytes()[:4], i, tx.Hash().Bytes()[:4])
-	//		if !canon {
-	//			prefix = fmt.Sprintf("%valt-", prefix)
-	//		}
-	//		dump, err = os.CreateTemp(os.TempDir(), prefix)
-	//		if err != nil {
-	//			return nil, err
-	//		}
-	//		dumps = append(dumps, dump.Name())
-	//
-	//		// Swap out the noop logger to the standard tracer
-	//		writer = bufio.NewWriter(dump)
-	//		vmConf = vm.Config{
-	//			Debug:                   true,
-	//			Tracer:                  logger.NewJSONLogger(&logConfig, writer),
-	//			EnablePreimageRecording: true,
-	//		}
-	//	}
-	//	// Execute the transaction and flush any traces to disk
-	//	vmenv := vm.NewEVM(vmctx, txContext, statedb, chainConfig, vmConf)
-	//	statedb.Prepare(tx.Hash(), i)
-	//	_, err = core.ApplyMessage(vmenv, msg, new(core.GasPool).AddGas(msg.Gas()))
-	//	if writer != nil {
-	//		writer.Flush()
-	//	}
-	//	if dump != nil {
-	//		dump.Close()
-	//		log.Info("Wrote standard trace", "file", dump.Name())
-	//	}
-	//	if err != nil {
-	//		return dumps, err
-	//	}
-	//	// Finalize the state so any modifications are written to the trie
-	//	// Only delete empty objects if EIP158/161 (a.k.a Spurious Dragon) is in effect
-	//	statedb.Finalise(vmenv.ChainConfig().IsEIP158(block.Number()))
-	//
-	//	// If we've traced the transaction we were looking for, abort
-	//	if tx.Hash() == txHash {
-	//		break
-	//	}
-	//}
-	//return dumps, nil
+	if config != nil && config.TxHash != (common.Hash{}) {
+		if !containsTx(b, config.TxHash) {
+			return nil, fmt.Errorf("transaction %#x not found in block", config.TxHash)
+		}
+	}
+	statedb, err := api.parentState(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+	// Retrieve the tracing configurations, or use default values
+	var (
+		logConfig logger.Config
+		txHash    common.Hash
+	)
+	if config != nil {
+		logConfig = config.Config
+		txHash = config.TxHash
+	}
+	logConfig.Debug = true
+
+	// Execute transaction, either tracing all or just the requested one
+	var (
+		dumps       []string
+		chainConfig = api.backend.ChainConfig()
+		vmctx       = api.blockContext(ctx, b)
+		gp          = new(core.GasPool).AddGas(b.GasLimit())
+		usedGas     = new(uint64)
+		canon       = true
+	)
+	// Check if there are any overrides: the caller may wish to enable a future
+	// fork when executing this block. Note, such overrides are only applicable to the
+	// actual specified block, not any preceding blocks that we have to go through
+	// in order to obtain the state.
+	// Therefore, it's perfectly valid to specify `"futureForkBlock": 0`, to enable `futureFork`
+
+	if config != nil && config.Overrides != nil {
+		// Copy the config, to not screw up the main config
+		chainConfigCopy := new(params.ChainConfig)
+		*chainConfigCopy = *chainConfig
+		chainConfig = chainConfigCopy
+		if berlin := config.Config.Overrides.BerlinBlock; berlin != nil {
+			chainConfig.BerlinBlock = berlin
+			canon = false
+		}
+	}
+	for i, tx := range b.Transactions() {
+		// Prepare the trasaction for un-traced execution
+		var (
+			hash   = transactions.ProtoToTransaction(tx).Hash()
+			vmConf vm.Config
+			dump   *os.File
+			writer *bufio.Writer
+			err    error
+		)
+		// If the transaction needs tracing, swap out the configs
+		if hash == txHash || txHash == (common.Hash{}) {
+			// Generate a unique temporary file to dump it into
+			prefix := fmt.Sprintf("block_%#x-%d-%#x-", b.Hash().Bytes()[:4], i, hash.Bytes()[:4])
+			if !canon {
+				prefix = fmt.Sprintf("%valt-", prefix)
+			}
+			dump, err = os.CreateTemp(os.TempDir(), prefix)
+			if err != nil {
+				return nil, err
+			}
+			dumps = append(dumps, dump.Name())
+
+			// Swap out the noop logger to the standard tracer
+			writer = bufio.NewWriter(dump)
+			vmConf = vm.Config{
+				Debug:                   true,
+				Tracer:                  logger.NewJSONLogger(&logConfig, writer),
+				EnablePreimageRecording: true,
+			}
+		}
+		// Execute the transaction and flush any traces to disk
+		vmenv := vm.NewEVM(vmctx, vm.TxContext{}, statedb, chainConfig, vmConf)
+		_, err = core.ApplyTransactionV1(vmenv, gp, statedb, b, i, usedGas)
+		if writer != nil {
+			writer.Flush()
+		}
+		if dump != nil {
+			dump.Close()
+			log.Info("Wrote standard trace", "file", dump.Name())
+		}
+		if err != nil {
+			return dumps, err
+		}
+		// Finalize the state so any modifications are written to the trie
+		statedb.Finalise(true)
+
+		// If we've traced the transaction we were looking for, abort
+		if hash == txHash {
+			break
+		}
+	}
+	return dumps, nil
 }
 
 // containsTx reports whether the transaction with a certain hash
 // is contained within the specified block.
-func containsTx(block *types.Block, hash common.Hash) bool {
-	for _, tx := range block.Transactions() {
-		if tx.Hash() == hash {
+func containsTx(b *block.Block, hash common.Hash) bool {
+	for _, tx := range b.Transactions() {
+		if transactions.ProtoToTransaction(tx).Hash() == hash {
 			return true
 		}
 	}
@@ -793,143 +742,137 @@ func containsTx(block *types.Block, hash common.Hash) bool {
 
 // TraceTransaction returns the structured logs created during the execution of EVM
 // and returns them as a JSON object.
-//func (api *API) TraceTransaction(ctx context.Context, hash common.Hash, config *TraceConfig) (interface{}, error) {
-//	_, blockHash, blockNumber, index, err := api.backend.GetTransaction(ctx, hash)
-//	if err != nil {
-//		return nil, err
-//	}
-//	// It shouldn't happen in practice.
-//	if blockNumber == 0 {
-//		return nil, errors.New("genesis is not traceable")
-//	}
-//	reexec := defaultTraceReexec
-//	if config != nil && config.Reexec != nil {
-//		reexec = *config.Reexec
-//	}
-//	block, err := api.blockByNumberAndHash(ctx, rpc.BlockNumber(blockNumber), blockHash)
-//	if err != nil {
-//		return nil, err
-//	}
-//	msg, vmctx, statedb, err := api.backend.StateAtTransaction(ctx, block, int(index), reexec)
-//	if err != nil {
-//		return nil, err
-//	}
-//	txctx := &Context{
-//		BlockHash: blockHash,
-//		TxIndex:   int(index),
-//		TxHash:    hash,
-//	}
-//	return api.traceTx(ctx, msg, txctx, vmctx, statedb, config)
-//}
-
-// TraceCall lets you trace a given eth_call. It collects the structured logs
+func (api *API) TraceTransaction(ctx context.Context, hash common.Hash, config *TraceConfig) (interface{}, error) {
+	tx, blockHash, blockNumber, index, err := api.backend.GetTransactionV1(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if tx == nil {
+		return nil, fmt.Errorf("transaction %#x not found", hash)
+	}
+	// It shouldn't happen in practice.
+	if blockNumber == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+	b, err := api.blockByNumberAndHash(ctx, rpc.BlockNumber(blockNumber), blockHash)
+	if err != nil {
+		return nil, err
+	}
+	msg, vmctx, statedb, err := api.backend.StateAtTransactionV1(ctx, b, int(index))
+	if err != nil {
+		return nil, err
+	}
+	txctx := &Context{
+		BlockHash: blockHash,
+		TxIndex:   int(index),
+		TxHash:    hash,
+	}
+	return api.traceTx(ctx, msg, txctx, vmctx, statedb, config)
+}
+
+// TraceCall lets you trace a given zond_call. It collects the structured logs
 // created during the execution of EVM if the given transaction was added on
 // top of the provided block and returns them as a JSON object.
 func (api *API) TraceCall(ctx context.Context, args zondapi.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, config *TraceCallConfig) (interface{}, error) {
-	return nil, nil
 	// Try to retrieve the specified block
-	//var (
-	//	err   error
-	//	block *types.Block
-	//)
-	//if hash, ok := blockNrOrHash.Hash(); ok {
-	//	block, err = api.blockByHash(ctx, hash)
-	//} else if number, ok := blockNrOrHash.Number(); ok {
-	//	if number == rpc.PendingBlockNumber {
-	//		// We don't have access to the miner here. For tracing 'future' transactions,
-	//		// it can be done with block- and state-overrides instead, which offers
-	//		// more flexibility and stability than trying to trace on 'pending', since
-	//		// the contents of 'pending' is unstable and probably not a true representation
-	//		// of what the next actual block is likely to contain.
-	//		return nil, errors.New("tracing on top of pending is not supported")
-	//	}
-	//	block, err = api.blockByNumber(ctx, number)
-	//} else {
-	//	return nil, errors.New("invalid arguments; neither block nor hash specified")
-	//}
-	//if err != nil {
-	//	return nil, err
-	//}
-	//// try to recompute the state
-	//reexec := defaultTraceReexec
-	//if config != nil && config.Reexec != nil {
-	//	reexec = *config.Reexec
-	//}
-	//statedb, err := api.backend.StateAtBlock(ctx, block, reexec, nil, true, false)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//vmctx := core.NewEVMBlockContext(block.Header(), api.chainContext(ctx), nil)
-	//// Apply the customization rules if required.
-	//if config != nil {
-	//	if err := config.StateOverrides.Apply(statedb); err != nil {
-	//		return nil, err
-	//	}
-	//	config.BlockOverrides.Apply(&vmctx)
-	//}
-	//// Execute the trace
-	//msg, err := args.ToMessage(api.backend.RPCGasCap(), block.BaseFee())
-	//if err != nil {
-	//	return nil, err
-	//}
-	//
-	//var traceConfig *TraceConfig
-	//if config != nil {
-	//	traceConfig = &TraceConfig{
-	//		Config:  config.Config,
-	//		Tracer:  config.Tracer,
-	//		Timeout: config.Timeout,
-	//		Reexec:  config.Reexec,
-	//	}
-	//}
-	//return api.traceTx(ctx, msg, new(Context), vmctx, statedb, traceConfig)
+	var (
+		err error
+		b   *block.Block
+	)
+	if hash, ok := blockNrOrHash.Hash(); ok {
+		b, err = api.blockByHash(ctx, hash)
+	} else if number, ok := blockNrOrHash.Number(); ok {
+		if number == rpc.PendingBlockNumber {
+			// We don't have access to the miner here. For tracing 'future' transactions,
+			// it can be done with block- and state-overrides instead, which offers
+			// more flexibility and stability than trying to trace on 'pending', since
+			// the contents of 'pending' is unstable and probably not a true representation
+			// of what the next actual block is likely to contain.
+			return nil, errors.New("tracing on top of pending is not supported")
+		}
+		b, err = api.blockByNumber(ctx, number)
+	} else {
+		return nil, errors.New("invalid arguments; neither block nor hash specified")
+	}
+	if err != nil {
+		return nil, err
+	}
+	statedb, err := api.backend.StateAtBlockV1(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+	vmctx := api.blockContext(ctx, b)
+	// Apply the customization rules if required.
+	if config != nil {
+		if err := config.StateOverrides.Apply(statedb); err != nil {
+			return nil, err
+		}
+		config.BlockOverrides.Apply(&vmctx)
+	}
+	// Execute the trace
+	msg, err := args.ToMessage(api.backend.RPCGasCap(), b.Header().BaseFee())
+	if err != nil {
+		return nil, err
+	}
+
+	var traceConfig *TraceConfig
+	if config != nil {
+		traceConfig = &TraceConfig{
+			Config:       config.Config,
+			Tracer:       config.Tracer,
+			Timeout:      config.Timeout,
+			Reexec:       config.Reexec,
+			TracerConfig: config.TracerConfig,
+		}
+	}
+	return api.traceTx(ctx, msg, new(Context), vmctx, statedb, traceConfig)
 }
 
 // traceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
-//func (api *API) traceTx(ctx context.Context, message core.Message, txctx *Context, vmctx vm.BlockContext, statedb *state.StateDB, config *TraceConfig) (interface{}, error) {
-//	var (
-//		tracer    Tracer
-//		err       error
-//		timeout   = defaultTraceTimeout
-//		txContext = core.NewEVMTxContext(message)
-//	)
-//	if config == nil {
-//		config = &TraceConfig{}
-//	}
-//	// Default tracer is the struct logger
-//	tracer = logger.NewStructLogger(config.Config)
-//	if config.Tracer != nil {
-//		tracer, err = New(*config.Tracer, txctx)
-//		if err != nil {
-//			return nil, err
-//		}
-//	}
-//	// Define a meaningful timeout of a single transaction trace
-//	if config.Timeout != nil {
-//		if timeout, err = time.ParseDuration(*config.Timeout); err != nil {
-//			return nil, err
-//		}
-//	}
-//	deadlineCtx, cancel := context.WithTimeout(ctx, timeout)
-//	go func() {
-//		<-deadlineCtx.Done()
-//		if errors.Is(deadlineCtx.Err(), context.DeadlineExceeded) {
-//			tracer.Stop(errors.New("execution timeout"))
-//		}
-//	}()
-//	defer cancel()
-//
-//	// Run the transaction with tracing enabled.
-//	vmenv := vm.NewEVM(vmctx, txContext, statedb, api.backend.ChainConfig(), vm.Config{Debug: true, Tracer: tracer, NoBaseFee: true})
-//	// Call Prepare to clear out the statedb access list
-//	statedb.Prepare(txctx.TxHash, txctx.TxIndex)
-//	if _, err = core.ApplyMessage(vmenv, message, new(core.GasPool).AddGas(message.Gas())); err != nil {
-//		return nil, fmt.Errorf("tracing failed: %w", err)
-//	}
-//	return tracer.GetResult()
-//}
+func (api *API) traceTx(ctx context.Context, message core.Message, txctx *Context, vmctx vm.BlockContext, statedb *state.StateDB, config *TraceConfig) (interface{}, error) {
+	var (
+		tracer    Tracer
+		err       error
+		timeout   = defaultTraceTimeout
+		txContext = core.NewEVMTxContext(message)
+	)
+	if config == nil {
+		config = &TraceConfig{}
+	}
+	// Default tracer is the struct logger
+	tracer = logger.NewStructLogger(config.Config)
+	if config.Tracer != nil {
+		tracer, err = New(*config.Tracer, txctx, config.TracerConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Define a meaningful timeout of a single transaction trace
+	if config.Timeout != nil {
+		if timeout, err = time.ParseDuration(*config.Timeout); err != nil {
+			return nil, err
+		}
+	}
+	deadlineCtx, cancel := context.WithTimeout(ctx, timeout)
+	go func() {
+		<-deadlineCtx.Done()
+		if errors.Is(deadlineCtx.Err(), context.DeadlineExceeded) {
+			tracer.Stop(errors.New("execution timeout"))
+		}
+	}()
+	defer cancel()
+
+	// Run the transaction with tracing enabled.
+	vmenv := vm.NewEVM(vmctx, txContext, statedb, api.backend.ChainConfig(), vm.Config{Debug: true, Tracer: tracer, NoBaseFee: true})
+	// Call SetTxContext to clear out the statedb access list
+	statedb.SetTxContext(txctx.TxHash, txctx.TxIndex)
+	if _, err = core.ApplyMessage(vmenv, message, new(core.GasPool).AddGas(message.Gas())); err != nil {
+		return nil, fmt.Errorf("tracing failed: %w", err)
+	}
+	return tracer.GetResult()
+}
 
 // APIs return the collection of RPC services the tracer package offers.
 func APIs(backend BackendV1) []rpc.API {
@@ -0,0 +1,267 @@
+// Copyright 2021 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/theQRL/zond/block"
+	"github.com/theQRL/zond/common"
+	"github.com/theQRL/zond/common/hexutil"
+	"github.com/theQRL/zond/consensus"
+	"github.com/theQRL/zond/core"
+	"github.com/theQRL/zond/core/rawdb"
+	"github.com/theQRL/zond/core/state"
+	"github.com/theQRL/zond/core/types"
+	"github.com/theQRL/zond/core/vm"
+	"github.com/theQRL/zond/internal/zondapi"
+	"github.com/theQRL/zond/params"
+	"github.com/theQRL/zond/protos"
+	"github.com/theQRL/zond/rpc"
+	"github.com/theQRL/zond/zond/tracers/logger"
+)
+
+var (
+	testSender   = common.HexToAddress("0x00000000000000000000000000000000000000f1")
+	testContract = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	testTxHash   = common.HexToHash("0x7777")
+
+	// testCode adds 1 and 2 and stores the sum in slot 0.
+	testCode = common.FromHex("0x600260010160005500")
+)
+
+// testBackend serves a short chain of blocks on top of a single state holding a
+// funded sender and a contract.
+type testBackend struct {
+	blocks  []*protos.Block
+	statedb *state.StateDB
+}
+
+func newTestBackend(t *testing.T, n int) *testBackend {
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	statedb.AddBalance(testSender, big.NewInt(1000000000000000000))
+	statedb.SetCode(testContract, testCode)
+	b := &testBackend{statedb: statedb}
+	var parent common.Hash
+	for i := 0; i < n; i++ {
+		hash := common.BigToHash(big.NewInt(int64(i + 1)))
+		b.blocks = append(b.blocks, &protos.Block{
+			Header: &protos.BlockHeader{
+				SlotNumber:       uint64(i),
+				Hash:             hash.Bytes(),
+				ParentHash:       parent.Bytes(),
+				GasLimit:         30000000,
+				TimestampSeconds: uint64(i * 60),
+			},
+			ProtocolTransactions: []*protos.ProtocolTransaction{{Pk: make([]byte, 64)}},
+		})
+		parent = hash
+	}
+	return b
+}
+
+func (b *testBackend) HeaderByHashV1(ctx context.Context, hash common.Hash) (*protos.BlockHeader, error) {
+	blk, err := b.BlockByHashV1(ctx, hash)
+	if blk == nil {
+		return nil, err
+	}
+	return blk.Header, err
+}
+
+func (b *testBackend) HeaderByNumberV1(ctx context.Context, number rpc.BlockNumber) (*protos.BlockHeader, error) {
+	blk, err := b.BlockByNumberV1(ctx, number)
+	if blk == nil {
+		return nil, err
+	}
+	return blk.Header, err
+}
+
+func (b *testBackend) BlockByHashV1(ctx context.Context, hash common.Hash) (*protos.Block, error) {
+	for _, blk := range b.blocks {
+		if common.BytesToHash(blk.Header.Hash) == hash {
+			return blk, nil
+		}
+	}
+	return nil, nil
+}
+
+func (b *testBackend) BlockByNumberV1(ctx context.Context, number rpc.BlockNumber) (*protos.Block, error) {
+	if number == rpc.LatestBlockNumber {
+		return b.blocks[len(b.blocks)-1], nil
+	}
+	if number < 0 || int(number) >= len(b.blocks) {
+		return nil, nil
+	}
+	return b.blocks[number], nil
+}
+
+func (b *testBackend) BadBlockByHashV1(ctx context.Context, hash common.Hash) (*protos.Block, error) {
+	return nil, nil
+}
+
+// GetTransactionV1 reports the test transaction as the first one of the last block.
+func (b *testBackend) GetTransactionV1(ctx context.Context, txHash common.Hash) (*protos.Transaction, common.Hash, uint64, uint64, error) {
+	if txHash != testTxHash {
+		return nil, common.Hash{}, 0, 0, nil
+	}
+	last := b.blocks[len(b.blocks)-1]
+	return &protos.Transaction{Hash: txHash.Bytes()}, common.BytesToHash(last.Header.Hash), last.Header.SlotNumber, 0, nil
+}
+
+func (b *testBackend) RPCGasCap() uint64 {
+	return 25000000
+}
+
+func (b *testBackend) ChainConfig() *params.ChainConfig {
+	return params.TestChainConfig
+}
+
+func (b *testBackend) Engine() consensus.Engine {
+	return nil
+}
+
+func (b *testBackend) StateAtBlockV1(ctx context.Context, blk *block.Block) (*state.StateDB, error) {
+	return b.statedb.Copy(), nil
+}
+
+func (b *testBackend) StateAtTransactionV1(ctx context.Context, blk *block.Block, txIndex int) (core.Message, vm.BlockContext, *state.StateDB, error) {
+	if txIndex != 0 {
+		return nil, vm.BlockContext{}, nil, errors.New("transaction index out of range")
+	}
+	statedb, err := b.StateAtBlockV1(ctx, blk)
+	if err != nil {
+		return nil, vm.BlockContext{}, nil, err
+	}
+	msg := types.NewMessage(testSender, &testContract, 0, new(big.Int), 100000, new(big.Int), new(big.Int), new(big.Int), nil, nil, false)
+	vmctx := core.NewEVMBlockContextV1(blk.Header(), func(uint64) common.Hash { return common.Hash{} }, blk.Minter())
+	return msg, vmctx, statedb, nil
+}
+
+// structLogs decodes the result of a struct logger trace.
+func structLogs(t *testing.T, result interface{}) *logger.ExecutionResult {
+	t.Helper()
+	raw, ok := result.(json.RawMessage)
+	if !ok {
+		t.Fatalf("unexpected result type %T", result)
+	}
+	res := new(logger.ExecutionResult)
+	if err := json.Unmarshal(raw, res); err != nil {
+		t.Fatal(err)
+	}
+	return res
+}
+
+// checkStructLogs asserts that the result is a successful struct logger trace
+// of the test contract.
+func checkStructLogs(t *testing.T, result interface{}) {
+	t.Helper()
+	res := structLogs(t, result)
+	if res.Failed {
+		t.Error("expected a successful execution")
+	}
+	var ops []string
+	for _, l := range res.StructLogs {
+		ops = append(ops, l.Op)
+	}
+	if want := "PUSH1 PUSH1 ADD PUSH1 SSTORE STOP"; strings.Join(ops, " ") != want {
+		t.Errorf("unexpected opcodes %v, want %s", ops, want)
+	}
+}
+
+func TestTraceCall(t *testing.T) {
+	backend := newTestBackend(t, 3)
+	api := NewAPI(backend)
+	args := zondapi.TransactionArgs{From: &testSender, To: &testContract}
+
+	result, err := api.TraceCall(context.Background(), args, rpc.BlockNumberOrHashWithNumber(1), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkStructLogs(t, result)
+
+	hash := common.BytesToHash(backend.blocks[2].Header.Hash)
+	result, err = api.TraceCall(context.Background(), args, rpc.BlockNumberOrHashWithHash(hash, false), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkStructLogs(t, result)
+
+	// State overrides are applied before the call is executed.
+	code := hexutil.Bytes(common.FromHex("0x00"))
+	config := &TraceCallConfig{StateOverrides: &zondapi.StateOverride{testContract: zondapi.OverrideAccount{Code: &code}}}
+	result, err = api.TraceCall(context.Background(), args, rpc.BlockNumberOrHashWithNumber(1), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if logs := structLogs(t, result).StructLogs; len(logs) != 1 || logs[0].Op != "STOP" {
+		t.Errorf("expected the overridden code to be traced, got %v", logs)
+	}
+}
+
+func TestTraceCallErrors(t *testing.T) {
+	api := NewAPI(newTestBackend(t, 3))
+	args := zondapi.TransactionArgs{From: &testSender, To: &testContract}
+	tracer, timeout := "noSuchTracer", "forever"
+
+	tests := []struct {
+		name   string
+		block  rpc.BlockNumberOrHash
+		config *TraceCallConfig
+		err    string
+	}{
+		{"pending", rpc.BlockNumberOrHashWithNumber(rpc.PendingBlockNumber), nil, "tracing on top of pending is not supported"},
+		{"unknown number", rpc.BlockNumberOrHashWithNumber(10), nil, "block #10 not found"},
+		{"unknown hash", rpc.BlockNumberOrHashWithHash(common.HexToHash("0xff"), false), nil, "not found"},
+		{"unknown tracer", rpc.BlockNumberOrHashWithNumber(1), &TraceCallConfig{Tracer: &tracer}, "tracer not found"},
+		{"bad timeout", rpc.BlockNumberOrHashWithNumber(1), &TraceCallConfig{Timeout: &timeout}, "invalid duration"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := api.TraceCall(context.Background(), args, tt.block, tt.config)
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("expected error containing %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestTraceTransaction(t *testing.T) {
+	api := NewAPI(newTestBackend(t, 3))
+
+	result, err := api.TraceTransaction(context.Background(), testTxHash, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkStructLogs(t, result)
+
+	if _, err := api.TraceTransaction(context.Background(), common.HexToHash("0x01"), nil); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("expected an unknown transaction to be rejected, got %v", err)
+	}
+
+	// A transaction reported in the genesis block cannot be traced.
+	if _, err := NewAPI(newTestBackend(t, 1)).TraceTransaction(context.Background(), testTxHash, nil); err == nil || !strings.Contains(err.Error(), "genesis is not traceable") {
+		t.Errorf("expected the genesis transaction to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright 2022 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package native_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/theQRL/zond/common"
+	"github.com/theQRL/zond/common/hexutil"
+	"github.com/theQRL/zond/core"
+	"github.com/theQRL/zond/core/rawdb"
+	"github.com/theQRL/zond/core/state"
+	"github.com/theQRL/zond/core/types"
+	"github.com/theQRL/zond/core/vm"
+	"github.com/theQRL/zond/params"
+	"github.com/theQRL/zond/zond/tracers"
+	_ "github.com/theQRL/zond/zond/tracers/native"
+)
+
+var (
+	sender   = common.HexToAddress("0x00000000000000000000000000000000000000f1")
+	coinbase = common.HexToAddress("0x00000000000000000000000000000000000000cb")
+	caller   = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	callee   = common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	reverter = common.HexToAddress("0x00000000000000000000000000000000000000cc")
+
+	senderBalance = big.NewInt(1000000000000000000)
+
+	// calleeCode stores 1 in slot 0 and emits an empty LOG0.
+	calleeCode = common.FromHex("0x600160005560006000a000")
+
+	// callerCode forwards its calldata to the callee with a CALL.
+	callerCode = common.FromHex("0x366000600037600060003660006000" + "73" + common.Bytes2Hex(callee.Bytes()) + "5af100")
+
+	// reverterCode reverts with Error("boom"), the ABI data following the code.
+	reverterCode = common.FromHex("0x6064600c6000396064" + "6000fd" +
+		"08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000004" +
+		"626f6f6d00000000000000000000000000000000000000000000000000000000")
+
+	// transferInput is a call to transfer(address,uint256) with a single argument word.
+	transferInput = common.FromHex("0xa9059cbb000000000000000000000000000000000000000000000000000000000000002a")
+)
+
+// newTestState returns a state holding the funded sender and the test contracts.
+func newTestState(t *testing.T) *state.StateDB {
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	statedb.AddBalance(sender, senderBalance)
+	statedb.SetCode(caller, callerCode)
+	statedb.SetCode(callee, calleeCode)
+	statedb.SetCode(reverter, reverterCode)
+	statedb.SetState(callee, common.Hash{}, common.BigToHash(big.NewInt(7)))
+	return statedb
+}
+
+// runTracer applies a call from the sender to the given contract on a fresh test
+// state and returns the result of the named tracer.
+func runTracer(t *testing.T, name string, cfg string, to common.Address, input []byte) json.RawMessage {
+	var config json.RawMessage
+	if cfg != "" {
+		config = json.RawMessage(cfg)
+	}
+	tracer, err := tracers.New(name, new(tracers.Context), config)
+	if err != nil {
+		t.Fatalf("failed to create %s: %v", name, err)
+	}
+	random := common.Hash{0x01}
+	blockCtx := vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		GetHash:     func(uint64) common.Hash { return common.Hash{} },
+		Coinbase:    coinbase,
+		BlockNumber: big.NewInt(1),
+		Time:        big.NewInt(1),
+		Difficulty:  new(big.Int),
+		BaseFee:     new(big.Int),
+		GasLimit:    1000000,
+		Random:      &random,
+	}
+	msg := types.NewMessage(sender, &to, 0, new(big.Int), 100000, big.NewInt(1), big.NewInt(1), big.NewInt(1), input, nil, false)
+	statedb := newTestState(t)
+	evm := vm.NewEVM(blockCtx, core.NewEVMTxContext(msg), statedb, params.TestChainConfig, vm.Config{Debug: true, Tracer: tracer})
+	if _, err := core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(msg.Gas())); err != nil {
+		t.Fatalf("failed to apply message: %v", err)
+	}
+	res, err := tracer.GetResult()
+	if err != nil {
+		t.Fatalf("failed to get %s result: %v", name, err)
+	}
+	return res
+}
+
+type callLog struct {
+	Address common.Address `json:"address"`
+	Topics  []common.Hash  `json:"topics"`
+	Data    hexutil.Bytes  `json:"data"`
+}
+
+type callFrame struct {
+	Type         string         `json:"type"`
+	From         common.Address `json:"from"`
+	To           common.Address `json:"to"`
+	Input        hexutil.Bytes  `json:"input"`
+	Output       hexutil.Bytes  `json:"output"`
+	Error        string         `json:"error"`
+	RevertReason string         `json:"revertReason"`
+	GasUsed      hexutil.Uint64 `json:"gasUsed"`
+	Calls        []callFrame    `json:"calls"`
+	Logs         []callLog      `json:"logs"`
+	Value        *hexutil.Big   `json:"value"`
+}
+
+func TestCallTracer(t *testing.T) {
+	var frame callFrame
+	if err := json.Unmarshal(runTracer(t, "callTracer", `{"withLog":true}`, caller, transferInput), &frame); err != nil {
+		t.Fatal(err)
+	}
+	if frame.Type != "CALL" || frame.From != sender || frame.To != caller {
+		t.Fatalf("unexpected top call %s from %x to %x", frame.Type, frame.From, frame.To)
+	}
+	if frame.Error != "" || frame.GasUsed == 0 {
+		t.Errorf("unexpected top call result: error %q, gas used %d", frame.Error, frame.GasUsed)
+	}
+	if len(frame.Calls) != 1 {
+		t.Fatalf("expected a single sub call, got %d", len(frame.Calls))
+	}
+	sub := frame.Calls[0]
+	if sub.Type != "CALL" || sub.From != caller || sub.To != callee {
+		t.Errorf("unexpected sub call %s from %x to %x", sub.Type, sub.From, sub.To)
+	}
+	if common.Bytes2Hex(sub.Input) != common.Bytes2Hex(transferInput) {
+		t.Errorf("unexpected sub call input %x", sub.Input)
+	}
+	if len(sub.Logs) != 1 || sub.Logs[0].Address != callee || len(sub.Logs[0].Topics) != 0 {
+		t.Errorf("unexpected sub call logs %+v", sub.Logs)
+	}
+
+	// Without the log option no logs are collected and only the top call is kept on request.
+	var top callFrame
+	if err := json.Unmarshal(runTracer(t, "callTracer", `{"onlyTopCall":true}`, caller, transferInput), &top); err != nil {
+		t.Fatal(err)
+	}
+	if len(top.Calls) != 0 || len(top.Logs) != 0 {
+		t.Errorf("expected only the top call, got %d calls and %d logs", len(top.Calls), len(top.Logs))
+	}
+}
+
+func TestCallTracerRevertReason(t *testing.T) {
+	var frame callFrame
+	if err := json.Unmarshal(runTracer(t, "callTracer", "", reverter, nil), &frame); err != nil {
+		t.Fatal(err)
+	}
+	if frame.Error != vm.ErrExecutionReverted.Error() {
+		t.Errorf("unexpected error %q", frame.Error)
+	}
+	if frame.RevertReason != "boom" {
+		t.Errorf("unexpected revert reason %q", frame.RevertReason)
+	}
+	if len(frame.Output) != 100 {
+		t.Errorf("expected the revert data as output, got %d bytes", len(frame.Output))
+	}
+}
+
+type account struct {
+	Balance *hexutil.Big                `json:"balance"`
+	Code    hexutil.Bytes               `json:"code"`
+	Nonce   uint64                      `json:"nonce"`
+	Storage map[common.Hash]common.Hash `json:"storage"`
+}
+
+func TestPrestateTracer(t *testing.T) {
+	var pre map[common.Address]*account
+	if err := json.Unmarshal(runTracer(t, "prestateTracer", "", caller, transferInput), &pre); err != nil {
+		t.Fatal(err)
+	}
+	for _, addr := range []common.Address{sender, caller, callee, coinbase} {
+		if pre[addr] == nil {
+			t.Fatalf("account %x missing from the prestate", addr)
+		}
+	}
+	if len(pre) != 4 {
+		t.Errorf("expected 4 accounts in the prestate, got %d", len(pre))
+	}
+	// The sender is reported as it was before buying gas and bumping the nonce.
+	if pre[sender].Balance.ToInt().Cmp(senderBalance) != 0 || pre[sender].Nonce != 0 {
+		t.Errorf("unexpected sender prestate: balance %v, nonce %d", pre[sender].Balance, pre[sender].Nonce)
+	}
+	if common.Bytes2Hex(pre[callee].Code) != common.Bytes2Hex(calleeCode) {
+		t.Errorf("unexpected callee code %x", pre[callee].Code)
+	}
+	if have := pre[callee].Storage[common.Hash{}]; have != common.BigToHash(big.NewInt(7)) {
+		t.Errorf("expected the slot value before the call, got %x", have)
+	}
+}
+
+func TestPrestateTracerDiffMode(t *testing.T) {
+	var diff struct {
+		Pre  map[common.Address]*account `json:"pre"`
+		Post map[common.Address]*account `json:"post"`
+	}
+	if err := json.Unmarshal(runTracer(t, "prestateTracer", `{"diffMode":true}`, caller, transferInput), &diff); err != nil {
+		t.Fatal(err)
+	}
+	// The caller contract is read but left untouched.
+	if _, ok := diff.Pre[caller]; ok {
+		t.Error("unmodified caller contract reported in the diff")
+	}
+	if diff.Pre[sender] == nil || diff.Post[sender] == nil {
+		t.Fatal("sender missing from the diff")
+	}
+	if diff.Post[sender].Nonce != 1 || diff.Post[sender].Balance.ToInt().Cmp(senderBalance) >= 0 {
+		t.Errorf("unexpected sender poststate: balance %v, nonce %d", diff.Post[sender].Balance, diff.Post[sender].Nonce)
+	}
+	if diff.Pre[callee] == nil || diff.Post[callee] == nil {
+		t.Fatal("callee missing from the diff")
+	}
+	if have := diff.Pre[callee].Storage[common.Hash{}]; have != common.BigToHash(big.NewInt(7)) {
+		t.Errorf("unexpected slot value before the call %x", have)
+	}
+	if have := diff.Post[callee].Storage[common.Hash{}]; have != common.BigToHash(big.NewInt(1)) {
+		t.Errorf("unexpected slot value after the call %x", have)
+	}
+	if len(diff.Post[callee].Code) != 0 {
+		t.Error("unchanged callee code reported in the poststate")
+	}
+}
+
+func TestFourByteTracer(t *testing.T) {
+	var ids map[string]int
+	if err := json.Unmarshal(runTracer(t, "4byteTracer", "", caller, transferInput), &ids); err != nil {
+		t.Fatal(err)
+	}
+	// The selector is counted for the outer call and for the forwarded sub call.
+	if len(ids) != 1 || ids["0xa9059cbb-32"] != 2 {
+		t.Errorf("unexpected identifiers %v", ids)
+	}
+
+	var none map[string]int
+	if err := json.Unmarshal(runTracer(t, "4byteTracer", "", reverter, nil), &none); err != nil {
+		t.Fatal(err)
+	}
+	if len(none) != 0 {
+		t.Errorf("expected no identifiers for a call without input, got %v", none)
+	}
+}
+
+func TestTracerNotFound(t *testing.T) {
+	if _, err := tracers.New("noSuchTracer", new(tracers.Context), nil); err == nil {
+		t.Error("expected an unknown tracer to be rejected")
+	}
+}
@@ -29,32 +29,31 @@ import (
 	"github.com/theQRL/zond/core/txpool"
 	"github.com/theQRL/zond/core/types"
 	"github.com/theQRL/zond/zond/downloader"
-
-	// "github.com/theQRL/zond/zond/gasprice"
+	"github.com/theQRL/zond/zond/gasprice"
 
 	"github.com/theQRL/zond/miner"
 	"github.com/theQRL/zond/params"
 )
 
 // FullNodeGPO contains default gasprice oracle settings for full node.
-// var FullNodeGPO = gasprice.Config{
-// 	Blocks:           20,
-// 	Percentile:       60,
-// 	MaxHeaderHistory: 1024,
-// 	MaxBlockHistory:  1024,
-// 	MaxPrice:         gasprice.DefaultMaxPrice,
-// 	IgnorePrice:      gasprice.DefaultIgnorePrice,
-// }
+var FullNodeGPO = gasprice.Config{
+	Blocks:           20,
+	Percentile:       60,
+	MaxHeaderHistory: 1024,
+	MaxBlockHistory:  1024,
+	MaxPrice:         gasprice.DefaultMaxPrice,
+	IgnorePrice:      gasprice.DefaultIgnorePrice,
+}
 
 // LightClientGPO contains default gasprice oracle settings for light client.
-// var LightClientGPO = gasprice.Config{
-// 	Blocks:           2,
-// 	Percentile:       60,
-// 	MaxHeaderHistory: 300,
-// 	MaxBlockHistory:  5,
-// 	MaxPrice:         gasprice.DefaultMaxPrice,
-// 	IgnorePrice:      gasprice.DefaultIgnorePrice,
-// }
+var LightClientGPO = gasprice.Config{
+	Blocks:           2,
+	Percentile:       60,
+	MaxHeaderHistory: 300,
+	MaxBlockHistory:  5,
+	MaxPrice:         gasprice.DefaultMaxPrice,
+	IgnorePrice:      gasprice.DefaultIgnorePrice,
+}
 
 // Defaults contains default settings for use on the Ethereum main net.
 var Defaults = Config{
@@ -84,8 +83,8 @@ var Defaults = Config{
 	TxPool:                  txpool.DefaultTxPoolConfig,
 	RPCGasCap:               50000000,
 	RPCEVMTimeout:           5 * time.Second,
-	// GPO:                     FullNodeGPO,
-	RPCTxFeeCap: 1, // 1 ether
+	GPO:                     FullNodeGPO,
+	RPCTxFeeCap:             1, // 1 ether
 }
 
 // func init() {
@@ -130,6 +129,7 @@ type Config struct {
 	NoPrefetch bool // Whether to disable prefetching and only load state on demand
 
 	TxLookupLimit uint64 `toml:",omitempty"` // The maximum number of blocks from head whose tx indices are reserved.
+	AddressIndex  bool   `toml:",omitempty"` // Whether to index the transactions touching each address.
 
 	// RequiredBlocks is a set of block number -> hash mappings which must be in the
 	// canonical chain of all remote peers. Setting the option makes geth verify the
@@ -177,7 +177,7 @@ type Config struct {
 	TxPool txpool.TxPoolConfig
 
 	// Gas Price Oracle options
-	// GPO gasprice.Config
+	GPO gasprice.Config
 
 	// Enables tracking of SHA3 preimages in the VM
 	EnablePreimageRecording bool